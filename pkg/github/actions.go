@@ -0,0 +1,365 @@
+package github
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	// workflowRunListLimit is the maximum number of workflow runs of a commit that are looked at.
+	workflowRunListLimit = 100
+	// failedJobLogLimit is the maximum number of failed jobs whose logs are downloaded.
+	failedJobLogLimit = 10
+	// failedJobLogByteLimit is the maximum size of the log lines returned for all failed jobs.
+	failedJobLogByteLimit = 64 * 1024
+)
+
+var (
+	// ansiEscape matches the ANSI escape sequences that color job logs.
+	ansiEscape = regexp.MustCompile(`\x1b\[[0-9;]*[A-Za-z]`)
+	// logTimestamp matches the timestamp that prefixes every line of a job log.
+	logTimestamp = regexp.MustCompile(`^(\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(?:\.\d+)?Z) ?`)
+)
+
+// failedStepLog is the end of the log of a failed step of a job.
+type failedStepLog struct {
+	Number int64    `json:"number"`
+	Name   string   `json:"name"`
+	Lines  []string `json:"lines"`
+}
+
+// failedJobLog is the end of the log of a failed workflow job, split by failed step when the
+// lines can be attributed to steps.
+type failedJobLog struct {
+	ID         int64           `json:"id"`
+	Name       string          `json:"name"`
+	Workflow   string          `json:"workflow"`
+	Conclusion string          `json:"conclusion"`
+	HTMLURL    string          `json:"html_url"`
+	Steps      []failedStepLog `json:"steps,omitempty"`
+	Lines      []string        `json:"lines,omitempty"`
+}
+
+// failedJobLogs are the logs of the failed workflow jobs of the head commit of a pull request.
+type failedJobLogs struct {
+	HeadSHA   string         `json:"head_sha"`
+	Jobs      []failedJobLog `json:"jobs"`
+	Truncated bool           `json:"truncated"`
+	Note      string         `json:"note,omitempty"`
+}
+
+// GetFailedCheckLogs creates a tool to get the output of the failed workflow jobs of a pull request.
+func GetFailedCheckLogs(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_pr_failed_job_logs",
+			mcp.WithDescription(t("TOOL_GET_PR_FAILED_JOB_LOGS_DESCRIPTION", "Get the last lines of output of the failed GitHub Actions jobs of a pull request, per failed step, to find out why CI failed")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+			mcp.WithNumber("tail_lines",
+				mcp.Description("Number of lines to return from the end of each failed step (default 200)"),
+				mcp.Min(1),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			tailLines, err := OptionalIntParamWithDefault(request, "tail_lines", 200)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if tailLines < 1 {
+				return mcp.NewToolResultError("tail_lines must be at least 1"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			pr, resp, err := client.PullRequests.Get(ctx, owner, repo, pullNumber)
+			if err != nil {
+				if result := apiErrorResult("failed to get pull request", err, http.StatusNotFound); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to get pull request: %w", err)
+			}
+			_ = resp.Body.Close()
+			sha := pr.GetHead().GetSHA()
+
+			opts := &github.ListWorkflowRunsOptions{
+				HeadSHA:     sha,
+				ListOptions: github.ListOptions{PerPage: 100},
+			}
+			runs, _, err := fetchAllPages(ctx, &opts.ListOptions, workflowRunListLimit, func() ([]*github.WorkflowRun, *github.Response, error) {
+				result, resp, err := client.Actions.ListRepositoryWorkflowRuns(ctx, owner, repo, opts)
+				if err != nil {
+					return nil, resp, err
+				}
+				return result.WorkflowRuns, resp, nil
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to list workflow runs: %w", err)
+			}
+
+			type failedJob struct {
+				job      *github.WorkflowJob
+				workflow string
+			}
+			var failedJobs []failedJob
+			for _, run := range runs {
+				// Runs in progress can have failed jobs already.
+				if run.GetStatus() == "completed" && !failedCheckConclusions[run.GetConclusion()] {
+					continue
+				}
+				jobOpts := &github.ListWorkflowJobsOptions{
+					Filter:      "latest",
+					ListOptions: github.ListOptions{PerPage: 100},
+				}
+				jobs, _, err := fetchAllPages(ctx, &jobOpts.ListOptions, checkRunListLimit, func() ([]*github.WorkflowJob, *github.Response, error) {
+					result, resp, err := client.Actions.ListWorkflowJobs(ctx, owner, repo, run.GetID(), jobOpts)
+					if err != nil {
+						return nil, resp, err
+					}
+					return result.Jobs, resp, nil
+				})
+				if err != nil {
+					return nil, fmt.Errorf("failed to list jobs of workflow run %d: %w", run.GetID(), err)
+				}
+				for _, job := range jobs {
+					if failedCheckConclusions[job.GetConclusion()] {
+						failedJobs = append(failedJobs, failedJob{job: job, workflow: run.GetName()})
+					}
+				}
+			}
+
+			result := failedJobLogs{
+				HeadSHA: sha,
+				Jobs:    []failedJobLog{},
+			}
+			var notes []string
+			if len(failedJobs) == 0 {
+				notes = append(notes, fmt.Sprintf("no failed workflow jobs for commit %s", sha))
+			}
+			if len(failedJobs) > failedJobLogLimit {
+				notes = append(notes, fmt.Sprintf("only the logs of the first %d of %d failed jobs are returned", failedJobLogLimit, len(failedJobs)))
+				failedJobs = failedJobs[:failedJobLogLimit]
+			}
+
+			remaining := failedJobLogByteLimit
+			for _, failed := range failedJobs {
+				jobLog, err := getFailedJobLog(ctx, client, owner, repo, failed.job, tailLines)
+				if err != nil {
+					return nil, err
+				}
+				jobLog.Workflow = failed.workflow
+				if limitFailedJobLog(&jobLog, &remaining) {
+					result.Truncated = true
+				}
+				result.Jobs = append(result.Jobs, jobLog)
+			}
+			if result.Truncated {
+				notes = append(notes, fmt.Sprintf("logs were truncated to %d bytes in total, keeping the last lines of each step", failedJobLogByteLimit))
+			}
+			result.Note = strings.Join(notes, "; ")
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// getFailedJobLog downloads the log of a failed job and keeps the last tailLines lines of each
+// failed step. Lines are attributed to steps by their timestamps. When the job has no failed
+// step with timestamps, as for jobs that timed out or were cancelled, the last lines of the
+// whole log are kept instead.
+func getFailedJobLog(ctx context.Context, client *github.Client, owner, repo string, job *github.WorkflowJob, tailLines int) (failedJobLog, error) {
+	jobLog := failedJobLog{
+		ID:         job.GetID(),
+		Name:       job.GetName(),
+		Conclusion: job.GetConclusion(),
+		HTMLURL:    job.GetHTMLURL(),
+	}
+
+	logURL, resp, err := client.Actions.GetWorkflowJobLogs(ctx, owner, repo, job.GetID(), 1)
+	if err != nil {
+		// Logs expire, and are not available for jobs that never started.
+		if resp != nil && (resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone) {
+			jobLog.Lines = []string{fmt.Sprintf("log not available: %s", resp.Status)}
+			return jobLog, nil
+		}
+		return jobLog, fmt.Errorf("failed to get log of job %d: %w", job.GetID(), err)
+	}
+
+	// The log is stored outside of the API, at a URL that carries its own authorization.
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, logURL.String(), nil)
+	if err != nil {
+		return jobLog, fmt.Errorf("failed to create request for log of job %d: %w", job.GetID(), err)
+	}
+	logResp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return jobLog, fmt.Errorf("failed to download log of job %d: %w", job.GetID(), err)
+	}
+	defer func() { _ = logResp.Body.Close() }()
+	if logResp.StatusCode != http.StatusOK {
+		return jobLog, fmt.Errorf("failed to download log of job %d: %s", job.GetID(), logResp.Status)
+	}
+
+	type stepWindow struct {
+		step       *github.TaskStep
+		start, end time.Time
+		tail       *tailBuffer
+	}
+	var steps []*stepWindow
+	for _, step := range job.Steps {
+		if step.GetConclusion() != "failure" || step.StartedAt == nil || step.CompletedAt == nil {
+			continue
+		}
+		// Step times are truncated to seconds, unlike the times of log lines.
+		steps = append(steps, &stepWindow{
+			step:  step,
+			start: step.GetStartedAt().Time,
+			end:   step.GetCompletedAt().Time.Add(time.Second),
+			tail:  newTailBuffer(tailLines),
+		})
+	}
+	jobTail := newTailBuffer(tailLines)
+
+	// Lines without a timestamp continue the step of the line before them.
+	var current *stepWindow
+	err = readLogLines(logResp.Body, func(line string) {
+		if match := logTimestamp.FindStringSubmatch(line); match != nil {
+			line = line[len(match[0]):]
+			current = nil
+			if timestamp, err := time.Parse(time.RFC3339Nano, match[1]); err == nil {
+				for _, step := range steps {
+					if !timestamp.Before(step.start) && timestamp.Before(step.end) {
+						current = step
+						break
+					}
+				}
+			}
+		}
+		line = ansiEscape.ReplaceAllString(line, "")
+		jobTail.add(line)
+		if current != nil {
+			current.tail.add(line)
+		}
+	})
+	if err != nil {
+		return jobLog, fmt.Errorf("failed to read log of job %d: %w", job.GetID(), err)
+	}
+
+	for _, step := range steps {
+		jobLog.Steps = append(jobLog.Steps, failedStepLog{
+			Number: step.step.GetNumber(),
+			Name:   step.step.GetName(),
+			Lines:  step.tail.lines,
+		})
+	}
+	if len(steps) == 0 {
+		jobLog.Lines = jobTail.lines
+	}
+	return jobLog, nil
+}
+
+// readLogLines calls fn for every line of a log as it is read, so that the log is never held in
+// memory as a whole.
+func readLogLines(r io.Reader, fn func(line string)) error {
+	reader := bufio.NewReader(r)
+	first := true
+	for {
+		line, err := reader.ReadString('\n')
+		if line != "" {
+			line = strings.TrimRight(line, "\r\n")
+			if first {
+				line = strings.TrimPrefix(line, "\ufeff")
+				first = false
+			}
+			fn(line)
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// tailBuffer keeps the last lines added to it.
+type tailBuffer struct {
+	size  int
+	lines []string
+}
+
+func newTailBuffer(size int) *tailBuffer {
+	return &tailBuffer{size: size, lines: []string{}}
+}
+
+func (b *tailBuffer) add(line string) {
+	b.lines = append(b.lines, line)
+	if len(b.lines) > b.size {
+		b.lines = b.lines[1:]
+	}
+}
+
+// limitFailedJobLog drops the first lines of the steps of a job log that do not fit in the
+// remaining number of bytes, and reports whether any lines were dropped.
+func limitFailedJobLog(jobLog *failedJobLog, remaining *int) bool {
+	truncated := false
+	limit := func(lines []string) []string {
+		size := 0
+		for i := len(lines) - 1; i >= 0; i-- {
+			size += len(lines[i]) + 1
+			if size > *remaining {
+				truncated = true
+				*remaining -= size - len(lines[i]) - 1
+				return lines[i+1:]
+			}
+		}
+		*remaining -= size
+		return lines
+	}
+
+	for i := range jobLog.Steps {
+		jobLog.Steps[i].Lines = limit(jobLog.Steps[i].Lines)
+	}
+	if jobLog.Lines != nil {
+		jobLog.Lines = limit(jobLog.Lines)
+	}
+	return truncated
+}
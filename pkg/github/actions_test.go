@@ -0,0 +1,342 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetFailedCheckLogs(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetFailedCheckLogs(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_pr_failed_job_logs", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
+	assert.Contains(t, tool.InputSchema.Properties, "tail_lines")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+
+	// The job logs are downloaded from outside of the API.
+	logs := map[string]string{
+		"/jobs/11": "\ufeff2025-03-01T12:00:01.0000000Z ##[group]Run actions/checkout\r\n" +
+			"2025-03-01T12:00:02.0000000Z ##[endgroup]\r\n" +
+			"2025-03-01T12:00:06.1234567Z === RUN   TestParser\r\n" +
+			"2025-03-01T12:00:06.2000000Z \x1b[31mFAIL\x1b[0m TestParser\r\n" +
+			"    parser_test.go:12: unexpected token\r\n" +
+			"2025-03-01T12:00:10.5000000Z ##[error]Process completed with exit code 1.\r\n" +
+			"2025-03-01T12:00:12.0000000Z Post job cleanup.\r\n",
+		"/jobs/21": "2025-03-01T12:00:01.0000000Z Run make e2e\n" +
+			"2025-03-01T12:59:59.0000000Z waiting for server\n" +
+			"2025-03-01T13:00:00.0000000Z ##[error]The job running on runner has exceeded the maximum execution time of 60 minutes.\n",
+	}
+	logServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		log, ok := logs[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(log))
+	}))
+	defer logServer.Close()
+
+	// jobLogsHandler redirects to the log of a job, as the API does.
+	jobLogsHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The path is /repos/{owner}/{repo}/actions/jobs/{job_id}/logs.
+		jobID := strings.Split(r.URL.Path, "/")[6]
+		if jobID == "31" {
+			w.WriteHeader(http.StatusGone)
+			_, _ = w.Write([]byte(`{"message": "Gone"}`))
+			return
+		}
+		w.Header().Set("Location", fmt.Sprintf("%s/jobs/%s", logServer.URL, jobID))
+		w.WriteHeader(http.StatusFound)
+	})
+
+	at := func(value string) *github.Timestamp {
+		ts, err := time.Parse(time.RFC3339, value)
+		require.NoError(t, err)
+		return &github.Timestamp{Time: ts}
+	}
+	mockPR := &github.PullRequest{
+		Number: github.Ptr(42),
+		Head:   &github.PullRequestBranch{SHA: github.Ptr("abc123")},
+	}
+	mockRuns := &github.WorkflowRuns{
+		TotalCount: github.Ptr(2),
+		WorkflowRuns: []*github.WorkflowRun{
+			{ID: github.Ptr(int64(1)), Name: github.Ptr("CI"), Status: github.Ptr("completed"), Conclusion: github.Ptr("failure")},
+			{ID: github.Ptr(int64(2)), Name: github.Ptr("Lint"), Status: github.Ptr("completed"), Conclusion: github.Ptr("success")},
+		},
+	}
+	testJob := &github.WorkflowJob{
+		ID:         github.Ptr(int64(11)),
+		Name:       github.Ptr("test"),
+		Conclusion: github.Ptr("failure"),
+		HTMLURL:    github.Ptr("https://github.com/owner/repo/actions/runs/1/job/11"),
+		Steps: []*github.TaskStep{
+			{Number: github.Ptr(int64(1)), Name: github.Ptr("Checkout"), Conclusion: github.Ptr("success"), StartedAt: at("2025-03-01T12:00:00Z"), CompletedAt: at("2025-03-01T12:00:05Z")},
+			{Number: github.Ptr(int64(2)), Name: github.Ptr("Test"), Conclusion: github.Ptr("failure"), StartedAt: at("2025-03-01T12:00:05Z"), CompletedAt: at("2025-03-01T12:00:10Z")},
+			{Number: github.Ptr(int64(3)), Name: github.Ptr("Post Checkout"), Conclusion: github.Ptr("success"), StartedAt: at("2025-03-01T12:00:11Z"), CompletedAt: at("2025-03-01T12:00:12Z")},
+		},
+	}
+	buildJob := &github.WorkflowJob{
+		ID:         github.Ptr(int64(12)),
+		Name:       github.Ptr("build"),
+		Conclusion: github.Ptr("success"),
+	}
+	timedOutJob := &github.WorkflowJob{
+		ID:         github.Ptr(int64(21)),
+		Name:       github.Ptr("e2e"),
+		Conclusion: github.Ptr("timed_out"),
+		Steps: []*github.TaskStep{
+			{Number: github.Ptr(int64(1)), Name: github.Ptr("Run make e2e"), Conclusion: github.Ptr("cancelled"), StartedAt: at("2025-03-01T12:00:00Z"), CompletedAt: at("2025-03-01T13:00:00Z")},
+		},
+	}
+	expiredJob := &github.WorkflowJob{
+		ID:         github.Ptr(int64(31)),
+		Name:       github.Ptr("old"),
+		Conclusion: github.Ptr("failure"),
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectedLogs   failedJobLogs
+		expectedErrMsg string
+	}{
+		{
+			name: "last lines of failed step",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					mockPR,
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsRunsByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"head_sha": "abc123",
+						"per_page": "100",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockRuns),
+					),
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsRunsJobsByOwnerByRepoByRunId,
+					expectQueryParams(t, map[string]string{
+						"filter":   "latest",
+						"per_page": "100",
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.Jobs{
+							TotalCount: github.Ptr(2),
+							Jobs:       []*github.WorkflowJob{buildJob, testJob},
+						}),
+					),
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsJobsLogsByOwnerByRepoByJobId,
+					jobLogsHandler,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"tail_lines": float64(3),
+			},
+			expectedLogs: failedJobLogs{
+				HeadSHA: "abc123",
+				Jobs: []failedJobLog{
+					{
+						ID:         11,
+						Name:       "test",
+						Workflow:   "CI",
+						Conclusion: "failure",
+						HTMLURL:    "https://github.com/owner/repo/actions/runs/1/job/11",
+						Steps: []failedStepLog{
+							{
+								Number: 2,
+								Name:   "Test",
+								Lines: []string{
+									"FAIL TestParser",
+									"    parser_test.go:12: unexpected token",
+									"##[error]Process completed with exit code 1.",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "job without failed step and expired log",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					mockPR,
+				),
+				mock.WithRequestMatch(
+					mock.GetReposActionsRunsByOwnerByRepo,
+					&github.WorkflowRuns{
+						TotalCount:   github.Ptr(1),
+						WorkflowRuns: []*github.WorkflowRun{{ID: github.Ptr(int64(3)), Name: github.Ptr("E2E"), Status: github.Ptr("in_progress")}},
+					},
+				),
+				mock.WithRequestMatch(
+					mock.GetReposActionsRunsJobsByOwnerByRepoByRunId,
+					&github.Jobs{
+						TotalCount: github.Ptr(2),
+						Jobs:       []*github.WorkflowJob{timedOutJob, expiredJob},
+					},
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsJobsLogsByOwnerByRepoByJobId,
+					jobLogsHandler,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"tail_lines": float64(2),
+			},
+			expectedLogs: failedJobLogs{
+				HeadSHA: "abc123",
+				Jobs: []failedJobLog{
+					{
+						ID:         21,
+						Name:       "e2e",
+						Workflow:   "E2E",
+						Conclusion: "timed_out",
+						Lines: []string{
+							"waiting for server",
+							"##[error]The job running on runner has exceeded the maximum execution time of 60 minutes.",
+						},
+					},
+					{
+						ID:         31,
+						Name:       "old",
+						Workflow:   "E2E",
+						Conclusion: "failure",
+						Lines:      []string{"log not available: 410 Gone"},
+					},
+				},
+			},
+		},
+		{
+			name: "no failed jobs",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					mockPR,
+				),
+				mock.WithRequestMatch(
+					mock.GetReposActionsRunsByOwnerByRepo,
+					&github.WorkflowRuns{
+						TotalCount:   github.Ptr(1),
+						WorkflowRuns: mockRuns.WorkflowRuns[1:],
+					},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectedLogs: failedJobLogs{
+				HeadSHA: "abc123",
+				Jobs:    []failedJobLog{},
+				Note:    "no failed workflow jobs for commit abc123",
+			},
+		},
+		{
+			name: "pull request not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(999),
+			},
+			expectedErrMsg: "failed to get pull request",
+		},
+		{
+			name:         "negative tail_lines",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"tail_lines": float64(-5),
+			},
+			expectedErrMsg: "tail_lines must be at least 1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetFailedCheckLogs(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+			require.False(t, result.IsError, textContent.Text)
+
+			// Unmarshal and verify the result
+			var returned failedJobLogs
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedLogs, returned)
+		})
+	}
+}
+
+func Test_LimitFailedJobLog(t *testing.T) {
+	jobLog := failedJobLog{
+		Steps: []failedStepLog{
+			{Number: 1, Lines: []string{"aaaa", "bbbb", "cccc"}},
+			{Number: 2, Lines: []string{"dddd", "eeee"}},
+		},
+	}
+
+	// Each line takes its length and a newline.
+	remaining := 12
+	assert.True(t, limitFailedJobLog(&jobLog, &remaining))
+	assert.Equal(t, []string{"bbbb", "cccc"}, jobLog.Steps[0].Lines)
+	assert.Equal(t, []string{}, jobLog.Steps[1].Lines)
+	assert.Equal(t, 2, remaining)
+
+	remaining = 100
+	assert.False(t, limitFailedJobLog(&jobLog, &remaining))
+	assert.Equal(t, 90, remaining)
+}
@@ -0,0 +1,455 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// maxRequiredApprovingReviews is the largest number of approving reviews a branch
+// protection can require.
+const maxRequiredApprovingReviews = 6
+
+// branchProtection is the classic protection of a branch, flattened. Settings that are
+// off are omitted, so an unprotected branch is just {"protected": false}.
+type branchProtection struct {
+	Protected bool `json:"protected"`
+	// RequiredApprovingReviews is only set when pull requests are required before merging.
+	RequiredApprovingReviews *int `json:"required_approving_reviews,omitempty"`
+	DismissStaleReviews      bool `json:"dismiss_stale_reviews,omitempty"`
+	RequireCodeOwnerReviews  bool `json:"require_code_owner_reviews,omitempty"`
+	// RequiredStatusChecks are the names of the checks that must pass before merging.
+	RequiredStatusChecks []string `json:"required_status_checks,omitempty"`
+	StrictStatusChecks   bool     `json:"strict_status_checks,omitempty"`
+	EnforceAdmins        bool     `json:"enforce_admins,omitempty"`
+	// RestrictPushes limits pushes to the listed users, teams and apps.
+	RestrictPushes   bool     `json:"restrict_pushes,omitempty"`
+	PushUsers        []string `json:"push_users,omitempty"`
+	PushTeams        []string `json:"push_teams,omitempty"`
+	PushApps         []string `json:"push_apps,omitempty"`
+	AllowForcePushes bool     `json:"allow_force_pushes,omitempty"`
+}
+
+// newBranchProtection flattens the protection of a branch.
+func newBranchProtection(protection *github.Protection) branchProtection {
+	flat := branchProtection{Protected: true}
+
+	// The API omits settings it has no value for, rather than reporting them off.
+	if enforceAdmins := protection.GetEnforceAdmins(); enforceAdmins != nil {
+		flat.EnforceAdmins = enforceAdmins.Enabled
+	}
+	if forcePushes := protection.GetAllowForcePushes(); forcePushes != nil {
+		flat.AllowForcePushes = forcePushes.Enabled
+	}
+
+	if reviews := protection.GetRequiredPullRequestReviews(); reviews != nil {
+		flat.RequiredApprovingReviews = github.Ptr(reviews.RequiredApprovingReviewCount)
+		flat.DismissStaleReviews = reviews.DismissStaleReviews
+		flat.RequireCodeOwnerReviews = reviews.RequireCodeOwnerReviews
+	}
+
+	if checks := protection.GetRequiredStatusChecks(); checks != nil {
+		flat.StrictStatusChecks = checks.Strict
+		switch {
+		case checks.Checks != nil:
+			for _, check := range *checks.Checks {
+				flat.RequiredStatusChecks = append(flat.RequiredStatusChecks, check.Context)
+			}
+		case checks.Contexts != nil:
+			flat.RequiredStatusChecks = append(flat.RequiredStatusChecks, *checks.Contexts...)
+		}
+	}
+
+	if restrictions := protection.GetRestrictions(); restrictions != nil {
+		flat.RestrictPushes = true
+		for _, user := range restrictions.Users {
+			flat.PushUsers = append(flat.PushUsers, user.GetLogin())
+		}
+		for _, team := range restrictions.Teams {
+			flat.PushTeams = append(flat.PushTeams, team.GetSlug())
+		}
+		for _, app := range restrictions.Apps {
+			flat.PushApps = append(flat.PushApps, app.GetSlug())
+		}
+	}
+
+	return flat
+}
+
+// protectionRequest translates a flattened protection into the payload replacing the
+// protection of a branch. Settings that are off are sent as null, which is how the API
+// turns them off.
+func (p branchProtection) protectionRequest() *github.ProtectionRequest {
+	request := &github.ProtectionRequest{
+		EnforceAdmins:    p.EnforceAdmins,
+		AllowForcePushes: github.Ptr(p.AllowForcePushes),
+	}
+
+	if p.RequiredApprovingReviews != nil {
+		request.RequiredPullRequestReviews = &github.PullRequestReviewsEnforcementRequest{
+			RequiredApprovingReviewCount: *p.RequiredApprovingReviews,
+			DismissStaleReviews:          p.DismissStaleReviews,
+			RequireCodeOwnerReviews:      p.RequireCodeOwnerReviews,
+		}
+	}
+
+	if len(p.RequiredStatusChecks) > 0 || p.StrictStatusChecks {
+		checks := make([]*github.RequiredStatusCheck, 0, len(p.RequiredStatusChecks))
+		for _, name := range p.RequiredStatusChecks {
+			checks = append(checks, &github.RequiredStatusCheck{Context: name})
+		}
+		request.RequiredStatusChecks = &github.RequiredStatusChecks{
+			Strict: p.StrictStatusChecks,
+			Checks: &checks,
+		}
+	}
+
+	if p.RestrictPushes {
+		// The API rejects null lists, restricting pushes to nobody takes empty ones.
+		request.Restrictions = &github.BranchRestrictionsRequest{
+			Users: append([]string{}, p.PushUsers...),
+			Teams: append([]string{}, p.PushTeams...),
+			Apps:  append([]string{}, p.PushApps...),
+		}
+	}
+
+	return request
+}
+
+// keepUnflattenedSettings carries the settings that are not part of the flattened protection
+// over from the current protection of a branch into request, since replacing the protection
+// would otherwise turn them off.
+func keepUnflattenedSettings(request *github.ProtectionRequest, current *github.Protection) {
+	if linearHistory := current.GetRequireLinearHistory(); linearHistory != nil {
+		request.RequireLinearHistory = github.Ptr(linearHistory.Enabled)
+	}
+	if conversationResolution := current.GetRequiredConversationResolution(); conversationResolution != nil {
+		request.RequiredConversationResolution = github.Ptr(conversationResolution.Enabled)
+	}
+	if deletions := current.GetAllowDeletions(); deletions != nil {
+		request.AllowDeletions = github.Ptr(deletions.Enabled)
+	}
+	if blockCreations := current.GetBlockCreations(); blockCreations != nil {
+		request.BlockCreations = blockCreations.Enabled
+	}
+	if lockBranch := current.GetLockBranch(); lockBranch != nil {
+		request.LockBranch = lockBranch.Enabled
+	}
+	if forkSyncing := current.GetAllowForkSyncing(); forkSyncing != nil {
+		request.AllowForkSyncing = forkSyncing.Enabled
+	}
+
+	if reviews, currentReviews := request.RequiredPullRequestReviews, current.GetRequiredPullRequestReviews(); reviews != nil && currentReviews != nil {
+		reviews.RequireLastPushApproval = github.Ptr(currentReviews.RequireLastPushApproval)
+		if restrictions := currentReviews.DismissalRestrictions; restrictions != nil {
+			users, teams, apps := actorNames(restrictions.Users, restrictions.Teams, restrictions.Apps)
+			reviews.DismissalRestrictionsRequest = &github.DismissalRestrictionsRequest{
+				Users: &users,
+				Teams: &teams,
+				Apps:  &apps,
+			}
+		}
+		if allowances := currentReviews.BypassPullRequestAllowances; allowances != nil {
+			users, teams, apps := actorNames(allowances.Users, allowances.Teams, allowances.Apps)
+			reviews.BypassPullRequestAllowancesRequest = &github.BypassPullRequestAllowancesRequest{
+				Users: users,
+				Teams: teams,
+				Apps:  apps,
+			}
+		}
+	}
+
+	// Checks that are still required keep the app that must provide them.
+	if checks, currentChecks := request.RequiredStatusChecks, current.GetRequiredStatusChecks(); checks != nil && currentChecks != nil && currentChecks.Checks != nil {
+		appIDs := make(map[string]*int64)
+		for _, check := range *currentChecks.Checks {
+			appIDs[check.Context] = check.AppID
+		}
+		for _, check := range *checks.Checks {
+			check.AppID = appIDs[check.Context]
+		}
+	}
+}
+
+// actorNames returns the logins of users and the slugs of teams and apps, as non-nil lists.
+func actorNames(users []*github.User, teams []*github.Team, apps []*github.App) ([]string, []string, []string) {
+	logins := make([]string, 0, len(users))
+	for _, user := range users {
+		logins = append(logins, user.GetLogin())
+	}
+	teamSlugs := make([]string, 0, len(teams))
+	for _, team := range teams {
+		teamSlugs = append(teamSlugs, team.GetSlug())
+	}
+	appSlugs := make([]string, 0, len(apps))
+	for _, app := range apps {
+		appSlugs = append(appSlugs, app.GetSlug())
+	}
+	return logins, teamSlugs, appSlugs
+}
+
+// GetBranchProtection creates a tool to get the classic protection of a branch.
+func GetBranchProtection(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_branch_protection",
+			mcp.WithDescription(t("TOOL_GET_BRANCH_PROTECTION_DESCRIPTION", "Get the classic protection of a branch: required reviews and status checks, admin enforcement, push restrictions and force pushes")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("branch",
+				mcp.Required(),
+				mcp.Description("Branch name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			branch, err := requiredParam[string](request, "branch")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			var flat branchProtection
+			protection, resp, err := client.Repositories.GetBranchProtection(ctx, owner, repo, branch)
+			switch {
+			case errors.Is(err, github.ErrBranchNotProtected):
+				// An unprotected branch is an answer, not a failure.
+			case err != nil:
+				if result := apiErrorResult("failed to get branch protection", err, http.StatusNotFound, http.StatusForbidden); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to get branch protection: %w", err)
+			default:
+				defer func() { _ = resp.Body.Close() }()
+				flat = newBranchProtection(protection)
+			}
+
+			r, err := json.Marshal(flat)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// UpdateBranchProtection creates a tool to replace the classic protection of a branch.
+func UpdateBranchProtection(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("update_branch_protection",
+			mcp.WithDescription(t("TOOL_UPDATE_BRANCH_PROTECTION_DESCRIPTION", "Replace the classic protection of a branch. Settings that are not given are turned off, so pass every setting to keep, as returned by get_branch_protection. Settings without a parameter here, like linear history, required signatures or dismissal restrictions, are kept as they are")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("branch",
+				mcp.Required(),
+				mcp.Description("Branch name"),
+			),
+			mcp.WithNumber("required_approving_reviews",
+				mcp.Description("Require pull requests with this many approving reviews (0 to 6) before merging"),
+				mcp.Min(0),
+				mcp.Max(maxRequiredApprovingReviews),
+			),
+			mcp.WithBoolean("dismiss_stale_reviews",
+				mcp.Description("Dismiss approving reviews when new commits are pushed, requires required_approving_reviews"),
+			),
+			mcp.WithBoolean("require_code_owner_reviews",
+				mcp.Description("Require a review from the code owners, requires required_approving_reviews"),
+			),
+			mcp.WithArray("required_status_checks",
+				mcp.Description("Names of the status checks that must pass before merging"),
+				mcp.Items(
+					map[string]interface{}{
+						"type": "string",
+					},
+				),
+			),
+			mcp.WithBoolean("strict_status_checks",
+				mcp.Description("Require branches to be up to date with the branch before merging"),
+			),
+			mcp.WithBoolean("enforce_admins",
+				mcp.Description("Apply the protection to administrators too"),
+			),
+			mcp.WithBoolean("restrict_pushes",
+				mcp.Description("Only allow the listed users, teams and apps to push, implied when any is listed"),
+			),
+			mcp.WithArray("push_users",
+				mcp.Description("Logins of the users allowed to push"),
+				mcp.Items(
+					map[string]interface{}{
+						"type": "string",
+					},
+				),
+			),
+			mcp.WithArray("push_teams",
+				mcp.Description("Slugs of the teams allowed to push"),
+				mcp.Items(
+					map[string]interface{}{
+						"type": "string",
+					},
+				),
+			),
+			mcp.WithArray("push_apps",
+				mcp.Description("Slugs of the apps allowed to push"),
+				mcp.Items(
+					map[string]interface{}{
+						"type": "string",
+					},
+				),
+			),
+			mcp.WithBoolean("allow_force_pushes",
+				mcp.Description("Allow force pushes by anyone with push access"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			branch, err := requiredParam[string](request, "branch")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			protection, err := branchProtectionParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			protectionRequest := protection.protectionRequest()
+
+			// Replacing the protection resets whatever the request leaves out, so the
+			// settings update_branch_protection has no parameters for are read first.
+			current, resp, err := client.Repositories.GetBranchProtection(ctx, owner, repo, branch)
+			switch {
+			case errors.Is(err, github.ErrBranchNotProtected):
+				// There is nothing to keep on an unprotected branch.
+			case err != nil:
+				if result := apiErrorResult("failed to get branch protection", err, http.StatusNotFound, http.StatusForbidden); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to get branch protection: %w", err)
+			default:
+				_ = resp.Body.Close()
+				keepUnflattenedSettings(protectionRequest, current)
+			}
+
+			updated, resp, err := client.Repositories.UpdateBranchProtection(ctx, owner, repo, branch, protectionRequest)
+			if err != nil {
+				if result := apiErrorResult("failed to update branch protection", err, http.StatusNotFound, http.StatusForbidden, http.StatusUnprocessableEntity); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to update branch protection: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			// Required signatures have their own endpoint, and are turned back on if the
+			// replaced protection required them.
+			if current.GetRequiredSignatures().GetEnabled() && !updated.GetRequiredSignatures().GetEnabled() {
+				_, resp, err := client.Repositories.RequireSignaturesOnProtectedBranch(ctx, owner, repo, branch)
+				if err != nil {
+					if result := apiErrorResult("failed to keep required signatures", err, http.StatusNotFound, http.StatusForbidden); result != nil {
+						return result, nil
+					}
+					return nil, fmt.Errorf("failed to keep required signatures: %w", err)
+				}
+				defer func() { _ = resp.Body.Close() }()
+			}
+
+			r, err := json.Marshal(newBranchProtection(updated))
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// branchProtectionParams reads the flattened protection given to update_branch_protection.
+func branchProtectionParams(request mcp.CallToolRequest) (branchProtection, error) {
+	protection := branchProtection{Protected: true}
+
+	reviews, hasReviews, err := OptionalParamOK[float64](request, "required_approving_reviews")
+	if err != nil {
+		return protection, err
+	}
+	if hasReviews {
+		if reviews < 0 || reviews > maxRequiredApprovingReviews || reviews != float64(int(reviews)) {
+			return protection, fmt.Errorf("required_approving_reviews must be a whole number from 0 to %d", maxRequiredApprovingReviews)
+		}
+		protection.RequiredApprovingReviews = github.Ptr(int(reviews))
+	}
+	if protection.DismissStaleReviews, err = OptionalParam[bool](request, "dismiss_stale_reviews"); err != nil {
+		return protection, err
+	}
+	if protection.RequireCodeOwnerReviews, err = OptionalParam[bool](request, "require_code_owner_reviews"); err != nil {
+		return protection, err
+	}
+	if !hasReviews && (protection.DismissStaleReviews || protection.RequireCodeOwnerReviews) {
+		return protection, errors.New("dismiss_stale_reviews and require_code_owner_reviews only apply together with required_approving_reviews")
+	}
+
+	if protection.RequiredStatusChecks, err = OptionalStringArrayParam(request, "required_status_checks"); err != nil {
+		return protection, err
+	}
+	if protection.StrictStatusChecks, err = OptionalParam[bool](request, "strict_status_checks"); err != nil {
+		return protection, err
+	}
+	if protection.EnforceAdmins, err = OptionalParam[bool](request, "enforce_admins"); err != nil {
+		return protection, err
+	}
+
+	if protection.RestrictPushes, err = OptionalParam[bool](request, "restrict_pushes"); err != nil {
+		return protection, err
+	}
+	if protection.PushUsers, err = OptionalStringArrayParam(request, "push_users"); err != nil {
+		return protection, err
+	}
+	if protection.PushTeams, err = OptionalStringArrayParam(request, "push_teams"); err != nil {
+		return protection, err
+	}
+	if protection.PushApps, err = OptionalStringArrayParam(request, "push_apps"); err != nil {
+		return protection, err
+	}
+	if len(protection.PushUsers) > 0 || len(protection.PushTeams) > 0 || len(protection.PushApps) > 0 {
+		protection.RestrictPushes = true
+	}
+
+	if protection.AllowForcePushes, err = OptionalParam[bool](request, "allow_force_pushes"); err != nil {
+		return protection, err
+	}
+
+	return protection, nil
+}
@@ -0,0 +1,528 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockProtection is the protection of a branch as returned by the API, with status checks
+// in both the checks and the deprecated contexts format.
+var mockProtection = &github.Protection{
+	RequiredStatusChecks: &github.RequiredStatusChecks{
+		Strict:   true,
+		Contexts: &[]string{"ci/build", "ci/test"},
+		Checks: &[]*github.RequiredStatusCheck{
+			{Context: "ci/build", AppID: github.Ptr(int64(15368))},
+			{Context: "ci/test"},
+		},
+	},
+	RequiredPullRequestReviews: &github.PullRequestReviewsEnforcement{
+		RequiredApprovingReviewCount: 2,
+		RequireCodeOwnerReviews:      true,
+	},
+	EnforceAdmins: &github.AdminEnforcement{Enabled: true},
+	Restrictions: &github.BranchRestrictions{
+		Users: []*github.User{{Login: github.Ptr("octocat")}},
+		Teams: []*github.Team{{Slug: github.Ptr("maintainers")}},
+		Apps:  []*github.App{},
+	},
+	AllowForcePushes: &github.AllowForcePushes{Enabled: false},
+}
+
+// mockFlatProtection is mockProtection flattened.
+var mockFlatProtection = branchProtection{
+	Protected:                true,
+	RequiredApprovingReviews: github.Ptr(2),
+	RequireCodeOwnerReviews:  true,
+	RequiredStatusChecks:     []string{"ci/build", "ci/test"},
+	StrictStatusChecks:       true,
+	EnforceAdmins:            true,
+	RestrictPushes:           true,
+	PushUsers:                []string{"octocat"},
+	PushTeams:                []string{"maintainers"},
+}
+
+// appliedProtection is the protection the API reports after applying a request.
+func appliedProtection(request *github.ProtectionRequest) *github.Protection {
+	protection := &github.Protection{
+		RequiredStatusChecks: request.RequiredStatusChecks,
+		EnforceAdmins:        &github.AdminEnforcement{Enabled: request.EnforceAdmins},
+		AllowForcePushes:     &github.AllowForcePushes{Enabled: request.GetAllowForcePushes()},
+	}
+	if reviews := request.RequiredPullRequestReviews; reviews != nil {
+		protection.RequiredPullRequestReviews = &github.PullRequestReviewsEnforcement{
+			RequiredApprovingReviewCount: reviews.RequiredApprovingReviewCount,
+			DismissStaleReviews:          reviews.DismissStaleReviews,
+			RequireCodeOwnerReviews:      reviews.RequireCodeOwnerReviews,
+		}
+	}
+	if restrictions := request.Restrictions; restrictions != nil {
+		protection.Restrictions = &github.BranchRestrictions{}
+		for _, login := range restrictions.Users {
+			protection.Restrictions.Users = append(protection.Restrictions.Users, &github.User{Login: github.Ptr(login)})
+		}
+		for _, slug := range restrictions.Teams {
+			protection.Restrictions.Teams = append(protection.Restrictions.Teams, &github.Team{Slug: github.Ptr(slug)})
+		}
+		for _, slug := range restrictions.Apps {
+			protection.Restrictions.Apps = append(protection.Restrictions.Apps, &github.App{Slug: github.Ptr(slug)})
+		}
+	}
+	return protection
+}
+
+func Test_BranchProtectionTranslation(t *testing.T) {
+	t.Run("nested to flat to nested", func(t *testing.T) {
+		flat := newBranchProtection(mockProtection)
+		assert.Equal(t, mockFlatProtection, flat)
+
+		payload, err := json.Marshal(flat.protectionRequest())
+		require.NoError(t, err)
+		assert.JSONEq(t, `{
+			"required_status_checks": {"strict": true, "checks": [{"context": "ci/build"}, {"context": "ci/test"}]},
+			"required_pull_request_reviews": {
+				"dismiss_stale_reviews": false,
+				"require_code_owner_reviews": true,
+				"required_approving_review_count": 2
+			},
+			"enforce_admins": true,
+			"restrictions": {"users": ["octocat"], "teams": ["maintainers"], "apps": []},
+			"allow_force_pushes": false
+		}`, string(payload))
+	})
+
+	tests := []struct {
+		name string
+		flat branchProtection
+	}{
+		{
+			name: "everything on",
+			flat: branchProtection{
+				Protected:                true,
+				RequiredApprovingReviews: github.Ptr(1),
+				DismissStaleReviews:      true,
+				RequireCodeOwnerReviews:  true,
+				RequiredStatusChecks:     []string{"build"},
+				StrictStatusChecks:       true,
+				EnforceAdmins:            true,
+				RestrictPushes:           true,
+				PushUsers:                []string{"octocat"},
+				PushTeams:                []string{"core"},
+				PushApps:                 []string{"deployer"},
+				AllowForcePushes:         true,
+			},
+		},
+		{
+			name: "everything off",
+			flat: branchProtection{Protected: true},
+		},
+		{
+			name: "pull requests without approvals",
+			flat: branchProtection{Protected: true, RequiredApprovingReviews: github.Ptr(0)},
+		},
+		{
+			name: "strict status checks without checks",
+			flat: branchProtection{Protected: true, StrictStatusChecks: true},
+		},
+		{
+			name: "pushes restricted to nobody",
+			flat: branchProtection{Protected: true, RestrictPushes: true},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run("flat to nested to flat: "+tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.flat, newBranchProtection(appliedProtection(tc.flat.protectionRequest())))
+		})
+	}
+}
+
+func Test_GetBranchProtection(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetBranchProtection(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_branch_protection", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "branch")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "branch"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectedText   string
+		expectedErrMsg string
+	}{
+		{
+			name: "protected branch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposBranchesProtectionByOwnerByRepoByBranch,
+					mockProtection,
+				),
+			),
+			expectedText: `{"protected":true,"required_approving_reviews":2,"require_code_owner_reviews":true,` +
+				`"required_status_checks":["ci/build","ci/test"],"strict_status_checks":true,"enforce_admins":true,` +
+				`"restrict_pushes":true,"push_users":["octocat"],"push_teams":["maintainers"]}`,
+		},
+		{
+			name: "unprotected branch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposBranchesProtectionByOwnerByRepoByBranch,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Branch not protected"}),
+				),
+			),
+			expectedText: `{"protected":false}`,
+		},
+		{
+			name: "sparse protection",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposBranchesProtectionByOwnerByRepoByBranch,
+					&github.Protection{
+						RequiredStatusChecks: &github.RequiredStatusChecks{
+							Contexts: &[]string{"ci/build"},
+						},
+					},
+				),
+			),
+			expectedText: `{"protected":true,"required_status_checks":["ci/build"]}`,
+		},
+		{
+			name: "branch not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposBranchesProtectionByOwnerByRepoByBranch,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Branch not found"}),
+				),
+			),
+			expectedErrMsg: "failed to get branch protection: Branch not found",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetBranchProtection(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "main",
+			}))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			assert.False(t, result.IsError)
+			assert.JSONEq(t, tc.expectedText, textContent.Text)
+		})
+	}
+}
+
+func Test_UpdateBranchProtection(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := UpdateBranchProtection(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "update_branch_protection", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "branch")
+	assert.Contains(t, tool.InputSchema.Properties, "required_approving_reviews")
+	assert.Contains(t, tool.InputSchema.Properties, "dismiss_stale_reviews")
+	assert.Contains(t, tool.InputSchema.Properties, "require_code_owner_reviews")
+	assert.Contains(t, tool.InputSchema.Properties, "required_status_checks")
+	assert.Contains(t, tool.InputSchema.Properties, "strict_status_checks")
+	assert.Contains(t, tool.InputSchema.Properties, "enforce_admins")
+	assert.Contains(t, tool.InputSchema.Properties, "restrict_pushes")
+	assert.Contains(t, tool.InputSchema.Properties, "push_users")
+	assert.Contains(t, tool.InputSchema.Properties, "push_teams")
+	assert.Contains(t, tool.InputSchema.Properties, "push_apps")
+	assert.Contains(t, tool.InputSchema.Properties, "allow_force_pushes")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "branch"})
+
+	// The current protection is read first, to keep the settings without a parameter.
+	mockUnprotected := mock.WithRequestMatchHandler(
+		mock.GetReposBranchesProtectionByOwnerByRepoByBranch,
+		mockResponse(t, http.StatusNotFound, map[string]string{"message": "Branch not protected"}),
+	)
+
+	tests := []struct {
+		name               string
+		mockedClient       *http.Client
+		requestArgs        map[string]interface{}
+		expectedProtection branchProtection
+		expectedErrMsg     string
+	}{
+		{
+			name: "flat settings sent nested",
+			mockedClient: mock.NewMockedHTTPClient(
+				mockUnprotected,
+				mock.WithRequestMatchHandler(
+					mock.PutReposBranchesProtectionByOwnerByRepoByBranch,
+					expectRequestBody(t, map[string]any{
+						"required_status_checks": map[string]any{
+							"strict": true,
+							"checks": []any{
+								map[string]any{"context": "ci/build"},
+								map[string]any{"context": "ci/test"},
+							},
+						},
+						"required_pull_request_reviews": map[string]any{
+							"dismiss_stale_reviews":           false,
+							"require_code_owner_reviews":      true,
+							"required_approving_review_count": float64(2),
+						},
+						"enforce_admins": true,
+						"restrictions": map[string]any{
+							"users": []any{"octocat"},
+							"teams": []any{"maintainers"},
+							"apps":  []any{},
+						},
+						"allow_force_pushes": false,
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockProtection),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":                      "owner",
+				"repo":                       "repo",
+				"branch":                     "main",
+				"required_approving_reviews": float64(2),
+				"require_code_owner_reviews": true,
+				"required_status_checks":     []any{"ci/build", "ci/test"},
+				"strict_status_checks":       true,
+				"enforce_admins":             true,
+				"push_users":                 []any{"octocat"},
+				"push_teams":                 []any{"maintainers"},
+			},
+			expectedProtection: mockFlatProtection,
+		},
+		{
+			name: "everything off",
+			mockedClient: mock.NewMockedHTTPClient(
+				mockUnprotected,
+				mock.WithRequestMatchHandler(
+					mock.PutReposBranchesProtectionByOwnerByRepoByBranch,
+					expectRequestBody(t, map[string]any{
+						"required_status_checks":        nil,
+						"required_pull_request_reviews": nil,
+						"enforce_admins":                false,
+						"restrictions":                  nil,
+						"allow_force_pushes":            true,
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.Protection{
+							EnforceAdmins:    &github.AdminEnforcement{Enabled: false},
+							AllowForcePushes: &github.AllowForcePushes{Enabled: true},
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":              "owner",
+				"repo":               "repo",
+				"branch":             "main",
+				"allow_force_pushes": true,
+			},
+			expectedProtection: branchProtection{Protected: true, AllowForcePushes: true},
+		},
+		{
+			name: "settings without a parameter kept",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposBranchesProtectionByOwnerByRepoByBranch,
+					&github.Protection{
+						RequiredStatusChecks: &github.RequiredStatusChecks{
+							Checks: &[]*github.RequiredStatusCheck{
+								{Context: "ci/build", AppID: github.Ptr(int64(15368))},
+							},
+						},
+						RequiredPullRequestReviews: &github.PullRequestReviewsEnforcement{
+							RequiredApprovingReviewCount: 1,
+							RequireLastPushApproval:      true,
+							DismissalRestrictions: &github.DismissalRestrictions{
+								Users: []*github.User{{Login: github.Ptr("octocat")}},
+							},
+							BypassPullRequestAllowances: &github.BypassPullRequestAllowances{
+								Teams: []*github.Team{{Slug: github.Ptr("release")}},
+							},
+						},
+						RequireLinearHistory:           &github.RequireLinearHistory{Enabled: true},
+						RequiredConversationResolution: &github.RequiredConversationResolution{Enabled: true},
+						AllowDeletions:                 &github.AllowDeletions{Enabled: false},
+						BlockCreations:                 &github.BlockCreations{Enabled: github.Ptr(true)},
+						LockBranch:                     &github.LockBranch{Enabled: github.Ptr(false)},
+						RequiredSignatures:             &github.SignaturesProtectedBranch{Enabled: github.Ptr(true)},
+					},
+				),
+				mock.WithRequestMatchHandler(
+					mock.PutReposBranchesProtectionByOwnerByRepoByBranch,
+					expectRequestBody(t, map[string]any{
+						"required_status_checks": map[string]any{
+							"strict": false,
+							"checks": []any{
+								map[string]any{"context": "ci/build", "app_id": float64(15368)},
+								map[string]any{"context": "ci/lint"},
+							},
+						},
+						"required_pull_request_reviews": map[string]any{
+							"dismissal_restrictions": map[string]any{
+								"users": []any{"octocat"},
+								"teams": []any{},
+								"apps":  []any{},
+							},
+							"bypass_pull_request_allowances": map[string]any{
+								"users": []any{},
+								"teams": []any{"release"},
+								"apps":  []any{},
+							},
+							"dismiss_stale_reviews":           false,
+							"require_code_owner_reviews":      false,
+							"required_approving_review_count": float64(2),
+							"require_last_push_approval":      true,
+						},
+						"enforce_admins":                   false,
+						"restrictions":                     nil,
+						"allow_force_pushes":               false,
+						"required_linear_history":          true,
+						"required_conversation_resolution": true,
+						"allow_deletions":                  false,
+						"block_creations":                  true,
+						"lock_branch":                      false,
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.Protection{
+							RequiredStatusChecks: &github.RequiredStatusChecks{
+								Checks: &[]*github.RequiredStatusCheck{
+									{Context: "ci/build", AppID: github.Ptr(int64(15368))},
+									{Context: "ci/lint"},
+								},
+							},
+							RequiredPullRequestReviews: &github.PullRequestReviewsEnforcement{
+								RequiredApprovingReviewCount: 2,
+								RequireLastPushApproval:      true,
+							},
+						}),
+					),
+				),
+				mock.WithRequestMatch(
+					mock.PostReposBranchesProtectionRequiredSignaturesByOwnerByRepoByBranch,
+					&github.SignaturesProtectedBranch{Enabled: github.Ptr(true)},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":                      "owner",
+				"repo":                       "repo",
+				"branch":                     "main",
+				"required_approving_reviews": float64(2),
+				"required_status_checks":     []any{"ci/build", "ci/lint"},
+			},
+			expectedProtection: branchProtection{
+				Protected:                true,
+				RequiredApprovingReviews: github.Ptr(2),
+				RequiredStatusChecks:     []string{"ci/build", "ci/lint"},
+			},
+		},
+		{
+			name: "branch not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposBranchesProtectionByOwnerByRepoByBranch,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Branch not found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "missing",
+			},
+			expectedErrMsg: "failed to get branch protection: Branch not found",
+		},
+		{
+			name:         "review options without reviews",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":                 "owner",
+				"repo":                  "repo",
+				"branch":                "main",
+				"dismiss_stale_reviews": true,
+			},
+			expectedErrMsg: "dismiss_stale_reviews and require_code_owner_reviews only apply together with required_approving_reviews",
+		},
+		{
+			name:         "too many reviews",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":                      "owner",
+				"repo":                       "repo",
+				"branch":                     "main",
+				"required_approving_reviews": float64(7),
+			},
+			expectedErrMsg: "required_approving_reviews must be a whole number from 0 to 6",
+		},
+		{
+			name: "unknown status check app",
+			mockedClient: mock.NewMockedHTTPClient(
+				mockUnprotected,
+				mock.WithRequestMatchHandler(
+					mock.PutReposBranchesProtectionByOwnerByRepoByBranch,
+					mockResponse(t, http.StatusUnprocessableEntity, map[string]string{"message": "Validation Failed"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":                  "owner",
+				"repo":                   "repo",
+				"branch":                 "main",
+				"required_status_checks": []any{"ci/build"},
+			},
+			expectedErrMsg: "failed to update branch protection: Validation Failed",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := UpdateBranchProtection(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedProtection branchProtection
+			err = json.Unmarshal([]byte(textContent.Text), &returnedProtection)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedProtection, returnedProtection)
+		})
+	}
+}
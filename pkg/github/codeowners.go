@@ -0,0 +1,151 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/google/go-github/v69/github"
+)
+
+// codeownersLocations are the paths a CODEOWNERS file can have, in the order GitHub looks
+// for them. Only the first one found is used.
+var codeownersLocations = []string{".github/CODEOWNERS", "CODEOWNERS", "docs/CODEOWNERS"}
+
+// codeownersRule is a line of a CODEOWNERS file.
+type codeownersRule struct {
+	Pattern string
+	Owners  []string
+	re      *regexp.Regexp
+}
+
+// codeownersRules are the rules of a CODEOWNERS file, in the order of the file.
+type codeownersRules []codeownersRule
+
+// owners returns the owners of a path, which are those of the last rule matching it. A
+// matching rule without owners leaves the path without owners.
+func (rules codeownersRules) owners(path string) []string {
+	for i := len(rules) - 1; i >= 0; i-- {
+		if rules[i].re.MatchString(path) {
+			return rules[i].Owners
+		}
+	}
+	return nil
+}
+
+// parseCodeowners parses the content of a CODEOWNERS file. Lines with patterns that are not
+// supported, like negations and character ranges, are skipped as GitHub ignores them too.
+func parseCodeowners(content string) codeownersRules {
+	var rules codeownersRules
+	for _, line := range strings.Split(content, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		re, err := compileCodeownersPattern(fields[0])
+		if err != nil {
+			continue
+		}
+
+		owners := []string{}
+		for _, owner := range fields[1:] {
+			if strings.HasPrefix(owner, "#") {
+				break
+			}
+			owners = append(owners, owner)
+		}
+		rules = append(rules, codeownersRule{Pattern: fields[0], Owners: owners, re: re})
+	}
+	return rules
+}
+
+// compileCodeownersPattern converts a CODEOWNERS pattern to a regular expression matching the
+// paths it applies to. Patterns follow the gitignore rules GitHub supports:
+//   - a pattern with a slash at the start or in the middle is relative to the root of the
+//     repository, other patterns match at any depth;
+//   - a trailing slash only matches the contents of a directory;
+//   - a pattern matching a directory matches everything in it, except for a trailing "/*",
+//     which only matches the files directly in the directory;
+//   - "*" and "?" match within a path segment, "**" matches across segments.
+func compileCodeownersPattern(pattern string) (*regexp.Regexp, error) {
+	if strings.HasPrefix(pattern, "!") || strings.ContainsAny(pattern, "[]") {
+		return nil, fmt.Errorf("unsupported pattern %q", pattern)
+	}
+
+	dirOnly := strings.HasSuffix(pattern, "/")
+	glob := strings.TrimSuffix(pattern, "/")
+	anchored := strings.Contains(glob, "/")
+	glob = strings.TrimPrefix(glob, "/")
+	if glob == "" {
+		return nil, fmt.Errorf("unsupported pattern %q", pattern)
+	}
+
+	var b strings.Builder
+	b.WriteString("^")
+	if !anchored {
+		b.WriteString("(?:.*/)?")
+	}
+	for i := 0; i < len(glob); i++ {
+		switch rest := glob[i:]; {
+		case i == 0 && strings.HasPrefix(rest, "**/"):
+			b.WriteString("(?:.*/)?")
+			i += 2
+		case strings.HasPrefix(rest, "/**/"):
+			b.WriteString("/(?:.*/)?")
+			i += 3
+		case strings.HasPrefix(rest, "**"):
+			b.WriteString(".*")
+			i++
+		case rest[0] == '*':
+			b.WriteString("[^/]*")
+		case rest[0] == '?':
+			b.WriteString("[^/]")
+		case rest[0] == '\\' && len(rest) > 1:
+			b.WriteString(regexp.QuoteMeta(rest[1:2]))
+			i++
+		default:
+			b.WriteString(regexp.QuoteMeta(rest[:1]))
+		}
+	}
+	switch {
+	case dirOnly:
+		b.WriteString("/.*")
+	case strings.HasSuffix(glob, "/*") && !strings.HasSuffix(glob, "/**"):
+		// Only the files directly in the directory.
+	default:
+		b.WriteString("(?:/.*)?")
+	}
+	b.WriteString("$")
+
+	return regexp.Compile(b.String())
+}
+
+// fetchCodeowners returns the path and rules of the CODEOWNERS file of a repository at a ref,
+// or an empty path when the repository has none.
+func fetchCodeowners(ctx context.Context, client *github.Client, owner, repo, ref string) (string, codeownersRules, error) {
+	for _, location := range codeownersLocations {
+		file, _, resp, err := client.Repositories.GetContents(ctx, owner, repo, location, &github.RepositoryContentGetOptions{Ref: ref})
+		if err != nil {
+			var errorResponse *github.ErrorResponse
+			if errors.As(err, &errorResponse) && errorResponse.Response != nil && errorResponse.Response.StatusCode == http.StatusNotFound {
+				continue
+			}
+			return "", nil, fmt.Errorf("failed to get %s: %w", location, err)
+		}
+		_ = resp.Body.Close()
+		// A directory at one of the locations is not a CODEOWNERS file.
+		if file == nil {
+			continue
+		}
+
+		content, err := file.GetContent()
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to decode %s: %w", location, err)
+		}
+		return location, parseCodeowners(content), nil
+	}
+	return "", nil, nil
+}
@@ -0,0 +1,128 @@
+package github
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CompileCodeownersPattern(t *testing.T) {
+	tests := []struct {
+		pattern    string
+		matches    []string
+		nonMatches []string
+	}{
+		{
+			pattern: "*",
+			matches: []string{"README.md", "src/main.go", "a/b/c/d.txt"},
+		},
+		{
+			pattern:    "*.js",
+			matches:    []string{"app.js", "src/app.js", "a/b/c.js"},
+			nonMatches: []string{"app.jsx", "app.js.map"},
+		},
+		{
+			pattern:    "docs",
+			matches:    []string{"docs", "docs/index.md", "src/docs/guide/setup.md"},
+			nonMatches: []string{"mydocs/index.md", "docs.md"},
+		},
+		{
+			// A trailing slash only matches the contents of a directory, at any depth.
+			pattern:    "apps/",
+			matches:    []string{"apps/web/index.js", "src/apps/cli/main.go"},
+			nonMatches: []string{"apps", "myapps/web/index.js"},
+		},
+		{
+			// A leading slash anchors the pattern to the root of the repository.
+			pattern:    "/docs/",
+			matches:    []string{"docs/index.md", "docs/guide/setup.md"},
+			nonMatches: []string{"src/docs/index.md", "docs"},
+		},
+		{
+			// A slash in the middle anchors the pattern too.
+			pattern:    "build/logs/",
+			matches:    []string{"build/logs/out.log", "build/logs/2025/out.log"},
+			nonMatches: []string{"src/build/logs/out.log", "build/logs"},
+		},
+		{
+			// A trailing "/*" only matches the files directly in the directory.
+			pattern:    "docs/*",
+			matches:    []string{"docs/getting-started.md"},
+			nonMatches: []string{"docs/build-app/troubleshooting.md", "src/docs/index.md"},
+		},
+		{
+			pattern:    "/scripts/**",
+			matches:    []string{"scripts/build.sh", "scripts/ci/test.sh"},
+			nonMatches: []string{"tools/scripts/build.sh"},
+		},
+		{
+			pattern:    "**/logs",
+			matches:    []string{"logs", "logs/out.log", "deploy/logs/out.log"},
+			nonMatches: []string{"deploy/logs.txt"},
+		},
+		{
+			pattern:    "apps/**/test",
+			matches:    []string{"apps/test/a.go", "apps/web/test/a.go", "apps/web/ui/test/a.go"},
+			nonMatches: []string{"apps/web/testing/a.go", "src/apps/test/a.go"},
+		},
+		{
+			pattern:    "file?.txt",
+			matches:    []string{"file1.txt", "data/fileA.txt"},
+			nonMatches: []string{"file10.txt", "file.txt"},
+		},
+		{
+			pattern:    `\#notes.md`,
+			matches:    []string{"#notes.md"},
+			nonMatches: []string{"notes.md"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.pattern, func(t *testing.T) {
+			re, err := compileCodeownersPattern(tc.pattern)
+			require.NoError(t, err)
+			for _, path := range tc.matches {
+				assert.True(t, re.MatchString(path), "%q should match %q", tc.pattern, path)
+			}
+			for _, path := range tc.nonMatches {
+				assert.False(t, re.MatchString(path), "%q should not match %q", tc.pattern, path)
+			}
+		})
+	}
+}
+
+func Test_CompileCodeownersPatternUnsupported(t *testing.T) {
+	for _, pattern := range []string{"!docs/", "[Dd]ocs/", "/"} {
+		_, err := compileCodeownersPattern(pattern)
+		assert.Error(t, err, pattern)
+	}
+}
+
+func Test_ParseCodeowners(t *testing.T) {
+	rules := parseCodeowners(`# Default owners
+*       @org/maintainers
+
+*.go    @gopher # Go code
+/docs/  @writer docs@example.com
+!vendor/ @nobody
+/docs/generated/
+`)
+
+	require.Len(t, rules, 4)
+	assert.Equal(t, []string{"@gopher"}, rules[1].Owners)
+
+	tests := []struct {
+		path     string
+		expected []string
+	}{
+		{path: "README.md", expected: []string{"@org/maintainers"}},
+		{path: "pkg/server.go", expected: []string{"@gopher"}},
+		{path: "docs/index.md", expected: []string{"@writer", "docs@example.com"}},
+		// The last matching rule applies, even when it has no owners.
+		{path: "docs/generated/api.md", expected: []string{}},
+	}
+	for _, tc := range tests {
+		assert.Equal(t, tc.expected, rules.owners(tc.path), tc.path)
+	}
+}
@@ -0,0 +1,427 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// repositoryPermissions are the permissions a user can have on a repository, from the
+// highest to the lowest.
+var repositoryPermissions = []string{"admin", "maintain", "push", "triage", "pull"}
+
+// collaborator is a trimmed down collaborator of a repository.
+type collaborator struct {
+	Login      string `json:"login"`
+	Permission string `json:"permission,omitempty"`
+	RoleName   string `json:"role_name,omitempty"`
+	HTMLURL    string `json:"html_url"`
+}
+
+// repositoryInvitation is a trimmed down pending invitation to collaborate on a repository.
+type repositoryInvitation struct {
+	ID         int64             `json:"id"`
+	Invitee    string            `json:"invitee"`
+	Inviter    string            `json:"inviter,omitempty"`
+	Permission string            `json:"permission"`
+	CreatedAt  *github.Timestamp `json:"created_at,omitempty"`
+	Expired    bool              `json:"expired,omitempty"`
+	HTMLURL    string            `json:"html_url"`
+}
+
+// addedCollaborator is the outcome of adding a collaborator: either an invitation the
+// user has to accept, or a direct change of the access the user already had.
+type addedCollaborator struct {
+	Username   string                `json:"username"`
+	Permission string                `json:"permission"`
+	Status     string                `json:"status"`
+	Invitation *repositoryInvitation `json:"invitation,omitempty"`
+}
+
+// userPermission is the access a user has on a repository.
+type userPermission struct {
+	Username   string `json:"username"`
+	Permission string `json:"permission"`
+	RoleName   string `json:"role_name,omitempty"`
+}
+
+// highestPermission returns the highest of the permissions a user has, or "" if none is set.
+func highestPermission(permissions map[string]bool) string {
+	for _, permission := range repositoryPermissions {
+		if permissions[permission] {
+			return permission
+		}
+	}
+	return ""
+}
+
+// ListCollaborators creates a tool to list the collaborators of a repository.
+func ListCollaborators(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_collaborators",
+			mcp.WithDescription(t("TOOL_LIST_COLLABORATORS_DESCRIPTION", "List the users with access to a repository, with their highest permission. Pending invitations are listed by list_repository_invitations")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("affiliation",
+				mcp.Description("Filter by affiliation: outside collaborators of an organization, users with direct access, or all (default)"),
+				mcp.Enum("outside", "direct", "all"),
+			),
+			mcp.WithString("permission",
+				mcp.Description("Only list collaborators with this permission"),
+				mcp.Enum(repositoryPermissions...),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			affiliation, err := OptionalParam[string](request, "affiliation")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			permission, err := OptionalParam[string](request, "permission")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts := &github.ListCollaboratorsOptions{
+				Affiliation: affiliation,
+				Permission:  permission,
+				ListOptions: github.ListOptions{
+					Page:    pagination.page,
+					PerPage: pagination.perPage,
+				},
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			users, resp, err := client.Repositories.ListCollaborators(ctx, owner, repo, opts)
+			if err != nil {
+				if result := apiErrorResult("failed to list collaborators", err, http.StatusNotFound, http.StatusForbidden); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to list collaborators: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			collaborators := make([]collaborator, 0, len(users))
+			for _, user := range users {
+				collaborators = append(collaborators, collaborator{
+					Login:      user.GetLogin(),
+					Permission: highestPermission(user.Permissions),
+					RoleName:   user.GetRoleName(),
+					HTMLURL:    user.GetHTMLURL(),
+				})
+			}
+
+			r, err := json.Marshal(collaborators)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// ListRepositoryInvitations creates a tool to list the pending invitations to collaborate on a repository.
+func ListRepositoryInvitations(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_repository_invitations",
+			mcp.WithDescription(t("TOOL_LIST_REPOSITORY_INVITATIONS_DESCRIPTION", "List the invitations to collaborate on a repository that are not accepted yet")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			invitations, resp, err := client.Repositories.ListInvitations(ctx, owner, repo, &github.ListOptions{
+				Page:    pagination.page,
+				PerPage: pagination.perPage,
+			})
+			if err != nil {
+				if result := apiErrorResult("failed to list invitations", err, http.StatusNotFound, http.StatusForbidden); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to list invitations: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			pending := make([]repositoryInvitation, 0, len(invitations))
+			for _, invitation := range invitations {
+				pending = append(pending, repositoryInvitation{
+					ID:         invitation.GetID(),
+					Invitee:    invitation.GetInvitee().GetLogin(),
+					Inviter:    invitation.GetInviter().GetLogin(),
+					Permission: invitation.GetPermissions(),
+					CreatedAt:  invitation.CreatedAt,
+					Expired:    invitation.GetExpired(),
+					HTMLURL:    invitation.GetHTMLURL(),
+				})
+			}
+
+			r, err := json.Marshal(pending)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// CheckUserPermission creates a tool to get the permission a user has on a repository.
+func CheckUserPermission(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_user_repository_permission",
+			mcp.WithDescription(t("TOOL_GET_USER_REPOSITORY_PERMISSION_DESCRIPTION", "Get the permission a user has on a repository, to check for access before making changes. Users without access have the permission none")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("username",
+				mcp.Description("Username to check, defaults to the authenticated user"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			username, err := OptionalParam[string](request, "username")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			if username == "" {
+				user, resp, err := client.Users.Get(ctx, "")
+				if err != nil {
+					return nil, fmt.Errorf("failed to get authenticated user: %w", err)
+				}
+				_ = resp.Body.Close()
+				username = user.GetLogin()
+			}
+
+			result := userPermission{
+				Username:   username,
+				Permission: "none",
+			}
+			level, resp, err := client.Repositories.GetPermissionLevel(ctx, owner, repo, username)
+			if err != nil {
+				// GitHub answers 404 for repositories and users it does not show to the
+				// authenticated user, who can then not rely on any access either.
+				if resp == nil || resp.StatusCode != http.StatusNotFound {
+					if result := apiErrorResult("failed to get permission", err, http.StatusForbidden); result != nil {
+						return result, nil
+					}
+					return nil, fmt.Errorf("failed to get permission: %w", err)
+				}
+			} else {
+				defer func() { _ = resp.Body.Close() }()
+				result.Permission = level.GetPermission()
+				result.RoleName = level.GetRoleName()
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// AddCollaborator creates a tool to give a user access to a repository.
+func AddCollaborator(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("add_collaborator",
+			mcp.WithDescription(t("TOOL_ADD_COLLABORATOR_DESCRIPTION", "Give a user access to a repository. Users without access are sent an invitation they have to accept, the permission of existing collaborators is changed directly")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("username",
+				mcp.Required(),
+				mcp.Description("Username of the user to add"),
+			),
+			mcp.WithString("permission",
+				mcp.Description("Permission to grant, defaults to push"),
+				mcp.Enum("pull", "triage", "push", "maintain", "admin"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			username, err := requiredParam[string](request, "username")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			permission, err := OptionalParam[string](request, "permission")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if permission == "" {
+				permission = "push"
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			invitation, resp, err := client.Repositories.AddCollaborator(ctx, owner, repo, username, &github.RepositoryAddCollaboratorOptions{
+				Permission: permission,
+			})
+			if err != nil {
+				if result := apiErrorResult("failed to add collaborator", err, http.StatusNotFound, http.StatusForbidden, http.StatusUnprocessableEntity); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to add collaborator: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			// GitHub answers 201 with an invitation for new collaborators, and 204 without a
+			// body when the user already had access, or is an organization member getting
+			// access directly.
+			result := addedCollaborator{
+				Username:   username,
+				Permission: permission,
+				Status:     "updated",
+			}
+			if resp.StatusCode == http.StatusCreated {
+				result.Status = "invited"
+				result.Invitation = &repositoryInvitation{
+					ID:         invitation.GetID(),
+					Invitee:    invitation.GetInvitee().GetLogin(),
+					Inviter:    invitation.GetInviter().GetLogin(),
+					Permission: invitation.GetPermissions(),
+					CreatedAt:  invitation.CreatedAt,
+					HTMLURL:    invitation.GetHTMLURL(),
+				}
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// RemoveCollaborator creates a tool to remove the access of a collaborator to a repository.
+func RemoveCollaborator(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("remove_collaborator",
+			mcp.WithDescription(t("TOOL_REMOVE_COLLABORATOR_DESCRIPTION", "Remove a collaborator from a repository. Access through an organization or team is not affected")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("username",
+				mcp.Required(),
+				mcp.Description("Username of the collaborator to remove"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			username, err := requiredParam[string](request, "username")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			resp, err := client.Repositories.RemoveCollaborator(ctx, owner, repo, username)
+			if err != nil {
+				if result := apiErrorResult("failed to remove collaborator", err, http.StatusNotFound, http.StatusForbidden, http.StatusUnprocessableEntity); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to remove collaborator: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			r, err := json.Marshal(map[string]interface{}{
+				"username": username,
+				"status":   "removed",
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
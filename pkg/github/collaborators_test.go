@@ -0,0 +1,523 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListCollaborators(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListCollaborators(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_collaborators", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "affiliation")
+	assert.Contains(t, tool.InputSchema.Properties, "permission")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	mockUsers := []*github.User{
+		{
+			Login:       github.Ptr("octocat"),
+			HTMLURL:     github.Ptr("https://github.com/octocat"),
+			RoleName:    github.Ptr("admin"),
+			Permissions: map[string]bool{"admin": true, "maintain": true, "push": true, "triage": true, "pull": true},
+		},
+		{
+			Login:       github.Ptr("hubot"),
+			HTMLURL:     github.Ptr("https://github.com/hubot"),
+			RoleName:    github.Ptr("read"),
+			Permissions: map[string]bool{"pull": true},
+		},
+	}
+
+	tests := []struct {
+		name                  string
+		mockedClient          *http.Client
+		requestArgs           map[string]interface{}
+		expectedCollaborators []collaborator
+		expectedErrMsg        string
+	}{
+		{
+			name: "filtered collaborators",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCollaboratorsByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"affiliation": "outside",
+						"permission":  "pull",
+						"page":        "2",
+						"per_page":    "10",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockUsers[1:]),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"affiliation": "outside",
+				"permission":  "pull",
+				"page":        float64(2),
+				"perPage":     float64(10),
+			},
+			expectedCollaborators: []collaborator{
+				{Login: "hubot", Permission: "pull", RoleName: "read", HTMLURL: "https://github.com/hubot"},
+			},
+		},
+		{
+			name: "all collaborators",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposCollaboratorsByOwnerByRepo,
+					mockUsers,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectedCollaborators: []collaborator{
+				{Login: "octocat", Permission: "admin", RoleName: "admin", HTMLURL: "https://github.com/octocat"},
+				{Login: "hubot", Permission: "pull", RoleName: "read", HTMLURL: "https://github.com/hubot"},
+			},
+		},
+		{
+			name: "no push access",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCollaboratorsByOwnerByRepo,
+					mockResponse(t, http.StatusForbidden, map[string]string{"message": "Must have push access to view repository collaborators."}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectedErrMsg: "failed to list collaborators: Must have push access to view repository collaborators.",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListCollaborators(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedCollaborators []collaborator
+			err = json.Unmarshal([]byte(textContent.Text), &returnedCollaborators)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedCollaborators, returnedCollaborators)
+		})
+	}
+}
+
+func Test_ListRepositoryInvitations(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListRepositoryInvitations(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_repository_invitations", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	created := &github.Timestamp{Time: time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)}
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetReposInvitationsByOwnerByRepo,
+			expectQueryParams(t, map[string]string{
+				"page":     "1",
+				"per_page": "30",
+			}).andThen(
+				mockResponse(t, http.StatusOK, []*github.RepositoryInvitation{
+					{
+						ID:          github.Ptr(int64(11)),
+						Invitee:     &github.User{Login: github.Ptr("newcomer")},
+						Inviter:     &github.User{Login: github.Ptr("octocat")},
+						Permissions: github.Ptr("write"),
+						CreatedAt:   created,
+						HTMLURL:     github.Ptr("https://github.com/owner/repo/invitations"),
+					},
+					{
+						ID:          github.Ptr(int64(12)),
+						Invitee:     &github.User{Login: github.Ptr("latecomer")},
+						Inviter:     &github.User{Login: github.Ptr("octocat")},
+						Permissions: github.Ptr("read"),
+						CreatedAt:   created,
+						Expired:     github.Ptr(true),
+						HTMLURL:     github.Ptr("https://github.com/owner/repo/invitations"),
+					},
+				}),
+			),
+		),
+	)
+
+	client := github.NewClient(mockedClient)
+	_, handler := ListRepositoryInvitations(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner": "owner",
+		"repo":  "repo",
+	}))
+	require.NoError(t, err)
+
+	var returnedInvitations []repositoryInvitation
+	err = json.Unmarshal([]byte(getTextResult(t, result).Text), &returnedInvitations)
+	require.NoError(t, err)
+	assert.Equal(t, []repositoryInvitation{
+		{ID: 11, Invitee: "newcomer", Inviter: "octocat", Permission: "write", CreatedAt: created, HTMLURL: "https://github.com/owner/repo/invitations"},
+		{ID: 12, Invitee: "latecomer", Inviter: "octocat", Permission: "read", CreatedAt: created, Expired: true, HTMLURL: "https://github.com/owner/repo/invitations"},
+	}, returnedInvitations)
+}
+
+func Test_CheckUserPermission(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CheckUserPermission(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_user_repository_permission", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "username")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	tests := []struct {
+		name               string
+		mockedClient       *http.Client
+		requestArgs        map[string]interface{}
+		expectedPermission userPermission
+		expectedErrMsg     string
+	}{
+		{
+			name: "permission of a given user",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCollaboratorsPermissionByOwnerByRepoByUsername,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						assert.Equal(t, "/repos/owner/repo/collaborators/contributor/permission", r.URL.Path)
+						mockResponse(t, http.StatusOK, &github.RepositoryPermissionLevel{
+							Permission: github.Ptr("write"),
+							RoleName:   github.Ptr("maintain"),
+							User:       &github.User{Login: github.Ptr("contributor")},
+						})(w, r)
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"username": "contributor",
+			},
+			expectedPermission: userPermission{Username: "contributor", Permission: "write", RoleName: "maintain"},
+		},
+		{
+			name: "defaults to the authenticated user",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetUser,
+					&github.User{Login: github.Ptr("octocat")},
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposCollaboratorsPermissionByOwnerByRepoByUsername,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						assert.Equal(t, "/repos/owner/repo/collaborators/octocat/permission", r.URL.Path)
+						mockResponse(t, http.StatusOK, &github.RepositoryPermissionLevel{
+							Permission: github.Ptr("admin"),
+							RoleName:   github.Ptr("admin"),
+						})(w, r)
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectedPermission: userPermission{Username: "octocat", Permission: "admin", RoleName: "admin"},
+		},
+		{
+			name: "not found is no permission",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCollaboratorsPermissionByOwnerByRepoByUsername,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "private-repo",
+				"username": "stranger",
+			},
+			expectedPermission: userPermission{Username: "stranger", Permission: "none"},
+		},
+		{
+			name: "forbidden",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCollaboratorsPermissionByOwnerByRepoByUsername,
+					mockResponse(t, http.StatusForbidden, map[string]string{"message": "Must have push access to view collaborator permission."}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"username": "contributor",
+			},
+			expectedErrMsg: "failed to get permission: Must have push access to view collaborator permission.",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := CheckUserPermission(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			var returnedPermission userPermission
+			err = json.Unmarshal([]byte(textContent.Text), &returnedPermission)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedPermission, returnedPermission)
+		})
+	}
+}
+
+func Test_AddCollaborator(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := AddCollaborator(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "add_collaborator", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "username")
+	assert.Contains(t, tool.InputSchema.Properties, "permission")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "username"})
+
+	created := &github.Timestamp{Time: time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectedResult addedCollaborator
+		expectedErrMsg string
+	}{
+		{
+			name: "invitation created",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposCollaboratorsByOwnerByRepoByUsername,
+					expectRequestBody(t, map[string]any{
+						"permission": "maintain",
+					}).andThen(
+						mockResponse(t, http.StatusCreated, &github.CollaboratorInvitation{
+							ID:          github.Ptr(int64(11)),
+							Invitee:     &github.User{Login: github.Ptr("newcomer")},
+							Inviter:     &github.User{Login: github.Ptr("octocat")},
+							Permissions: github.Ptr("maintain"),
+							CreatedAt:   created,
+							HTMLURL:     github.Ptr("https://github.com/owner/repo/invitations"),
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"username":   "newcomer",
+				"permission": "maintain",
+			},
+			expectedResult: addedCollaborator{
+				Username:   "newcomer",
+				Permission: "maintain",
+				Status:     "invited",
+				Invitation: &repositoryInvitation{
+					ID:         11,
+					Invitee:    "newcomer",
+					Inviter:    "octocat",
+					Permission: "maintain",
+					CreatedAt:  created,
+					HTMLURL:    "https://github.com/owner/repo/invitations",
+				},
+			},
+		},
+		{
+			name: "user already has access",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposCollaboratorsByOwnerByRepoByUsername,
+					expectRequestBody(t, map[string]any{
+						"permission": "push",
+					}).andThen(
+						func(w http.ResponseWriter, _ *http.Request) {
+							w.WriteHeader(http.StatusNoContent)
+						},
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"username": "hubot",
+			},
+			expectedResult: addedCollaborator{
+				Username:   "hubot",
+				Permission: "push",
+				Status:     "updated",
+			},
+		},
+		{
+			name: "user not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposCollaboratorsByOwnerByRepoByUsername,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"username": "ghost",
+			},
+			expectedErrMsg: "failed to add collaborator: Not Found",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := AddCollaborator(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedResult addedCollaborator
+			err = json.Unmarshal([]byte(textContent.Text), &returnedResult)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, returnedResult)
+		})
+	}
+}
+
+func Test_RemoveCollaborator(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := RemoveCollaborator(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "remove_collaborator", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "username")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "username"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectedText   string
+		expectedErrMsg string
+	}{
+		{
+			name: "collaborator removed",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.DeleteReposCollaboratorsByOwnerByRepoByUsername,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNoContent)
+					}),
+				),
+			),
+			expectedText: `{"status":"removed","username":"hubot"}`,
+		},
+		{
+			name: "not allowed",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.DeleteReposCollaboratorsByOwnerByRepoByUsername,
+					mockResponse(t, http.StatusForbidden, map[string]string{"message": "Must have admin rights to Repository."}),
+				),
+			),
+			expectedErrMsg: "failed to remove collaborator: Must have admin rights to Repository.",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := RemoveCollaborator(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"username": "hubot",
+			}))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			assert.False(t, result.IsError)
+			assert.Equal(t, tc.expectedText, textContent.Text)
+		})
+	}
+}
@@ -3,9 +3,11 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/github/github-mcp-server/pkg/translations"
@@ -26,18 +28,7 @@ func ListDiscussions(getClient GetClientFn, t translations.TranslationHelperFunc
 				mcp.Required(),
 				mcp.Description("Repository name"),
 			),
-			mcp.WithString("direction",
-				mcp.Description("Sort direction ('asc', 'desc')"),
-				mcp.Enum("asc", "desc"),
-			),
-			mcp.WithString("category_id",
-				mcp.Description("Filter by category ID"),
-			),
-			mcp.WithString("pinned",
-				mcp.Description("Filter by pinned status ('true', 'false')"),
-				mcp.Enum("true", "false"),
-			),
-			WithPagination(),
+			withDiscussionListOptions(),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := requiredParam[string](request, "owner")
@@ -49,53 +40,124 @@ func ListDiscussions(getClient GetClientFn, t translations.TranslationHelperFunc
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			opts := &github.DiscussionListOptions{}
-
-			// Set optional parameters if provided
-			direction, err := OptionalParam[string](request, "direction")
+			opts, err := discussionListOptions(request)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			if direction != "" {
-				opts.Direction = direction
+			since, before, err := discussionCreatedWindow(request, opts)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
 			}
-
-			categoryID, err := OptionalParam[string](request, "category_id")
+			offset, err := OptionalIntParam(request, "offset")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			if categoryID != "" {
-				opts.CategoryID = categoryID
+			if offset < 0 {
+				return mcp.NewToolResultError("offset must not be negative"), nil
 			}
 
-			pinnedStr, err := OptionalParam[string](request, "pinned")
+			client, err := getClient(ctx)
 			if err != nil {
-				return mcp.NewToolResultError(err.Error()), nil
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			if !since.IsZero() || !before.IsZero() {
+				result, err := listDiscussionsCreatedBetween(ctx, opts, since, before, offset,
+					func(ctx context.Context, opts *github.DiscussionListOptions) ([]*github.Discussion, *github.Response, error) {
+						return client.Discussions.ListDiscussions(ctx, owner, repo, opts)
+					})
+				if err != nil {
+					return nil, fmt.Errorf("failed to list discussions: %w", err)
+				}
+
+				r, err := json.Marshal(result)
+				if err != nil {
+					return nil, fmt.Errorf("failed to marshal discussions: %w", err)
+				}
+
+				return mcp.NewToolResultText(string(r)), nil
 			}
-			if pinnedStr == "true" {
-				pinned := true
-				opts.Pinned = &pinned
-			} else if pinnedStr == "false" {
-				pinned := false
-				opts.Pinned = &pinned
+
+			discussions, resp, err := client.Discussions.ListDiscussions(ctx, owner, repo, opts)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list discussions: %w", err)
 			}
+			defer func() { _ = resp.Body.Close() }()
 
-			pagination, err := OptionalPaginationParams(request)
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list discussions: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(discussions)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal discussions: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// ListOrgDiscussions creates a tool to list discussions of a GitHub organization
+func ListOrgDiscussions(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_org_discussions",
+			mcp.WithDescription(t("TOOL_LIST_ORG_DISCUSSIONS_DESCRIPTION", "List organization-level discussions of a GitHub organization with filtering options")),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			withDiscussionListOptions(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := requiredParam[string](request, "org")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			opts.ListOptions = github.ListOptions{
-				Page:    pagination.page,
-				PerPage: pagination.perPage,
+
+			opts, err := discussionListOptions(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			since, before, err := discussionCreatedWindow(request, opts)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			offset, err := OptionalIntParam(request, "offset")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if offset < 0 {
+				return mcp.NewToolResultError("offset must not be negative"), nil
 			}
 
 			client, err := getClient(ctx)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
-			discussions, resp, err := client.Discussions.ListDiscussions(ctx, owner, repo, opts)
+
+			if !since.IsZero() || !before.IsZero() {
+				result, err := listDiscussionsCreatedBetween(ctx, opts, since, before, offset,
+					func(ctx context.Context, opts *github.DiscussionListOptions) ([]*github.Discussion, *github.Response, error) {
+						return client.Discussions.ListOrgDiscussions(ctx, org, opts)
+					})
+				if err != nil {
+					return nil, fmt.Errorf("failed to list organization discussions: %w", err)
+				}
+
+				r, err := json.Marshal(result)
+				if err != nil {
+					return nil, fmt.Errorf("failed to marshal discussions: %w", err)
+				}
+
+				return mcp.NewToolResultText(string(r)), nil
+			}
+
+			discussions, resp, err := client.Discussions.ListOrgDiscussions(ctx, org, opts)
 			if err != nil {
-				return nil, fmt.Errorf("failed to list discussions: %w", err)
+				return nil, fmt.Errorf("failed to list organization discussions: %w", err)
 			}
 			defer func() { _ = resp.Body.Close() }()
 
@@ -104,7 +166,7 @@ func ListDiscussions(getClient GetClientFn, t translations.TranslationHelperFunc
 				if err != nil {
 					return nil, fmt.Errorf("failed to read response body: %w", err)
 				}
-				return mcp.NewToolResultError(fmt.Sprintf("failed to list discussions: %s", string(body))), nil
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list organization discussions: %s", string(body))), nil
 			}
 
 			r, err := json.Marshal(discussions)
@@ -116,6 +178,198 @@ func ListDiscussions(getClient GetClientFn, t translations.TranslationHelperFunc
 		}
 }
 
+// withDiscussionListOptions adds the filtering and pagination parameters shared by the
+// tools listing discussions.
+func withDiscussionListOptions() mcp.ToolOption {
+	return func(tool *mcp.Tool) {
+		mcp.WithString("sort",
+			mcp.Description("Sort field ('created', 'updated', 'comments'), defaults to 'created'"),
+			mcp.Enum("created", "updated", "comments"),
+		)(tool)
+
+		mcp.WithString("direction",
+			mcp.Description("Sort direction ('asc', 'desc'), defaults to 'desc'"),
+			mcp.Enum("asc", "desc"),
+		)(tool)
+
+		mcp.WithString("category_id",
+			mcp.Description("Filter by category ID"),
+		)(tool)
+
+		mcp.WithString("pinned",
+			mcp.Description("Filter by pinned status ('true', 'false')"),
+			mcp.Enum("true", "false"),
+		)(tool)
+
+		mcp.WithString("since",
+			mcp.Description("Only discussions created at or after this ISO 8601 timestamp (requires sort 'created')"),
+		)(tool)
+
+		mcp.WithString("before",
+			mcp.Description("Only discussions created before this ISO 8601 timestamp (requires sort 'created')"),
+		)(tool)
+
+		mcp.WithNumber("offset",
+			mcp.Description("Discussions to skip on the first page when listing by since or before. Pass next_page as page and next_offset as offset to get the next matches"),
+			mcp.Min(0),
+		)(tool)
+
+		WithPagination()(tool)
+	}
+}
+
+// discussionListOptions builds the list options from the parameters added by withDiscussionListOptions.
+func discussionListOptions(request mcp.CallToolRequest) (*github.DiscussionListOptions, error) {
+	opts := &github.DiscussionListOptions{
+		Sort:      "created",
+		Direction: "desc",
+	}
+
+	// Set optional parameters if provided
+	sort, err := OptionalParam[string](request, "sort")
+	if err != nil {
+		return nil, err
+	}
+	if sort != "" {
+		opts.Sort = sort
+	}
+
+	direction, err := OptionalParam[string](request, "direction")
+	if err != nil {
+		return nil, err
+	}
+	if direction != "" {
+		opts.Direction = direction
+	}
+
+	categoryID, err := OptionalParam[string](request, "category_id")
+	if err != nil {
+		return nil, err
+	}
+	if categoryID != "" {
+		opts.CategoryID = categoryID
+	}
+
+	pinnedStr, err := OptionalParam[string](request, "pinned")
+	if err != nil {
+		return nil, err
+	}
+	if pinnedStr == "true" {
+		pinned := true
+		opts.Pinned = &pinned
+	} else if pinnedStr == "false" {
+		pinned := false
+		opts.Pinned = &pinned
+	}
+
+	pagination, err := OptionalPaginationParams(request)
+	if err != nil {
+		return nil, err
+	}
+	opts.ListOptions = github.ListOptions{
+		Page:    pagination.page,
+		PerPage: pagination.perPage,
+	}
+
+	return opts, nil
+}
+
+// discussionListMaxPages bounds the number of pages fetched when filtering discussions by
+// creation time.
+const discussionListMaxPages = 10
+
+// discussionCreatedWindow returns the "since" and "before" parameters added by
+// withDiscussionListOptions. Unset bounds are returned as the zero time.
+func discussionCreatedWindow(request mcp.CallToolRequest, opts *github.DiscussionListOptions) (since, before time.Time, err error) {
+	since, err = OptionalTimestampParam(request, "since")
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	before, err = OptionalTimestampParam(request, "before")
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	if since.IsZero() && before.IsZero() {
+		return since, before, nil
+	}
+	if !since.IsZero() && !before.IsZero() && !since.Before(before) {
+		return time.Time{}, time.Time{}, fmt.Errorf("since must be earlier than before")
+	}
+	if opts.Sort != "created" {
+		return time.Time{}, time.Time{}, fmt.Errorf("since and before can only be combined with sort 'created'")
+	}
+	return since, before, nil
+}
+
+// discussionsCreatedBetween is a page of the discussions created in a time window. Matches
+// do not line up with the pages of the API, so next_page and next_offset give the page and
+// the position on it to resume from, and truncated tells that the scan stopped at the page
+// limit before reaching the end of the window.
+type discussionsCreatedBetween struct {
+	Discussions   []*github.Discussion `json:"discussions"`
+	PagesConsumed int                  `json:"pages_consumed"`
+	NextPage      int                  `json:"next_page,omitempty"`
+	NextOffset    int                  `json:"next_offset,omitempty"`
+	Truncated     bool                 `json:"truncated,omitempty"`
+}
+
+// listDiscussionsCreatedBetween pages through discussions sorted by creation time, starting
+// offset discussions into the first page, and keeps those created in [since, before) until a
+// page worth of matches is collected. Since the endpoint cannot filter by time, it stops early
+// once the discussions fall outside of the window in the sort direction.
+func listDiscussionsCreatedBetween(ctx context.Context, opts *github.DiscussionListOptions, since, before time.Time, offset int,
+	list func(context.Context, *github.DiscussionListOptions) ([]*github.Discussion, *github.Response, error)) (*discussionsCreatedBetween, error) {
+	descending := opts.Direction != "asc"
+	result := &discussionsCreatedBetween{Discussions: []*github.Discussion{}}
+	if opts.Page < 1 {
+		opts.Page = 1
+	}
+	for {
+		discussions, resp, err := list(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		_ = resp.Body.Close()
+		result.PagesConsumed++
+
+		for i := offset; i < len(discussions); i++ {
+			created := discussions[i].GetCreatedAt().Time
+			if !since.IsZero() && created.Before(since) {
+				if descending {
+					return result, nil
+				}
+				continue
+			}
+			if !before.IsZero() && !created.Before(before) {
+				if !descending {
+					return result, nil
+				}
+				continue
+			}
+			result.Discussions = append(result.Discussions, discussions[i])
+			if len(result.Discussions) < opts.PerPage {
+				continue
+			}
+			if i+1 < len(discussions) {
+				result.NextPage, result.NextOffset = opts.Page, i+1
+			} else {
+				result.NextPage = resp.NextPage
+			}
+			return result, nil
+		}
+		if resp.NextPage == 0 {
+			return result, nil
+		}
+		if result.PagesConsumed == discussionListMaxPages {
+			result.NextPage = resp.NextPage
+			result.Truncated = true
+			return result, nil
+		}
+		opts.Page = resp.NextPage
+		offset = 0
+	}
+}
+
 // GetDiscussion creates a tool to get details of a specific discussion in a GitHub repository
 func GetDiscussion(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("get_discussion",
@@ -250,6 +504,16 @@ func GetDiscussionComments(getClient GetClientFn, t translations.TranslationHelp
 				mcp.Required(),
 				mcp.Description("Discussion number"),
 			),
+			mcp.WithBoolean("include_replies",
+				mcp.Description("Embed the replies to each comment, up to 100 per comment"),
+			),
+			mcp.WithBoolean("fetch_all",
+				mcp.Description("Fetch all pages of comments, starting at page, instead of a single page"),
+			),
+			mcp.WithNumber("max_comments",
+				mcp.Description("Maximum number of comments to fetch when fetch_all is set (default 500)"),
+				mcp.Min(1),
+			),
 			WithPagination(),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -266,6 +530,23 @@ func GetDiscussionComments(getClient GetClientFn, t translations.TranslationHelp
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
+			includeReplies, err := OptionalParam[bool](request, "include_replies")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			fetchAll, err := OptionalParam[bool](request, "fetch_all")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			maxComments, err := OptionalIntParamWithDefault(request, "max_comments", 500)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if maxComments < 1 {
+				return mcp.NewToolResultError("max_comments must be at least 1"), nil
+			}
+
 			pagination, err := OptionalPaginationParams(request)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
@@ -281,21 +562,57 @@ func GetDiscussionComments(getClient GetClientFn, t translations.TranslationHelp
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
-			comments, resp, err := client.Discussions.ListDiscussionComments(ctx, owner, repo, discussionNumber, opts)
-			if err != nil {
-				return nil, fmt.Errorf("failed to get discussion comments: %w", err)
+
+			var comments []*github.DiscussionComment
+			var truncated bool
+			if fetchAll {
+				comments, truncated, err = fetchAllPages(ctx, &opts.ListOptions, maxComments, func() ([]*github.DiscussionComment, *github.Response, error) {
+					return client.Discussions.ListDiscussionComments(ctx, owner, repo, discussionNumber, opts)
+				})
+				if err != nil {
+					message := fmt.Sprintf("failed to get discussion comments after fetching %d", len(comments))
+					if result, marshalErr := rateLimitErrorResult(message, err); result != nil || marshalErr != nil {
+						return result, marshalErr
+					}
+					return nil, fmt.Errorf("%s: %w", message, err)
+				}
+			} else {
+				var resp *github.Response
+				comments, resp, err = client.Discussions.ListDiscussionComments(ctx, owner, repo, discussionNumber, opts)
+				if err != nil {
+					return nil, fmt.Errorf("failed to get discussion comments: %w", err)
+				}
+				defer func() { _ = resp.Body.Close() }()
+
+				if resp.StatusCode != http.StatusOK {
+					body, err := io.ReadAll(resp.Body)
+					if err != nil {
+						return nil, fmt.Errorf("failed to read response body: %w", err)
+					}
+					return mcp.NewToolResultError(fmt.Sprintf("failed to get discussion comments: %s", string(body))), nil
+				}
 			}
-			defer func() { _ = resp.Body.Close() }()
 
-			if resp.StatusCode != http.StatusOK {
-				body, err := io.ReadAll(resp.Body)
+			var result interface{} = comments
+			if includeReplies {
+				commentsWithReplies, err := discussionCommentsWithReplies(ctx, client, comments)
 				if err != nil {
-					return nil, fmt.Errorf("failed to read response body: %w", err)
+					if result := graphQLErrorResult("failed to get discussion comment replies", err); result != nil {
+						return result, nil
+					}
+					return nil, fmt.Errorf("failed to get discussion comment replies: %w", err)
+				}
+				result = commentsWithReplies
+			}
+			if fetchAll {
+				result = map[string]interface{}{
+					"comments":      result,
+					"total_fetched": len(comments),
+					"truncated":     truncated,
 				}
-				return mcp.NewToolResultError(fmt.Sprintf("failed to get discussion comments: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(comments)
+			r, err := json.Marshal(result)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal comments: %w", err)
 			}
@@ -324,6 +641,9 @@ func AddDiscussionComment(getClient GetClientFn, t translations.TranslationHelpe
 				mcp.Required(),
 				mcp.Description("Comment text"),
 			),
+			mcp.WithNumber("reply_to_id",
+				mcp.Description("ID of an existing top-level comment to reply to, creating a threaded reply"),
+			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := requiredParam[string](request, "owner")
@@ -342,6 +662,19 @@ func AddDiscussionComment(getClient GetClientFn, t translations.TranslationHelpe
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			replyToID, err := OptionalIntParam(request, "reply_to_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if replyToID != 0 {
+				// Threaded replies can only be created through the GraphQL API
+				client, err := getClient(ctx)
+				if err != nil {
+					return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+				}
+				return addDiscussionCommentReply(ctx, client, owner, repo, discussionNumber, int64(replyToID), body)
+			}
 
 			comment := &github.DiscussionComment{
 				Body: github.Ptr(body),
@@ -453,3 +786,1779 @@ func CreateDiscussion(getClient GetClientFn, t translations.TranslationHelperFun
 			return mcp.NewToolResultText(string(r)), nil
 		}
 }
+
+// UpdateDiscussion creates a tool to update an existing discussion in a GitHub repository
+func UpdateDiscussion(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("update_discussion",
+			mcp.WithDescription(t("TOOL_UPDATE_DISCUSSION_DESCRIPTION", "Update the title, body, or category of an existing discussion in a GitHub repository")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("discussion_number",
+				mcp.Required(),
+				mcp.Description("Discussion number to update"),
+			),
+			mcp.WithString("title",
+				mcp.Description("New title"),
+			),
+			mcp.WithString("body",
+				mcp.Description("New body content"),
+			),
+			mcp.WithString("category_id",
+				mcp.Description("New category ID"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			discussionNumber, err := RequiredInt(request, "discussion_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			// Build the update request only with provided fields
+			discussionRequest := &github.DiscussionRequest{}
+			updateNeeded := false
+
+			if title, ok, err := OptionalParamOK[string](request, "title"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok && title != "" {
+				discussionRequest.Title = github.Ptr(title)
+				updateNeeded = true
+			}
+
+			if body, ok, err := OptionalParamOK[string](request, "body"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok && body != "" {
+				discussionRequest.Body = github.Ptr(body)
+				updateNeeded = true
+			}
+
+			if categoryID, ok, err := OptionalParamOK[string](request, "category_id"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok && categoryID != "" {
+				discussionRequest.CategoryID = github.Ptr(categoryID)
+				updateNeeded = true
+			}
+
+			if !updateNeeded {
+				return mcp.NewToolResultError("at least one of title, body, or category_id is required"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			discussion, resp, err := client.Discussions.EditDiscussion(ctx, owner, repo, discussionNumber, discussionRequest)
+			if err != nil {
+				return nil, fmt.Errorf("failed to update discussion: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to update discussion: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(discussion)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal discussion: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// DeleteDiscussion creates a tool to delete a discussion in a GitHub repository
+func DeleteDiscussion(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("delete_discussion",
+			mcp.WithDescription(t("TOOL_DELETE_DISCUSSION_DESCRIPTION", "Delete a discussion in a GitHub repository. This cannot be undone, so confirm must be set to true")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("discussion_number",
+				mcp.Required(),
+				mcp.Description("Discussion number to delete"),
+			),
+			mcp.WithBoolean("confirm",
+				mcp.Required(),
+				mcp.Description("Must be true to confirm the discussion should be permanently deleted"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			discussionNumber, err := RequiredInt(request, "discussion_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			confirm, err := OptionalParam[bool](request, "confirm")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if !confirm {
+				return mcp.NewToolResultError("confirm must be set to true to delete a discussion"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			resp, err := client.Discussions.DeleteDiscussion(ctx, owner, repo, discussionNumber)
+			if err != nil {
+				if result := apiErrorResult("failed to delete discussion", err, http.StatusNotFound, http.StatusForbidden); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to delete discussion: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusNoContent {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to delete discussion: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(map[string]interface{}{
+				"discussion_number": discussionNumber,
+				"status":            "deleted",
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// UpdateDiscussionComment creates a tool to update a comment on a discussion
+func UpdateDiscussionComment(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("update_discussion_comment",
+			mcp.WithDescription(t("TOOL_UPDATE_DISCUSSION_COMMENT_DESCRIPTION", "Update the body of an existing comment on a discussion")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("discussion_number",
+				mcp.Required(),
+				mcp.Description("Discussion number the comment belongs to"),
+			),
+			mcp.WithNumber("comment_id",
+				mcp.Required(),
+				mcp.Description("ID of the comment to update"),
+			),
+			mcp.WithString("body",
+				mcp.Required(),
+				mcp.Description("New comment text"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			discussionNumber, err := RequiredInt(request, "discussion_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			commentID, err := RequiredInt(request, "comment_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			body, err := requiredParam[string](request, "body")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			comment := &github.DiscussionComment{
+				Body: github.Ptr(body),
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			updatedComment, resp, err := client.Discussions.EditDiscussionComment(ctx, owner, repo, discussionNumber, int64(commentID), comment)
+			if err != nil {
+				return nil, fmt.Errorf("failed to update discussion comment: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to update discussion comment: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(updatedComment)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// MarkDiscussionAnswer creates a tool to mark or unmark a discussion comment as the answer
+func MarkDiscussionAnswer(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("mark_discussion_answer",
+			mcp.WithDescription(t("TOOL_MARK_DISCUSSION_ANSWER_DESCRIPTION", "Mark a comment as the answer to a discussion in a category that accepts answers, or remove the answer mark")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("discussion_number",
+				mcp.Required(),
+				mcp.Description("Discussion number"),
+			),
+			mcp.WithNumber("comment_id",
+				mcp.Required(),
+				mcp.Description("ID of the top-level comment to mark as the answer"),
+			),
+			mcp.WithBoolean("unmark",
+				mcp.Description("Remove the answer mark from the comment instead of setting it"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			discussionNumber, err := RequiredInt(request, "discussion_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			commentID, err := RequiredInt(request, "comment_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			unmark, err := OptionalParam[bool](request, "unmark")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			node, err := resolveDiscussionComment(ctx, client, owner, repo, discussionNumber, int64(commentID))
+			if err != nil {
+				if result := graphQLErrorResult("failed to get discussion comments", err); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to get discussion comments: %w", err)
+			}
+			if !node.answerable {
+				return mcp.NewToolResultError(fmt.Sprintf("discussion %d is in a category that does not support answers", discussionNumber)), nil
+			}
+
+			mutation, field := markDiscussionCommentAsAnswerMutation, "markDiscussionCommentAsAnswer"
+			if unmark {
+				mutation, field = unmarkDiscussionCommentAsAnswerMutation, "unmarkDiscussionCommentAsAnswer"
+			}
+
+			var response map[string]struct {
+				Discussion struct {
+					AnswerChosenAt *time.Time `json:"answerChosenAt"`
+					Answer         *struct {
+						URL string `json:"url"`
+					} `json:"answer"`
+				} `json:"discussion"`
+			}
+			err = executeGraphQL(ctx, client, mutation, map[string]interface{}{
+				"id": node.commentID,
+			}, &response)
+			if err != nil {
+				if result := graphQLErrorResult("failed to update discussion answer", err); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to update discussion answer: %w", err)
+			}
+
+			discussion := response[field].Discussion
+			result := map[string]interface{}{
+				"discussion_number": discussionNumber,
+				"answer_chosen_at":  discussion.AnswerChosenAt,
+				"answer_url":        nil,
+			}
+			if discussion.Answer != nil {
+				result["answer_url"] = discussion.Answer.URL
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// PinDiscussion creates a tool to pin a discussion
+func PinDiscussion(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("pin_discussion",
+			mcp.WithDescription(t("TOOL_PIN_DISCUSSION_DESCRIPTION", "Pin a discussion to the top of the repository discussions list. A repository can have at most four pinned discussions")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("discussion_number",
+				mcp.Required(),
+				mcp.Description("Discussion number"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			discussionNumber, err := RequiredInt(request, "discussion_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			return setDiscussionPinned(ctx, client, owner, repo, discussionNumber, true)
+		}
+}
+
+// UnpinDiscussion creates a tool to unpin a discussion
+func UnpinDiscussion(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("unpin_discussion",
+			mcp.WithDescription(t("TOOL_UNPIN_DISCUSSION_DESCRIPTION", "Unpin a pinned discussion in a GitHub repository")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("discussion_number",
+				mcp.Required(),
+				mcp.Description("Discussion number"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			discussionNumber, err := RequiredInt(request, "discussion_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			return setDiscussionPinned(ctx, client, owner, repo, discussionNumber, false)
+		}
+}
+
+// SearchDiscussions creates a tool to search for discussions across GitHub repositories
+func SearchDiscussions(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("search_discussions",
+			mcp.WithDescription(t("TOOL_SEARCH_DISCUSSIONS_DESCRIPTION", "Search for discussions across GitHub repositories, optionally scoped to an owner or repository")),
+			mcp.WithString("query",
+				mcp.Required(),
+				mcp.Description("Search query using GitHub discussions search syntax"),
+			),
+			mcp.WithString("owner",
+				mcp.Description("Restrict the search to repositories of this owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Description("Restrict the search to this repository (requires owner)"),
+			),
+			mcp.WithString("sort",
+				mcp.Description("Sort field"),
+				mcp.Enum("created", "updated", "comments", "reactions"),
+			),
+			mcp.WithString("order",
+				mcp.Description("Sort order ('asc' or 'desc')"),
+				mcp.Enum("asc", "desc"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			query, err := requiredParam[string](request, "query")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			owner, err := OptionalParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := OptionalParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			sort, err := OptionalParam[string](request, "sort")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			order, err := OptionalParam[string](request, "order")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			switch {
+			case repo != "" && owner == "":
+				return mcp.NewToolResultError("owner is required when repo is set"), nil
+			case repo != "":
+				query += fmt.Sprintf(" repo:%s/%s", owner, repo)
+			case owner != "":
+				query += fmt.Sprintf(" user:%s", owner)
+			}
+			if sort != "" {
+				query += " sort:" + sort
+				if order != "" {
+					query += "-" + order
+				}
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			// The GraphQL API pages with cursors, so walk forward to the requested page.
+			var cursor *string
+			for page := 1; page < pagination.page; page++ {
+				var response struct {
+					Search struct {
+						DiscussionCount int `json:"discussionCount"`
+						PageInfo        struct {
+							HasNextPage bool   `json:"hasNextPage"`
+							EndCursor   string `json:"endCursor"`
+						} `json:"pageInfo"`
+					} `json:"search"`
+				}
+				err := executeGraphQL(ctx, client, searchDiscussionsCursorQuery, map[string]interface{}{
+					"query": query,
+					"first": pagination.perPage,
+					"after": cursor,
+				}, &response)
+				if err != nil {
+					if result := graphQLErrorResult("failed to search discussions", err); result != nil {
+						return result, nil
+					}
+					return nil, fmt.Errorf("failed to search discussions: %w", err)
+				}
+				if !response.Search.PageInfo.HasNextPage {
+					// The page is past the last one, but the total still counts all matches.
+					r, err := json.Marshal(map[string]interface{}{
+						"total_count": response.Search.DiscussionCount,
+						"items":       []discussionSearchResult{},
+					})
+					if err != nil {
+						return nil, fmt.Errorf("failed to marshal response: %w", err)
+					}
+					return mcp.NewToolResultText(string(r)), nil
+				}
+				cursor = github.Ptr(response.Search.PageInfo.EndCursor)
+			}
+
+			var response struct {
+				Search struct {
+					DiscussionCount int `json:"discussionCount"`
+					Nodes           []struct {
+						Number    int       `json:"number"`
+						Title     string    `json:"title"`
+						URL       string    `json:"url"`
+						CreatedAt time.Time `json:"createdAt"`
+						BodyText  string    `json:"bodyText"`
+						Category  struct {
+							Name string `json:"name"`
+						} `json:"category"`
+					} `json:"nodes"`
+				} `json:"search"`
+			}
+			err = executeGraphQL(ctx, client, searchDiscussionsQuery, map[string]interface{}{
+				"query": query,
+				"first": pagination.perPage,
+				"after": cursor,
+			}, &response)
+			if err != nil {
+				if result := graphQLErrorResult("failed to search discussions", err); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to search discussions: %w", err)
+			}
+
+			items := make([]discussionSearchResult, 0, len(response.Search.Nodes))
+			for _, node := range response.Search.Nodes {
+				items = append(items, discussionSearchResult{
+					Number:    node.Number,
+					Title:     node.Title,
+					URL:       node.URL,
+					Category:  node.Category.Name,
+					CreatedAt: node.CreatedAt,
+					Excerpt:   excerpt(node.BodyText, discussionExcerptLength),
+				})
+			}
+
+			r, err := json.Marshal(map[string]interface{}{
+				"total_count": response.Search.DiscussionCount,
+				"items":       items,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// AddDiscussionLabels creates a tool to add labels to a discussion
+func AddDiscussionLabels(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("add_discussion_labels",
+			mcp.WithDescription(t("TOOL_ADD_DISCUSSION_LABELS_DESCRIPTION", "Add labels to a discussion in a GitHub repository")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("discussion_number",
+				mcp.Required(),
+				mcp.Description("Discussion number"),
+			),
+			mcp.WithArray("labels",
+				mcp.Required(),
+				mcp.Description("Names of the labels to add"),
+				mcp.Items(
+					map[string]interface{}{
+						"type": "string",
+					},
+				),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			discussionNumber, err := RequiredInt(request, "discussion_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			labels, err := OptionalStringArrayParam(request, "labels")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if len(labels) == 0 {
+				return mcp.NewToolResultError("missing required parameter: labels"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			return updateDiscussionLabels(ctx, client, owner, repo, discussionNumber, labels, true)
+		}
+}
+
+// RemoveDiscussionLabels creates a tool to remove labels from a discussion
+func RemoveDiscussionLabels(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("remove_discussion_labels",
+			mcp.WithDescription(t("TOOL_REMOVE_DISCUSSION_LABELS_DESCRIPTION", "Remove labels from a discussion in a GitHub repository")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("discussion_number",
+				mcp.Required(),
+				mcp.Description("Discussion number"),
+			),
+			mcp.WithArray("labels",
+				mcp.Required(),
+				mcp.Description("Names of the labels to remove"),
+				mcp.Items(
+					map[string]interface{}{
+						"type": "string",
+					},
+				),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			discussionNumber, err := RequiredInt(request, "discussion_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			labels, err := OptionalStringArrayParam(request, "labels")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if len(labels) == 0 {
+				return mcp.NewToolResultError("missing required parameter: labels"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			return updateDiscussionLabels(ctx, client, owner, repo, discussionNumber, labels, false)
+		}
+}
+
+// ConvertIssueToDiscussion creates a tool to convert an issue into a discussion
+func ConvertIssueToDiscussion(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("convert_issue_to_discussion",
+			mcp.WithDescription(t("TOOL_CONVERT_ISSUE_TO_DISCUSSION_DESCRIPTION", "Convert an issue in a GitHub repository into a discussion")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("issue_number",
+				mcp.Required(),
+				mcp.Description("Number of the issue to convert"),
+			),
+			mcp.WithString("category_id",
+				mcp.Required(),
+				mcp.Description("ID or slug of the discussion category to create the discussion in"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			issueNumber, err := RequiredInt(request, "issue_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			categoryID, err := requiredParam[string](request, "category_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			var query struct {
+				Repository struct {
+					Issue *struct {
+						ID string `json:"id"`
+					} `json:"issue"`
+					DiscussionCategories struct {
+						Nodes []struct {
+							ID   string `json:"id"`
+							Slug string `json:"slug"`
+						} `json:"nodes"`
+					} `json:"discussionCategories"`
+				} `json:"repository"`
+			}
+			err = executeGraphQL(ctx, client, issueAndDiscussionCategoriesQuery, map[string]interface{}{
+				"owner":  owner,
+				"repo":   repo,
+				"number": issueNumber,
+			}, &query)
+			if err != nil {
+				if result := graphQLErrorResult("failed to get issue", err); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to get issue: %w", err)
+			}
+			if query.Repository.Issue == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("issue %d not found", issueNumber)), nil
+			}
+			var categoryNodeID string
+			for _, category := range query.Repository.DiscussionCategories.Nodes {
+				if category.ID == categoryID || category.Slug == categoryID {
+					categoryNodeID = category.ID
+					break
+				}
+			}
+			if categoryNodeID == "" {
+				return mcp.NewToolResultError(fmt.Sprintf("discussion category %s not found", categoryID)), nil
+			}
+
+			var mutation struct {
+				ConvertIssueToDiscussion struct {
+					Discussion struct {
+						Number int    `json:"number"`
+						URL    string `json:"url"`
+					} `json:"discussion"`
+				} `json:"convertIssueToDiscussion"`
+			}
+			err = executeGraphQL(ctx, client, convertIssueToDiscussionMutation, map[string]interface{}{
+				"issueId":    query.Repository.Issue.ID,
+				"categoryId": categoryNodeID,
+			}, &mutation)
+			if err != nil {
+				if result := graphQLErrorResult("failed to convert issue to discussion", err); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to convert issue to discussion: %w", err)
+			}
+
+			discussion := mutation.ConvertIssueToDiscussion.Discussion
+			r, err := json.Marshal(map[string]interface{}{
+				"issue_number":      issueNumber,
+				"discussion_number": discussion.Number,
+				"url":               discussion.URL,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// TransferDiscussion creates a tool to transfer a discussion to another repository
+func TransferDiscussion(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("transfer_discussion",
+			mcp.WithDescription(t("TOOL_TRANSFER_DISCUSSION_DESCRIPTION", "Transfer a discussion to another repository owned by the same user or organization")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("discussion_number",
+				mcp.Required(),
+				mcp.Description("Discussion number"),
+			),
+			mcp.WithString("target_repo",
+				mcp.Required(),
+				mcp.Description("Repository to transfer the discussion to, in 'owner/name' form"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			discussionNumber, err := RequiredInt(request, "discussion_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			targetRepo, err := requiredParam[string](request, "target_repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			targetOwner, targetName, ok := strings.Cut(targetRepo, "/")
+			if !ok || targetOwner == "" || targetName == "" || strings.Contains(targetName, "/") {
+				return mcp.NewToolResultError(fmt.Sprintf("target_repo must be in 'owner/name' form, got %q", targetRepo)), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			discussionID, err := resolveDiscussionID(ctx, client, owner, repo, discussionNumber)
+			if err != nil {
+				if result := graphQLErrorResult("failed to get discussion", err); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to get discussion: %w", err)
+			}
+
+			var query struct {
+				Repository *struct {
+					ID string `json:"id"`
+				} `json:"repository"`
+			}
+			err = executeGraphQL(ctx, client, repositoryIDQuery, map[string]interface{}{
+				"owner": targetOwner,
+				"repo":  targetName,
+			}, &query)
+			if err != nil {
+				if result := graphQLErrorResult("failed to get target repository", err); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to get target repository: %w", err)
+			}
+			if query.Repository == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("repository %s not found", targetRepo)), nil
+			}
+
+			var mutation struct {
+				TransferDiscussion struct {
+					Discussion struct {
+						Number int    `json:"number"`
+						URL    string `json:"url"`
+					} `json:"discussion"`
+				} `json:"transferDiscussion"`
+			}
+			err = executeGraphQL(ctx, client, transferDiscussionMutation, map[string]interface{}{
+				"discussionId": discussionID,
+				"repositoryId": query.Repository.ID,
+			}, &mutation)
+			if err != nil {
+				if result := graphQLErrorResult("failed to transfer discussion", err); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to transfer discussion: %w", err)
+			}
+
+			discussion := mutation.TransferDiscussion.Discussion
+			r, err := json.Marshal(map[string]interface{}{
+				"repository":        targetRepo,
+				"discussion_number": discussion.Number,
+				"url":               discussion.URL,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// CreateDiscussionCategory creates a tool to create a discussion category in a GitHub repository
+func CreateDiscussionCategory(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_discussion_category",
+			mcp.WithDescription(t("TOOL_CREATE_DISCUSSION_CATEGORY_DESCRIPTION", "Create a discussion category in a GitHub repository")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("name",
+				mcp.Required(),
+				mcp.Description("Category name"),
+			),
+			mcp.WithString("description",
+				mcp.Description("Category description"),
+			),
+			mcp.WithString("emoji",
+				mcp.Description("Emoji shortcode for the category, e.g. ':speech_balloon:'"),
+			),
+			mcp.WithString("format",
+				mcp.Description("Discussion format of the category, defaults to 'open'"),
+				mcp.Enum("open", "question", "announcement", "poll"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			name, err := requiredParam[string](request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			input, err := discussionCategoryInput(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			input["name"] = name
+			if _, ok := input["format"]; !ok {
+				input["format"] = "OPEN"
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			var query struct {
+				Repository *struct {
+					ID string `json:"id"`
+				} `json:"repository"`
+			}
+			err = executeGraphQL(ctx, client, repositoryIDQuery, map[string]interface{}{
+				"owner": owner,
+				"repo":  repo,
+			}, &query)
+			if err != nil {
+				if result := graphQLErrorResult("failed to get repository", err); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to get repository: %w", err)
+			}
+			if query.Repository == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("repository %s/%s not found", owner, repo)), nil
+			}
+			input["repositoryId"] = query.Repository.ID
+
+			var mutation struct {
+				CreateDiscussionCategory struct {
+					Category discussionCategoryNode `json:"category"`
+				} `json:"createDiscussionCategory"`
+			}
+			err = executeGraphQL(ctx, client, createDiscussionCategoryMutation, map[string]interface{}{
+				"input": input,
+			}, &mutation)
+			if err != nil {
+				return discussionCategoryErrorResult("failed to create discussion category", name, err)
+			}
+
+			r, err := json.Marshal(mutation.CreateDiscussionCategory.Category)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// UpdateDiscussionCategory creates a tool to update a discussion category in a GitHub repository
+func UpdateDiscussionCategory(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("update_discussion_category",
+			mcp.WithDescription(t("TOOL_UPDATE_DISCUSSION_CATEGORY_DESCRIPTION", "Update the name, description, emoji, or format of a discussion category")),
+			mcp.WithString("category_id",
+				mcp.Required(),
+				mcp.Description("Node ID of the discussion category"),
+			),
+			mcp.WithString("name",
+				mcp.Description("New category name"),
+			),
+			mcp.WithString("description",
+				mcp.Description("New category description"),
+			),
+			mcp.WithString("emoji",
+				mcp.Description("New emoji shortcode for the category, e.g. ':speech_balloon:'"),
+			),
+			mcp.WithString("format",
+				mcp.Description("New discussion format of the category"),
+				mcp.Enum("open", "question", "announcement", "poll"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			categoryID, err := requiredParam[string](request, "category_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			name, err := OptionalParam[string](request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			input, err := discussionCategoryInput(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if name != "" {
+				input["name"] = name
+			}
+			if len(input) == 0 {
+				return mcp.NewToolResultError("at least one of name, description, emoji, or format is required"), nil
+			}
+			input["categoryId"] = categoryID
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			var mutation struct {
+				UpdateDiscussionCategory struct {
+					Category discussionCategoryNode `json:"category"`
+				} `json:"updateDiscussionCategory"`
+			}
+			err = executeGraphQL(ctx, client, updateDiscussionCategoryMutation, map[string]interface{}{
+				"input": input,
+			}, &mutation)
+			if err != nil {
+				return discussionCategoryErrorResult("failed to update discussion category", name, err)
+			}
+
+			r, err := json.Marshal(mutation.UpdateDiscussionCategory.Category)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// discussionCommentReply is a reply to a discussion comment created through the GraphQL API.
+type discussionCommentReply struct {
+	ID        int64        `json:"id"`
+	NodeID    string       `json:"node_id"`
+	Body      string       `json:"body"`
+	HTMLURL   string       `json:"html_url"`
+	User      *github.User `json:"user,omitempty"`
+	CreatedAt time.Time    `json:"created_at"`
+	ParentID  int64        `json:"parent_id"`
+}
+
+const discussionCommentsQuery = `query DiscussionComments($owner: String!, $repo: String!, $number: Int!, $after: String) {
+  repository(owner: $owner, name: $repo) {
+    discussion(number: $number) {
+      id
+      category { isAnswerable }
+      comments(first: 100, after: $after) {
+        nodes { id databaseId }
+        pageInfo { hasNextPage endCursor }
+      }
+    }
+  }
+}`
+
+const addDiscussionCommentMutation = `mutation AddDiscussionComment($discussionId: ID!, $body: String!, $replyToId: ID!) {
+  addDiscussionComment(input: {discussionId: $discussionId, body: $body, replyToId: $replyToId}) {
+    comment {
+      id
+      databaseId
+      body
+      url
+      createdAt
+      author { login }
+      replyTo { databaseId }
+    }
+  }
+}`
+
+const markDiscussionCommentAsAnswerMutation = `mutation MarkDiscussionCommentAsAnswer($id: ID!) {
+  markDiscussionCommentAsAnswer(input: {id: $id}) {
+    discussion {
+      answerChosenAt
+      answer { url }
+    }
+  }
+}`
+
+const unmarkDiscussionCommentAsAnswerMutation = `mutation UnmarkDiscussionCommentAsAnswer($id: ID!) {
+  unmarkDiscussionCommentAsAnswer(input: {id: $id}) {
+    discussion {
+      answerChosenAt
+      answer { url }
+    }
+  }
+}`
+
+const discussionIDQuery = `query DiscussionID($owner: String!, $repo: String!, $number: Int!) {
+  repository(owner: $owner, name: $repo) {
+    discussion(number: $number) { id }
+  }
+}`
+
+const pinDiscussionMutation = `mutation PinDiscussion($discussionId: ID!) {
+  pinDiscussion(input: {discussionId: $discussionId}) {
+    discussion {
+      repository {
+        pinnedDiscussions(first: 4) {
+          nodes { discussion { number } }
+        }
+      }
+    }
+  }
+}`
+
+const unpinDiscussionMutation = `mutation UnpinDiscussion($discussionId: ID!) {
+  unpinDiscussion(input: {discussionId: $discussionId}) {
+    discussion {
+      repository {
+        pinnedDiscussions(first: 4) {
+          nodes { discussion { number } }
+        }
+      }
+    }
+  }
+}`
+
+// resolveDiscussionID looks up the node ID of a discussion. A missing discussion is reported
+// as a NOT_FOUND graphQLErrors.
+func resolveDiscussionID(ctx context.Context, client *github.Client, owner, repo string, discussionNumber int) (string, error) {
+	var query struct {
+		Repository struct {
+			Discussion *struct {
+				ID string `json:"id"`
+			} `json:"discussion"`
+		} `json:"repository"`
+	}
+	err := executeGraphQL(ctx, client, discussionIDQuery, map[string]interface{}{
+		"owner":  owner,
+		"repo":   repo,
+		"number": discussionNumber,
+	}, &query)
+	if err != nil {
+		return "", err
+	}
+	if query.Repository.Discussion == nil {
+		return "", graphQLErrors{{Type: "NOT_FOUND", Message: fmt.Sprintf("discussion %d not found", discussionNumber)}}
+	}
+	return query.Repository.Discussion.ID, nil
+}
+
+// setDiscussionPinned pins or unpins a discussion and reports its position among the pinned
+// discussions of the repository afterwards, starting at 1, or null when it is not pinned.
+func setDiscussionPinned(ctx context.Context, client *github.Client, owner, repo string, discussionNumber int, pin bool) (*mcp.CallToolResult, error) {
+	discussionID, err := resolveDiscussionID(ctx, client, owner, repo, discussionNumber)
+	if err != nil {
+		if result := graphQLErrorResult("failed to get discussion", err); result != nil {
+			return result, nil
+		}
+		return nil, fmt.Errorf("failed to get discussion: %w", err)
+	}
+
+	mutation, field, action := pinDiscussionMutation, "pinDiscussion", "pin"
+	if !pin {
+		mutation, field, action = unpinDiscussionMutation, "unpinDiscussion", "unpin"
+	}
+
+	var response map[string]struct {
+		Discussion struct {
+			Repository struct {
+				PinnedDiscussions struct {
+					Nodes []struct {
+						Discussion struct {
+							Number int `json:"number"`
+						} `json:"discussion"`
+					} `json:"nodes"`
+				} `json:"pinnedDiscussions"`
+			} `json:"repository"`
+		} `json:"discussion"`
+	}
+	err = executeGraphQL(ctx, client, mutation, map[string]interface{}{
+		"discussionId": discussionID,
+	}, &response)
+	if err != nil {
+		if result := graphQLErrorResult(fmt.Sprintf("failed to %s discussion", action), err); result != nil {
+			return result, nil
+		}
+		return nil, fmt.Errorf("failed to %s discussion: %w", action, err)
+	}
+
+	result := map[string]interface{}{
+		"discussion_number": discussionNumber,
+		"pinned":            false,
+		"pinned_position":   nil,
+	}
+	for i, node := range response[field].Discussion.Repository.PinnedDiscussions.Nodes {
+		if node.Discussion.Number == discussionNumber {
+			result["pinned"] = true
+			result["pinned_position"] = i + 1
+			break
+		}
+	}
+
+	r, err := json.Marshal(result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal response: %w", err)
+	}
+
+	return mcp.NewToolResultText(string(r)), nil
+}
+
+const discussionLabelsQuery = `query DiscussionLabels($owner: String!, $repo: String!, $number: Int!) {
+  repository(owner: $owner, name: $repo) {
+    discussion(number: $number) {
+      id
+      labels(first: 100) { nodes { name } }
+    }
+  }
+}`
+
+const labelIDQuery = `query LabelID($owner: String!, $repo: String!, $name: String!) {
+  repository(owner: $owner, name: $repo) {
+    label(name: $name) { id }
+  }
+}`
+
+const addLabelsToLabelableMutation = `mutation AddLabelsToLabelable($labelableId: ID!, $labelIds: [ID!]!) {
+  addLabelsToLabelable(input: {labelableId: $labelableId, labelIds: $labelIds}) {
+    labelable {
+      labels(first: 100) { nodes { name } }
+    }
+  }
+}`
+
+const removeLabelsFromLabelableMutation = `mutation RemoveLabelsFromLabelable($labelableId: ID!, $labelIds: [ID!]!) {
+  removeLabelsFromLabelable(input: {labelableId: $labelableId, labelIds: $labelIds}) {
+    labelable {
+      labels(first: 100) { nodes { name } }
+    }
+  }
+}`
+
+// labelConnection is the labels field of a labelable object in the GraphQL API.
+type labelConnection struct {
+	Nodes []struct {
+		Name string `json:"name"`
+	} `json:"nodes"`
+}
+
+func (c labelConnection) names() []string {
+	names := make([]string, len(c.Nodes))
+	for i, node := range c.Nodes {
+		names[i] = node.Name
+	}
+	return names
+}
+
+// discussionLabelError reports a label that could not be added to or removed from a discussion.
+type discussionLabelError struct {
+	Label   string `json:"label"`
+	Message string `json:"message"`
+}
+
+// updateDiscussionLabels adds or removes labels on a discussion. Labels that do not exist in the
+// repository are reported individually in the result instead of failing the whole call. The
+// result holds the labels of the discussion afterwards.
+func updateDiscussionLabels(ctx context.Context, client *github.Client, owner, repo string, discussionNumber int, labels []string, add bool) (*mcp.CallToolResult, error) {
+	var query struct {
+		Repository struct {
+			Discussion *struct {
+				ID     string          `json:"id"`
+				Labels labelConnection `json:"labels"`
+			} `json:"discussion"`
+		} `json:"repository"`
+	}
+	err := executeGraphQL(ctx, client, discussionLabelsQuery, map[string]interface{}{
+		"owner":  owner,
+		"repo":   repo,
+		"number": discussionNumber,
+	}, &query)
+	if err != nil {
+		if result := graphQLErrorResult("failed to get discussion", err); result != nil {
+			return result, nil
+		}
+		return nil, fmt.Errorf("failed to get discussion: %w", err)
+	}
+	discussion := query.Repository.Discussion
+	if discussion == nil {
+		return mcp.NewToolResultError(fmt.Sprintf("discussion %d not found", discussionNumber)), nil
+	}
+
+	labelIDs := []string{}
+	labelErrors := []discussionLabelError{}
+	for _, name := range labels {
+		var labelQuery struct {
+			Repository struct {
+				Label *struct {
+					ID string `json:"id"`
+				} `json:"label"`
+			} `json:"repository"`
+		}
+		err := executeGraphQL(ctx, client, labelIDQuery, map[string]interface{}{
+			"owner": owner,
+			"repo":  repo,
+			"name":  name,
+		}, &labelQuery)
+		if err != nil {
+			var gqlErrs graphQLErrors
+			if !errors.As(err, &gqlErrs) {
+				return nil, fmt.Errorf("failed to get label %s: %w", name, err)
+			}
+			labelErrors = append(labelErrors, discussionLabelError{Label: name, Message: gqlErrs.Error()})
+			continue
+		}
+		if labelQuery.Repository.Label == nil {
+			labelErrors = append(labelErrors, discussionLabelError{Label: name, Message: "label not found"})
+			continue
+		}
+		labelIDs = append(labelIDs, labelQuery.Repository.Label.ID)
+	}
+
+	finalLabels := discussion.Labels.names()
+	if len(labelIDs) > 0 {
+		mutation, field, action := addLabelsToLabelableMutation, "addLabelsToLabelable", "add"
+		if !add {
+			mutation, field, action = removeLabelsFromLabelableMutation, "removeLabelsFromLabelable", "remove"
+		}
+
+		var response map[string]struct {
+			Labelable struct {
+				Labels labelConnection `json:"labels"`
+			} `json:"labelable"`
+		}
+		err = executeGraphQL(ctx, client, mutation, map[string]interface{}{
+			"labelableId": discussion.ID,
+			"labelIds":    labelIDs,
+		}, &response)
+		if err != nil {
+			if result := graphQLErrorResult(fmt.Sprintf("failed to %s discussion labels", action), err); result != nil {
+				return result, nil
+			}
+			return nil, fmt.Errorf("failed to %s discussion labels: %w", action, err)
+		}
+		finalLabels = response[field].Labelable.Labels.names()
+	}
+
+	r, err := json.Marshal(map[string]interface{}{
+		"discussion_number": discussionNumber,
+		"labels":            finalLabels,
+		"errors":            labelErrors,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal response: %w", err)
+	}
+
+	return mcp.NewToolResultText(string(r)), nil
+}
+
+const issueAndDiscussionCategoriesQuery = `query IssueAndDiscussionCategories($owner: String!, $repo: String!, $number: Int!) {
+  repository(owner: $owner, name: $repo) {
+    issue(number: $number) { id }
+    discussionCategories(first: 100) {
+      nodes { id slug }
+    }
+  }
+}`
+
+const convertIssueToDiscussionMutation = `mutation ConvertIssueToDiscussion($issueId: ID!, $categoryId: ID!) {
+  convertIssueToDiscussion(input: {issueId: $issueId, categoryId: $categoryId}) {
+    discussion { number url }
+  }
+}`
+
+const repositoryIDQuery = `query RepositoryID($owner: String!, $repo: String!) {
+  repository(owner: $owner, name: $repo) { id }
+}`
+
+const transferDiscussionMutation = `mutation TransferDiscussion($discussionId: ID!, $repositoryId: ID!) {
+  transferDiscussion(input: {discussionId: $discussionId, repositoryId: $repositoryId}) {
+    discussion { number url }
+  }
+}`
+
+const createDiscussionCategoryMutation = `mutation CreateDiscussionCategory($input: CreateDiscussionCategoryInput!) {
+  createDiscussionCategory(input: $input) {
+    category { id name slug description emoji isAnswerable }
+  }
+}`
+
+const updateDiscussionCategoryMutation = `mutation UpdateDiscussionCategory($input: UpdateDiscussionCategoryInput!) {
+  updateDiscussionCategory(input: $input) {
+    category { id name slug description emoji isAnswerable }
+  }
+}`
+
+// discussionCategoryNode is a discussion category returned by the GraphQL API. Its ID can be
+// used as the category_id of the discussion tools.
+type discussionCategoryNode struct {
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	Slug         string `json:"slug"`
+	Description  string `json:"description"`
+	Emoji        string `json:"emoji"`
+	IsAnswerable bool   `json:"isAnswerable"`
+}
+
+// discussionCategoryInput reads the optional description, emoji, and format parameters of the
+// discussion category tools into a GraphQL mutation input.
+func discussionCategoryInput(request mcp.CallToolRequest) (map[string]interface{}, error) {
+	input := map[string]interface{}{}
+
+	description, err := OptionalParam[string](request, "description")
+	if err != nil {
+		return nil, err
+	}
+	if description != "" {
+		input["description"] = description
+	}
+
+	emoji, err := OptionalParam[string](request, "emoji")
+	if err != nil {
+		return nil, err
+	}
+	if emoji != "" {
+		input["emoji"] = emoji
+	}
+
+	format, err := OptionalParam[string](request, "format")
+	if err != nil {
+		return nil, err
+	}
+	if format != "" {
+		input["format"] = strings.ToUpper(format)
+	}
+
+	return input, nil
+}
+
+// discussionCategoryNameTaken is the validation message of a discussion category mutation
+// giving a name another category of the repository has.
+const discussionCategoryNameTaken = "name has already been taken"
+
+// discussionCategoryErrorResult converts an error of a discussion category mutation into a
+// tool result, reporting a name that is already taken explicitly. name is empty when the
+// mutation leaves the name unchanged.
+func discussionCategoryErrorResult(message, name string, err error) (*mcp.CallToolResult, error) {
+	var gqlErrs graphQLErrors
+	if !errors.As(err, &gqlErrs) {
+		return nil, fmt.Errorf("%s: %w", message, err)
+	}
+	for _, e := range gqlErrs {
+		if name != "" && strings.Contains(strings.ToLower(e.Message), discussionCategoryNameTaken) {
+			return mcp.NewToolResultError(fmt.Sprintf("%s: a discussion category named %q already exists", message, name)), nil
+		}
+	}
+	return graphQLErrorResult(message, err), nil
+}
+
+// discussionCommentRepliesLimit is the maximum number of replies embedded per comment.
+const discussionCommentRepliesLimit = 100
+
+const discussionCommentRepliesQuery = `query DiscussionCommentReplies($ids: [ID!]!, $first: Int!) {
+  nodes(ids: $ids) {
+    ... on DiscussionComment {
+      id
+      replies(first: $first) {
+        totalCount
+        nodes {
+          id
+          databaseId
+          body
+          url
+          createdAt
+          author { login }
+        }
+      }
+    }
+  }
+}`
+
+// discussionCommentWithReplies is a discussion comment together with its replies.
+type discussionCommentWithReplies struct {
+	*github.DiscussionComment
+	Replies          []*discussionCommentReply `json:"replies"`
+	RepliesTruncated bool                      `json:"replies_truncated"`
+}
+
+// discussionCommentsWithReplies fetches the replies to the given comments through the GraphQL
+// API in a single request. Comments with more than discussionCommentRepliesLimit replies are
+// marked as truncated.
+func discussionCommentsWithReplies(ctx context.Context, client *github.Client, comments []*github.DiscussionComment) ([]*discussionCommentWithReplies, error) {
+	result := make([]*discussionCommentWithReplies, len(comments))
+	ids := make([]string, 0, len(comments))
+	byNodeID := make(map[string]*discussionCommentWithReplies, len(comments))
+	for i, comment := range comments {
+		result[i] = &discussionCommentWithReplies{
+			DiscussionComment: comment,
+			Replies:           []*discussionCommentReply{},
+		}
+		if comment.GetNodeID() != "" {
+			ids = append(ids, comment.GetNodeID())
+			byNodeID[comment.GetNodeID()] = result[i]
+		}
+	}
+	if len(ids) == 0 {
+		return result, nil
+	}
+
+	var query struct {
+		Nodes []*struct {
+			ID      string `json:"id"`
+			Replies struct {
+				TotalCount int `json:"totalCount"`
+				Nodes      []struct {
+					ID         string    `json:"id"`
+					DatabaseID int64     `json:"databaseId"`
+					Body       string    `json:"body"`
+					URL        string    `json:"url"`
+					CreatedAt  time.Time `json:"createdAt"`
+					Author     *struct {
+						Login string `json:"login"`
+					} `json:"author"`
+				} `json:"nodes"`
+			} `json:"replies"`
+		} `json:"nodes"`
+	}
+	err := executeGraphQL(ctx, client, discussionCommentRepliesQuery, map[string]interface{}{
+		"ids":   ids,
+		"first": discussionCommentRepliesLimit,
+	}, &query)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, node := range query.Nodes {
+		if node == nil {
+			continue
+		}
+		comment, ok := byNodeID[node.ID]
+		if !ok {
+			continue
+		}
+		for _, reply := range node.Replies.Nodes {
+			r := &discussionCommentReply{
+				ID:        reply.DatabaseID,
+				NodeID:    reply.ID,
+				Body:      reply.Body,
+				HTMLURL:   reply.URL,
+				CreatedAt: reply.CreatedAt,
+				ParentID:  comment.GetID(),
+			}
+			if reply.Author != nil {
+				r.User = &github.User{Login: github.Ptr(reply.Author.Login)}
+			}
+			comment.Replies = append(comment.Replies, r)
+		}
+		comment.RepliesTruncated = node.Replies.TotalCount > len(node.Replies.Nodes)
+	}
+
+	return result, nil
+}
+
+// discussionExcerptLength is the number of characters of the body kept in discussion search results.
+const discussionExcerptLength = 200
+
+// discussionSearchResult is a trimmed down discussion returned by search_discussions.
+type discussionSearchResult struct {
+	Number    int       `json:"number"`
+	Title     string    `json:"title"`
+	URL       string    `json:"url"`
+	Category  string    `json:"category"`
+	CreatedAt time.Time `json:"created_at"`
+	Excerpt   string    `json:"excerpt"`
+}
+
+const searchDiscussionsCursorQuery = `query SearchDiscussionsCursor($query: String!, $first: Int!, $after: String) {
+  search(query: $query, type: DISCUSSION, first: $first, after: $after) {
+    discussionCount
+    pageInfo { hasNextPage endCursor }
+  }
+}`
+
+const searchDiscussionsQuery = `query SearchDiscussions($query: String!, $first: Int!, $after: String) {
+  search(query: $query, type: DISCUSSION, first: $first, after: $after) {
+    discussionCount
+    nodes {
+      ... on Discussion {
+        number
+        title
+        url
+        createdAt
+        bodyText
+        category { name }
+      }
+    }
+  }
+}`
+
+// excerpt shortens s to at most n characters, marking truncation with an ellipsis.
+func excerpt(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n]) + "..."
+}
+
+// discussionCommentNode identifies a discussion comment in the GraphQL API.
+type discussionCommentNode struct {
+	discussionID string
+	commentID    string
+	answerable   bool
+}
+
+// resolveDiscussionComment looks up the node IDs of a discussion and of the top-level comment
+// with the given database ID, paging through the comments of the discussion until it is found.
+// A missing discussion or comment is reported as a NOT_FOUND graphQLErrors.
+func resolveDiscussionComment(ctx context.Context, client *github.Client, owner, repo string, discussionNumber int, commentID int64) (*discussionCommentNode, error) {
+	var cursor *string
+	for {
+		var query struct {
+			Repository struct {
+				Discussion *struct {
+					ID       string `json:"id"`
+					Category struct {
+						IsAnswerable bool `json:"isAnswerable"`
+					} `json:"category"`
+					Comments struct {
+						Nodes []struct {
+							ID         string `json:"id"`
+							DatabaseID int64  `json:"databaseId"`
+						} `json:"nodes"`
+						PageInfo struct {
+							HasNextPage bool   `json:"hasNextPage"`
+							EndCursor   string `json:"endCursor"`
+						} `json:"pageInfo"`
+					} `json:"comments"`
+				} `json:"discussion"`
+			} `json:"repository"`
+		}
+		err := executeGraphQL(ctx, client, discussionCommentsQuery, map[string]interface{}{
+			"owner":  owner,
+			"repo":   repo,
+			"number": discussionNumber,
+			"after":  cursor,
+		}, &query)
+		if err != nil {
+			return nil, err
+		}
+
+		discussion := query.Repository.Discussion
+		if discussion == nil {
+			return nil, graphQLErrors{{Type: "NOT_FOUND", Message: fmt.Sprintf("discussion %d not found", discussionNumber)}}
+		}
+		for _, node := range discussion.Comments.Nodes {
+			if node.DatabaseID == commentID {
+				return &discussionCommentNode{
+					discussionID: discussion.ID,
+					commentID:    node.ID,
+					answerable:   discussion.Category.IsAnswerable,
+				}, nil
+			}
+		}
+		if !discussion.Comments.PageInfo.HasNextPage {
+			return nil, graphQLErrors{{Type: "NOT_FOUND", Message: fmt.Sprintf("comment %d not found in discussion %d", commentID, discussionNumber)}}
+		}
+		cursor = github.Ptr(discussion.Comments.PageInfo.EndCursor)
+	}
+}
+
+// addDiscussionCommentReply replies to the top-level discussion comment with the given database ID.
+// The node IDs of the discussion and the parent comment are resolved before running the mutation.
+func addDiscussionCommentReply(ctx context.Context, client *github.Client, owner, repo string, discussionNumber int, replyToID int64, body string) (*mcp.CallToolResult, error) {
+	node, err := resolveDiscussionComment(ctx, client, owner, repo, discussionNumber, replyToID)
+	if err != nil {
+		if result := graphQLErrorResult("failed to get discussion comments", err); result != nil {
+			return result, nil
+		}
+		return nil, fmt.Errorf("failed to get discussion comments: %w", err)
+	}
+
+	var mutation struct {
+		AddDiscussionComment struct {
+			Comment struct {
+				ID         string    `json:"id"`
+				DatabaseID int64     `json:"databaseId"`
+				Body       string    `json:"body"`
+				URL        string    `json:"url"`
+				CreatedAt  time.Time `json:"createdAt"`
+				Author     *struct {
+					Login string `json:"login"`
+				} `json:"author"`
+				ReplyTo struct {
+					DatabaseID int64 `json:"databaseId"`
+				} `json:"replyTo"`
+			} `json:"comment"`
+		} `json:"addDiscussionComment"`
+	}
+	err = executeGraphQL(ctx, client, addDiscussionCommentMutation, map[string]interface{}{
+		"discussionId": node.discussionID,
+		"body":         body,
+		"replyToId":    node.commentID,
+	}, &mutation)
+	if err != nil {
+		if result := graphQLErrorResult("failed to create discussion comment", err); result != nil {
+			return result, nil
+		}
+		return nil, fmt.Errorf("failed to create discussion comment: %w", err)
+	}
+
+	comment := mutation.AddDiscussionComment.Comment
+	reply := discussionCommentReply{
+		ID:        comment.DatabaseID,
+		NodeID:    comment.ID,
+		Body:      comment.Body,
+		HTMLURL:   comment.URL,
+		CreatedAt: comment.CreatedAt,
+		ParentID:  comment.ReplyTo.DatabaseID,
+	}
+	if comment.Author != nil {
+		reply.User = &github.User{Login: github.Ptr(comment.Author.Login)}
+	}
+
+	r, err := json.Marshal(reply)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal response: %w", err)
+	}
+
+	return mcp.NewToolResultText(string(r)), nil
+}
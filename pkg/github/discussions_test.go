@@ -3,7 +3,9 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -24,6 +26,7 @@ func Test_ListDiscussions(t *testing.T) {
 	assert.NotEmpty(t, tool.Description)
 	assert.Contains(t, tool.InputSchema.Properties, "owner")
 	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "sort")
 	assert.Contains(t, tool.InputSchema.Properties, "direction")
 	assert.Contains(t, tool.InputSchema.Properties, "category_id")
 	assert.Contains(t, tool.InputSchema.Properties, "pinned")
@@ -83,6 +86,7 @@ func Test_ListDiscussions(t *testing.T) {
 				mock.WithRequestMatchHandler(
 					mock.GetReposDiscussionsByOwnerByRepo,
 					expectQueryParams(t, map[string]string{
+						"sort":       "updated",
 						"direction":  "desc",
 						"category":   "1",
 						"pinned":     "true",
@@ -96,6 +100,7 @@ func Test_ListDiscussions(t *testing.T) {
 			requestArgs: map[string]interface{}{
 				"owner":       "owner",
 				"repo":        "repo",
+				"sort":        "updated",
 				"direction":   "desc",
 				"category_id": "1",
 				"pinned":      "true",
@@ -105,6 +110,98 @@ func Test_ListDiscussions(t *testing.T) {
 			expectError:          false,
 			expectedDiscussions: mockDiscussions,
 		},
+		{
+			name: "list discussions defaults to created desc",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposDiscussionsByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"sort":      "created",
+						"direction": "desc",
+						"page":      "1",
+						"per_page":  "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockDiscussions),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:         false,
+			expectedDiscussions: mockDiscussions,
+		},
+		{
+			name: "list discussions sorted by created",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposDiscussionsByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"sort":      "created",
+						"direction": "desc",
+						"page":      "1",
+						"per_page":  "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockDiscussions),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"sort":  "created",
+			},
+			expectError:         false,
+			expectedDiscussions: mockDiscussions,
+		},
+		{
+			name: "list discussions sorted by updated ascending",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposDiscussionsByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"sort":      "updated",
+						"direction": "asc",
+						"page":      "1",
+						"per_page":  "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockDiscussions),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":     "owner",
+				"repo":      "repo",
+				"sort":      "updated",
+				"direction": "asc",
+			},
+			expectError:         false,
+			expectedDiscussions: mockDiscussions,
+		},
+		{
+			name: "list discussions sorted by comments",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposDiscussionsByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"sort":      "comments",
+						"direction": "desc",
+						"page":      "1",
+						"per_page":  "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockDiscussions),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"sort":  "comments",
+			},
+			expectError:         false,
+			expectedDiscussions: mockDiscussions,
+		},
 		{
 			name: "list discussions fails with error",
 			mockedClient: mock.NewMockedHTTPClient(
@@ -766,3 +863,2418 @@ func Test_CreateDiscussion(t *testing.T) {
 		})
 	}
 }
+
+func Test_UpdateDiscussion(t *testing.T) {
+	// Verify tool definition
+	mockClient := github.NewClient(nil)
+	tool, _ := UpdateDiscussion(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "update_discussion", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "discussion_number")
+	assert.Contains(t, tool.InputSchema.Properties, "title")
+	assert.Contains(t, tool.InputSchema.Properties, "body")
+	assert.Contains(t, tool.InputSchema.Properties, "category_id")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "discussion_number"})
+
+	// Setup mock discussion for success case
+	mockDiscussion := &github.Discussion{
+		Number:     github.Ptr(42),
+		Title:      github.Ptr("Updated Title"),
+		Body:       github.Ptr("This is a test discussion"),
+		HTMLURL:    github.Ptr("https://github.com/owner/repo/discussions/42"),
+		CategoryID: github.Ptr("2"),
+		Category:   &github.DiscussionCategory{ID: github.Ptr("2"), Name: github.Ptr("Q&A")},
+	}
+
+	tests := []struct {
+		name               string
+		mockedClient       *http.Client
+		requestArgs        map[string]interface{}
+		expectError        bool
+		expectedDiscussion *github.Discussion
+		expectedErrMsg     string
+	}{
+		{
+			name: "update title only",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposDiscussionsByOwnerByRepoByDiscussionNumber,
+					expectRequestBody(t, map[string]any{
+						"title": "Updated Title",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockDiscussion),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":             "owner",
+				"repo":              "repo",
+				"discussion_number": float64(42),
+				"title":             "Updated Title",
+			},
+			expectError:        false,
+			expectedDiscussion: mockDiscussion,
+		},
+		{
+			name: "update all fields",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposDiscussionsByOwnerByRepoByDiscussionNumber,
+					expectRequestBody(t, map[string]any{
+						"title":       "Updated Title",
+						"body":        "This is a test discussion",
+						"category_id": "2",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockDiscussion),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":             "owner",
+				"repo":              "repo",
+				"discussion_number": float64(42),
+				"title":             "Updated Title",
+				"body":              "This is a test discussion",
+				"category_id":       "2",
+			},
+			expectError:        false,
+			expectedDiscussion: mockDiscussion,
+		},
+		{
+			name:         "no update fields provided",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":             "owner",
+				"repo":              "repo",
+				"discussion_number": float64(42),
+			},
+			expectError:    false,
+			expectedErrMsg: "at least one of title, body, or category_id is required",
+		},
+		{
+			name: "discussion update fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposDiscussionsByOwnerByRepoByDiscussionNumber,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":             "owner",
+				"repo":              "repo",
+				"discussion_number": float64(999),
+				"title":             "Updated Title",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to update discussion",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := UpdateDiscussion(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			if tc.expectedErrMsg != "" {
+				require.NotNil(t, result)
+				textContent := getTextResult(t, result)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			// Unmarshal and verify the result
+			var returnedDiscussion github.Discussion
+			err = json.Unmarshal([]byte(textContent.Text), &returnedDiscussion)
+			require.NoError(t, err)
+			assert.Equal(t, *tc.expectedDiscussion.Number, *returnedDiscussion.Number)
+			assert.Equal(t, *tc.expectedDiscussion.Title, *returnedDiscussion.Title)
+			assert.Equal(t, *tc.expectedDiscussion.CategoryID, *returnedDiscussion.CategoryID)
+		})
+	}
+}
+
+func Test_DeleteDiscussion(t *testing.T) {
+	// Verify tool definition
+	mockClient := github.NewClient(nil)
+	tool, _ := DeleteDiscussion(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "delete_discussion", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "discussion_number")
+	assert.Contains(t, tool.InputSchema.Properties, "confirm")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "discussion_number", "confirm"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "successful deletion",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.DeleteReposDiscussionsByOwnerByRepoByDiscussionNumber,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNoContent)
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":             "owner",
+				"repo":              "repo",
+				"discussion_number": float64(42),
+				"confirm":           true,
+			},
+			expectError: false,
+		},
+		{
+			name:         "deletion not confirmed",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":             "owner",
+				"repo":              "repo",
+				"discussion_number": float64(42),
+				"confirm":           false,
+			},
+			expectError:    false,
+			expectedErrMsg: "confirm must be set to true",
+		},
+		{
+			name: "discussion not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.DeleteReposDiscussionsByOwnerByRepoByDiscussionNumber,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":             "owner",
+				"repo":              "repo",
+				"discussion_number": float64(999),
+				"confirm":           true,
+			},
+			expectError:    false,
+			expectedErrMsg: "failed to delete discussion: Not Found",
+		},
+		{
+			name: "deletion forbidden",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.DeleteReposDiscussionsByOwnerByRepoByDiscussionNumber,
+					mockResponse(t, http.StatusForbidden, map[string]string{"message": "Must have admin rights to Repository."}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":             "owner",
+				"repo":              "repo",
+				"discussion_number": float64(42),
+				"confirm":           true,
+			},
+			expectError:    false,
+			expectedErrMsg: "Must have admin rights to Repository.",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := DeleteDiscussion(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returned map[string]interface{}
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, float64(42), returned["discussion_number"])
+			assert.Equal(t, "deleted", returned["status"])
+		})
+	}
+}
+
+func Test_AddDiscussionCommentReply(t *testing.T) {
+	// Verify tool definition
+	mockClient := github.NewClient(nil)
+	tool, _ := AddDiscussionComment(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Contains(t, tool.InputSchema.Properties, "reply_to_id")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "discussion_number", "body"})
+
+	commentsPage := func(ids map[string]int64, hasNextPage bool) map[string]any {
+		nodes := []map[string]any{}
+		for id, databaseID := range ids {
+			nodes = append(nodes, map[string]any{"id": id, "databaseId": databaseID})
+		}
+		return map[string]any{
+			"repository": map[string]any{
+				"discussion": map[string]any{
+					"id": "D_kwDOA",
+					"comments": map[string]any{
+						"nodes":    nodes,
+						"pageInfo": map[string]any{"hasNextPage": hasNextPage, "endCursor": "cursor1"},
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name             string
+		mockedClient     *http.Client
+		requestArgs      map[string]interface{}
+		expectError      bool
+		expectedErrMsg   string
+		expectedParentID int64
+	}{
+		{
+			name: "reply to comment on second page",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockGraphQL(t,
+						graphQLExchange{
+							query:             "query DiscussionComments",
+							expectedVariables: map[string]any{"owner": "owner", "repo": "repo", "number": float64(42), "after": nil},
+							data:              commentsPage(map[string]int64{"DC_kwDOA1": 111}, true),
+						},
+						graphQLExchange{
+							query:             "query DiscussionComments",
+							expectedVariables: map[string]any{"after": "cursor1"},
+							data:              commentsPage(map[string]int64{"DC_kwDOA2": 123}, false),
+						},
+						graphQLExchange{
+							query: "mutation AddDiscussionComment",
+							expectedVariables: map[string]any{
+								"discussionId": "D_kwDOA",
+								"body":         "This is a reply",
+								"replyToId":    "DC_kwDOA2",
+							},
+							data: map[string]any{
+								"addDiscussionComment": map[string]any{
+									"comment": map[string]any{
+										"id":         "DC_kwDOA3",
+										"databaseId": 456,
+										"body":       "This is a reply",
+										"url":        "https://github.com/owner/repo/discussions/42#discussioncomment-456",
+										"createdAt":  "2024-01-01T00:00:00Z",
+										"author":     map[string]any{"login": "testuser"},
+										"replyTo":    map[string]any{"databaseId": 123},
+									},
+								},
+							},
+						},
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":             "owner",
+				"repo":              "repo",
+				"discussion_number": float64(42),
+				"body":              "This is a reply",
+				"reply_to_id":       float64(123),
+			},
+			expectError:      false,
+			expectedParentID: 123,
+		},
+		{
+			name: "reply to nonexistent comment",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockGraphQL(t, graphQLExchange{
+						query: "query DiscussionComments",
+						data:  commentsPage(map[string]int64{"DC_kwDOA1": 111}, false),
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":             "owner",
+				"repo":              "repo",
+				"discussion_number": float64(42),
+				"body":              "This is a reply",
+				"reply_to_id":       float64(999),
+			},
+			expectError:    false,
+			expectedErrMsg: "comment 999 not found in discussion 42",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := AddDiscussionComment(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedReply discussionCommentReply
+			err = json.Unmarshal([]byte(textContent.Text), &returnedReply)
+			require.NoError(t, err)
+			assert.Equal(t, int64(456), returnedReply.ID)
+			assert.Equal(t, "This is a reply", returnedReply.Body)
+			assert.Equal(t, "testuser", *returnedReply.User.Login)
+			assert.Equal(t, tc.expectedParentID, returnedReply.ParentID)
+		})
+	}
+}
+
+func Test_UpdateDiscussionComment(t *testing.T) {
+	// Verify tool definition
+	mockClient := github.NewClient(nil)
+	tool, _ := UpdateDiscussionComment(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "update_discussion_comment", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "discussion_number")
+	assert.Contains(t, tool.InputSchema.Properties, "comment_id")
+	assert.Contains(t, tool.InputSchema.Properties, "body")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "discussion_number", "comment_id", "body"})
+
+	// Setup mock comment for success case
+	mockComment := &github.DiscussionComment{
+		ID:      github.Ptr(int64(123)),
+		Number:  github.Ptr(1),
+		Body:    github.Ptr("This is an updated comment"),
+		User:    &github.User{Login: github.Ptr("testuser")},
+		HTMLURL: github.Ptr("https://github.com/owner/repo/discussions/42#discussioncomment-123"),
+	}
+
+	tests := []struct {
+		name            string
+		mockedClient    *http.Client
+		requestArgs     map[string]interface{}
+		expectError     bool
+		expectedComment *github.DiscussionComment
+		expectedErrMsg  string
+	}{
+		{
+			name: "successful comment update",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposDiscussionsCommentsByOwnerByRepoByDiscussionNumberByCommentId,
+					expectRequestBody(t, map[string]any{
+						"body": "This is an updated comment",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockComment),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":             "owner",
+				"repo":              "repo",
+				"discussion_number": float64(42),
+				"comment_id":        float64(123),
+				"body":              "This is an updated comment",
+			},
+			expectError:     false,
+			expectedComment: mockComment,
+		},
+		{
+			name:         "empty body is rejected",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":             "owner",
+				"repo":              "repo",
+				"discussion_number": float64(42),
+				"comment_id":        float64(123),
+				"body":              "",
+			},
+			expectError:    false,
+			expectedErrMsg: "missing required parameter: body",
+		},
+		{
+			name: "comment update fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposDiscussionsCommentsByOwnerByRepoByDiscussionNumberByCommentId,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":             "owner",
+				"repo":              "repo",
+				"discussion_number": float64(42),
+				"comment_id":        float64(999),
+				"body":              "This is an updated comment",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to update discussion comment",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := UpdateDiscussionComment(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			if tc.expectedErrMsg != "" {
+				require.NotNil(t, result)
+				textContent := getTextResult(t, result)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			// Unmarshal and verify the result
+			var returnedComment github.DiscussionComment
+			err = json.Unmarshal([]byte(textContent.Text), &returnedComment)
+			require.NoError(t, err)
+			assert.Equal(t, *tc.expectedComment.ID, *returnedComment.ID)
+			assert.Equal(t, *tc.expectedComment.Body, *returnedComment.Body)
+			assert.Equal(t, *tc.expectedComment.HTMLURL, *returnedComment.HTMLURL)
+		})
+	}
+}
+
+func Test_MarkDiscussionAnswer(t *testing.T) {
+	// Verify tool definition
+	mockClient := github.NewClient(nil)
+	tool, _ := MarkDiscussionAnswer(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "mark_discussion_answer", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "discussion_number")
+	assert.Contains(t, tool.InputSchema.Properties, "comment_id")
+	assert.Contains(t, tool.InputSchema.Properties, "unmark")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "discussion_number", "comment_id"})
+
+	commentsPage := func(answerable bool) map[string]any {
+		return map[string]any{
+			"repository": map[string]any{
+				"discussion": map[string]any{
+					"id":       "D_kwDOA",
+					"category": map[string]any{"isAnswerable": answerable},
+					"comments": map[string]any{
+						"nodes":    []map[string]any{{"id": "DC_kwDOA1", "databaseId": 123}},
+						"pageInfo": map[string]any{"hasNextPage": false, "endCursor": "cursor1"},
+					},
+				},
+			},
+		}
+	}
+
+	answerURL := "https://github.com/owner/repo/discussions/42#discussioncomment-123"
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedErrMsg string
+		expectedResult map[string]any
+	}{
+		{
+			name: "mark comment as answer",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockGraphQL(t,
+						graphQLExchange{
+							query: "query DiscussionComments",
+							data:  commentsPage(true),
+						},
+						graphQLExchange{
+							query:             "mutation MarkDiscussionCommentAsAnswer",
+							expectedVariables: map[string]any{"id": "DC_kwDOA1"},
+							data: map[string]any{
+								"markDiscussionCommentAsAnswer": map[string]any{
+									"discussion": map[string]any{
+										"answerChosenAt": "2024-01-01T00:00:00Z",
+										"answer":         map[string]any{"url": answerURL},
+									},
+								},
+							},
+						},
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":             "owner",
+				"repo":              "repo",
+				"discussion_number": float64(42),
+				"comment_id":        float64(123),
+			},
+			expectError: false,
+			expectedResult: map[string]any{
+				"discussion_number": float64(42),
+				"answer_chosen_at":  "2024-01-01T00:00:00Z",
+				"answer_url":        answerURL,
+			},
+		},
+		{
+			name: "unmark answer",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockGraphQL(t,
+						graphQLExchange{
+							query: "query DiscussionComments",
+							data:  commentsPage(true),
+						},
+						graphQLExchange{
+							query:             "mutation UnmarkDiscussionCommentAsAnswer",
+							expectedVariables: map[string]any{"id": "DC_kwDOA1"},
+							data: map[string]any{
+								"unmarkDiscussionCommentAsAnswer": map[string]any{
+									"discussion": map[string]any{
+										"answerChosenAt": nil,
+										"answer":         nil,
+									},
+								},
+							},
+						},
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":             "owner",
+				"repo":              "repo",
+				"discussion_number": float64(42),
+				"comment_id":        float64(123),
+				"unmark":            true,
+			},
+			expectError: false,
+			expectedResult: map[string]any{
+				"discussion_number": float64(42),
+				"answer_chosen_at":  nil,
+				"answer_url":        nil,
+			},
+		},
+		{
+			name: "category does not support answers",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockGraphQL(t, graphQLExchange{
+						query: "query DiscussionComments",
+						data:  commentsPage(false),
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":             "owner",
+				"repo":              "repo",
+				"discussion_number": float64(42),
+				"comment_id":        float64(123),
+			},
+			expectError:    false,
+			expectedErrMsg: "discussion 42 is in a category that does not support answers",
+		},
+		{
+			name: "nonexistent comment",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockGraphQL(t, graphQLExchange{
+						query: "query DiscussionComments",
+						data:  commentsPage(true),
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":             "owner",
+				"repo":              "repo",
+				"discussion_number": float64(42),
+				"comment_id":        float64(999),
+			},
+			expectError:    false,
+			expectedErrMsg: "comment 999 not found in discussion 42",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := MarkDiscussionAnswer(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedResult map[string]any
+			err = json.Unmarshal([]byte(textContent.Text), &returnedResult)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, returnedResult)
+		})
+	}
+}
+
+func Test_PinDiscussion(t *testing.T) {
+	// Verify tool definitions
+	mockClient := github.NewClient(nil)
+	pinTool, _ := PinDiscussion(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	unpinTool, _ := UnpinDiscussion(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "pin_discussion", pinTool.Name)
+	assert.Equal(t, "unpin_discussion", unpinTool.Name)
+	for _, tool := range []mcp.Tool{pinTool, unpinTool} {
+		assert.NotEmpty(t, tool.Description)
+		assert.Contains(t, tool.InputSchema.Properties, "owner")
+		assert.Contains(t, tool.InputSchema.Properties, "repo")
+		assert.Contains(t, tool.InputSchema.Properties, "discussion_number")
+		assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "discussion_number"})
+	}
+
+	discussionID := graphQLExchange{
+		query:             "query DiscussionID",
+		expectedVariables: map[string]any{"owner": "owner", "repo": "repo", "number": float64(42)},
+		data: map[string]any{
+			"repository": map[string]any{"discussion": map[string]any{"id": "D_kwDOA"}},
+		},
+	}
+	pinnedDiscussions := func(field string, numbers ...int) map[string]any {
+		nodes := []map[string]any{}
+		for _, n := range numbers {
+			nodes = append(nodes, map[string]any{"discussion": map[string]any{"number": n}})
+		}
+		return map[string]any{
+			field: map[string]any{
+				"discussion": map[string]any{
+					"repository": map[string]any{
+						"pinnedDiscussions": map[string]any{"nodes": nodes},
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name           string
+		pin            bool
+		mockedClient   *http.Client
+		expectError    bool
+		expectedErrMsg string
+		expectedResult map[string]any
+	}{
+		{
+			name: "pin discussion",
+			pin:  true,
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockGraphQL(t, discussionID, graphQLExchange{
+						query:             "mutation PinDiscussion",
+						expectedVariables: map[string]any{"discussionId": "D_kwDOA"},
+						data:              pinnedDiscussions("pinDiscussion", 7, 42),
+					}),
+				),
+			),
+			expectError: false,
+			expectedResult: map[string]any{
+				"discussion_number": float64(42),
+				"pinned":            true,
+				"pinned_position":   float64(2),
+			},
+		},
+		{
+			name: "unpin discussion",
+			pin:  false,
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockGraphQL(t, discussionID, graphQLExchange{
+						query:             "mutation UnpinDiscussion",
+						expectedVariables: map[string]any{"discussionId": "D_kwDOA"},
+						data:              pinnedDiscussions("unpinDiscussion", 7),
+					}),
+				),
+			),
+			expectError: false,
+			expectedResult: map[string]any{
+				"discussion_number": float64(42),
+				"pinned":            false,
+				"pinned_position":   nil,
+			},
+		},
+		{
+			name: "pin limit reached",
+			pin:  true,
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockGraphQL(t, discussionID, graphQLExchange{
+						query: "mutation PinDiscussion",
+						errors: []map[string]any{
+							{"type": "UNPROCESSABLE", "message": "You can only pin up to 4 discussions"},
+						},
+					}),
+				),
+			),
+			expectError:    false,
+			expectedErrMsg: "failed to pin discussion: You can only pin up to 4 discussions",
+		},
+		{
+			name: "discussion not found",
+			pin:  true,
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockGraphQL(t, graphQLExchange{
+						query: "query DiscussionID",
+						data:  map[string]any{"repository": map[string]any{"discussion": nil}},
+					}),
+				),
+			),
+			expectError:    false,
+			expectedErrMsg: "discussion 42 not found",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := UnpinDiscussion(stubGetClientFn(client), translations.NullTranslationHelper)
+			if tc.pin {
+				_, handler = PinDiscussion(stubGetClientFn(client), translations.NullTranslationHelper)
+			}
+
+			// Create call request
+			request := createMCPRequest(map[string]interface{}{
+				"owner":             "owner",
+				"repo":              "repo",
+				"discussion_number": float64(42),
+			})
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedResult map[string]any
+			err = json.Unmarshal([]byte(textContent.Text), &returnedResult)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, returnedResult)
+		})
+	}
+}
+
+func Test_SearchDiscussions(t *testing.T) {
+	// Verify tool definition
+	mockClient := github.NewClient(nil)
+	tool, _ := SearchDiscussions(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "search_discussions", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "query")
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "sort")
+	assert.Contains(t, tool.InputSchema.Properties, "order")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"query"})
+
+	longBody := strings.Repeat("a", 250)
+	searchResult := map[string]any{
+		"search": map[string]any{
+			"discussionCount": 1,
+			"nodes": []map[string]any{
+				{
+					"number":    42,
+					"title":     "How do I configure caching?",
+					"url":       "https://github.com/owner/repo/discussions/42",
+					"createdAt": "2024-01-01T00:00:00Z",
+					"bodyText":  longBody,
+					"category":  map[string]any{"name": "Q&A"},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedErrMsg string
+		expectedTotal  int
+		expectNoItems  bool
+	}{
+		{
+			name: "search scoped to repository",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockGraphQL(t, graphQLExchange{
+						query: "query SearchDiscussions(",
+						expectedVariables: map[string]any{
+							"query": "caching repo:owner/repo sort:created-asc",
+							"first": float64(30),
+							"after": nil,
+						},
+						data: searchResult,
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"query": "caching",
+				"owner": "owner",
+				"repo":  "repo",
+				"sort":  "created",
+				"order": "asc",
+			},
+			expectError:   false,
+			expectedTotal: 1,
+		},
+		{
+			name: "search scoped to owner on second page",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockGraphQL(t,
+						graphQLExchange{
+							query: "query SearchDiscussionsCursor",
+							expectedVariables: map[string]any{
+								"query": "caching user:owner",
+								"first": float64(10),
+								"after": nil,
+							},
+							data: map[string]any{
+								"search": map[string]any{
+									"pageInfo": map[string]any{"hasNextPage": true, "endCursor": "cursor1"},
+								},
+							},
+						},
+						graphQLExchange{
+							query: "query SearchDiscussions(",
+							expectedVariables: map[string]any{
+								"query": "caching user:owner",
+								"first": float64(10),
+								"after": "cursor1",
+							},
+							data: searchResult,
+						},
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"query":   "caching",
+				"owner":   "owner",
+				"page":    float64(2),
+				"perPage": float64(10),
+			},
+			expectError:   false,
+			expectedTotal: 1,
+		},
+		{
+			name: "page past the last one",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockGraphQL(t,
+						graphQLExchange{
+							query: "query SearchDiscussionsCursor",
+							expectedVariables: map[string]any{
+								"query": "caching",
+								"first": float64(10),
+								"after": nil,
+							},
+							data: map[string]any{
+								"search": map[string]any{
+									"discussionCount": 7,
+									"pageInfo":        map[string]any{"hasNextPage": false, "endCursor": "cursor1"},
+								},
+							},
+						},
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"query":   "caching",
+				"page":    float64(3),
+				"perPage": float64(10),
+			},
+			expectError:   false,
+			expectedTotal: 7,
+			expectNoItems: true,
+		},
+		{
+			name:         "repo without owner",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"query": "caching",
+				"repo":  "repo",
+			},
+			expectError:    false,
+			expectedErrMsg: "owner is required when repo is set",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := SearchDiscussions(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedResult struct {
+				TotalCount int                      `json:"total_count"`
+				Items      []discussionSearchResult `json:"items"`
+			}
+			err = json.Unmarshal([]byte(textContent.Text), &returnedResult)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedTotal, returnedResult.TotalCount)
+			if tc.expectNoItems {
+				assert.Empty(t, returnedResult.Items)
+				return
+			}
+			require.Len(t, returnedResult.Items, 1)
+			assert.Equal(t, 42, returnedResult.Items[0].Number)
+			assert.Equal(t, "Q&A", returnedResult.Items[0].Category)
+			assert.Equal(t, strings.Repeat("a", 200)+"...", returnedResult.Items[0].Excerpt)
+		})
+	}
+}
+
+func Test_ListOrgDiscussions(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListOrgDiscussions(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_org_discussions", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "org")
+	assert.Contains(t, tool.InputSchema.Properties, "sort")
+	assert.Contains(t, tool.InputSchema.Properties, "direction")
+	assert.Contains(t, tool.InputSchema.Properties, "category_id")
+	assert.Contains(t, tool.InputSchema.Properties, "pinned")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org"})
+
+	// Setup mock discussions for success case
+	mockDiscussions := []*github.Discussion{
+		{
+			Number:     github.Ptr(12),
+			Title:      github.Ptr("Quarterly planning"),
+			Body:       github.Ptr("Planning for the next quarter"),
+			HTMLURL:    github.Ptr("https://github.com/orgs/org/discussions/12"),
+			CreatedAt:  &github.Timestamp{Time: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)},
+			CategoryID: github.Ptr("1"),
+			Category:   &github.DiscussionCategory{ID: github.Ptr("1"), Name: github.Ptr("Announcements")},
+		},
+		{
+			Number:     github.Ptr(34),
+			Title:      github.Ptr("New onboarding guide"),
+			Body:       github.Ptr("Feedback welcome"),
+			HTMLURL:    github.Ptr("https://github.com/orgs/org/discussions/34"),
+			CreatedAt:  &github.Timestamp{Time: time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)},
+			CategoryID: github.Ptr("2"),
+			Category:   &github.DiscussionCategory{ID: github.Ptr("2"), Name: github.Ptr("General")},
+		},
+	}
+
+	tests := []struct {
+		name                string
+		mockedClient        *http.Client
+		requestArgs         map[string]interface{}
+		expectError         bool
+		expectedErrMsg      string
+		expectedDiscussions []*github.Discussion
+	}{
+		{
+			name: "list organization discussions with minimal parameters",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetOrgsDiscussionsByOrg,
+					mockDiscussions,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"org": "org",
+			},
+			expectError:         false,
+			expectedDiscussions: mockDiscussions,
+		},
+		{
+			name: "list organization discussions with all parameters",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetOrgsDiscussionsByOrg,
+					expectQueryParams(t, map[string]string{
+						"sort":      "comments",
+						"direction": "asc",
+						"category":  "1",
+						"pinned":    "false",
+						"page":      "2",
+						"per_page":  "10",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockDiscussions),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"org":         "org",
+				"sort":        "comments",
+				"direction":   "asc",
+				"category_id": "1",
+				"pinned":      "false",
+				"page":        float64(2),
+				"perPage":     float64(10),
+			},
+			expectError:         false,
+			expectedDiscussions: mockDiscussions,
+		},
+		{
+			name: "list organization discussions fails with error",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetOrgsDiscussionsByOrg,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"org": "nonexistent",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list organization discussions",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListOrgDiscussions(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+
+			// Parse the result and get the text content if no error
+			textContent := getTextResult(t, result)
+
+			// Unmarshal and verify the result
+			var returnedDiscussions []*github.Discussion
+			err = json.Unmarshal([]byte(textContent.Text), &returnedDiscussions)
+			require.NoError(t, err)
+
+			assert.Len(t, returnedDiscussions, len(tc.expectedDiscussions))
+			for i, discussion := range returnedDiscussions {
+				assert.Equal(t, *tc.expectedDiscussions[i].Number, *discussion.Number)
+				assert.Equal(t, *tc.expectedDiscussions[i].Title, *discussion.Title)
+				assert.Equal(t, *tc.expectedDiscussions[i].HTMLURL, *discussion.HTMLURL)
+				assert.Equal(t, *tc.expectedDiscussions[i].CategoryID, *discussion.CategoryID)
+			}
+		})
+	}
+}
+
+func Test_DiscussionLabels(t *testing.T) {
+	// Verify tool definitions
+	mockClient := github.NewClient(nil)
+	addTool, _ := AddDiscussionLabels(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	removeTool, _ := RemoveDiscussionLabels(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "add_discussion_labels", addTool.Name)
+	assert.Equal(t, "remove_discussion_labels", removeTool.Name)
+	for _, tool := range []mcp.Tool{addTool, removeTool} {
+		assert.NotEmpty(t, tool.Description)
+		assert.Contains(t, tool.InputSchema.Properties, "owner")
+		assert.Contains(t, tool.InputSchema.Properties, "repo")
+		assert.Contains(t, tool.InputSchema.Properties, "discussion_number")
+		assert.Contains(t, tool.InputSchema.Properties, "labels")
+		assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "discussion_number", "labels"})
+	}
+
+	labelNodes := func(names ...string) map[string]any {
+		nodes := []map[string]any{}
+		for _, name := range names {
+			nodes = append(nodes, map[string]any{"name": name})
+		}
+		return map[string]any{"nodes": nodes}
+	}
+	discussionLabels := graphQLExchange{
+		query:             "query DiscussionLabels",
+		expectedVariables: map[string]any{"owner": "owner", "repo": "repo", "number": float64(42)},
+		data: map[string]any{
+			"repository": map[string]any{
+				"discussion": map[string]any{"id": "D_kwDOA", "labels": labelNodes("question", "bug")},
+			},
+		},
+	}
+	labelID := func(name string, id any) graphQLExchange {
+		label := any(nil)
+		if id != nil {
+			label = map[string]any{"id": id}
+		}
+		return graphQLExchange{
+			query:             "query LabelID",
+			expectedVariables: map[string]any{"name": name},
+			data:              map[string]any{"repository": map[string]any{"label": label}},
+		}
+	}
+
+	tests := []struct {
+		name           string
+		add            bool
+		mockedClient   *http.Client
+		labels         []any
+		expectedErrMsg string
+		expectedLabels []any
+		expectedErrors []any
+	}{
+		{
+			name: "add labels with one nonexistent label",
+			add:  true,
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockGraphQL(t,
+						discussionLabels,
+						labelID("enhancement", "LA_1"),
+						labelID("nonexistent", nil),
+						graphQLExchange{
+							query: "mutation AddLabelsToLabelable",
+							expectedVariables: map[string]any{
+								"labelableId": "D_kwDOA",
+								"labelIds":    []any{"LA_1"},
+							},
+							data: map[string]any{
+								"addLabelsToLabelable": map[string]any{
+									"labelable": map[string]any{"labels": labelNodes("question", "bug", "enhancement")},
+								},
+							},
+						},
+					),
+				),
+			),
+			labels:         []any{"enhancement", "nonexistent"},
+			expectedLabels: []any{"question", "bug", "enhancement"},
+			expectedErrors: []any{map[string]any{"label": "nonexistent", "message": "label not found"}},
+		},
+		{
+			name: "remove labels",
+			add:  false,
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockGraphQL(t,
+						discussionLabels,
+						labelID("bug", "LA_2"),
+						graphQLExchange{
+							query: "mutation RemoveLabelsFromLabelable",
+							expectedVariables: map[string]any{
+								"labelableId": "D_kwDOA",
+								"labelIds":    []any{"LA_2"},
+							},
+							data: map[string]any{
+								"removeLabelsFromLabelable": map[string]any{
+									"labelable": map[string]any{"labels": labelNodes("question")},
+								},
+							},
+						},
+					),
+				),
+			),
+			labels:         []any{"bug"},
+			expectedLabels: []any{"question"},
+			expectedErrors: []any{},
+		},
+		{
+			name: "only nonexistent labels leave the discussion unchanged",
+			add:  true,
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockGraphQL(t,
+						discussionLabels,
+						labelID("nonexistent", nil),
+					),
+				),
+			),
+			labels:         []any{"nonexistent"},
+			expectedLabels: []any{"question", "bug"},
+			expectedErrors: []any{map[string]any{"label": "nonexistent", "message": "label not found"}},
+		},
+		{
+			name:           "empty labels",
+			add:            true,
+			mockedClient:   mock.NewMockedHTTPClient(),
+			labels:         []any{},
+			expectedErrMsg: "missing required parameter: labels",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := RemoveDiscussionLabels(stubGetClientFn(client), translations.NullTranslationHelper)
+			if tc.add {
+				_, handler = AddDiscussionLabels(stubGetClientFn(client), translations.NullTranslationHelper)
+			}
+
+			// Create call request
+			request := createMCPRequest(map[string]interface{}{
+				"owner":             "owner",
+				"repo":              "repo",
+				"discussion_number": float64(42),
+				"labels":            tc.labels,
+			})
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedResult map[string]any
+			err = json.Unmarshal([]byte(textContent.Text), &returnedResult)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedLabels, returnedResult["labels"])
+			assert.Equal(t, tc.expectedErrors, returnedResult["errors"])
+		})
+	}
+}
+
+func Test_ConvertIssueToDiscussion(t *testing.T) {
+	// Verify tool definition
+	mockClient := github.NewClient(nil)
+	tool, _ := ConvertIssueToDiscussion(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "convert_issue_to_discussion", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "issue_number")
+	assert.Contains(t, tool.InputSchema.Properties, "category_id")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "issue_number", "category_id"})
+
+	issueAndCategories := graphQLExchange{
+		query:             "query IssueAndDiscussionCategories",
+		expectedVariables: map[string]any{"owner": "owner", "repo": "repo", "number": float64(7)},
+		data: map[string]any{
+			"repository": map[string]any{
+				"issue": map[string]any{"id": "I_kwDOA"},
+				"discussionCategories": map[string]any{
+					"nodes": []map[string]any{
+						{"id": "DIC_kwDOA1", "slug": "general"},
+						{"id": "DIC_kwDOA2", "slug": "q-a"},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		categoryID     string
+		expectedErrMsg string
+		expectedResult map[string]any
+	}{
+		{
+			name: "convert issue",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockGraphQL(t, issueAndCategories, graphQLExchange{
+						query:             "mutation ConvertIssueToDiscussion",
+						expectedVariables: map[string]any{"issueId": "I_kwDOA", "categoryId": "DIC_kwDOA2"},
+						data: map[string]any{
+							"convertIssueToDiscussion": map[string]any{
+								"discussion": map[string]any{
+									"number": 43,
+									"url":    "https://github.com/owner/repo/discussions/43",
+								},
+							},
+						},
+					}),
+				),
+			),
+			categoryID: "q-a",
+			expectedResult: map[string]any{
+				"issue_number":      float64(7),
+				"discussion_number": float64(43),
+				"url":               "https://github.com/owner/repo/discussions/43",
+			},
+		},
+		{
+			name: "issue already converted",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockGraphQL(t, issueAndCategories, graphQLExchange{
+						query: "mutation ConvertIssueToDiscussion",
+						errors: []map[string]any{
+							{"type": "UNPROCESSABLE", "message": "Issue has already been converted to a discussion"},
+						},
+					}),
+				),
+			),
+			categoryID:     "DIC_kwDOA1",
+			expectedErrMsg: "failed to convert issue to discussion: Issue has already been converted to a discussion",
+		},
+		{
+			name: "unknown category",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockGraphQL(t, issueAndCategories),
+				),
+			),
+			categoryID:     "ideas",
+			expectedErrMsg: "discussion category ideas not found",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ConvertIssueToDiscussion(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(7),
+				"category_id":  tc.categoryID,
+			})
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedResult map[string]any
+			err = json.Unmarshal([]byte(textContent.Text), &returnedResult)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, returnedResult)
+		})
+	}
+}
+
+func Test_TransferDiscussion(t *testing.T) {
+	// Verify tool definition
+	mockClient := github.NewClient(nil)
+	tool, _ := TransferDiscussion(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "transfer_discussion", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "discussion_number")
+	assert.Contains(t, tool.InputSchema.Properties, "target_repo")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "discussion_number", "target_repo"})
+
+	discussionID := graphQLExchange{
+		query:             "query DiscussionID",
+		expectedVariables: map[string]any{"owner": "owner", "repo": "repo", "number": float64(42)},
+		data: map[string]any{
+			"repository": map[string]any{"discussion": map[string]any{"id": "D_kwDOA"}},
+		},
+	}
+	repositoryID := func(owner string) graphQLExchange {
+		return graphQLExchange{
+			query:             "query RepositoryID",
+			expectedVariables: map[string]any{"owner": owner, "repo": "target"},
+			data: map[string]any{
+				"repository": map[string]any{"id": "R_kgDOB"},
+			},
+		}
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		targetRepo     string
+		expectedErrMsg string
+		expectedResult map[string]any
+	}{
+		{
+			name: "transfer discussion",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockGraphQL(t, discussionID, repositoryID("owner"), graphQLExchange{
+						query:             "mutation TransferDiscussion",
+						expectedVariables: map[string]any{"discussionId": "D_kwDOA", "repositoryId": "R_kgDOB"},
+						data: map[string]any{
+							"transferDiscussion": map[string]any{
+								"discussion": map[string]any{
+									"number": 5,
+									"url":    "https://github.com/owner/target/discussions/5",
+								},
+							},
+						},
+					}),
+				),
+			),
+			targetRepo: "owner/target",
+			expectedResult: map[string]any{
+				"repository":        "owner/target",
+				"discussion_number": float64(5),
+				"url":               "https://github.com/owner/target/discussions/5",
+			},
+		},
+		{
+			name: "cross-organization transfer",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockGraphQL(t, discussionID, repositoryID("other"), graphQLExchange{
+						query: "mutation TransferDiscussion",
+						errors: []map[string]any{
+							{"type": "UNPROCESSABLE", "message": "Discussions can only be transferred within the same owner"},
+						},
+					}),
+				),
+			),
+			targetRepo:     "other/target",
+			expectedErrMsg: "failed to transfer discussion: Discussions can only be transferred within the same owner",
+		},
+		{
+			name:           "malformed target repository",
+			mockedClient:   mock.NewMockedHTTPClient(),
+			targetRepo:     "target",
+			expectedErrMsg: "target_repo must be in 'owner/name' form",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := TransferDiscussion(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(map[string]interface{}{
+				"owner":             "owner",
+				"repo":              "repo",
+				"discussion_number": float64(42),
+				"target_repo":       tc.targetRepo,
+			})
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedResult map[string]any
+			err = json.Unmarshal([]byte(textContent.Text), &returnedResult)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, returnedResult)
+		})
+	}
+}
+
+func Test_GetDiscussionCommentsWithReplies(t *testing.T) {
+	// Verify tool definition
+	mockClient := github.NewClient(nil)
+	tool, _ := GetDiscussionComments(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Contains(t, tool.InputSchema.Properties, "include_replies")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "discussion_number"})
+
+	// Setup mock comments, only the first one has replies
+	mockComments := []*github.DiscussionComment{
+		{
+			ID:      github.Ptr(int64(123)),
+			NodeID:  github.Ptr("DC_kwDOA1"),
+			Number:  github.Ptr(1),
+			Body:    github.Ptr("How do I configure caching?"),
+			User:    &github.User{Login: github.Ptr("user1")},
+			HTMLURL: github.Ptr("https://github.com/owner/repo/discussions/42#discussioncomment-123"),
+		},
+		{
+			ID:      github.Ptr(int64(456)),
+			NodeID:  github.Ptr("DC_kwDOA2"),
+			Number:  github.Ptr(2),
+			Body:    github.Ptr("Thanks for the release!"),
+			User:    &github.User{Login: github.Ptr("user2")},
+			HTMLURL: github.Ptr("https://github.com/owner/repo/discussions/42#discussioncomment-456"),
+		},
+	}
+
+	replies := func(totalCount int, nodes ...map[string]any) map[string]any {
+		return map[string]any{"totalCount": totalCount, "nodes": nodes}
+	}
+
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatch(
+			mock.GetReposDiscussionsCommentsByOwnerByRepoByDiscussionNumber,
+			mockComments,
+		),
+		mock.WithRequestMatchHandler(
+			postGraphQL,
+			mockGraphQL(t, graphQLExchange{
+				query: "query DiscussionCommentReplies",
+				expectedVariables: map[string]any{
+					"ids":   []any{"DC_kwDOA1", "DC_kwDOA2"},
+					"first": float64(100),
+				},
+				data: map[string]any{
+					"nodes": []map[string]any{
+						{
+							"id": "DC_kwDOA1",
+							"replies": replies(150, map[string]any{
+								"id":         "DC_kwDOA3",
+								"databaseId": 789,
+								"body":       "Set the cache option in the config file",
+								"url":        "https://github.com/owner/repo/discussions/42#discussioncomment-789",
+								"createdAt":  "2024-01-01T00:00:00Z",
+								"author":     map[string]any{"login": "maintainer"},
+							}),
+						},
+						{
+							"id":      "DC_kwDOA2",
+							"replies": replies(0),
+						},
+					},
+				},
+			}),
+		),
+	)
+
+	client := github.NewClient(mockedClient)
+	_, handler := GetDiscussionComments(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	request := createMCPRequest(map[string]interface{}{
+		"owner":             "owner",
+		"repo":              "repo",
+		"discussion_number": float64(42),
+		"include_replies":   true,
+	})
+
+	result, err := handler(context.Background(), request)
+	require.NoError(t, err)
+	textContent := getTextResult(t, result)
+
+	var returnedComments []struct {
+		ID               int64                    `json:"id"`
+		Body             string                   `json:"body"`
+		Replies          []discussionCommentReply `json:"replies"`
+		RepliesTruncated bool                     `json:"replies_truncated"`
+	}
+	err = json.Unmarshal([]byte(textContent.Text), &returnedComments)
+	require.NoError(t, err)
+	require.Len(t, returnedComments, 2)
+
+	assert.Equal(t, int64(123), returnedComments[0].ID)
+	assert.Equal(t, "How do I configure caching?", returnedComments[0].Body)
+	require.Len(t, returnedComments[0].Replies, 1)
+	assert.Equal(t, int64(789), returnedComments[0].Replies[0].ID)
+	assert.Equal(t, int64(123), returnedComments[0].Replies[0].ParentID)
+	assert.Equal(t, "maintainer", *returnedComments[0].Replies[0].User.Login)
+	assert.True(t, returnedComments[0].RepliesTruncated)
+
+	assert.Equal(t, int64(456), returnedComments[1].ID)
+	assert.Empty(t, returnedComments[1].Replies)
+	assert.False(t, returnedComments[1].RepliesTruncated)
+}
+
+func Test_CreateDiscussionCategory(t *testing.T) {
+	// Verify tool definition
+	mockClient := github.NewClient(nil)
+	tool, _ := CreateDiscussionCategory(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "create_discussion_category", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "name")
+	assert.Contains(t, tool.InputSchema.Properties, "description")
+	assert.Contains(t, tool.InputSchema.Properties, "emoji")
+	assert.Contains(t, tool.InputSchema.Properties, "format")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "name"})
+
+	repositoryID := graphQLExchange{
+		query:             "query RepositoryID",
+		expectedVariables: map[string]any{"owner": "owner", "repo": "repo"},
+		data:              map[string]any{"repository": map[string]any{"id": "R_kgDOA"}},
+	}
+
+	tests := []struct {
+		name             string
+		mockedClient     *http.Client
+		requestArgs      map[string]interface{}
+		expectedErrMsg   string
+		expectedCategory discussionCategoryNode
+	}{
+		{
+			name: "create category",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockGraphQL(t, repositoryID, graphQLExchange{
+						query: "mutation CreateDiscussionCategory",
+						expectedVariables: map[string]any{
+							"input": map[string]any{
+								"repositoryId": "R_kgDOA",
+								"name":         "Help",
+								"description":  "Ask the community for help",
+								"emoji":        ":pray:",
+								"format":       "QUESTION",
+							},
+						},
+						data: map[string]any{
+							"createDiscussionCategory": map[string]any{
+								"category": map[string]any{
+									"id":           "DIC_kwDOA1",
+									"name":         "Help",
+									"slug":         "help",
+									"description":  "Ask the community for help",
+									"emoji":        ":pray:",
+									"isAnswerable": true,
+								},
+							},
+						},
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"name":        "Help",
+				"description": "Ask the community for help",
+				"emoji":       ":pray:",
+				"format":      "question",
+			},
+			expectedCategory: discussionCategoryNode{
+				ID:           "DIC_kwDOA1",
+				Name:         "Help",
+				Slug:         "help",
+				Description:  "Ask the community for help",
+				Emoji:        ":pray:",
+				IsAnswerable: true,
+			},
+		},
+		{
+			name: "category name already exists",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockGraphQL(t, repositoryID, graphQLExchange{
+						query: "mutation CreateDiscussionCategory",
+						errors: []map[string]any{
+							{"type": "UNPROCESSABLE", "message": "Name has already been taken"},
+						},
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"name":  "General",
+			},
+			expectedErrMsg: `failed to create discussion category: a discussion category named "General" already exists`,
+		},
+		{
+			name: "error mentioning already other than a taken name",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockGraphQL(t, repositoryID, graphQLExchange{
+						query: "mutation CreateDiscussionCategory",
+						errors: []map[string]any{
+							{"type": "UNPROCESSABLE", "message": "Repository already has the maximum number of discussion categories"},
+						},
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"name":  "General",
+			},
+			expectedErrMsg: "failed to create discussion category: Repository already has the maximum number of discussion categories",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := CreateDiscussionCategory(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedCategory discussionCategoryNode
+			err = json.Unmarshal([]byte(textContent.Text), &returnedCategory)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedCategory, returnedCategory)
+		})
+	}
+}
+
+func Test_UpdateDiscussionCategory(t *testing.T) {
+	// Verify tool definition
+	mockClient := github.NewClient(nil)
+	tool, _ := UpdateDiscussionCategory(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "update_discussion_category", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "category_id")
+	assert.Contains(t, tool.InputSchema.Properties, "name")
+	assert.Contains(t, tool.InputSchema.Properties, "description")
+	assert.Contains(t, tool.InputSchema.Properties, "emoji")
+	assert.Contains(t, tool.InputSchema.Properties, "format")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"category_id"})
+
+	tests := []struct {
+		name             string
+		mockedClient     *http.Client
+		requestArgs      map[string]interface{}
+		expectedErrMsg   string
+		expectedCategory discussionCategoryNode
+	}{
+		{
+			name: "rename category",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockGraphQL(t, graphQLExchange{
+						query: "mutation UpdateDiscussionCategory",
+						expectedVariables: map[string]any{
+							"input": map[string]any{
+								"categoryId": "DIC_kwDOA1",
+								"name":       "Support",
+							},
+						},
+						data: map[string]any{
+							"updateDiscussionCategory": map[string]any{
+								"category": map[string]any{
+									"id":   "DIC_kwDOA1",
+									"name": "Support",
+									"slug": "support",
+								},
+							},
+						},
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"category_id": "DIC_kwDOA1",
+				"name":        "Support",
+			},
+			expectedCategory: discussionCategoryNode{
+				ID:   "DIC_kwDOA1",
+				Name: "Support",
+				Slug: "support",
+			},
+		},
+		{
+			name: "category name already exists",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockGraphQL(t, graphQLExchange{
+						query: "mutation UpdateDiscussionCategory",
+						errors: []map[string]any{
+							{"type": "UNPROCESSABLE", "message": "Name has already been taken"},
+						},
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"category_id": "DIC_kwDOA1",
+				"name":        "General",
+			},
+			expectedErrMsg: `failed to update discussion category: a discussion category named "General" already exists`,
+		},
+		{
+			name: "error mentioning already without a new name",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockGraphQL(t, graphQLExchange{
+						query: "mutation UpdateDiscussionCategory",
+						errors: []map[string]any{
+							{"type": "UNPROCESSABLE", "message": "Emoji is already in use"},
+						},
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"category_id": "DIC_kwDOA1",
+				"emoji":       ":rocket:",
+			},
+			expectedErrMsg: "failed to update discussion category: Emoji is already in use",
+		},
+		{
+			name:         "no fields to update",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"category_id": "DIC_kwDOA1",
+			},
+			expectedErrMsg: "at least one of name, description, emoji, or format is required",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := UpdateDiscussionCategory(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedCategory discussionCategoryNode
+			err = json.Unmarshal([]byte(textContent.Text), &returnedCategory)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedCategory, returnedCategory)
+		})
+	}
+}
+
+func Test_ListDiscussionsCreatedWindow(t *testing.T) {
+	// Verify tool definition
+	mockClient := github.NewClient(nil)
+	tool, _ := ListDiscussions(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Contains(t, tool.InputSchema.Properties, "since")
+	assert.Contains(t, tool.InputSchema.Properties, "before")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	discussion := func(number int, day int) *github.Discussion {
+		return &github.Discussion{
+			Number:    github.Ptr(number),
+			Title:     github.Ptr(fmt.Sprintf("Discussion %d", number)),
+			CreatedAt: &github.Timestamp{Time: time.Date(2024, 1, day, 12, 0, 0, 0, time.UTC)},
+		}
+	}
+	// More pages than are scanned, all newer than the window
+	newerPages := make([]interface{}, discussionListMaxPages+1)
+	for i := range newerPages {
+		newerPages[i] = []*github.Discussion{discussion(100-i, 20)}
+	}
+
+	tests := []struct {
+		name               string
+		mockedClient       *http.Client
+		requestArgs        map[string]interface{}
+		expectedErrMsg     string
+		expectedNumbers    []int
+		expectedPages      int
+		expectedNextPage   int
+		expectedNextOffset int
+		expectedTruncated  bool
+	}{
+		{
+			name: "stops once discussions are older than since",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchPages(
+					mock.GetReposDiscussionsByOwnerByRepo,
+					[]*github.Discussion{discussion(5, 10), discussion(4, 8)},
+					[]*github.Discussion{discussion(3, 6), discussion(2, 3)},
+					[]*github.Discussion{discussion(1, 1)},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"since":  "2024-01-05",
+				"before": "2024-01-09T00:00:00Z",
+			},
+			expectedNumbers: []int{4, 3},
+			expectedPages:   2,
+		},
+		{
+			name: "ascending order stops at before",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchPages(
+					mock.GetReposDiscussionsByOwnerByRepo,
+					[]*github.Discussion{discussion(1, 1), discussion(2, 3)},
+					[]*github.Discussion{discussion(3, 6), discussion(4, 8)},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":     "owner",
+				"repo":      "repo",
+				"direction": "asc",
+				"before":    "2024-01-04",
+			},
+			expectedNumbers: []int{1, 2},
+			expectedPages:   2,
+		},
+		{
+			name: "matches spanning pages resume on the page they stop",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchPages(
+					mock.GetReposDiscussionsByOwnerByRepo,
+					[]*github.Discussion{discussion(6, 10), discussion(5, 9)},
+					[]*github.Discussion{discussion(4, 8), discussion(3, 7)},
+					[]*github.Discussion{discussion(2, 6)},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"since":   "2024-01-05",
+				"perPage": float64(3),
+			},
+			expectedNumbers:    []int{6, 5, 4},
+			expectedPages:      2,
+			expectedNextPage:   2,
+			expectedNextOffset: 1,
+		},
+		{
+			name: "resume from next offset",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposDiscussionsByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"sort":      "created",
+						"direction": "desc",
+						"page":      "2",
+						"per_page":  "3",
+					}).andThen(
+						mockResponse(t, http.StatusOK, []*github.Discussion{discussion(4, 8), discussion(3, 7)}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"since":   "2024-01-05",
+				"page":    float64(2),
+				"perPage": float64(3),
+				"offset":  float64(1),
+			},
+			expectedNumbers: []int{3},
+			expectedPages:   1,
+		},
+		{
+			name: "page limit reached before the window",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchPages(
+					mock.GetReposDiscussionsByOwnerByRepo,
+					newerPages...,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"before": "2024-01-15",
+			},
+			expectedNumbers:   []int{},
+			expectedPages:     discussionListMaxPages,
+			expectedNextPage:  discussionListMaxPages + 1,
+			expectedTruncated: true,
+		},
+		{
+			name:         "negative offset",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"since":  "2024-01-05",
+				"offset": float64(-1),
+			},
+			expectedErrMsg: "offset must not be negative",
+		},
+		{
+			name:         "malformed timestamp",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"since": "last week",
+			},
+			expectedErrMsg: "invalid since: invalid ISO 8601 timestamp: last week",
+		},
+		{
+			name:         "time window with another sort field",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"sort":  "updated",
+				"since": "2024-01-05",
+			},
+			expectedErrMsg: "since and before can only be combined with sort 'created'",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListDiscussions(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedResult discussionsCreatedBetween
+			err = json.Unmarshal([]byte(textContent.Text), &returnedResult)
+			require.NoError(t, err)
+
+			numbers := []int{}
+			for _, discussion := range returnedResult.Discussions {
+				numbers = append(numbers, *discussion.Number)
+			}
+			assert.Equal(t, tc.expectedNumbers, numbers)
+			assert.Equal(t, tc.expectedPages, returnedResult.PagesConsumed)
+			assert.Equal(t, tc.expectedNextPage, returnedResult.NextPage)
+			assert.Equal(t, tc.expectedNextOffset, returnedResult.NextOffset)
+			assert.Equal(t, tc.expectedTruncated, returnedResult.Truncated)
+		})
+	}
+}
+
+func Test_GetDiscussionCommentsFetchAll(t *testing.T) {
+	// Verify tool definition
+	mockClient := github.NewClient(nil)
+	tool, _ := GetDiscussionComments(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Contains(t, tool.InputSchema.Properties, "fetch_all")
+	assert.Contains(t, tool.InputSchema.Properties, "max_comments")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "discussion_number"})
+
+	comment := func(id int64) *github.DiscussionComment {
+		return &github.DiscussionComment{
+			ID:   github.Ptr(id),
+			Body: github.Ptr(fmt.Sprintf("Comment %d", id)),
+		}
+	}
+	pages := mock.WithRequestMatchPages(
+		mock.GetReposDiscussionsCommentsByOwnerByRepoByDiscussionNumber,
+		[]*github.DiscussionComment{comment(1), comment(2)},
+		[]*github.DiscussionComment{comment(3), comment(4)},
+		[]*github.DiscussionComment{comment(5)},
+	)
+
+	tests := []struct {
+		name              string
+		mockedClient      *http.Client
+		requestArgs       map[string]interface{}
+		expectedErrMsg    string
+		expectedIDs       []int64
+		expectedTruncated bool
+	}{
+		{
+			name:         "fetch all pages",
+			mockedClient: mock.NewMockedHTTPClient(pages),
+			requestArgs: map[string]interface{}{
+				"owner":             "owner",
+				"repo":              "repo",
+				"discussion_number": float64(42),
+				"fetch_all":         true,
+			},
+			expectedIDs: []int64{1, 2, 3, 4, 5},
+		},
+		{
+			name:         "fetch all pages up to max_comments",
+			mockedClient: mock.NewMockedHTTPClient(pages),
+			requestArgs: map[string]interface{}{
+				"owner":             "owner",
+				"repo":              "repo",
+				"discussion_number": float64(42),
+				"fetch_all":         true,
+				"max_comments":      float64(3),
+			},
+			expectedIDs:       []int64{1, 2, 3},
+			expectedTruncated: true,
+		},
+		{
+			name:         "negative max_comments",
+			mockedClient: mock.NewMockedHTTPClient(pages),
+			requestArgs: map[string]interface{}{
+				"owner":             "owner",
+				"repo":              "repo",
+				"discussion_number": float64(42),
+				"fetch_all":         true,
+				"max_comments":      float64(-1),
+			},
+			expectedErrMsg: "max_comments must be at least 1",
+		},
+		{
+			name: "rate limited",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposDiscussionsCommentsByOwnerByRepoByDiscussionNumber,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.Header().Set("X-RateLimit-Remaining", "0")
+						w.Header().Set("X-RateLimit-Reset", "1704110400")
+						w.WriteHeader(http.StatusForbidden)
+						_, _ = w.Write([]byte(`{"message": "API rate limit exceeded"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":             "owner",
+				"repo":              "repo",
+				"discussion_number": float64(42),
+				"fetch_all":         true,
+			},
+			expectedErrMsg: `{"error":"rate_limited","message":"failed to get discussion comments after fetching 0: API rate limit exceeded","retry_after_seconds":0}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetDiscussionComments(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedResult struct {
+				Comments     []*github.DiscussionComment `json:"comments"`
+				TotalFetched int                         `json:"total_fetched"`
+				Truncated    bool                        `json:"truncated"`
+			}
+			err = json.Unmarshal([]byte(textContent.Text), &returnedResult)
+			require.NoError(t, err)
+
+			ids := []int64{}
+			for _, comment := range returnedResult.Comments {
+				ids = append(ids, *comment.ID)
+			}
+			assert.Equal(t, tc.expectedIDs, ids)
+			assert.Equal(t, len(tc.expectedIDs), returnedResult.TotalFetched)
+			assert.Equal(t, tc.expectedTruncated, returnedResult.Truncated)
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// graphQLRequest is the body of a request to the GitHub GraphQL API.
+type graphQLRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables,omitempty"`
+}
+
+// graphQLError is a single entry of the errors array in a GraphQL response.
+type graphQLError struct {
+	Type    string `json:"type,omitempty"`
+	Message string `json:"message"`
+}
+
+// graphQLErrors are the errors reported in the body of a GraphQL response.
+type graphQLErrors []graphQLError
+
+func (e graphQLErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Message
+	}
+	return strings.Join(messages, "; ")
+}
+
+// hasType reports whether any of the errors has the given type, e.g. "NOT_FOUND".
+func (e graphQLErrors) hasType(errorType string) bool {
+	for _, err := range e {
+		if err.Type == errorType {
+			return true
+		}
+	}
+	return false
+}
+
+// graphQLURL returns the GraphQL endpoint matching the REST base URL of the client.
+// GitHub Enterprise Server serves REST under /api/v3/ and GraphQL under /api/graphql.
+func graphQLURL(client *github.Client) string {
+	u := *client.BaseURL
+	if strings.HasSuffix(u.Path, "/api/v3/") {
+		u.Path = strings.TrimSuffix(u.Path, "v3/") + "graphql"
+	} else {
+		u.Path += "graphql"
+	}
+	return u.String()
+}
+
+// executeGraphQL runs a query or mutation against the GitHub GraphQL API using the
+// transport and credentials of the REST client, and decodes the "data" field of the
+// response into v. Errors reported in the response body are returned as graphQLErrors.
+func executeGraphQL(ctx context.Context, client *github.Client, query string, variables map[string]interface{}, v interface{}) error {
+	req, err := client.NewRequest(http.MethodPost, graphQLURL(client), &graphQLRequest{
+		Query:     query,
+		Variables: variables,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create GraphQL request: %w", err)
+	}
+
+	var response struct {
+		Data   json.RawMessage `json:"data"`
+		Errors graphQLErrors   `json:"errors"`
+	}
+	if _, err := client.Do(ctx, req, &response); err != nil {
+		return err
+	}
+	if len(response.Errors) > 0 {
+		return response.Errors
+	}
+
+	if v != nil && len(response.Data) > 0 {
+		if err := json.Unmarshal(response.Data, v); err != nil {
+			return fmt.Errorf("failed to unmarshal GraphQL response: %w", err)
+		}
+	}
+	return nil
+}
+
+// graphQLErrorResult converts errors reported in the body of a GraphQL response into a
+// tool result error, so that clients can recover from them. It returns nil when err is
+// not a graphQLErrors.
+func graphQLErrorResult(message string, err error) *mcp.CallToolResult {
+	var gqlErrs graphQLErrors
+	if !errors.As(err, &gqlErrs) {
+		return nil
+	}
+	return mcp.NewToolResultError(fmt.Sprintf("%s: %s", message, gqlErrs.Error()))
+}
@@ -0,0 +1,137 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GraphQLURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		baseURL  string
+		expected string
+	}{
+		{
+			name:     "github.com",
+			baseURL:  "https://api.github.com/",
+			expected: "https://api.github.com/graphql",
+		},
+		{
+			name:     "github enterprise server",
+			baseURL:  "https://ghes.example.com/api/v3/",
+			expected: "https://ghes.example.com/api/graphql",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(nil)
+			baseURL, err := url.Parse(tc.baseURL)
+			require.NoError(t, err)
+			client.BaseURL = baseURL
+
+			assert.Equal(t, tc.expected, graphQLURL(client))
+		})
+	}
+}
+
+func Test_ExecuteGraphQL(t *testing.T) {
+	type viewerResult struct {
+		Viewer struct {
+			Login string `json:"login"`
+		} `json:"viewer"`
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedErrMsg string
+		expectedLogin  string
+	}{
+		{
+			name: "successful query",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockGraphQL(t, graphQLExchange{
+						query:             "query Viewer",
+						expectedVariables: map[string]any{"first": float64(1)},
+						data:              map[string]any{"viewer": map[string]any{"login": "octocat"}},
+					}),
+				),
+			),
+			expectError:   false,
+			expectedLogin: "octocat",
+		},
+		{
+			name: "errors in response body",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockGraphQL(t, graphQLExchange{
+						query: "query Viewer",
+						errors: []map[string]any{
+							{"type": "NOT_FOUND", "message": "Could not resolve to a node"},
+							{"message": "Something else went wrong"},
+						},
+					}),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "Could not resolve to a node; Something else went wrong",
+		},
+		{
+			name: "http error",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockResponse(t, http.StatusUnauthorized, map[string]string{"message": "Bad credentials"}),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "Bad credentials",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+
+			var result viewerResult
+			err := executeGraphQL(context.Background(), client, "query Viewer($first: Int!) { viewer { login } }", map[string]interface{}{"first": 1}, &result)
+
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedLogin, result.Viewer.Login)
+		})
+	}
+}
+
+func Test_GraphQLErrorsHasType(t *testing.T) {
+	errs := graphQLErrors{{Type: "FORBIDDEN", Message: "no"}, {Message: "untyped"}}
+	assert.True(t, errs.hasType("FORBIDDEN"))
+	assert.False(t, errs.hasType("NOT_FOUND"))
+}
+
+func Test_GraphQLErrorResult(t *testing.T) {
+	result := graphQLErrorResult("failed to pin discussion", graphQLErrors{{Message: "first"}, {Message: "second"}})
+	require.NotNil(t, result)
+	assert.True(t, result.IsError)
+	assert.Equal(t, "failed to pin discussion: first; second", getTextResult(t, result).Text)
+
+	assert.Nil(t, graphQLErrorResult("failed to pin discussion", errors.New("connection reset")))
+}
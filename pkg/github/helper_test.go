@@ -3,9 +3,11 @@ package github
 import (
 	"encoding/json"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -68,6 +70,72 @@ func mockResponse(t *testing.T, code int, body interface{}) http.HandlerFunc {
 	}
 }
 
+// mockSequence is a helper function to create a mock HTTP response handler that answers
+// successive requests with the given handlers, in order. Requests beyond the last handler fail the test.
+func mockSequence(t *testing.T, handlers ...http.HandlerFunc) http.HandlerFunc {
+	t.Helper()
+	requests := 0
+	return func(w http.ResponseWriter, r *http.Request) {
+		require.Less(t, requests, len(handlers), "unexpected request %d to %s", requests+1, r.URL.Path)
+		handler := handlers[requests]
+		requests++
+		handler(w, r)
+	}
+}
+
+// postGraphQL is the endpoint pattern of the GitHub GraphQL API, which is not
+// included in the patterns generated by go-github-mock.
+var postGraphQL = mock.EndpointPattern{
+	Pattern: "/graphql",
+	Method:  http.MethodPost,
+}
+
+// graphQLExchange describes a single expected GraphQL request and the response to it.
+// The request is matched by a substring of its query, usually the operation name.
+type graphQLExchange struct {
+	query             string
+	expectedVariables map[string]any
+	data              any
+	errors            []map[string]any
+}
+
+// mockGraphQL is a helper function to create a mock HTTP response handler for the
+// GraphQL endpoint. Each exchange is used once, in order of matching, so the same
+// operation can be answered differently across successive requests.
+func mockGraphQL(t *testing.T, exchanges ...graphQLExchange) http.HandlerFunc {
+	t.Helper()
+	used := make([]bool, len(exchanges))
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Query     string         `json:"query"`
+			Variables map[string]any `json:"variables"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		for i, exchange := range exchanges {
+			if used[i] || !strings.Contains(req.Query, exchange.query) {
+				continue
+			}
+			used[i] = true
+
+			for k, v := range exchange.expectedVariables {
+				require.Equal(t, v, req.Variables[k], "unexpected value for GraphQL variable %s", k)
+			}
+
+			body := map[string]any{"data": exchange.data}
+			if exchange.errors != nil {
+				body["errors"] = exchange.errors
+			}
+			b, err := json.Marshal(body)
+			require.NoError(t, err)
+			_, _ = w.Write(b)
+			return
+		}
+
+		require.Failf(t, "unexpected GraphQL request", "query: %s", req.Query)
+	}
+}
+
 // createMCPRequest is a helper function to create a MCP request with the given arguments.
 func createMCPRequest(args map[string]interface{}) mcp.CallToolRequest {
 	return mcp.CallToolRequest{
@@ -0,0 +1,222 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	// duplicateSearchTerms is the maximum number of terms of a duplicate search query. The
+	// search API accepts at most five OR operators.
+	duplicateSearchTerms = 6
+	// duplicateSearchResults is the number of results fetched per duplicate search query.
+	duplicateSearchResults = 30
+)
+
+// stopWords are words left out of duplicate searches because nearly every issue contains them.
+var stopWords = map[string]bool{
+	"a": true, "about": true, "after": true, "all": true, "also": true, "an": true, "and": true,
+	"any": true, "are": true, "as": true, "at": true, "be": true, "been": true, "but": true,
+	"by": true, "can": true, "cannot": true, "could": true, "does": true, "doesn": true,
+	"don": true, "for": true, "from": true, "get": true, "gets": true, "had": true, "has": true,
+	"have": true, "how": true, "if": true, "in": true, "into": true, "is": true, "isn": true,
+	"it": true, "its": true, "not": true, "of": true, "on": true, "or": true, "should": true,
+	"so": true, "some": true, "than": true, "that": true, "the": true, "their": true,
+	"then": true, "there": true, "these": true, "this": true, "to": true, "too": true,
+	"use": true, "using": true, "was": true, "we": true, "were": true, "what": true,
+	"when": true, "where": true, "which": true, "while": true, "why": true, "will": true,
+	"with": true, "won": true, "would": true, "you": true, "your": true,
+}
+
+// duplicateCandidate is an existing issue that may duplicate a new one.
+type duplicateCandidate struct {
+	Number int     `json:"number"`
+	Title  string  `json:"title"`
+	State  string  `json:"state"`
+	URL    string  `json:"url"`
+	Score  float64 `json:"score"`
+}
+
+// FindDuplicateIssues creates a tool to find existing issues similar to a new issue.
+func FindDuplicateIssues(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("find_duplicate_issues",
+			mcp.WithDescription(t("TOOL_FIND_DUPLICATE_ISSUES_DESCRIPTION", "Find existing issues in a GitHub repository that are similar to a new issue, ranked by similarity. Use before creating an issue")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("title",
+				mcp.Required(),
+				mcp.Description("Title of the new issue"),
+			),
+			mcp.WithString("body",
+				mcp.Description("Body of the new issue, used to refine the ranking"),
+			),
+			mcp.WithNumber("limit",
+				mcp.Description("Maximum number of candidates to return (default 5)"),
+				mcp.Min(1),
+				mcp.Max(duplicateSearchResults),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			title, err := requiredParam[string](request, "title")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			body, err := OptionalParam[string](request, "body")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			limit, err := OptionalIntParamWithDefault(request, "limit", 5)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if limit < 1 || limit > duplicateSearchResults {
+				return mcp.NewToolResultError(fmt.Sprintf("limit must be between 1 and %d", duplicateSearchResults)), nil
+			}
+
+			titleTerms := significantTerms(title)
+			if len(titleTerms) == 0 {
+				return mcp.NewToolResultError("title has no significant terms to search for"), nil
+			}
+			bodyTerms := significantTerms(body)
+
+			// Search for issues containing all of the terms first, then for issues
+			// containing any of them, which finds issues worded differently.
+			searchTerms := titleTerms[:min(len(titleTerms), duplicateSearchTerms)]
+			scope := fmt.Sprintf("repo:%s/%s is:issue in:title", owner, repo)
+			queries := []string{scope + " " + strings.Join(searchTerms, " ")}
+			if len(searchTerms) > 1 {
+				queries = append(queries, scope+" "+strings.Join(searchTerms, " OR "))
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			seen := make(map[int]bool)
+			var candidates []duplicateCandidate
+			for _, query := range queries {
+				result, resp, err := client.Search.Issues(ctx, query, &github.SearchOptions{
+					ListOptions: github.ListOptions{PerPage: duplicateSearchResults},
+				})
+				if err != nil {
+					if result := apiErrorResult("failed to search issues", err, http.StatusUnprocessableEntity); result != nil {
+						return result, nil
+					}
+					return nil, fmt.Errorf("failed to search issues: %w", err)
+				}
+				if resp.StatusCode != http.StatusOK {
+					body, err := io.ReadAll(resp.Body)
+					_ = resp.Body.Close()
+					if err != nil {
+						return nil, fmt.Errorf("failed to read response body: %w", err)
+					}
+					return mcp.NewToolResultError(fmt.Sprintf("failed to search issues: %s", string(body))), nil
+				}
+				_ = resp.Body.Close()
+
+				for _, issue := range result.Issues {
+					if seen[issue.GetNumber()] {
+						continue
+					}
+					seen[issue.GetNumber()] = true
+
+					score := termOverlap(titleTerms, significantTerms(issue.GetTitle()))
+					if len(bodyTerms) > 0 {
+						score = 0.7*score + 0.3*termOverlap(bodyTerms, significantTerms(issue.GetBody()))
+					}
+					candidates = append(candidates, duplicateCandidate{
+						Number: issue.GetNumber(),
+						Title:  issue.GetTitle(),
+						State:  issue.GetState(),
+						URL:    issue.GetHTMLURL(),
+						Score:  math.Round(score*100) / 100,
+					})
+				}
+			}
+
+			// Rank by score, preferring newer issues on ties.
+			sort.Slice(candidates, func(i, j int) bool {
+				if candidates[i].Score != candidates[j].Score {
+					return candidates[i].Score > candidates[j].Score
+				}
+				return candidates[i].Number > candidates[j].Number
+			})
+			candidates = candidates[:min(len(candidates), limit)]
+			if candidates == nil {
+				candidates = []duplicateCandidate{}
+			}
+
+			r, err := json.Marshal(candidates)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// significantTerms splits text into lowercase words, without stop words, single characters,
+// and repeated words, in order of first occurrence.
+func significantTerms(text string) []string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	seen := make(map[string]bool)
+	var terms []string
+	for _, word := range words {
+		if len(word) < 2 || stopWords[word] || seen[word] {
+			continue
+		}
+		seen[word] = true
+		terms = append(terms, word)
+	}
+	return terms
+}
+
+// termOverlap is the Jaccard similarity of two sets of terms, between 0 and 1.
+func termOverlap(a, b []string) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+	set := make(map[string]bool, len(a))
+	for _, term := range a {
+		set[term] = true
+	}
+	shared := 0
+	union := len(set)
+	for _, term := range b {
+		if set[term] {
+			shared++
+		} else {
+			union++
+		}
+	}
+	return float64(shared) / float64(union)
+}
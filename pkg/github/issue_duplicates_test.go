@@ -0,0 +1,217 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_FindDuplicateIssues(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := FindDuplicateIssues(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "find_duplicate_issues", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "title")
+	assert.Contains(t, tool.InputSchema.Properties, "body")
+	assert.Contains(t, tool.InputSchema.Properties, "limit")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "title"})
+
+	issue := func(number int, title, body string) *github.Issue {
+		return &github.Issue{
+			Number:  github.Ptr(number),
+			Title:   github.Ptr(title),
+			Body:    github.Ptr(body),
+			State:   github.Ptr("open"),
+			HTMLURL: github.Ptr("https://github.com/owner/repo/issues/" + title),
+		}
+	}
+	// serveSearches answers each search query with its own results and fails on any other query.
+	serveSearches := func(results map[string][]*github.Issue) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			q := r.URL.Query().Get("q")
+			issues, ok := results[q]
+			if !assert.True(t, ok, "unexpected query %q", q) {
+				mockResponse(t, http.StatusUnprocessableEntity, map[string]string{"message": "Validation Failed"}).ServeHTTP(w, r)
+				return
+			}
+			assert.Equal(t, "30", r.URL.Query().Get("per_page"))
+			mockResponse(t, http.StatusOK, &github.IssuesSearchResult{
+				Total:  github.Ptr(len(issues)),
+				Issues: issues,
+			}).ServeHTTP(w, r)
+		}
+	}
+
+	tests := []struct {
+		name               string
+		mockedClient       *http.Client
+		requestArgs        map[string]interface{}
+		expectError        bool
+		expectedErrMsg     string
+		expectedCandidates []duplicateCandidate
+	}{
+		{
+			name: "candidates are deduplicated and ranked",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetSearchIssues,
+					serveSearches(map[string][]*github.Issue{
+						"repo:owner/repo is:issue in:title crash uploading large files": {
+							issue(10, "Crash when uploading large files", ""),
+						},
+						"repo:owner/repo is:issue in:title crash OR uploading OR large OR files": {
+							issue(10, "Crash when uploading large files", ""),
+							issue(12, "Upload of large files fails", ""),
+							issue(7, "Crash on startup", ""),
+							issue(15, "Large files crash", ""),
+						},
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"title": "Crash when uploading large files",
+				"limit": float64(3),
+			},
+			expectedCandidates: []duplicateCandidate{
+				{Number: 10, Title: "Crash when uploading large files", State: "open", URL: "https://github.com/owner/repo/issues/Crash when uploading large files", Score: 1},
+				{Number: 15, Title: "Large files crash", State: "open", URL: "https://github.com/owner/repo/issues/Large files crash", Score: 0.75},
+				{Number: 12, Title: "Upload of large files fails", State: "open", URL: "https://github.com/owner/repo/issues/Upload of large files fails", Score: 0.33},
+			},
+		},
+		{
+			name: "body refines the ranking",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetSearchIssues,
+					serveSearches(map[string][]*github.Issue{
+						"repo:owner/repo is:issue in:title crash startup": {
+							issue(3, "Startup crash", "The app crashes"),
+							issue(4, "Crash at startup", "Segfault in the renderer module"),
+						},
+						"repo:owner/repo is:issue in:title crash OR startup": {},
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"title": "Crash on startup",
+				"body":  "Segfault in renderer",
+			},
+			expectedCandidates: []duplicateCandidate{
+				{Number: 4, Title: "Crash at startup", State: "open", URL: "https://github.com/owner/repo/issues/Crash at startup", Score: 0.9},
+				{Number: 3, Title: "Startup crash", State: "open", URL: "https://github.com/owner/repo/issues/Startup crash", Score: 0.7},
+			},
+		},
+		{
+			name: "no candidates",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetSearchIssues,
+					serveSearches(map[string][]*github.Issue{
+						"repo:owner/repo is:issue in:title flaky": {},
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"title": "Flaky",
+			},
+			expectedCandidates: []duplicateCandidate{},
+		},
+		{
+			name:         "title without significant terms",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"title": "It is not what it should be",
+			},
+			expectError:    true,
+			expectedErrMsg: "title has no significant terms to search for",
+		},
+		{
+			name:         "negative limit",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"title": "Flaky test",
+				"limit": float64(-1),
+			},
+			expectError:    true,
+			expectedErrMsg: "limit must be between 1 and 30",
+		},
+		{
+			name:         "limit above the search results",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"title": "Flaky test",
+				"limit": float64(31),
+			},
+			expectError:    true,
+			expectedErrMsg: "limit must be between 1 and 30",
+		},
+		{
+			name: "search fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetSearchIssues,
+					mockResponse(t, http.StatusUnprocessableEntity, map[string]string{"message": "Validation Failed"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"title": "Flaky test",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to search issues",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := FindDuplicateIssues(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectError {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+			require.False(t, result.IsError, textContent.Text)
+
+			// Unmarshal and verify the result
+			var returnedCandidates []duplicateCandidate
+			err = json.Unmarshal([]byte(textContent.Text), &returnedCandidates)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedCandidates, returnedCandidates)
+		})
+	}
+}
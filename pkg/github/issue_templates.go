@@ -0,0 +1,262 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"gopkg.in/yaml.v3"
+)
+
+// issueTemplateDir is the directory GitHub reads issue templates from.
+const issueTemplateDir = ".github/ISSUE_TEMPLATE"
+
+// issueTemplate is an issue template of a repository, either a markdown template with YAML
+// front matter, which has a body, or an issue form, which has form fields.
+type issueTemplate struct {
+	Path        string           `json:"path"`
+	Name        string           `json:"name"`
+	About       string           `json:"about,omitempty"`
+	TitlePrefix string           `json:"title_prefix,omitempty"`
+	Labels      []string         `json:"labels"`
+	Body        string           `json:"body,omitempty"`
+	FormFields  []issueFormField `json:"form_fields,omitempty"`
+	Error       string           `json:"error,omitempty"`
+}
+
+// issueFormField is an input of an issue form.
+type issueFormField struct {
+	Type        string   `json:"type"`
+	ID          string   `json:"id,omitempty"`
+	Label       string   `json:"label"`
+	Description string   `json:"description,omitempty"`
+	Options     []string `json:"options,omitempty"`
+	Required    bool     `json:"required"`
+}
+
+// templateLabels are the labels of an issue template, which may be written as a YAML list or
+// as a comma separated string.
+type templateLabels []string
+
+func (l *templateLabels) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.ScalarNode {
+		*l = nil
+		for _, label := range strings.Split(value.Value, ",") {
+			if label = strings.TrimSpace(label); label != "" {
+				*l = append(*l, label)
+			}
+		}
+		return nil
+	}
+	var labels []string
+	if err := value.Decode(&labels); err != nil {
+		return err
+	}
+	*l = labels
+	return nil
+}
+
+// issueTemplateHeader holds the keys shared by the front matter of markdown templates and
+// issue forms. Markdown templates use "about" where issue forms use "description".
+type issueTemplateHeader struct {
+	Name        string         `yaml:"name"`
+	About       string         `yaml:"about"`
+	Description string         `yaml:"description"`
+	Title       string         `yaml:"title"`
+	Labels      templateLabels `yaml:"labels"`
+}
+
+// issueFormDocument is the part of an issue form YAML file describing its fields.
+type issueFormDocument struct {
+	issueTemplateHeader `yaml:",inline"`
+	Body                []struct {
+		Type       string `yaml:"type"`
+		ID         string `yaml:"id"`
+		Attributes struct {
+			Label       string `yaml:"label"`
+			Description string `yaml:"description"`
+			Options     []any  `yaml:"options"`
+		} `yaml:"attributes"`
+		Validations struct {
+			Required bool `yaml:"required"`
+		} `yaml:"validations"`
+	} `yaml:"body"`
+}
+
+// ListIssueTemplates creates a tool to list the issue templates of a repository.
+func ListIssueTemplates(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_issue_templates",
+			mcp.WithDescription(t("TOOL_LIST_ISSUE_TEMPLATES_DESCRIPTION", "List the issue templates and issue forms of a GitHub repository, to follow when creating issues")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			templates := []*issueTemplate{}
+			_, entries, resp, err := client.Repositories.GetContents(ctx, owner, repo, issueTemplateDir, nil)
+			if err != nil {
+				// A repository without templates has no template directory.
+				var errorResponse *github.ErrorResponse
+				if errors.As(err, &errorResponse) && errorResponse.Response != nil && errorResponse.Response.StatusCode == http.StatusNotFound {
+					return marshalIssueTemplates(templates)
+				}
+				return nil, fmt.Errorf("failed to list issue templates: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list issue templates: %s", string(body))), nil
+			}
+
+			for _, entry := range entries {
+				name := entry.GetName()
+				ext := strings.ToLower(path.Ext(name))
+				if entry.GetType() != "file" || (ext != ".md" && ext != ".yml" && ext != ".yaml") {
+					continue
+				}
+				// config.yml configures the template chooser and is not a template.
+				if strings.TrimSuffix(strings.ToLower(name), ext) == "config" && ext != ".md" {
+					continue
+				}
+
+				file, _, resp, err := client.Repositories.GetContents(ctx, owner, repo, entry.GetPath(), nil)
+				if err != nil {
+					return nil, fmt.Errorf("failed to get issue template %s: %w", entry.GetPath(), err)
+				}
+				_ = resp.Body.Close()
+				content, err := file.GetContent()
+				if err != nil {
+					return nil, fmt.Errorf("failed to decode issue template %s: %w", entry.GetPath(), err)
+				}
+
+				var template *issueTemplate
+				if ext == ".md" {
+					template, err = parseMarkdownIssueTemplate(content)
+				} else {
+					template, err = parseIssueForm(content)
+				}
+				if err != nil {
+					template = &issueTemplate{Error: err.Error()}
+				}
+				template.Path = entry.GetPath()
+				if template.Name == "" {
+					template.Name = strings.TrimSuffix(name, path.Ext(name))
+				}
+				if template.Labels == nil {
+					template.Labels = []string{}
+				}
+				templates = append(templates, template)
+			}
+
+			return marshalIssueTemplates(templates)
+		}
+}
+
+func marshalIssueTemplates(templates []*issueTemplate) (*mcp.CallToolResult, error) {
+	r, err := json.Marshal(templates)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal response: %w", err)
+	}
+
+	return mcp.NewToolResultText(string(r)), nil
+}
+
+// parseMarkdownIssueTemplate parses a markdown issue template. The YAML front matter between
+// the leading "---" lines is optional; without it the whole content is the body.
+func parseMarkdownIssueTemplate(content string) (*issueTemplate, error) {
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+
+	template := &issueTemplate{Body: strings.TrimSpace(content)}
+	rest, ok := strings.CutPrefix(content, "---\n")
+	if !ok {
+		return template, nil
+	}
+	// The closing "---" directly follows the opening one when the front matter is empty.
+	frontMatter, body, ok := strings.Cut("\n"+rest, "\n---")
+	if !ok {
+		return nil, errors.New("front matter is not terminated by '---'")
+	}
+
+	var header issueTemplateHeader
+	if err := yaml.Unmarshal([]byte(frontMatter), &header); err != nil {
+		return nil, fmt.Errorf("invalid front matter: %w", err)
+	}
+	template.Name = header.Name
+	template.About = header.About
+	template.TitlePrefix = header.Title
+	template.Labels = header.Labels
+	template.Body = strings.TrimSpace(body)
+	return template, nil
+}
+
+// parseIssueForm parses an issue form, keeping the input fields of its body. Markdown
+// elements only show text to the author and are left out.
+func parseIssueForm(content string) (*issueTemplate, error) {
+	var form issueFormDocument
+	if err := yaml.Unmarshal([]byte(content), &form); err != nil {
+		return nil, fmt.Errorf("invalid issue form: %w", err)
+	}
+
+	template := &issueTemplate{
+		Name:        form.Name,
+		About:       form.Description,
+		TitlePrefix: form.Title,
+		Labels:      form.Labels,
+		FormFields:  []issueFormField{},
+	}
+	for _, element := range form.Body {
+		if element.Type == "markdown" {
+			continue
+		}
+		field := issueFormField{
+			Type:        element.Type,
+			ID:          element.ID,
+			Label:       element.Attributes.Label,
+			Description: element.Attributes.Description,
+			Required:    element.Validations.Required,
+		}
+		// Checkbox options are objects with a label, dropdown options are plain strings.
+		for _, option := range element.Attributes.Options {
+			switch o := option.(type) {
+			case string:
+				field.Options = append(field.Options, o)
+			case map[string]any:
+				field.Options = append(field.Options, fmt.Sprint(o["label"]))
+			}
+		}
+		template.FormFields = append(template.FormFields, field)
+	}
+	return template, nil
+}
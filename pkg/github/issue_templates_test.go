@@ -0,0 +1,226 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const bugReportTemplate = `---
+name: Bug report
+about: Create a report to help us improve
+title: "[BUG] "
+labels: bug, needs triage
+assignees: ''
+---
+
+**Describe the bug**
+A clear and concise description of what the bug is.
+`
+
+const featureRequestForm = `name: Feature request
+description: Suggest an idea for this project
+title: "[Feature]: "
+labels: ["enhancement"]
+body:
+  - type: markdown
+    attributes:
+      value: Thanks for taking the time to suggest a feature!
+  - type: textarea
+    id: problem
+    attributes:
+      label: Problem
+      description: What problem does the feature solve?
+    validations:
+      required: true
+  - type: dropdown
+    id: area
+    attributes:
+      label: Area
+      options:
+        - API
+        - CLI
+  - type: checkboxes
+    id: terms
+    attributes:
+      label: Code of Conduct
+      options:
+        - label: I agree to follow the Code of Conduct
+          required: true
+`
+
+const templateChooserConfig = `blank_issues_enabled: false
+`
+
+func Test_ListIssueTemplates(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListIssueTemplates(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_issue_templates", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	// Template paths contain slashes, so match the rest of the path
+	getContents := mock.EndpointPattern{
+		Pattern: "/repos/{owner}/{repo}/contents/{path:.+}",
+		Method:  http.MethodGet,
+	}
+
+	entry := func(name string) *github.RepositoryContent {
+		return &github.RepositoryContent{
+			Type: github.Ptr("file"),
+			Name: github.Ptr(name),
+			Path: github.Ptr(issueTemplateDir + "/" + name),
+		}
+	}
+	file := func(content string) *github.RepositoryContent {
+		return &github.RepositoryContent{
+			Type:    github.Ptr("file"),
+			Content: github.Ptr(content),
+		}
+	}
+	serveContents := func(files map[string]any) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			body, ok := files[r.URL.Path]
+			if !ok {
+				mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}).ServeHTTP(w, r)
+				return
+			}
+			mockResponse(t, http.StatusOK, body).ServeHTTP(w, r)
+		}
+	}
+	contentsPath := "/repos/owner/repo/contents/" + issueTemplateDir
+
+	tests := []struct {
+		name              string
+		mockedClient      *http.Client
+		expectedTemplates []*issueTemplate
+	}{
+		{
+			name: "markdown template and issue form",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					getContents,
+					serveContents(map[string]any{
+						contentsPath: []*github.RepositoryContent{
+							entry("bug_report.md"),
+							entry("config.yml"),
+							entry("feature_request.yml"),
+						},
+						contentsPath + "/bug_report.md":       file(bugReportTemplate),
+						contentsPath + "/feature_request.yml": file(featureRequestForm),
+						contentsPath + "/config.yml":          file(templateChooserConfig),
+					}),
+				),
+			),
+			expectedTemplates: []*issueTemplate{
+				{
+					Path:        issueTemplateDir + "/bug_report.md",
+					Name:        "Bug report",
+					About:       "Create a report to help us improve",
+					TitlePrefix: "[BUG] ",
+					Labels:      []string{"bug", "needs triage"},
+					Body:        "**Describe the bug**\nA clear and concise description of what the bug is.",
+				},
+				{
+					Path:        issueTemplateDir + "/feature_request.yml",
+					Name:        "Feature request",
+					About:       "Suggest an idea for this project",
+					TitlePrefix: "[Feature]: ",
+					Labels:      []string{"enhancement"},
+					FormFields: []issueFormField{
+						{Type: "textarea", ID: "problem", Label: "Problem", Description: "What problem does the feature solve?", Required: true},
+						{Type: "dropdown", ID: "area", Label: "Area", Options: []string{"API", "CLI"}},
+						{Type: "checkboxes", ID: "terms", Label: "Code of Conduct", Options: []string{"I agree to follow the Code of Conduct"}},
+					},
+				},
+			},
+		},
+		{
+			name: "malformed template is reported",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					getContents,
+					serveContents(map[string]any{
+						contentsPath:                []*github.RepositoryContent{entry("broken.md")},
+						contentsPath + "/broken.md": file("---\nname: Broken\n"),
+					}),
+				),
+			),
+			expectedTemplates: []*issueTemplate{
+				{
+					Path:   issueTemplateDir + "/broken.md",
+					Name:   "broken",
+					Labels: []string{},
+					Error:  "front matter is not terminated by '---'",
+				},
+			},
+		},
+		{
+			name: "empty front matter",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					getContents,
+					serveContents(map[string]any{
+						contentsPath:                  []*github.RepositoryContent{entry("question.md")},
+						contentsPath + "/question.md": file("---\n---\n\nWhat would you like to know?\n"),
+					}),
+				),
+			),
+			expectedTemplates: []*issueTemplate{
+				{
+					Path:   issueTemplateDir + "/question.md",
+					Name:   "question",
+					Labels: []string{},
+					Body:   "What would you like to know?",
+				},
+			},
+		},
+		{
+			name: "repository without templates",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					getContents,
+					serveContents(map[string]any{}),
+				),
+			),
+			expectedTemplates: []*issueTemplate{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListIssueTemplates(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			})
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+			require.False(t, result.IsError, textContent.Text)
+
+			// Unmarshal and verify the result
+			var returnedTemplates []*issueTemplate
+			err = json.Unmarshal([]byte(textContent.Text), &returnedTemplates)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedTemplates, returnedTemplates)
+		})
+	}
+}
@@ -3,9 +3,14 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/github/github-mcp-server/pkg/translations"
@@ -51,6 +56,9 @@ func GetIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (tool
 			}
 			issue, resp, err := client.Issues.Get(ctx, owner, repo, issueNumber)
 			if err != nil {
+				if result := apiErrorResult("failed to get issue", err, http.StatusNotFound); result != nil {
+					return result, nil
+				}
 				return nil, fmt.Errorf("failed to get issue: %w", err)
 			}
 			defer func() { _ = resp.Body.Close() }()
@@ -63,7 +71,18 @@ func GetIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (tool
 				return mcp.NewToolResultError(fmt.Sprintf("failed to get issue: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(issue)
+			// Issues transferred to another repository answer with a 301, which the
+			// HTTP client follows to the issue's new location.
+			var result interface{} = issue
+			if resp.Request != nil && resp.Request.Response != nil &&
+				resp.Request.Response.StatusCode == http.StatusMovedPermanently {
+				result = &transferredIssue{
+					Issue:         issue,
+					TransferredTo: issueRepositoryFullName(issue),
+				}
+			}
+
+			r, err := json.Marshal(result)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal issue: %w", err)
 			}
@@ -72,6 +91,23 @@ func GetIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (tool
 		}
 }
 
+// transferredIssue is an issue that was transferred from the requested repository
+// to the repository named by TransferredTo.
+type transferredIssue struct {
+	*github.Issue
+	TransferredTo string `json:"transferred_to"`
+}
+
+// issueRepositoryFullName returns the "owner/name" of the repository an issue
+// belongs to, derived from its repository API URL.
+func issueRepositoryFullName(issue *github.Issue) string {
+	if issue.Repository != nil && issue.Repository.GetFullName() != "" {
+		return issue.Repository.GetFullName()
+	}
+	_, fullName, _ := strings.Cut(issue.GetRepositoryURL(), "/repos/")
+	return fullName
+}
+
 // AddIssueComment creates a tool to add a comment to an issue.
 func AddIssueComment(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("add_issue_comment",
@@ -110,6 +146,9 @@ func AddIssueComment(getClient GetClientFn, t translations.TranslationHelperFunc
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			if strings.TrimSpace(body) == "" {
+				return mcp.NewToolResultError("comment body must not be empty"), nil
+			}
 
 			comment := &github.IssueComment{
 				Body: github.Ptr(body),
@@ -121,6 +160,10 @@ func AddIssueComment(getClient GetClientFn, t translations.TranslationHelperFunc
 			}
 			createdComment, resp, err := client.Issues.CreateComment(ctx, owner, repo, issueNumber, comment)
 			if err != nil {
+				// Locked issues only accept comments from collaborators and answer with a 403.
+				if result := apiErrorResult("failed to create comment", err, http.StatusNotFound, http.StatusForbidden); result != nil {
+					return result, nil
+				}
 				return nil, fmt.Errorf("failed to create comment: %w", err)
 			}
 			defer func() { _ = resp.Body.Close() }()
@@ -142,70 +185,60 @@ func AddIssueComment(getClient GetClientFn, t translations.TranslationHelperFunc
 		}
 }
 
-// SearchIssues creates a tool to search for issues and pull requests.
-func SearchIssues(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
-	return mcp.NewTool("search_issues",
-			mcp.WithDescription(t("TOOL_SEARCH_ISSUES_DESCRIPTION", "Search for issues and pull requests across GitHub repositories")),
-			mcp.WithString("q",
+// UpdateIssueComment creates a tool to edit a comment on an issue.
+func UpdateIssueComment(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("update_issue_comment",
+			mcp.WithDescription(t("TOOL_UPDATE_ISSUE_COMMENT_DESCRIPTION", "Update the body of a comment on an issue")),
+			mcp.WithString("owner",
 				mcp.Required(),
-				mcp.Description("Search query using GitHub issues search syntax"),
+				mcp.Description("Repository owner"),
 			),
-			mcp.WithString("sort",
-				mcp.Description("Sort field (comments, reactions, created, etc.)"),
-				mcp.Enum(
-					"comments",
-					"reactions",
-					"reactions-+1",
-					"reactions--1",
-					"reactions-smile",
-					"reactions-thinking_face",
-					"reactions-heart",
-					"reactions-tada",
-					"interactions",
-					"created",
-					"updated",
-				),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
 			),
-			mcp.WithString("order",
-				mcp.Description("Sort order ('asc' or 'desc')"),
-				mcp.Enum("asc", "desc"),
+			mcp.WithNumber("comment_id",
+				mcp.Required(),
+				mcp.Description("ID of the comment to update"),
+			),
+			mcp.WithString("body",
+				mcp.Required(),
+				mcp.Description("New comment text"),
 			),
-			WithPagination(),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-			query, err := requiredParam[string](request, "q")
+			owner, err := requiredParam[string](request, "owner")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			sort, err := OptionalParam[string](request, "sort")
+			repo, err := requiredParam[string](request, "repo")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			order, err := OptionalParam[string](request, "order")
+			commentID, err := RequiredInt(request, "comment_id")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			pagination, err := OptionalPaginationParams(request)
+			body, err := requiredParam[string](request, "body")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-
-			opts := &github.SearchOptions{
-				Sort:  sort,
-				Order: order,
-				ListOptions: github.ListOptions{
-					PerPage: pagination.perPage,
-					Page:    pagination.page,
-				},
+			if strings.TrimSpace(body) == "" {
+				return mcp.NewToolResultError("comment body must not be empty"), nil
 			}
 
 			client, err := getClient(ctx)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
-			result, resp, err := client.Search.Issues(ctx, query, opts)
+			comment, resp, err := client.Issues.EditComment(ctx, owner, repo, int64(commentID), &github.IssueComment{
+				Body: github.Ptr(body),
+			})
 			if err != nil {
-				return nil, fmt.Errorf("failed to search issues: %w", err)
+				if result := apiErrorResult("failed to update comment", err, http.StatusNotFound, http.StatusForbidden); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to update comment: %w", err)
 			}
 			defer func() { _ = resp.Body.Close() }()
 
@@ -214,10 +247,10 @@ func SearchIssues(getClient GetClientFn, t translations.TranslationHelperFunc) (
 				if err != nil {
 					return nil, fmt.Errorf("failed to read response body: %w", err)
 				}
-				return mcp.NewToolResultError(fmt.Sprintf("failed to search issues: %s", string(body))), nil
+				return mcp.NewToolResultError(fmt.Sprintf("failed to update comment: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(result)
+			r, err := json.Marshal(comment)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
@@ -226,10 +259,10 @@ func SearchIssues(getClient GetClientFn, t translations.TranslationHelperFunc) (
 		}
 }
 
-// CreateIssue creates a tool to create a new issue in a GitHub repository.
-func CreateIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
-	return mcp.NewTool("create_issue",
-			mcp.WithDescription(t("TOOL_CREATE_ISSUE_DESCRIPTION", "Create a new issue in a GitHub repository")),
+// DeleteIssueComment creates a tool to delete a comment on an issue.
+func DeleteIssueComment(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("delete_issue_comment",
+			mcp.WithDescription(t("TOOL_DELETE_ISSUE_COMMENT_DESCRIPTION", "Delete a comment on an issue")),
 			mcp.WithString("owner",
 				mcp.Required(),
 				mcp.Description("Repository owner"),
@@ -238,31 +271,9 @@ func CreateIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 				mcp.Required(),
 				mcp.Description("Repository name"),
 			),
-			mcp.WithString("title",
+			mcp.WithNumber("comment_id",
 				mcp.Required(),
-				mcp.Description("Issue title"),
-			),
-			mcp.WithString("body",
-				mcp.Description("Issue body content"),
-			),
-			mcp.WithArray("assignees",
-				mcp.Description("Usernames to assign to this issue"),
-				mcp.Items(
-					map[string]interface{}{
-						"type": "string",
-					},
-				),
-			),
-			mcp.WithArray("labels",
-				mcp.Description("Labels to apply to this issue"),
-				mcp.Items(
-					map[string]interface{}{
-						"type": "string",
-					},
-				),
-			),
-			mcp.WithNumber("milestone",
-				mcp.Description("Milestone number"),
+				mcp.Description("ID of the comment to delete"),
 			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -274,68 +285,36 @@ func CreateIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			title, err := requiredParam[string](request, "title")
-			if err != nil {
-				return mcp.NewToolResultError(err.Error()), nil
-			}
-
-			// Optional parameters
-			body, err := OptionalParam[string](request, "body")
-			if err != nil {
-				return mcp.NewToolResultError(err.Error()), nil
-			}
-
-			// Get assignees
-			assignees, err := OptionalStringArrayParam(request, "assignees")
-			if err != nil {
-				return mcp.NewToolResultError(err.Error()), nil
-			}
-
-			// Get labels
-			labels, err := OptionalStringArrayParam(request, "labels")
-			if err != nil {
-				return mcp.NewToolResultError(err.Error()), nil
-			}
-
-			// Get optional milestone
-			milestone, err := OptionalIntParam(request, "milestone")
+			commentID, err := RequiredInt(request, "comment_id")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			var milestoneNum *int
-			if milestone != 0 {
-				milestoneNum = &milestone
-			}
-
-			// Create the issue request
-			issueRequest := &github.IssueRequest{
-				Title:     github.Ptr(title),
-				Body:      github.Ptr(body),
-				Assignees: &assignees,
-				Labels:    &labels,
-				Milestone: milestoneNum,
-			}
-
 			client, err := getClient(ctx)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
-			issue, resp, err := client.Issues.Create(ctx, owner, repo, issueRequest)
+			resp, err := client.Issues.DeleteComment(ctx, owner, repo, int64(commentID))
 			if err != nil {
-				return nil, fmt.Errorf("failed to create issue: %w", err)
+				if result := apiErrorResult("failed to delete comment", err, http.StatusNotFound, http.StatusForbidden); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to delete comment: %w", err)
 			}
 			defer func() { _ = resp.Body.Close() }()
 
-			if resp.StatusCode != http.StatusCreated {
+			if resp.StatusCode != http.StatusNoContent {
 				body, err := io.ReadAll(resp.Body)
 				if err != nil {
 					return nil, fmt.Errorf("failed to read response body: %w", err)
 				}
-				return mcp.NewToolResultError(fmt.Sprintf("failed to create issue: %s", string(body))), nil
+				return mcp.NewToolResultError(fmt.Sprintf("failed to delete comment: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(issue)
+			r, err := json.Marshal(map[string]interface{}{
+				"comment_id": commentID,
+				"status":     "deleted",
+			})
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
@@ -344,10 +323,29 @@ func CreateIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 		}
 }
 
-// ListIssues creates a tool to list and filter repository issues
-func ListIssues(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
-	return mcp.NewTool("list_issues",
-			mcp.WithDescription(t("TOOL_LIST_ISSUES_DESCRIPTION", "List issues in a GitHub repository with filtering options")),
+// issueAssignees is the result of changing the assignees of an issue.
+type issueAssignees struct {
+	IssueNumber      int      `json:"issue_number"`
+	Assignees        []string `json:"assignees"`
+	InvalidAssignees []string `json:"invalid_assignees,omitempty"`
+}
+
+// newIssueAssignees lists the logins assigned to an issue.
+func newIssueAssignees(issue *github.Issue) *issueAssignees {
+	result := &issueAssignees{
+		IssueNumber: issue.GetNumber(),
+		Assignees:   make([]string, 0, len(issue.Assignees)),
+	}
+	for _, assignee := range issue.Assignees {
+		result.Assignees = append(result.Assignees, assignee.GetLogin())
+	}
+	return result
+}
+
+// AssignIssue creates a tool to add assignees to an issue.
+func AssignIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("assign_issue",
+			mcp.WithDescription(t("TOOL_ASSIGN_ISSUE_DESCRIPTION", "Add assignees to an issue, keeping its existing assignees")),
 			mcp.WithString("owner",
 				mcp.Required(),
 				mcp.Description("Repository owner"),
@@ -356,30 +354,22 @@ func ListIssues(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 				mcp.Required(),
 				mcp.Description("Repository name"),
 			),
-			mcp.WithString("state",
-				mcp.Description("Filter by state ('open', 'closed', 'all')"),
-				mcp.Enum("open", "closed", "all"),
+			mcp.WithNumber("issue_number",
+				mcp.Required(),
+				mcp.Description("Issue number"),
 			),
-			mcp.WithArray("labels",
-				mcp.Description("Filter by labels"),
+			mcp.WithArray("assignees",
+				mcp.Required(),
+				mcp.Description("Usernames to assign to the issue"),
 				mcp.Items(
 					map[string]interface{}{
 						"type": "string",
 					},
 				),
 			),
-			mcp.WithString("sort",
-				mcp.Description("Sort by ('created', 'updated', 'comments')"),
-				mcp.Enum("created", "updated", "comments"),
-			),
-			mcp.WithString("direction",
-				mcp.Description("Sort direction ('asc', 'desc')"),
-				mcp.Enum("asc", "desc"),
-			),
-			mcp.WithString("since",
-				mcp.Description("Filter by date (ISO 8601 timestamp)"),
+			mcp.WithBoolean("validate",
+				mcp.Description("Check that each user can be assigned first, and only assign the valid ones"),
 			),
-			WithPagination(),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := requiredParam[string](request, "owner")
@@ -390,82 +380,81 @@ func ListIssues(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-
-			opts := &github.IssueListByRepoOptions{}
-
-			// Set optional parameters if provided
-			opts.State, err = OptionalParam[string](request, "state")
+			issueNumber, err := RequiredInt(request, "issue_number")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-
-			// Get labels
-			opts.Labels, err = OptionalStringArrayParam(request, "labels")
+			assignees, err := OptionalStringArrayParam(request, "assignees")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-
-			opts.Sort, err = OptionalParam[string](request, "sort")
-			if err != nil {
-				return mcp.NewToolResultError(err.Error()), nil
+			if len(assignees) == 0 {
+				return mcp.NewToolResultError("missing required parameter: assignees"), nil
 			}
-
-			opts.Direction, err = OptionalParam[string](request, "direction")
+			validate, err := OptionalParam[bool](request, "validate")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			since, err := OptionalParam[string](request, "since")
+			client, err := getClient(ctx)
 			if err != nil {
-				return mcp.NewToolResultError(err.Error()), nil
-			}
-			if since != "" {
-				timestamp, err := parseISOTimestamp(since)
-				if err != nil {
-					return mcp.NewToolResultError(fmt.Sprintf("failed to list issues: %s", err.Error())), nil
-				}
-				opts.Since = timestamp
-			}
-
-			if page, ok := request.Params.Arguments["page"].(float64); ok {
-				opts.Page = int(page)
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
 
-			if perPage, ok := request.Params.Arguments["perPage"].(float64); ok {
-				opts.PerPage = int(perPage)
+			var invalid []string
+			if validate {
+				valid := make([]string, 0, len(assignees))
+				for _, assignee := range assignees {
+					ok, resp, err := client.Issues.IsAssignee(ctx, owner, repo, assignee)
+					if err != nil {
+						return nil, fmt.Errorf("failed to check assignee %s: %w", assignee, err)
+					}
+					_ = resp.Body.Close()
+					if ok {
+						valid = append(valid, assignee)
+					} else {
+						invalid = append(invalid, assignee)
+					}
+				}
+				if len(valid) == 0 {
+					return mcp.NewToolResultError(fmt.Sprintf("none of the users can be assigned: %s", strings.Join(invalid, ", "))), nil
+				}
+				assignees = valid
 			}
 
-			client, err := getClient(ctx)
-			if err != nil {
-				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
-			}
-			issues, resp, err := client.Issues.ListByRepo(ctx, owner, repo, opts)
+			issue, resp, err := client.Issues.AddAssignees(ctx, owner, repo, issueNumber, assignees)
 			if err != nil {
-				return nil, fmt.Errorf("failed to list issues: %w", err)
+				if result := apiErrorResult("failed to assign issue", err, http.StatusNotFound, http.StatusForbidden); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to assign issue: %w", err)
 			}
 			defer func() { _ = resp.Body.Close() }()
 
-			if resp.StatusCode != http.StatusOK {
+			if resp.StatusCode != http.StatusCreated {
 				body, err := io.ReadAll(resp.Body)
 				if err != nil {
 					return nil, fmt.Errorf("failed to read response body: %w", err)
 				}
-				return mcp.NewToolResultError(fmt.Sprintf("failed to list issues: %s", string(body))), nil
+				return mcp.NewToolResultError(fmt.Sprintf("failed to assign issue: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(issues)
+			result := newIssueAssignees(issue)
+			result.InvalidAssignees = invalid
+
+			r, err := json.Marshal(result)
 			if err != nil {
-				return nil, fmt.Errorf("failed to marshal issues: %w", err)
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
 
 			return mcp.NewToolResultText(string(r)), nil
 		}
 }
 
-// UpdateIssue creates a tool to update an existing issue in a GitHub repository.
-func UpdateIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
-	return mcp.NewTool("update_issue",
-			mcp.WithDescription(t("TOOL_UPDATE_ISSUE_DESCRIPTION", "Update an existing issue in a GitHub repository")),
+// UnassignIssue creates a tool to remove assignees from an issue.
+func UnassignIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("unassign_issue",
+			mcp.WithDescription(t("TOOL_UNASSIGN_ISSUE_DESCRIPTION", "Remove assignees from an issue")),
 			mcp.WithString("owner",
 				mcp.Required(),
 				mcp.Description("Repository owner"),
@@ -476,37 +465,17 @@ func UpdateIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 			),
 			mcp.WithNumber("issue_number",
 				mcp.Required(),
-				mcp.Description("Issue number to update"),
-			),
-			mcp.WithString("title",
-				mcp.Description("New title"),
-			),
-			mcp.WithString("body",
-				mcp.Description("New description"),
-			),
-			mcp.WithString("state",
-				mcp.Description("New state ('open' or 'closed')"),
-				mcp.Enum("open", "closed"),
-			),
-			mcp.WithArray("labels",
-				mcp.Description("New labels"),
-				mcp.Items(
-					map[string]interface{}{
-						"type": "string",
-					},
-				),
+				mcp.Description("Issue number"),
 			),
 			mcp.WithArray("assignees",
-				mcp.Description("New assignees"),
+				mcp.Required(),
+				mcp.Description("Usernames to remove from the issue's assignees"),
 				mcp.Items(
 					map[string]interface{}{
 						"type": "string",
 					},
 				),
 			),
-			mcp.WithNumber("milestone",
-				mcp.Description("New milestone number"),
-			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := requiredParam[string](request, "owner")
@@ -521,69 +490,2367 @@ func UpdateIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-
-			// Create the issue request with only provided fields
-			issueRequest := &github.IssueRequest{}
-
-			// Set optional parameters if provided
-			title, err := OptionalParam[string](request, "title")
+			assignees, err := OptionalStringArrayParam(request, "assignees")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			if title != "" {
-				issueRequest.Title = github.Ptr(title)
+			if len(assignees) == 0 {
+				return mcp.NewToolResultError("missing required parameter: assignees"), nil
 			}
 
-			body, err := OptionalParam[string](request, "body")
+			client, err := getClient(ctx)
 			if err != nil {
-				return mcp.NewToolResultError(err.Error()), nil
-			}
-			if body != "" {
-				issueRequest.Body = github.Ptr(body)
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
-
-			state, err := OptionalParam[string](request, "state")
+			issue, resp, err := client.Issues.RemoveAssignees(ctx, owner, repo, issueNumber, assignees)
 			if err != nil {
-				return mcp.NewToolResultError(err.Error()), nil
+				if result := apiErrorResult("failed to unassign issue", err, http.StatusNotFound, http.StatusForbidden); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to unassign issue: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to unassign issue: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(newIssueAssignees(issue))
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// AddLabelsToIssue creates a tool to add labels to an issue.
+func AddLabelsToIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("add_labels_to_issue",
+			mcp.WithDescription(t("TOOL_ADD_LABELS_TO_ISSUE_DESCRIPTION", "Add labels to an issue, keeping its existing labels")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("issue_number",
+				mcp.Required(),
+				mcp.Description("Issue number"),
+			),
+			mcp.WithArray("labels",
+				mcp.Required(),
+				mcp.Description("Names of the labels to add"),
+				mcp.Items(
+					map[string]interface{}{
+						"type": "string",
+					},
+				),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			issueNumber, err := RequiredInt(request, "issue_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			labels, err := OptionalStringArrayParam(request, "labels")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if len(labels) == 0 {
+				return mcp.NewToolResultError("missing required parameter: labels"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			issueLabels, resp, err := client.Issues.AddLabelsToIssue(ctx, owner, repo, issueNumber, labels)
+			if err != nil {
+				if result := apiErrorResult("failed to add labels", err, http.StatusNotFound, http.StatusForbidden, http.StatusUnprocessableEntity); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to add labels: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to add labels: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(issueLabels)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// SetIssueLabels creates a tool to replace the labels of an issue.
+func SetIssueLabels(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("set_issue_labels",
+			mcp.WithDescription(t("TOOL_SET_ISSUE_LABELS_DESCRIPTION", "Replace all labels of an issue")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("issue_number",
+				mcp.Required(),
+				mcp.Description("Issue number"),
+			),
+			mcp.WithArray("labels",
+				mcp.Required(),
+				mcp.Description("Names of the labels the issue should have, an empty list removes all labels"),
+				mcp.Items(
+					map[string]interface{}{
+						"type": "string",
+					},
+				),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			issueNumber, err := RequiredInt(request, "issue_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			labels, err := OptionalStringArrayParam(request, "labels")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			// An empty list is valid here and removes all labels.
+			if _, ok := request.Params.Arguments["labels"]; !ok {
+				return mcp.NewToolResultError("missing required parameter: labels"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			issueLabels, resp, err := client.Issues.ReplaceLabelsForIssue(ctx, owner, repo, issueNumber, labels)
+			if err != nil {
+				if result := apiErrorResult("failed to set labels", err, http.StatusNotFound, http.StatusForbidden, http.StatusUnprocessableEntity); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to set labels: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to set labels: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(issueLabels)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// RemoveLabelFromIssue creates a tool to remove a label from an issue.
+func RemoveLabelFromIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("remove_label_from_issue",
+			mcp.WithDescription(t("TOOL_REMOVE_LABEL_FROM_ISSUE_DESCRIPTION", "Remove a label from an issue")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("issue_number",
+				mcp.Required(),
+				mcp.Description("Issue number"),
+			),
+			mcp.WithString("label",
+				mcp.Required(),
+				mcp.Description("Name of the label to remove"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			issueNumber, err := RequiredInt(request, "issue_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			label, err := requiredParam[string](request, "label")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			issueLabels, resp, err := removeIssueLabel(ctx, client, owner, repo, issueNumber, label)
+			if err != nil {
+				if result := apiErrorResult("failed to remove label", err, http.StatusNotFound, http.StatusForbidden); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to remove label: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to remove label: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(issueLabels)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// removeIssueLabel removes a label from an issue like IssueService.RemoveLabelForIssue, but
+// escapes the label name for the URL path and returns the labels remaining on the issue.
+func removeIssueLabel(ctx context.Context, client *github.Client, owner, repo string, issueNumber int, label string) ([]*github.Label, *github.Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/issues/%d/labels/%s", owner, repo, issueNumber, url.PathEscape(label))
+	req, err := client.NewRequest(http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var labels []*github.Label
+	resp, err := client.Do(ctx, req, &labels)
+	if err != nil {
+		return nil, resp, err
+	}
+	return labels, resp, nil
+}
+
+// issueSearchResult is a trimmed issue search result.
+type issueSearchResult struct {
+	TotalCount        int               `json:"total_count"`
+	IncompleteResults bool              `json:"incomplete_results"`
+	Items             []issueSearchItem `json:"items"`
+}
+
+// issueSearchItem is an issue or pull request matched by a search.
+type issueSearchItem struct {
+	Number    int               `json:"number"`
+	Title     string            `json:"title"`
+	State     string            `json:"state"`
+	Labels    []string          `json:"labels"`
+	URL       string            `json:"url"`
+	UpdatedAt *github.Timestamp `json:"updated_at,omitempty"`
+}
+
+func newIssueSearchItem(issue *github.Issue) issueSearchItem {
+	item := issueSearchItem{
+		Number:    issue.GetNumber(),
+		Title:     issue.GetTitle(),
+		State:     issue.GetState(),
+		Labels:    []string{},
+		URL:       issue.GetHTMLURL(),
+		UpdatedAt: issue.UpdatedAt,
+	}
+	for _, label := range issue.Labels {
+		item.Labels = append(item.Labels, label.GetName())
+	}
+	return item
+}
+
+// SearchIssues creates a tool to search for issues and pull requests.
+func SearchIssues(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("search_issues",
+			mcp.WithDescription(t("TOOL_SEARCH_ISSUES_DESCRIPTION", "Search for issues and pull requests across GitHub repositories")),
+			mcp.WithString("q",
+				mcp.Required(),
+				mcp.Description("Search query using GitHub issues search syntax, combined with the qualifiers below"),
+			),
+			mcp.WithString("owner",
+				mcp.Description("Only search repositories of this user or organization"),
+			),
+			mcp.WithString("repo",
+				mcp.Description("Only search this repository, requires owner"),
+			),
+			mcp.WithString("state",
+				mcp.Description("Filter by state"),
+				mcp.Enum("open", "closed"),
+			),
+			mcp.WithArray("labels",
+				mcp.Description("Only match results with all of these labels"),
+				mcp.Items(
+					map[string]interface{}{
+						"type": "string",
+					},
+				),
+			),
+			mcp.WithString("assignee",
+				mcp.Description("Filter by assignee username"),
+			),
+			mcp.WithString("author",
+				mcp.Description("Filter by author username"),
+			),
+			mcp.WithString("created_after",
+				mcp.Description("Only match results created at or after this time (ISO 8601 timestamp)"),
+			),
+			mcp.WithString("created_before",
+				mcp.Description("Only match results created at or before this time (ISO 8601 timestamp)"),
+			),
+			mcp.WithString("updated_after",
+				mcp.Description("Only match results updated at or after this time (ISO 8601 timestamp)"),
+			),
+			mcp.WithString("updated_before",
+				mcp.Description("Only match results updated at or before this time (ISO 8601 timestamp)"),
+			),
+			mcp.WithBoolean("is_pr",
+				mcp.Description("Only match pull requests when true, or only issues when false"),
+			),
+			mcp.WithString("sort",
+				mcp.Description("Sort field (comments, reactions, created, etc.)"),
+				mcp.Enum(
+					"comments",
+					"reactions",
+					"reactions-+1",
+					"reactions--1",
+					"reactions-smile",
+					"reactions-thinking_face",
+					"reactions-heart",
+					"reactions-tada",
+					"interactions",
+					"created",
+					"updated",
+				),
+			),
+			mcp.WithString("order",
+				mcp.Description("Sort order ('asc' or 'desc')"),
+				mcp.Enum("asc", "desc"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			query, err := requiredParam[string](request, "q")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			qualifiers, err := issueSearchQualifiers(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			query = strings.Join(append([]string{query}, qualifiers...), " ")
+			sort, err := OptionalParam[string](request, "sort")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			order, err := OptionalParam[string](request, "order")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts := &github.SearchOptions{
+				Sort:  sort,
+				Order: order,
+				ListOptions: github.ListOptions{
+					PerPage: pagination.perPage,
+					Page:    pagination.page,
+				},
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			result, resp, err := client.Search.Issues(ctx, query, opts)
+			if err != nil {
+				return nil, fmt.Errorf("failed to search issues: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to search issues: %s", string(body))), nil
+			}
+
+			trimmed := issueSearchResult{
+				TotalCount:        result.GetTotal(),
+				IncompleteResults: result.GetIncompleteResults(),
+				Items:             make([]issueSearchItem, 0, len(result.Issues)),
+			}
+			for _, issue := range result.Issues {
+				trimmed.Items = append(trimmed.Items, newIssueSearchItem(issue))
+			}
+
+			r, err := json.Marshal(trimmed)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// issueSearchQualifiers builds search qualifiers from the structured filters of the
+// search_issues tool, in a fixed order.
+func issueSearchQualifiers(request mcp.CallToolRequest) ([]string, error) {
+	var qualifiers []string
+
+	owner, err := OptionalParam[string](request, "owner")
+	if err != nil {
+		return nil, err
+	}
+	repo, err := OptionalParam[string](request, "repo")
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case repo != "" && owner == "":
+		return nil, errors.New("repo can only be used together with owner")
+	case repo != "":
+		qualifiers = append(qualifiers, searchQualifier("repo", owner+"/"+repo))
+	case owner != "":
+		qualifiers = append(qualifiers, searchQualifier("user", owner))
+	}
+
+	isPR, ok, err := OptionalParamOK[bool](request, "is_pr")
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		if isPR {
+			qualifiers = append(qualifiers, "is:pr")
+		} else {
+			qualifiers = append(qualifiers, "is:issue")
+		}
+	}
+
+	for _, name := range []string{"state", "assignee", "author"} {
+		value, err := OptionalParam[string](request, name)
+		if err != nil {
+			return nil, err
+		}
+		if value != "" {
+			qualifiers = append(qualifiers, searchQualifier(name, value))
+		}
+	}
+
+	labels, err := OptionalStringArrayParam(request, "labels")
+	if err != nil {
+		return nil, err
+	}
+	for _, label := range labels {
+		qualifiers = append(qualifiers, searchQualifier("label", label))
+	}
+
+	for _, field := range []string{"created", "updated"} {
+		after, err := OptionalParam[string](request, field+"_after")
+		if err != nil {
+			return nil, err
+		}
+		before, err := OptionalParam[string](request, field+"_before")
+		if err != nil {
+			return nil, err
+		}
+		if after != "" {
+			if _, err := parseISOTimestamp(after); err != nil {
+				return nil, fmt.Errorf("invalid %s_after: %w", field, err)
+			}
+		}
+		if before != "" {
+			if _, err := parseISOTimestamp(before); err != nil {
+				return nil, fmt.Errorf("invalid %s_before: %w", field, err)
+			}
+		}
+		switch {
+		case after != "" && before != "":
+			qualifiers = append(qualifiers, fmt.Sprintf("%s:%s..%s", field, after, before))
+		case after != "":
+			qualifiers = append(qualifiers, fmt.Sprintf("%s:>=%s", field, after))
+		case before != "":
+			qualifiers = append(qualifiers, fmt.Sprintf("%s:<=%s", field, before))
+		}
+	}
+
+	return qualifiers, nil
+}
+
+// searchQualifier formats a search qualifier, quoting values that contain whitespace.
+func searchQualifier(name, value string) string {
+	if strings.ContainsAny(value, " \t") {
+		return fmt.Sprintf("%s:%q", name, value)
+	}
+	return name + ":" + value
+}
+
+// CreateIssue creates a tool to create a new issue in a GitHub repository.
+func CreateIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_issue",
+			mcp.WithDescription(t("TOOL_CREATE_ISSUE_DESCRIPTION", "Create a new issue in a GitHub repository")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("title",
+				mcp.Required(),
+				mcp.Description("Issue title"),
+			),
+			mcp.WithString("body",
+				mcp.Description("Issue body content"),
+			),
+			mcp.WithArray("assignees",
+				mcp.Description("Usernames to assign to this issue"),
+				mcp.Items(
+					map[string]interface{}{
+						"type": "string",
+					},
+				),
+			),
+			mcp.WithArray("labels",
+				mcp.Description("Labels to apply to this issue"),
+				mcp.Items(
+					map[string]interface{}{
+						"type": "string",
+					},
+				),
+			),
+			mcp.WithNumber("milestone",
+				mcp.Description("Milestone number"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			title, err := requiredParam[string](request, "title")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			// Optional parameters
+			body, err := OptionalParam[string](request, "body")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			// Get assignees
+			assignees, err := OptionalStringArrayParam(request, "assignees")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			// Get labels
+			labels, err := OptionalStringArrayParam(request, "labels")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			// Get optional milestone
+			milestone, err := OptionalIntParam(request, "milestone")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			// Create the issue request, only sending the optional fields that were provided
+			issueRequest := &github.IssueRequest{
+				Title: github.Ptr(title),
+			}
+			if body != "" {
+				issueRequest.Body = github.Ptr(body)
+			}
+			if len(assignees) > 0 {
+				issueRequest.Assignees = &assignees
+			}
+			if len(labels) > 0 {
+				issueRequest.Labels = &labels
+			}
+			if milestone != 0 {
+				issueRequest.Milestone = &milestone
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			issue, resp, err := client.Issues.Create(ctx, owner, repo, issueRequest)
+			if err != nil {
+				// A 422 names the field that failed validation, e.g. an unknown assignee.
+				if result := apiErrorResult("failed to create issue", err, http.StatusUnprocessableEntity); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to create issue: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusCreated {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to create issue: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(issue)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// ListIssues creates a tool to list and filter repository issues
+func ListIssues(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_issues",
+			mcp.WithDescription(t("TOOL_LIST_ISSUES_DESCRIPTION", "List issues in a GitHub repository with filtering options")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("state",
+				mcp.Description("Filter by state ('open', 'closed', 'all')"),
+				mcp.Enum("open", "closed", "all"),
+			),
+			mcp.WithArray("labels",
+				mcp.Description("Filter by labels"),
+				mcp.Items(
+					map[string]interface{}{
+						"type": "string",
+					},
+				),
+			),
+			mcp.WithString("assignee",
+				mcp.Description("Filter by assignee username, 'none' for unassigned issues, or '*' for any assignee"),
+			),
+			mcp.WithString("creator",
+				mcp.Description("Filter by the username of the issue author"),
+			),
+			mcp.WithString("mentioned",
+				mcp.Description("Filter by a username mentioned in the issue"),
+			),
+			mcp.WithString("sort",
+				mcp.Description("Sort by ('created', 'updated', 'comments')"),
+				mcp.Enum("created", "updated", "comments"),
+			),
+			mcp.WithString("direction",
+				mcp.Description("Sort direction ('asc', 'desc')"),
+				mcp.Enum("asc", "desc"),
+			),
+			mcp.WithString("since",
+				mcp.Description("Filter by date (ISO 8601 timestamp)"),
+			),
+			mcp.WithBoolean("include_pull_requests",
+				mcp.Description("Include pull requests in the results, which the API returns alongside issues"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts := &github.IssueListByRepoOptions{}
+
+			// Set optional parameters if provided
+			opts.State, err = OptionalParam[string](request, "state")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			// Get labels
+			opts.Labels, err = OptionalStringArrayParam(request, "labels")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts.Assignee, err = OptionalParam[string](request, "assignee")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts.Creator, err = OptionalParam[string](request, "creator")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts.Mentioned, err = OptionalParam[string](request, "mentioned")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts.Sort, err = OptionalParam[string](request, "sort")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts.Direction, err = OptionalParam[string](request, "direction")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			since, err := OptionalParam[string](request, "since")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if since != "" {
+				timestamp, err := parseISOTimestamp(since)
+				if err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("failed to list issues: %s", err.Error())), nil
+				}
+				opts.Since = timestamp
+			}
+
+			includePullRequests, err := OptionalParam[bool](request, "include_pull_requests")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if page, ok := request.Params.Arguments["page"].(float64); ok {
+				opts.Page = int(page)
+			}
+
+			if perPage, ok := request.Params.Arguments["perPage"].(float64); ok {
+				opts.PerPage = int(perPage)
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			issues, resp, err := client.Issues.ListByRepo(ctx, owner, repo, opts)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list issues: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list issues: %s", string(body))), nil
+			}
+
+			if !includePullRequests {
+				filtered := make([]*github.Issue, 0, len(issues))
+				for _, issue := range issues {
+					if !issue.IsPullRequest() {
+						filtered = append(filtered, issue)
+					}
+				}
+				issues = filtered
+			}
+
+			r, err := json.Marshal(issues)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal issues: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// userIssue is a trimmed issue listed across repositories, which names its repository.
+type userIssue struct {
+	Repository string `json:"repository"`
+	issueSearchItem
+}
+
+// ListMyIssues creates a tool to list issues of the authenticated user across repositories.
+func ListMyIssues(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_my_issues",
+			mcp.WithDescription(t("TOOL_LIST_MY_ISSUES_DESCRIPTION", "List issues of the authenticated user across all repositories, such as the issues assigned to them")),
+			mcp.WithString("filter",
+				mcp.Description("Which issues to list ('assigned', 'created', 'mentioned', 'subscribed', 'all'), defaults to 'assigned'"),
+				mcp.Enum("assigned", "created", "mentioned", "subscribed", "all"),
+			),
+			mcp.WithString("org",
+				mcp.Description("Only list issues in repositories of this organization"),
+			),
+			mcp.WithString("state",
+				mcp.Description("Filter by state ('open', 'closed', 'all')"),
+				mcp.Enum("open", "closed", "all"),
+			),
+			mcp.WithArray("labels",
+				mcp.Description("Filter by labels"),
+				mcp.Items(
+					map[string]interface{}{
+						"type": "string",
+					},
+				),
+			),
+			mcp.WithString("since",
+				mcp.Description("Only issues updated at or after this time (ISO 8601 timestamp)"),
+			),
+			mcp.WithString("sort",
+				mcp.Description("Sort by ('created', 'updated', 'comments')"),
+				mcp.Enum("created", "updated", "comments"),
+			),
+			mcp.WithString("direction",
+				mcp.Description("Sort direction ('asc', 'desc')"),
+				mcp.Enum("asc", "desc"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			opts := &github.IssueListOptions{}
+
+			var err error
+			opts.Filter, err = OptionalParam[string](request, "filter")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			org, err := OptionalParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			opts.State, err = OptionalParam[string](request, "state")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			opts.Labels, err = OptionalStringArrayParam(request, "labels")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			opts.Sort, err = OptionalParam[string](request, "sort")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			opts.Direction, err = OptionalParam[string](request, "direction")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			since, err := OptionalParam[string](request, "since")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if since != "" {
+				opts.Since, err = parseISOTimestamp(since)
+				if err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("invalid since: %s", err.Error())), nil
+				}
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			opts.Page = pagination.page
+			opts.PerPage = pagination.perPage
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			var issues []*github.Issue
+			var resp *github.Response
+			if org != "" {
+				issues, resp, err = client.Issues.ListByOrg(ctx, org, opts)
+			} else {
+				issues, resp, err = client.Issues.List(ctx, true, opts)
+			}
+			if err != nil {
+				if result := apiErrorResult("failed to list issues", err, http.StatusNotFound); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to list issues: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list issues: %s", string(body))), nil
+			}
+
+			trimmed := make([]userIssue, 0, len(issues))
+			for _, issue := range issues {
+				trimmed = append(trimmed, userIssue{
+					Repository:      issueRepositoryFullName(issue),
+					issueSearchItem: newIssueSearchItem(issue),
+				})
+			}
+
+			r, err := json.Marshal(trimmed)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal issues: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// mentioningIssuesResult is the result of searching for issues mentioning a user.
+type mentioningIssuesResult struct {
+	TotalCount        int         `json:"total_count"`
+	IncompleteResults bool        `json:"incomplete_results"`
+	Items             []userIssue `json:"items"`
+	Warnings          []string    `json:"warnings,omitempty"`
+}
+
+// ListIssuesMentioningUser creates a tool to list issues in which a user is mentioned.
+func ListIssuesMentioningUser(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_issues_mentioning_user",
+			mcp.WithDescription(t("TOOL_LIST_ISSUES_MENTIONING_USER_DESCRIPTION", "List issues in which a user is @mentioned, optionally within an organization or a repository")),
+			mcp.WithString("username",
+				mcp.Required(),
+				mcp.Description("Username of the mentioned user"),
+			),
+			mcp.WithString("org",
+				mcp.Description("Only list issues in repositories of this organization"),
+			),
+			mcp.WithString("owner",
+				mcp.Description("Repository owner, used together with repo"),
+			),
+			mcp.WithString("repo",
+				mcp.Description("Only list issues in this repository, requires owner. Takes precedence over org"),
+			),
+			mcp.WithString("state",
+				mcp.Description("Filter by state"),
+				mcp.Enum("open", "closed"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			username, err := requiredParam[string](request, "username")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			org, err := OptionalParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			owner, err := OptionalParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := OptionalParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			state, err := OptionalParam[string](request, "state")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			result := mentioningIssuesResult{}
+			qualifiers := []string{searchQualifier("mentions", username), "is:issue"}
+			switch {
+			case (owner == "") != (repo == ""):
+				return mcp.NewToolResultError("owner and repo must be used together"), nil
+			case repo != "":
+				qualifiers = append(qualifiers, searchQualifier("repo", owner+"/"+repo))
+				if org != "" {
+					result.Warnings = append(result.Warnings, fmt.Sprintf("org %q was ignored because repo %s/%s is more specific", org, owner, repo))
+				}
+			case org != "":
+				qualifiers = append(qualifiers, searchQualifier("org", org))
 			}
 			if state != "" {
-				issueRequest.State = github.Ptr(state)
+				qualifiers = append(qualifiers, searchQualifier("state", state))
+			}
+
+			opts := &github.SearchOptions{
+				ListOptions: github.ListOptions{
+					PerPage: pagination.perPage,
+					Page:    pagination.page,
+				},
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			issues, resp, err := client.Search.Issues(ctx, strings.Join(qualifiers, " "), opts)
+			if err != nil {
+				if result := apiErrorResult("failed to search issues", err, http.StatusUnprocessableEntity); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to search issues: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to search issues: %s", string(body))), nil
+			}
+
+			result.TotalCount = issues.GetTotal()
+			result.IncompleteResults = issues.GetIncompleteResults()
+			result.Items = make([]userIssue, 0, len(issues.Issues))
+			for _, issue := range issues.Issues {
+				result.Items = append(result.Items, userIssue{
+					Repository:      issueRepositoryFullName(issue),
+					issueSearchItem: newIssueSearchItem(issue),
+				})
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// UpdateIssue creates a tool to update an existing issue in a GitHub repository.
+func UpdateIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("update_issue",
+			mcp.WithDescription(t("TOOL_UPDATE_ISSUE_DESCRIPTION", "Update an existing issue in a GitHub repository")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("issue_number",
+				mcp.Required(),
+				mcp.Description("Issue number to update"),
+			),
+			mcp.WithString("title",
+				mcp.Description("New title"),
+			),
+			mcp.WithString("body",
+				mcp.Description("New description"),
+			),
+			mcp.WithString("state",
+				mcp.Description("New state ('open' or 'closed')"),
+				mcp.Enum("open", "closed"),
+			),
+			mcp.WithString("state_reason",
+				mcp.Description("Reason for the state change, only allowed together with state ('reopened' requires state 'open', the others require 'closed')"),
+				mcp.Enum("completed", "not_planned", "duplicate", "reopened"),
+			),
+			mcp.WithArray("labels",
+				mcp.Description("New labels"),
+				mcp.Items(
+					map[string]interface{}{
+						"type": "string",
+					},
+				),
+			),
+			mcp.WithArray("assignees",
+				mcp.Description("New assignees"),
+				mcp.Items(
+					map[string]interface{}{
+						"type": "string",
+					},
+				),
+			),
+			mcp.WithNumber("milestone",
+				mcp.Description("New milestone number, or 0 to remove the milestone"),
+			),
+			mcp.WithString("milestone_title",
+				mcp.Description("Title of the new milestone, as an alternative to milestone"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			issueNumber, err := RequiredInt(request, "issue_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			update, err := parseIssueUpdate(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			if update.milestoneTitle != "" {
+				result, err := resolveMilestoneTitle(ctx, client, owner, repo, update)
+				if result != nil || err != nil {
+					return result, err
+				}
+			}
+			updatedIssue, resp, err := editIssue(ctx, client, owner, repo, issueNumber, update)
+			if err != nil {
+				return nil, fmt.Errorf("failed to update issue: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to update issue: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(updatedIssue)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// issueUpdate holds the issue fields to update, as parsed from the parameters of update_issue.
+type issueUpdate struct {
+	request        *github.IssueRequest
+	clearMilestone bool
+	milestoneTitle string
+}
+
+// parseIssueUpdate reads the optional issue fields shared by update_issue and bulk_update_issues.
+// Only fields that are provided are set on the request.
+func parseIssueUpdate(request mcp.CallToolRequest) (*issueUpdate, error) {
+	update := &issueUpdate{request: &github.IssueRequest{}}
+
+	title, err := OptionalParam[string](request, "title")
+	if err != nil {
+		return nil, err
+	}
+	if title != "" {
+		update.request.Title = github.Ptr(title)
+	}
+
+	body, err := OptionalParam[string](request, "body")
+	if err != nil {
+		return nil, err
+	}
+	if body != "" {
+		update.request.Body = github.Ptr(body)
+	}
+
+	state, err := OptionalParam[string](request, "state")
+	if err != nil {
+		return nil, err
+	}
+	if state != "" {
+		update.request.State = github.Ptr(state)
+	}
+
+	stateReason, err := OptionalParam[string](request, "state_reason")
+	if err != nil {
+		return nil, err
+	}
+	if err := validateStateReason(state, stateReason); err != nil {
+		return nil, err
+	}
+	if stateReason != "" {
+		update.request.StateReason = github.Ptr(stateReason)
+	}
+
+	labels, err := OptionalStringArrayParam(request, "labels")
+	if err != nil {
+		return nil, err
+	}
+	if len(labels) > 0 {
+		update.request.Labels = &labels
+	}
+
+	assignees, err := OptionalStringArrayParam(request, "assignees")
+	if err != nil {
+		return nil, err
+	}
+	if len(assignees) > 0 {
+		update.request.Assignees = &assignees
+	}
+
+	milestone, hasMilestone, err := OptionalParamOK[float64](request, "milestone")
+	if err != nil {
+		return nil, err
+	}
+	update.clearMilestone = hasMilestone && milestone == 0
+	if hasMilestone && milestone != 0 {
+		update.request.Milestone = github.Ptr(int(milestone))
+	}
+
+	update.milestoneTitle, err = OptionalParam[string](request, "milestone_title")
+	if err != nil {
+		return nil, err
+	}
+	if update.milestoneTitle != "" && hasMilestone {
+		return nil, errors.New("only one of milestone and milestone_title can be provided")
+	}
+
+	return update, nil
+}
+
+// resolveMilestoneTitle sets the milestone of the update to the milestone titled
+// update.milestoneTitle. It returns a tool result error when no milestone has that title.
+func resolveMilestoneTitle(ctx context.Context, client *github.Client, owner, repo string, update *issueUpdate) (*mcp.CallToolResult, error) {
+	milestoneNum, titles, err := findMilestoneByTitle(ctx, client, owner, repo, update.milestoneTitle)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list milestones: %w", err)
+	}
+	if milestoneNum == 0 {
+		return mcp.NewToolResultError(fmt.Sprintf("milestone %q not found, available milestones: %s",
+			update.milestoneTitle, strings.Join(titles, ", "))), nil
+	}
+	update.request.Milestone = &milestoneNum
+	return nil, nil
+}
+
+// editIssue applies the update to an issue.
+func editIssue(ctx context.Context, client *github.Client, owner, repo string, issueNumber int, update *issueUpdate) (*github.Issue, *github.Response, error) {
+	if update.clearMilestone {
+		return editIssueClearingMilestone(ctx, client, owner, repo, issueNumber, update.request)
+	}
+	return client.Issues.Edit(ctx, owner, repo, issueNumber, update.request)
+}
+
+const (
+	// bulkUpdateLimit is the maximum number of issues updated by one bulk_update_issues call.
+	bulkUpdateLimit = 50
+	// bulkUpdateWorkers is the number of issues updated concurrently.
+	bulkUpdateWorkers = 5
+)
+
+// bulkIssueResult is the outcome of updating one issue of a bulk update.
+type bulkIssueResult struct {
+	Number int    `json:"number"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// BulkUpdateIssues creates a tool to apply the same update to several issues of a repository.
+func BulkUpdateIssues(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("bulk_update_issues",
+			mcp.WithDescription(t("TOOL_BULK_UPDATE_ISSUES_DESCRIPTION", fmt.Sprintf("Apply the same state, label, assignee, or milestone change to up to %d issues of a GitHub repository, reporting the outcome for each issue", bulkUpdateLimit))),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithArray("issue_numbers",
+				mcp.Required(),
+				mcp.Description(fmt.Sprintf("Numbers of the issues to update, at most %d", bulkUpdateLimit)),
+				mcp.Items(
+					map[string]interface{}{
+						"type": "number",
+					},
+				),
+			),
+			mcp.WithString("state",
+				mcp.Description("New state ('open' or 'closed')"),
+				mcp.Enum("open", "closed"),
+			),
+			mcp.WithString("state_reason",
+				mcp.Description("Reason for the state change, only allowed together with state ('reopened' requires state 'open', the others require 'closed')"),
+				mcp.Enum("completed", "not_planned", "duplicate", "reopened"),
+			),
+			mcp.WithArray("labels",
+				mcp.Description("New labels, replacing the current labels of each issue"),
+				mcp.Items(
+					map[string]interface{}{
+						"type": "string",
+					},
+				),
+			),
+			mcp.WithArray("assignees",
+				mcp.Description("New assignees, replacing the current assignees of each issue"),
+				mcp.Items(
+					map[string]interface{}{
+						"type": "string",
+					},
+				),
+			),
+			mcp.WithNumber("milestone",
+				mcp.Description("New milestone number, or 0 to remove the milestone"),
+			),
+			mcp.WithString("milestone_title",
+				mcp.Description("Title of the new milestone, as an alternative to milestone"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			issueNumbers, err := RequiredIntArrayParam(request, "issue_numbers")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			// An issue listed twice is updated once, rather than concurrently with itself.
+			seen := make(map[int]bool, len(issueNumbers))
+			unique := make([]int, 0, len(issueNumbers))
+			for _, issueNumber := range issueNumbers {
+				if !seen[issueNumber] {
+					seen[issueNumber] = true
+					unique = append(unique, issueNumber)
+				}
+			}
+			issueNumbers = unique
+			if len(issueNumbers) > bulkUpdateLimit {
+				return mcp.NewToolResultError(fmt.Sprintf("at most %d issues can be updated at once, got %d", bulkUpdateLimit, len(issueNumbers))), nil
+			}
+
+			update, err := parseIssueUpdate(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if update.request.State == nil && update.request.Labels == nil && update.request.Assignees == nil &&
+				update.request.Milestone == nil && !update.clearMilestone && update.milestoneTitle == "" {
+				return mcp.NewToolResultError("at least one of state, labels, assignees, milestone, or milestone_title must be provided"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			if update.milestoneTitle != "" {
+				result, err := resolveMilestoneTitle(ctx, client, owner, repo, update)
+				if result != nil || err != nil {
+					return result, err
+				}
+			}
+
+			results := bulkEditIssues(ctx, client, owner, repo, issueNumbers, update)
+
+			r, err := json.Marshal(results)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// bulkEditIssues applies the update to the issues using bulkUpdateWorkers concurrent workers.
+// Failures are recorded per issue. Once the context is done or the secondary rate limit is
+// hit, the issues that were not started yet are skipped, while started updates complete.
+func bulkEditIssues(ctx context.Context, client *github.Client, owner, repo string, issueNumbers []int, update *issueUpdate) []bulkIssueResult {
+	stop, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	results := make([]bulkIssueResult, len(issueNumbers))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for range min(bulkUpdateWorkers, len(issueNumbers)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				if stop.Err() != nil {
+					results[i] = skippedBulkIssue(stop, issueNumbers[i])
+					continue
+				}
+				result := bulkIssueResult{Number: issueNumbers[i], Status: "updated"}
+				_, resp, err := editIssue(ctx, client, owner, repo, issueNumbers[i], update)
+				if resp != nil {
+					_ = resp.Body.Close()
+				}
+				if err != nil {
+					var abuseErr *github.AbuseRateLimitError
+					if errors.As(err, &abuseErr) {
+						cancel(errors.New("secondary rate limit exceeded"))
+					}
+					result.Status = "failed"
+					result.Error = bulkIssueError(err)
+				}
+				results[i] = result
+			}
+		}()
+	}
+
+	for i := range issueNumbers {
+		select {
+		case jobs <- i:
+		case <-stop.Done():
+			results[i] = skippedBulkIssue(stop, issueNumbers[i])
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	return results
+}
+
+// skippedBulkIssue is the result of an issue left out of a bulk update once stop is done.
+func skippedBulkIssue(stop context.Context, issueNumber int) bulkIssueResult {
+	return bulkIssueResult{
+		Number: issueNumber,
+		Status: "skipped",
+		Error:  "not attempted: " + context.Cause(stop).Error(),
+	}
+}
+
+// bulkIssueError describes why updating an issue failed, preferring the message of the API.
+func bulkIssueError(err error) string {
+	var abuseErr *github.AbuseRateLimitError
+	if errors.As(err, &abuseErr) {
+		return "secondary rate limit exceeded: " + abuseErr.Message
+	}
+	var errorResponse *github.ErrorResponse
+	if errors.As(err, &errorResponse) {
+		return apiErrorDetails(errorResponse)
+	}
+	return err.Error()
+}
+
+// CloseIssue creates a tool to close an issue with a reason and an optional closing comment.
+func CloseIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("close_issue",
+			mcp.WithDescription(t("TOOL_CLOSE_ISSUE_DESCRIPTION", "Close an issue in a GitHub repository, recording why it was closed")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("issue_number",
+				mcp.Required(),
+				mcp.Description("Issue number to close"),
+			),
+			mcp.WithString("state_reason",
+				mcp.Description("Reason for closing the issue (default 'completed')"),
+				mcp.Enum("completed", "not_planned", "duplicate"),
+			),
+			mcp.WithString("comment",
+				mcp.Description("Comment to post on the issue before closing it"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			issueNumber, err := RequiredInt(request, "issue_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			stateReason, err := OptionalParam[string](request, "state_reason")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if stateReason == "" {
+				stateReason = "completed"
+			}
+			if err := validateStateReason("closed", stateReason); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			comment, err := OptionalParam[string](request, "comment")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			// Post the comment first, so that the issue is left open if it cannot be added.
+			if comment != "" {
+				_, resp, err := client.Issues.CreateComment(ctx, owner, repo, issueNumber, &github.IssueComment{
+					Body: github.Ptr(comment),
+				})
+				if err != nil {
+					if result := apiErrorResult("failed to add closing comment", err, http.StatusNotFound, http.StatusForbidden); result != nil {
+						return result, nil
+					}
+					return nil, fmt.Errorf("failed to add closing comment: %w", err)
+				}
+				_ = resp.Body.Close()
+			}
+
+			issue, resp, err := client.Issues.Edit(ctx, owner, repo, issueNumber, &github.IssueRequest{
+				State:       github.Ptr("closed"),
+				StateReason: github.Ptr(stateReason),
+			})
+			if err != nil {
+				if result := apiErrorResult("failed to close issue", err, http.StatusNotFound, http.StatusForbidden, http.StatusUnprocessableEntity); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to close issue: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to close issue: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(issue)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// TransferIssue creates a tool to transfer an issue to another repository of the same owner.
+func TransferIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("transfer_issue",
+			mcp.WithDescription(t("TOOL_TRANSFER_ISSUE_DESCRIPTION", "Transfer an issue to another repository of the same owner")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("issue_number",
+				mcp.Required(),
+				mcp.Description("Issue number"),
+			),
+			mcp.WithString("new_repo",
+				mcp.Required(),
+				mcp.Description("Repository to transfer the issue to, as 'name' or 'owner/name' with the same owner"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			issueNumber, err := RequiredInt(request, "issue_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			newRepo, err := requiredParam[string](request, "new_repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if newOwner, name, ok := strings.Cut(newRepo, "/"); ok {
+				if !strings.EqualFold(newOwner, owner) {
+					return mcp.NewToolResultError(fmt.Sprintf("issues can only be transferred within the same owner: %q is not owned by %q", newRepo, owner)), nil
+				}
+				newRepo = name
+			}
+			if newRepo == "" || strings.Contains(newRepo, "/") {
+				return mcp.NewToolResultError(fmt.Sprintf("new_repo must be 'name' or 'owner/name', got %q", newRepo)), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			var query struct {
+				Repository *struct {
+					Issue *struct {
+						ID string `json:"id"`
+					} `json:"issue"`
+				} `json:"repository"`
+				Target *struct {
+					ID string `json:"id"`
+				} `json:"target"`
+			}
+			err = executeGraphQL(ctx, client, issueAndTargetRepositoryIDsQuery, map[string]interface{}{
+				"owner":   owner,
+				"repo":    repo,
+				"number":  issueNumber,
+				"newRepo": newRepo,
+			}, &query)
+			if err != nil {
+				if result := graphQLErrorResult("failed to get issue", err); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to get issue: %w", err)
+			}
+			if query.Repository == nil || query.Repository.Issue == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("issue #%d not found in %s/%s", issueNumber, owner, repo)), nil
+			}
+			if query.Target == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("repository %s/%s not found", owner, newRepo)), nil
+			}
+
+			var mutation struct {
+				TransferIssue struct {
+					Issue struct {
+						Number int    `json:"number"`
+						URL    string `json:"url"`
+					} `json:"issue"`
+				} `json:"transferIssue"`
+			}
+			err = executeGraphQL(ctx, client, transferIssueMutation, map[string]interface{}{
+				"issueId":      query.Repository.Issue.ID,
+				"repositoryId": query.Target.ID,
+			}, &mutation)
+			if err != nil {
+				if result := graphQLErrorResult("failed to transfer issue", err); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to transfer issue: %w", err)
+			}
+
+			issue := mutation.TransferIssue.Issue
+			r, err := json.Marshal(map[string]interface{}{
+				"repository":   owner + "/" + newRepo,
+				"issue_number": issue.Number,
+				"url":          issue.URL,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// PinIssue creates a tool to pin an issue.
+func PinIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("pin_issue",
+			mcp.WithDescription(t("TOOL_PIN_ISSUE_DESCRIPTION", "Pin an issue to the top of the repository issues list. A repository can have at most three pinned issues")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("issue_number",
+				mcp.Required(),
+				mcp.Description("Issue number"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			issueNumber, err := RequiredInt(request, "issue_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			return setIssuePinned(ctx, client, owner, repo, issueNumber, true)
+		}
+}
+
+// UnpinIssue creates a tool to unpin an issue.
+func UnpinIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("unpin_issue",
+			mcp.WithDescription(t("TOOL_UNPIN_ISSUE_DESCRIPTION", "Unpin an issue from the repository issues list")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("issue_number",
+				mcp.Required(),
+				mcp.Description("Issue number"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			issueNumber, err := RequiredInt(request, "issue_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			return setIssuePinned(ctx, client, owner, repo, issueNumber, false)
+		}
+}
+
+// validateStateReason checks that a state reason is only given together with a
+// state it is compatible with.
+func validateStateReason(state, stateReason string) error {
+	switch {
+	case stateReason == "":
+		return nil
+	case state == "":
+		return fmt.Errorf("state_reason can only be set together with state")
+	case stateReason == "reopened" && state != "open":
+		return fmt.Errorf("state_reason 'reopened' requires state 'open'")
+	case stateReason != "reopened" && state != "closed":
+		return fmt.Errorf("state_reason '%s' requires state 'closed'", stateReason)
+	}
+	return nil
+}
+
+// clearMilestoneIssueRequest is an issue edit that explicitly sets the milestone to
+// null, which IssueRequest cannot express since it omits an empty milestone.
+type clearMilestoneIssueRequest struct {
+	*github.IssueRequest
+	Milestone *int `json:"milestone"`
+}
+
+// editIssueClearingMilestone edits an issue like IssueService.Edit and removes its
+// milestone in the same request.
+func editIssueClearingMilestone(ctx context.Context, client *github.Client, owner, repo string, issueNumber int, issueRequest *github.IssueRequest) (*github.Issue, *github.Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/issues/%d", owner, repo, issueNumber)
+	req, err := client.NewRequest(http.MethodPatch, u, &clearMilestoneIssueRequest{IssueRequest: issueRequest})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	issue := new(github.Issue)
+	resp, err := client.Do(ctx, req, issue)
+	if err != nil {
+		return nil, resp, err
+	}
+	return issue, resp, nil
+}
+
+// GetIssueComments creates a tool to get comments for a GitHub issue.
+func GetIssueComments(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_issue_comments",
+			mcp.WithDescription(t("TOOL_GET_ISSUE_COMMENTS_DESCRIPTION", "Get comments for a GitHub issue")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("issue_number",
+				mcp.Required(),
+				mcp.Description("Issue number"),
+			),
+			mcp.WithString("since",
+				mcp.Description("Only comments updated at or after this time (ISO 8601 timestamp)"),
+			),
+			mcp.WithString("sort",
+				mcp.Description("Sort by ('created', 'updated')"),
+				mcp.Enum("created", "updated"),
+			),
+			mcp.WithString("direction",
+				mcp.Description("Sort direction ('asc', 'desc')"),
+				mcp.Enum("asc", "desc"),
+			),
+			mcp.WithNumber("page",
+				mcp.Description("Page number"),
+			),
+			mcp.WithNumber("per_page",
+				mcp.Description("Number of records per page"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			issueNumber, err := RequiredInt(request, "issue_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			page, err := OptionalIntParamWithDefault(request, "page", 1)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			perPage, err := OptionalIntParamWithDefault(request, "per_page", 30)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts := &github.IssueListCommentsOptions{
+				ListOptions: github.ListOptions{
+					Page:    page,
+					PerPage: perPage,
+				},
+			}
+
+			since, err := OptionalTimestampParam(request, "since")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if !since.IsZero() {
+				opts.Since = &since
+			}
+
+			sort, err := OptionalParam[string](request, "sort")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if sort != "" {
+				opts.Sort = github.Ptr(sort)
+			}
+
+			direction, err := OptionalParam[string](request, "direction")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if direction != "" {
+				opts.Direction = github.Ptr(direction)
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			comments, resp, err := client.Issues.ListComments(ctx, owner, repo, issueNumber, opts)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get issue comments: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get issue comments: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(comments)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// subIssuesSummary counts the sub-issues of an issue.
+type subIssuesSummary struct {
+	Total            int `json:"total"`
+	Completed        int `json:"completed"`
+	PercentCompleted int `json:"percent_completed"`
+}
+
+// subIssueParent is the parent issue returned when changing its sub-issues.
+type subIssueParent struct {
+	Number           int              `json:"number"`
+	HTMLURL          string           `json:"html_url"`
+	SubIssuesSummary subIssuesSummary `json:"sub_issues_summary"`
+}
+
+// ListSubIssues creates a tool to list the sub-issues of an issue.
+func ListSubIssues(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_sub_issues",
+			mcp.WithDescription(t("TOOL_LIST_SUB_ISSUES_DESCRIPTION", "List the sub-issues of an issue, in priority order")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("issue_number",
+				mcp.Required(),
+				mcp.Description("Number of the parent issue"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			issueNumber, err := RequiredInt(request, "issue_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			u := fmt.Sprintf("repos/%v/%v/issues/%d/sub_issues?page=%d&per_page=%d", owner, repo, issueNumber, pagination.page, pagination.perPage)
+			req, err := client.NewRequest(http.MethodGet, u, nil)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create request: %w", err)
+			}
+			var subIssues []*github.Issue
+			resp, err := client.Do(ctx, req, &subIssues)
+			if err != nil {
+				if result := apiErrorResult("failed to list sub-issues", err, http.StatusNotFound); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to list sub-issues: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list sub-issues: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(subIssues)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// AddSubIssue creates a tool to add a sub-issue to an issue.
+func AddSubIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("add_sub_issue",
+			mcp.WithDescription(t("TOOL_ADD_SUB_ISSUE_DESCRIPTION", "Add an existing issue as a sub-issue of another issue")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("issue_number",
+				mcp.Required(),
+				mcp.Description("Number of the parent issue"),
+			),
+			mcp.WithNumber("sub_issue_id",
+				mcp.Required(),
+				mcp.Description("ID of the sub-issue, which is not the same as its number"),
+			),
+			mcp.WithBoolean("replace_parent",
+				mcp.Description("Move the sub-issue from its current parent issue, if it has one"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			issueNumber, err := RequiredInt(request, "issue_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			subIssueID, err := RequiredInt(request, "sub_issue_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			replaceParent, err := OptionalParam[bool](request, "replace_parent")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			// Get labels
-			labels, err := OptionalStringArrayParam(request, "labels")
+			body := map[string]interface{}{
+				"sub_issue_id": subIssueID,
+			}
+			if replaceParent {
+				body["replace_parent"] = true
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			return updateSubIssues(ctx, client, http.MethodPost, fmt.Sprintf("repos/%v/%v/issues/%d/sub_issues", owner, repo, issueNumber), "failed to add sub-issue", body)
+		}
+}
+
+// RemoveSubIssue creates a tool to remove a sub-issue from an issue.
+func RemoveSubIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("remove_sub_issue",
+			mcp.WithDescription(t("TOOL_REMOVE_SUB_ISSUE_DESCRIPTION", "Remove a sub-issue from its parent issue")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("issue_number",
+				mcp.Required(),
+				mcp.Description("Number of the parent issue"),
+			),
+			mcp.WithNumber("sub_issue_id",
+				mcp.Required(),
+				mcp.Description("ID of the sub-issue, which is not the same as its number"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			if len(labels) > 0 {
-				issueRequest.Labels = &labels
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			issueNumber, err := RequiredInt(request, "issue_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			subIssueID, err := RequiredInt(request, "sub_issue_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			// Get assignees
-			assignees, err := OptionalStringArrayParam(request, "assignees")
+			body := map[string]interface{}{
+				"sub_issue_id": subIssueID,
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			return updateSubIssues(ctx, client, http.MethodDelete, fmt.Sprintf("repos/%v/%v/issues/%d/sub_issue", owner, repo, issueNumber), "failed to remove sub-issue", body)
+		}
+}
+
+// ReprioritizeSubIssue creates a tool to move a sub-issue within the priority order of its parent.
+func ReprioritizeSubIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("reprioritize_sub_issue",
+			mcp.WithDescription(t("TOOL_REPRIORITIZE_SUB_ISSUE_DESCRIPTION", "Move a sub-issue before or after another sub-issue of the same parent issue")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("issue_number",
+				mcp.Required(),
+				mcp.Description("Number of the parent issue"),
+			),
+			mcp.WithNumber("sub_issue_id",
+				mcp.Required(),
+				mcp.Description("ID of the sub-issue, which is not the same as its number"),
+			),
+			mcp.WithNumber("after_id",
+				mcp.Description("ID of the sub-issue to place it after (either after_id or before_id is required)"),
+			),
+			mcp.WithNumber("before_id",
+				mcp.Description("ID of the sub-issue to place it before (either after_id or before_id is required)"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			if len(assignees) > 0 {
-				issueRequest.Assignees = &assignees
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			issueNumber, err := RequiredInt(request, "issue_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			subIssueID, err := RequiredInt(request, "sub_issue_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			afterID, err := OptionalIntParam(request, "after_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			beforeID, err := OptionalIntParam(request, "before_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if (afterID == 0) == (beforeID == 0) {
+				return mcp.NewToolResultError("exactly one of after_id or before_id must be provided"), nil
 			}
 
-			milestone, err := OptionalIntParam(request, "milestone")
+			body := map[string]interface{}{
+				"sub_issue_id": subIssueID,
+			}
+			if afterID != 0 {
+				body["after_id"] = afterID
+			} else {
+				body["before_id"] = beforeID
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			return updateSubIssues(ctx, client, http.MethodPatch, fmt.Sprintf("repos/%v/%v/issues/%d/sub_issues/priority", owner, repo, issueNumber), "failed to reprioritize sub-issue", body)
+		}
+}
+
+// updateSubIssues sends a request changing the sub-issues of an issue and returns the parent
+// issue with its updated sub-issue counts. Reaching the limit of sub-issues is reported
+// separately from other validation errors.
+func updateSubIssues(ctx context.Context, client *github.Client, method, u, message string, body interface{}) (*mcp.CallToolResult, error) {
+	req, err := client.NewRequest(method, u, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	parent := new(subIssueParent)
+	resp, err := client.Do(ctx, req, parent)
+	if err != nil {
+		var errorResponse *github.ErrorResponse
+		if errors.As(err, &errorResponse) && errorResponse.Response != nil &&
+			errorResponse.Response.StatusCode == http.StatusUnprocessableEntity &&
+			strings.Contains(strings.ToLower(errorResponse.Error()), "maximum") {
+			return apiErrorResult(message+": the parent issue has reached the maximum number of sub-issues", err), nil
+		}
+		if result := apiErrorResult(message, err, http.StatusNotFound, http.StatusForbidden, http.StatusUnprocessableEntity); result != nil {
+			return result, nil
+		}
+		return nil, fmt.Errorf("%s: %w", message, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read response body: %w", err)
+		}
+		return mcp.NewToolResultError(fmt.Sprintf("%s: %s", message, string(body))), nil
+	}
+
+	r, err := json.Marshal(parent)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal response: %w", err)
+	}
+
+	return mcp.NewToolResultText(string(r)), nil
+}
+
+// issueTimelineEvent is a trimmed down issue timeline event, keeping the information that
+// links the issue to other issues and pull requests.
+type issueTimelineEvent struct {
+	Event     string                    `json:"event"`
+	Actor     string                    `json:"actor,omitempty"`
+	CreatedAt *github.Timestamp         `json:"created_at,omitempty"`
+	Label     string                    `json:"label,omitempty"`
+	Assignee  string                    `json:"assignee,omitempty"`
+	Source    *issueTimelineEventSource `json:"source,omitempty"`
+}
+
+// issueTimelineEventSource is the issue or pull request that cross-referenced an issue.
+type issueTimelineEventSource struct {
+	Type       string `json:"type"`
+	Number     int    `json:"number"`
+	Repository string `json:"repository"`
+	URL        string `json:"url,omitempty"`
+}
+
+// newIssueTimelineEvent trims a timeline event down to an issueTimelineEvent.
+func newIssueTimelineEvent(event *github.Timeline) *issueTimelineEvent {
+	trimmed := &issueTimelineEvent{
+		Event:     event.GetEvent(),
+		CreatedAt: event.CreatedAt,
+	}
+	// Commented events have an author instead of an actor.
+	if event.Actor != nil {
+		trimmed.Actor = event.Actor.GetLogin()
+	} else if event.User != nil {
+		trimmed.Actor = event.User.GetLogin()
+	}
+	if event.Label != nil {
+		trimmed.Label = event.Label.GetName()
+	}
+	if event.Assignee != nil {
+		trimmed.Assignee = event.Assignee.GetLogin()
+	}
+	if event.Source != nil && event.Source.Issue != nil {
+		source := event.Source.Issue
+		trimmed.Source = &issueTimelineEventSource{
+			Type:       "issue",
+			Number:     source.GetNumber(),
+			Repository: issueRepositoryFullName(source),
+			URL:        source.GetHTMLURL(),
+		}
+		if source.IsPullRequest() {
+			trimmed.Source.Type = "pull_request"
+		}
+	}
+	return trimmed
+}
+
+// GetIssueTimeline creates a tool to get the timeline of events of an issue.
+func GetIssueTimeline(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_issue_timeline",
+			mcp.WithDescription(t("TOOL_GET_ISSUE_TIMELINE_DESCRIPTION", "Get the timeline of an issue, such as cross-references from other issues and pull requests, label changes, and state changes")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("issue_number",
+				mcp.Required(),
+				mcp.Description("Issue number"),
+			),
+			mcp.WithArray("event_types",
+				mcp.Description("Only return events of these types, e.g. 'cross-referenced', 'closed', 'reopened', 'labeled', 'assigned'"),
+				mcp.Items(
+					map[string]interface{}{
+						"type": "string",
+					},
+				),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			if milestone != 0 {
-				milestoneNum := milestone
-				issueRequest.Milestone = &milestoneNum
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			issueNumber, err := RequiredInt(request, "issue_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			eventTypes, err := OptionalStringArrayParam(request, "event_types")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			opts := &github.ListOptions{
+				Page:    pagination.page,
+				PerPage: pagination.perPage,
 			}
 
 			client, err := getClient(ctx)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
-			updatedIssue, resp, err := client.Issues.Edit(ctx, owner, repo, issueNumber, issueRequest)
+			timeline, resp, err := client.Issues.ListIssueTimeline(ctx, owner, repo, issueNumber, opts)
 			if err != nil {
-				return nil, fmt.Errorf("failed to update issue: %w", err)
+				if result := apiErrorResult("failed to get issue timeline", err, http.StatusNotFound); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to get issue timeline: %w", err)
 			}
 			defer func() { _ = resp.Body.Close() }()
 
@@ -592,10 +2859,18 @@ func UpdateIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 				if err != nil {
 					return nil, fmt.Errorf("failed to read response body: %w", err)
 				}
-				return mcp.NewToolResultError(fmt.Sprintf("failed to update issue: %s", string(body))), nil
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get issue timeline: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(updatedIssue)
+			events := make([]*issueTimelineEvent, 0, len(timeline))
+			for _, event := range timeline {
+				if len(eventTypes) > 0 && !slices.Contains(eventTypes, event.GetEvent()) {
+					continue
+				}
+				events = append(events, newIssueTimelineEvent(event))
+			}
+
+			r, err := json.Marshal(events)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
@@ -604,10 +2879,30 @@ func UpdateIssue(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 		}
 }
 
-// GetIssueComments creates a tool to get comments for a GitHub issue.
-func GetIssueComments(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
-	return mcp.NewTool("get_issue_comments",
-			mcp.WithDescription(t("TOOL_GET_ISSUE_COMMENTS_DESCRIPTION", "Get comments for a GitHub issue")),
+// issueEventListLimit is the maximum number of events fetched to filter the events of an issue.
+const issueEventListLimit = 1000
+
+// issueEvent is a trimmed down issue event.
+type issueEvent struct {
+	Event     string            `json:"event"`
+	Actor     string            `json:"actor,omitempty"`
+	CreatedAt *github.Timestamp `json:"created_at,omitempty"`
+	Label     string            `json:"label,omitempty"`
+	Assignee  string            `json:"assignee,omitempty"`
+	CommitID  string            `json:"commit_id,omitempty"`
+}
+
+// issueEventList is a page of the events of an issue. Truncated reports that events of the
+// requested type were left out, because filtering stopped at issueEventListLimit events.
+type issueEventList struct {
+	Events    []*issueEvent `json:"events"`
+	Truncated bool          `json:"truncated,omitempty"`
+}
+
+// GetIssueEvents creates a tool to list the events of an issue.
+func GetIssueEvents(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_issue_events",
+			mcp.WithDescription(t("TOOL_LIST_ISSUE_EVENTS_DESCRIPTION", "List the events of an issue, such as labeled, assigned, closed, and referenced, with the commits that caused them")),
 			mcp.WithString("owner",
 				mcp.Required(),
 				mcp.Description("Repository owner"),
@@ -620,12 +2915,10 @@ func GetIssueComments(getClient GetClientFn, t translations.TranslationHelperFun
 				mcp.Required(),
 				mcp.Description("Issue number"),
 			),
-			mcp.WithNumber("page",
-				mcp.Description("Page number"),
-			),
-			mcp.WithNumber("per_page",
-				mcp.Description("Number of records per page"),
+			mcp.WithString("event",
+				mcp.Description("Only return events of this type, e.g. 'labeled', 'assigned', 'closed', 'referenced'. Only the first 1000 events are filtered, the result is marked truncated when there are more"),
 			),
+			WithPagination(),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := requiredParam[string](request, "owner")
@@ -640,41 +2933,75 @@ func GetIssueComments(getClient GetClientFn, t translations.TranslationHelperFun
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			page, err := OptionalIntParamWithDefault(request, "page", 1)
+			eventType, err := OptionalParam[string](request, "event")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			perPage, err := OptionalIntParamWithDefault(request, "per_page", 30)
+			pagination, err := OptionalPaginationParams(request)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			opts := &github.IssueListCommentsOptions{
-				ListOptions: github.ListOptions{
-					Page:    page,
-					PerPage: perPage,
-				},
-			}
-
 			client, err := getClient(ctx)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
-			comments, resp, err := client.Issues.ListComments(ctx, owner, repo, issueNumber, opts)
+
+			opts := &github.ListOptions{
+				Page:    pagination.page,
+				PerPage: pagination.perPage,
+			}
+			var events []*github.IssueEvent
+			var truncated bool
+			if eventType == "" {
+				var resp *github.Response
+				events, resp, err = client.Issues.ListIssueEvents(ctx, owner, repo, issueNumber, opts)
+				if resp != nil {
+					_ = resp.Body.Close()
+				}
+			} else {
+				// The API cannot filter events, so fetch all of them and page through the
+				// matching events.
+				opts.Page = 1
+				opts.PerPage = 100
+				var all []*github.IssueEvent
+				all, truncated, err = fetchAllPages(ctx, opts, issueEventListLimit, func() ([]*github.IssueEvent, *github.Response, error) {
+					return client.Issues.ListIssueEvents(ctx, owner, repo, issueNumber, opts)
+				})
+				for _, event := range all {
+					if event.GetEvent() == eventType {
+						events = append(events, event)
+					}
+				}
+			}
 			if err != nil {
-				return nil, fmt.Errorf("failed to get issue comments: %w", err)
+				if result := apiErrorResult("failed to list issue events", err, http.StatusNotFound); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to list issue events: %w", err)
 			}
-			defer func() { _ = resp.Body.Close() }()
-
-			if resp.StatusCode != http.StatusOK {
-				body, err := io.ReadAll(resp.Body)
-				if err != nil {
-					return nil, fmt.Errorf("failed to read response body: %w", err)
+			if eventType != "" {
+				if events, err = paginateSlice(events, pagination); err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
 				}
-				return mcp.NewToolResultError(fmt.Sprintf("failed to get issue comments: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(comments)
+			list := issueEventList{
+				Events:    make([]*issueEvent, 0, len(events)),
+				Truncated: truncated,
+			}
+			for _, event := range events {
+				list.Events = append(list.Events, &issueEvent{
+					Event:     event.GetEvent(),
+					Actor:     event.GetActor().GetLogin(),
+					CreatedAt: event.CreatedAt,
+					Label:     event.GetLabel().GetName(),
+					Assignee:  event.GetAssignee().GetLogin(),
+					CommitID:  event.GetCommitID(),
+				})
+			}
+
+			r, err := json.Marshal(list)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
@@ -706,3 +3033,156 @@ func parseISOTimestamp(timestamp string) (time.Time, error) {
 	// Return error with supported formats
 	return time.Time{}, fmt.Errorf("invalid ISO 8601 timestamp: %s (supported formats: YYYY-MM-DDThh:mm:ssZ or YYYY-MM-DD)", timestamp)
 }
+
+// pinnedIssuesLimit is the maximum number of issues a repository can pin.
+const pinnedIssuesLimit = 3
+
+// resolveIssueID looks up the node ID of an issue. A missing issue is reported
+// as a NOT_FOUND graphQLErrors.
+func resolveIssueID(ctx context.Context, client *github.Client, owner, repo string, issueNumber int) (string, error) {
+	var query struct {
+		Repository struct {
+			Issue *struct {
+				ID string `json:"id"`
+			} `json:"issue"`
+		} `json:"repository"`
+	}
+	err := executeGraphQL(ctx, client, issueIDQuery, map[string]interface{}{
+		"owner":  owner,
+		"repo":   repo,
+		"number": issueNumber,
+	}, &query)
+	if err != nil {
+		return "", err
+	}
+	if query.Repository.Issue == nil {
+		return "", graphQLErrors{{Type: "NOT_FOUND", Message: fmt.Sprintf("issue %d not found", issueNumber)}}
+	}
+	return query.Repository.Issue.ID, nil
+}
+
+// setIssuePinned pins or unpins an issue. When pinning fails because the repository already
+// pins the maximum number of issues, the currently pinned issues are listed in the error so
+// that the client can pick one to unpin.
+func setIssuePinned(ctx context.Context, client *github.Client, owner, repo string, issueNumber int, pin bool) (*mcp.CallToolResult, error) {
+	issueID, err := resolveIssueID(ctx, client, owner, repo, issueNumber)
+	if err != nil {
+		if result := graphQLErrorResult("failed to get issue", err); result != nil {
+			return result, nil
+		}
+		return nil, fmt.Errorf("failed to get issue: %w", err)
+	}
+
+	mutation, field, action := pinIssueMutation, "pinIssue", "pin"
+	if !pin {
+		mutation, field, action = unpinIssueMutation, "unpinIssue", "unpin"
+	}
+
+	var response map[string]struct {
+		Issue struct {
+			IsPinned bool `json:"isPinned"`
+		} `json:"issue"`
+	}
+	err = executeGraphQL(ctx, client, mutation, map[string]interface{}{
+		"issueId": issueID,
+	}, &response)
+	if err != nil {
+		var gqlErrs graphQLErrors
+		if pin && errors.As(err, &gqlErrs) {
+			pinned, pinnedErr := listPinnedIssues(ctx, client, owner, repo)
+			if pinnedErr == nil && len(pinned) >= pinnedIssuesLimit {
+				p, err := json.Marshal(pinned)
+				if err != nil {
+					return nil, fmt.Errorf("failed to marshal pinned issues: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to pin issue: %s; currently pinned issues: %s", gqlErrs.Error(), string(p))), nil
+			}
+		}
+		if result := graphQLErrorResult(fmt.Sprintf("failed to %s issue", action), err); result != nil {
+			return result, nil
+		}
+		return nil, fmt.Errorf("failed to %s issue: %w", action, err)
+	}
+
+	r, err := json.Marshal(map[string]interface{}{
+		"issue_number": issueNumber,
+		"pinned":       response[field].Issue.IsPinned,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal response: %w", err)
+	}
+
+	return mcp.NewToolResultText(string(r)), nil
+}
+
+// pinnedIssue is an issue pinned to the top of the repository issues list.
+type pinnedIssue struct {
+	Number int    `json:"number"`
+	Title  string `json:"title"`
+	URL    string `json:"url"`
+}
+
+// listPinnedIssues returns the issues pinned in a repository, in the order they are shown.
+func listPinnedIssues(ctx context.Context, client *github.Client, owner, repo string) ([]pinnedIssue, error) {
+	var query struct {
+		Repository struct {
+			PinnedIssues struct {
+				Nodes []struct {
+					Issue pinnedIssue `json:"issue"`
+				} `json:"nodes"`
+			} `json:"pinnedIssues"`
+		} `json:"repository"`
+	}
+	err := executeGraphQL(ctx, client, pinnedIssuesQuery, map[string]interface{}{
+		"owner": owner,
+		"repo":  repo,
+	}, &query)
+	if err != nil {
+		return nil, err
+	}
+
+	pinned := make([]pinnedIssue, 0, len(query.Repository.PinnedIssues.Nodes))
+	for _, node := range query.Repository.PinnedIssues.Nodes {
+		pinned = append(pinned, node.Issue)
+	}
+	return pinned, nil
+}
+
+const issueAndTargetRepositoryIDsQuery = `query IssueAndTargetRepositoryIDs($owner: String!, $repo: String!, $number: Int!, $newRepo: String!) {
+  repository(owner: $owner, name: $repo) {
+    issue(number: $number) { id }
+  }
+  target: repository(owner: $owner, name: $newRepo) { id }
+}`
+
+const transferIssueMutation = `mutation TransferIssue($issueId: ID!, $repositoryId: ID!) {
+  transferIssue(input: {issueId: $issueId, repositoryId: $repositoryId}) {
+    issue { number url }
+  }
+}`
+
+const issueIDQuery = `query IssueID($owner: String!, $repo: String!, $number: Int!) {
+  repository(owner: $owner, name: $repo) {
+    issue(number: $number) { id }
+  }
+}`
+
+const pinIssueMutation = `mutation PinIssue($issueId: ID!) {
+  pinIssue(input: {issueId: $issueId}) {
+    issue { isPinned }
+  }
+}`
+
+const unpinIssueMutation = `mutation UnpinIssue($issueId: ID!) {
+  unpinIssue(input: {issueId: $issueId}) {
+    issue { isPinned }
+  }
+}`
+
+const pinnedIssuesQuery = `query PinnedIssues($owner: String!, $repo: String!) {
+  repository(owner: $owner, name: $repo) {
+    pinnedIssues(first: 3) {
+      nodes { issue { number title url } }
+    }
+  }
+}`
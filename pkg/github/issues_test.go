@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -36,12 +38,23 @@ func Test_GetIssue(t *testing.T) {
 		HTMLURL: github.Ptr("https://github.com/owner/repo/issues/42"),
 	}
 
+	// Setup mock issue that was transferred to another repository
+	mockTransferredIssue := &github.Issue{
+		Number:        github.Ptr(7),
+		Title:         github.Ptr("Moved Issue"),
+		Body:          github.Ptr("This issue lives elsewhere now"),
+		State:         github.Ptr("open"),
+		HTMLURL:       github.Ptr("https://github.com/new-owner/new-repo/issues/7"),
+		RepositoryURL: github.Ptr("https://api.github.com/repos/new-owner/new-repo"),
+	}
+
 	tests := []struct {
 		name           string
 		mockedClient   *http.Client
 		requestArgs    map[string]interface{}
 		expectError    bool
 		expectedIssue  *github.Issue
+		expectedMoved  string
 		expectedErrMsg string
 	}{
 		{
@@ -65,7 +78,7 @@ func Test_GetIssue(t *testing.T) {
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
 					mock.GetReposIssuesByOwnerByRepoByIssueNumber,
-					mockResponse(t, http.StatusNotFound, `{"message": "Issue not found"}`),
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
 				),
 			),
 			requestArgs: map[string]interface{}{
@@ -73,8 +86,34 @@ func Test_GetIssue(t *testing.T) {
 				"repo":         "repo",
 				"issue_number": float64(999),
 			},
-			expectError:    true,
-			expectedErrMsg: "failed to get issue",
+			expectError:    false,
+			expectedErrMsg: "failed to get issue: Not Found",
+		},
+		{
+			name: "issue transferred to another repository",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposIssuesByOwnerByRepoByIssueNumber,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						http.Redirect(w, r, "/repositories/123/issues/7", http.StatusMovedPermanently)
+					}),
+				),
+				mock.WithRequestMatch(
+					mock.EndpointPattern{
+						Pattern: "/repositories/{repository_id}/issues/{issue_number}",
+						Method:  http.MethodGet,
+					},
+					mockTransferredIssue,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(7),
+			},
+			expectError:   false,
+			expectedIssue: mockTransferredIssue,
+			expectedMoved: "new-owner/new-repo",
 		},
 	}
 
@@ -100,13 +139,20 @@ func Test_GetIssue(t *testing.T) {
 			require.NoError(t, err)
 			textContent := getTextResult(t, result)
 
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
 			// Unmarshal and verify the result
-			var returnedIssue github.Issue
+			var returnedIssue transferredIssue
 			err = json.Unmarshal([]byte(textContent.Text), &returnedIssue)
 			require.NoError(t, err)
 			assert.Equal(t, *tc.expectedIssue.Number, *returnedIssue.Number)
 			assert.Equal(t, *tc.expectedIssue.Title, *returnedIssue.Title)
 			assert.Equal(t, *tc.expectedIssue.Body, *returnedIssue.Body)
+			assert.Equal(t, tc.expectedMoved, returnedIssue.TransferredTo)
 		})
 	}
 }
@@ -147,7 +193,11 @@ func Test_AddIssueComment(t *testing.T) {
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
 					mock.PostReposIssuesCommentsByOwnerByRepoByIssueNumber,
-					mockResponse(t, http.StatusCreated, mockComment),
+					expectRequestBody(t, map[string]any{
+						"body": "This is a test comment",
+					}).andThen(
+						mockResponse(t, http.StatusCreated, mockComment),
+					),
 				),
 			),
 			requestArgs: map[string]interface{}{
@@ -179,6 +229,35 @@ func Test_AddIssueComment(t *testing.T) {
 			expectError:    false,
 			expectedErrMsg: "missing required parameter: body",
 		},
+		{
+			name:         "whitespace-only comment body",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"body":         "  \n\t",
+			},
+			expectError:    false,
+			expectedErrMsg: "comment body must not be empty",
+		},
+		{
+			name: "comment on locked issue",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposIssuesCommentsByOwnerByRepoByIssueNumber,
+					mockResponse(t, http.StatusForbidden, map[string]string{"message": "Unable to create comment because issue is locked."}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"body":         "This is a test comment",
+			},
+			expectError:    false,
+			expectedErrMsg: "failed to create comment: Unable to create comment because issue is locked.",
+		},
 	}
 
 	for _, tc := range tests {
@@ -212,6 +291,7 @@ func Test_AddIssueComment(t *testing.T) {
 
 			if tc.expectedErrMsg != "" {
 				require.NotNil(t, result)
+				assert.True(t, result.IsError)
 				textContent := getTextResult(t, result)
 				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
 				return
@@ -229,122 +309,88 @@ func Test_AddIssueComment(t *testing.T) {
 			assert.Equal(t, *tc.expectedComment.ID, *returnedComment.ID)
 			assert.Equal(t, *tc.expectedComment.Body, *returnedComment.Body)
 			assert.Equal(t, *tc.expectedComment.User.Login, *returnedComment.User.Login)
+			assert.Equal(t, *tc.expectedComment.HTMLURL, *returnedComment.HTMLURL)
 
 		})
 	}
 }
 
-func Test_SearchIssues(t *testing.T) {
+func Test_UpdateIssueComment(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
-	tool, _ := SearchIssues(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	tool, _ := UpdateIssueComment(stubGetClientFn(mockClient), translations.NullTranslationHelper)
 
-	assert.Equal(t, "search_issues", tool.Name)
+	assert.Equal(t, "update_issue_comment", tool.Name)
 	assert.NotEmpty(t, tool.Description)
-	assert.Contains(t, tool.InputSchema.Properties, "q")
-	assert.Contains(t, tool.InputSchema.Properties, "sort")
-	assert.Contains(t, tool.InputSchema.Properties, "order")
-	assert.Contains(t, tool.InputSchema.Properties, "perPage")
-	assert.Contains(t, tool.InputSchema.Properties, "page")
-	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"q"})
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "comment_id")
+	assert.Contains(t, tool.InputSchema.Properties, "body")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "comment_id", "body"})
 
-	// Setup mock search results
-	mockSearchResult := &github.IssuesSearchResult{
-		Total:             github.Ptr(2),
-		IncompleteResults: github.Ptr(false),
-		Issues: []*github.Issue{
-			{
-				Number:   github.Ptr(42),
-				Title:    github.Ptr("Bug: Something is broken"),
-				Body:     github.Ptr("This is a bug report"),
-				State:    github.Ptr("open"),
-				HTMLURL:  github.Ptr("https://github.com/owner/repo/issues/42"),
-				Comments: github.Ptr(5),
-				User: &github.User{
-					Login: github.Ptr("user1"),
-				},
-			},
-			{
-				Number:   github.Ptr(43),
-				Title:    github.Ptr("Feature: Add new functionality"),
-				Body:     github.Ptr("This is a feature request"),
-				State:    github.Ptr("open"),
-				HTMLURL:  github.Ptr("https://github.com/owner/repo/issues/43"),
-				Comments: github.Ptr(3),
-				User: &github.User{
-					Login: github.Ptr("user2"),
-				},
-			},
-		},
+	mockComment := &github.IssueComment{
+		ID:      github.Ptr(int64(123)),
+		Body:    github.Ptr("Updated comment"),
+		HTMLURL: github.Ptr("https://github.com/owner/repo/issues/42#issuecomment-123"),
 	}
 
 	tests := []struct {
-		name           string
-		mockedClient   *http.Client
-		requestArgs    map[string]interface{}
-		expectError    bool
-		expectedResult *github.IssuesSearchResult
-		expectedErrMsg string
+		name            string
+		mockedClient    *http.Client
+		requestArgs     map[string]interface{}
+		expectError     bool
+		expectedComment *github.IssueComment
+		expectedErrMsg  string
 	}{
 		{
-			name: "successful issues search with all parameters",
+			name: "successful comment update",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
-					mock.GetSearchIssues,
-					expectQueryParams(
-						t,
-						map[string]string{
-							"q":        "repo:owner/repo is:issue is:open",
-							"sort":     "created",
-							"order":    "desc",
-							"page":     "1",
-							"per_page": "30",
-						},
-					).andThen(
-						mockResponse(t, http.StatusOK, mockSearchResult),
+					mock.PatchReposIssuesCommentsByOwnerByRepoByCommentId,
+					expectRequestBody(t, map[string]any{
+						"body": "Updated comment",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockComment),
 					),
 				),
 			),
 			requestArgs: map[string]interface{}{
-				"q":       "repo:owner/repo is:issue is:open",
-				"sort":    "created",
-				"order":   "desc",
-				"page":    float64(1),
-				"perPage": float64(30),
+				"owner":      "owner",
+				"repo":       "repo",
+				"comment_id": float64(123),
+				"body":       "Updated comment",
 			},
-			expectError:    false,
-			expectedResult: mockSearchResult,
+			expectError:     false,
+			expectedComment: mockComment,
 		},
 		{
-			name: "issues search with minimal parameters",
+			name: "comment of another user",
 			mockedClient: mock.NewMockedHTTPClient(
-				mock.WithRequestMatch(
-					mock.GetSearchIssues,
-					mockSearchResult,
+				mock.WithRequestMatchHandler(
+					mock.PatchReposIssuesCommentsByOwnerByRepoByCommentId,
+					mockResponse(t, http.StatusForbidden, map[string]string{"message": "Must have admin rights to Repository."}),
 				),
 			),
 			requestArgs: map[string]interface{}{
-				"q": "repo:owner/repo is:issue is:open",
+				"owner":      "owner",
+				"repo":       "repo",
+				"comment_id": float64(123),
+				"body":       "Updated comment",
 			},
 			expectError:    false,
-			expectedResult: mockSearchResult,
+			expectedErrMsg: "failed to update comment: Must have admin rights to Repository.",
 		},
 		{
-			name: "search issues fails",
-			mockedClient: mock.NewMockedHTTPClient(
-				mock.WithRequestMatchHandler(
-					mock.GetSearchIssues,
-					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
-						w.WriteHeader(http.StatusBadRequest)
-						_, _ = w.Write([]byte(`{"message": "Validation Failed"}`))
-					}),
-				),
-			),
+			name:         "empty comment body",
+			mockedClient: mock.NewMockedHTTPClient(),
 			requestArgs: map[string]interface{}{
-				"q": "invalid:query",
+				"owner":      "owner",
+				"repo":       "repo",
+				"comment_id": float64(123),
+				"body":       " ",
 			},
-			expectError:    true,
-			expectedErrMsg: "failed to search issues",
+			expectError:    false,
+			expectedErrMsg: "comment body must not be empty",
 		},
 	}
 
@@ -352,7 +398,7 @@ func Test_SearchIssues(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			// Setup client with mock
 			client := github.NewClient(tc.mockedClient)
-			_, handler := SearchIssues(stubGetClientFn(client), translations.NullTranslationHelper)
+			_, handler := UpdateIssueComment(stubGetClientFn(client), translations.NullTranslationHelper)
 
 			// Create call request
 			request := createMCPRequest(tc.requestArgs)
@@ -368,137 +414,76 @@ func Test_SearchIssues(t *testing.T) {
 			}
 
 			require.NoError(t, err)
-
-			// Parse the result and get the text content if no error
 			textContent := getTextResult(t, result)
 
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
 			// Unmarshal and verify the result
-			var returnedResult github.IssuesSearchResult
-			err = json.Unmarshal([]byte(textContent.Text), &returnedResult)
+			var returnedComment github.IssueComment
+			err = json.Unmarshal([]byte(textContent.Text), &returnedComment)
 			require.NoError(t, err)
-			assert.Equal(t, *tc.expectedResult.Total, *returnedResult.Total)
-			assert.Equal(t, *tc.expectedResult.IncompleteResults, *returnedResult.IncompleteResults)
-			assert.Len(t, returnedResult.Issues, len(tc.expectedResult.Issues))
-			for i, issue := range returnedResult.Issues {
-				assert.Equal(t, *tc.expectedResult.Issues[i].Number, *issue.Number)
-				assert.Equal(t, *tc.expectedResult.Issues[i].Title, *issue.Title)
-				assert.Equal(t, *tc.expectedResult.Issues[i].State, *issue.State)
-				assert.Equal(t, *tc.expectedResult.Issues[i].HTMLURL, *issue.HTMLURL)
-				assert.Equal(t, *tc.expectedResult.Issues[i].User.Login, *issue.User.Login)
-			}
+			assert.Equal(t, *tc.expectedComment.ID, *returnedComment.ID)
+			assert.Equal(t, *tc.expectedComment.Body, *returnedComment.Body)
+			assert.Equal(t, *tc.expectedComment.HTMLURL, *returnedComment.HTMLURL)
 		})
 	}
 }
 
-func Test_CreateIssue(t *testing.T) {
+func Test_DeleteIssueComment(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
-	tool, _ := CreateIssue(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	tool, _ := DeleteIssueComment(stubGetClientFn(mockClient), translations.NullTranslationHelper)
 
-	assert.Equal(t, "create_issue", tool.Name)
+	assert.Equal(t, "delete_issue_comment", tool.Name)
 	assert.NotEmpty(t, tool.Description)
 	assert.Contains(t, tool.InputSchema.Properties, "owner")
 	assert.Contains(t, tool.InputSchema.Properties, "repo")
-	assert.Contains(t, tool.InputSchema.Properties, "title")
-	assert.Contains(t, tool.InputSchema.Properties, "body")
-	assert.Contains(t, tool.InputSchema.Properties, "assignees")
-	assert.Contains(t, tool.InputSchema.Properties, "labels")
-	assert.Contains(t, tool.InputSchema.Properties, "milestone")
-	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "title"})
-
-	// Setup mock issue for success case
-	mockIssue := &github.Issue{
-		Number:    github.Ptr(123),
-		Title:     github.Ptr("Test Issue"),
-		Body:      github.Ptr("This is a test issue"),
-		State:     github.Ptr("open"),
-		HTMLURL:   github.Ptr("https://github.com/owner/repo/issues/123"),
-		Assignees: []*github.User{{Login: github.Ptr("user1")}, {Login: github.Ptr("user2")}},
-		Labels:    []*github.Label{{Name: github.Ptr("bug")}, {Name: github.Ptr("help wanted")}},
-		Milestone: &github.Milestone{Number: github.Ptr(5)},
-	}
+	assert.Contains(t, tool.InputSchema.Properties, "comment_id")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "comment_id"})
 
 	tests := []struct {
 		name           string
 		mockedClient   *http.Client
 		requestArgs    map[string]interface{}
 		expectError    bool
-		expectedIssue  *github.Issue
 		expectedErrMsg string
 	}{
 		{
-			name: "successful issue creation with all fields",
-			mockedClient: mock.NewMockedHTTPClient(
-				mock.WithRequestMatchHandler(
-					mock.PostReposIssuesByOwnerByRepo,
-					expectRequestBody(t, map[string]any{
-						"title":     "Test Issue",
-						"body":      "This is a test issue",
-						"labels":    []any{"bug", "help wanted"},
-						"assignees": []any{"user1", "user2"},
-						"milestone": float64(5),
-					}).andThen(
-						mockResponse(t, http.StatusCreated, mockIssue),
-					),
-				),
-			),
-			requestArgs: map[string]interface{}{
-				"owner":     "owner",
-				"repo":      "repo",
-				"title":     "Test Issue",
-				"body":      "This is a test issue",
-				"assignees": []any{"user1", "user2"},
-				"labels":    []any{"bug", "help wanted"},
-				"milestone": float64(5),
-			},
-			expectError:   false,
-			expectedIssue: mockIssue,
-		},
-		{
-			name: "successful issue creation with minimal fields",
+			name: "successful comment deletion",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
-					mock.PostReposIssuesByOwnerByRepo,
-					mockResponse(t, http.StatusCreated, &github.Issue{
-						Number:  github.Ptr(124),
-						Title:   github.Ptr("Minimal Issue"),
-						HTMLURL: github.Ptr("https://github.com/owner/repo/issues/124"),
-						State:   github.Ptr("open"),
+					mock.DeleteReposIssuesCommentsByOwnerByRepoByCommentId,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNoContent)
 					}),
 				),
 			),
 			requestArgs: map[string]interface{}{
-				"owner":     "owner",
-				"repo":      "repo",
-				"title":     "Minimal Issue",
-				"assignees": nil, // Expect no failure with nil optional value.
+				"owner":      "owner",
+				"repo":       "repo",
+				"comment_id": float64(123),
 			},
 			expectError: false,
-			expectedIssue: &github.Issue{
-				Number:  github.Ptr(124),
-				Title:   github.Ptr("Minimal Issue"),
-				HTMLURL: github.Ptr("https://github.com/owner/repo/issues/124"),
-				State:   github.Ptr("open"),
-			},
 		},
 		{
-			name: "issue creation fails",
+			name: "comment not found",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
-					mock.PostReposIssuesByOwnerByRepo,
-					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
-						w.WriteHeader(http.StatusUnprocessableEntity)
-						_, _ = w.Write([]byte(`{"message": "Validation failed"}`))
-					}),
+					mock.DeleteReposIssuesCommentsByOwnerByRepoByCommentId,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
 				),
 			),
 			requestArgs: map[string]interface{}{
-				"owner": "owner",
-				"repo":  "repo",
-				"title": "",
+				"owner":      "owner",
+				"repo":       "repo",
+				"comment_id": float64(999),
 			},
 			expectError:    false,
-			expectedErrMsg: "missing required parameter: title",
+			expectedErrMsg: "failed to delete comment: Not Found",
 		},
 	}
 
@@ -506,7 +491,7 @@ func Test_CreateIssue(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			// Setup client with mock
 			client := github.NewClient(tc.mockedClient)
-			_, handler := CreateIssue(stubGetClientFn(client), translations.NullTranslationHelper)
+			_, handler := DeleteIssueComment(stubGetClientFn(client), translations.NullTranslationHelper)
 
 			// Create call request
 			request := createMCPRequest(tc.requestArgs)
@@ -521,176 +506,161 @@ func Test_CreateIssue(t *testing.T) {
 				return
 			}
 
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
 			if tc.expectedErrMsg != "" {
-				require.NotNil(t, result)
-				textContent := getTextResult(t, result)
+				assert.True(t, result.IsError)
 				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
 				return
 			}
 
+			// Unmarshal and verify the confirmation
+			var confirmation map[string]interface{}
+			err = json.Unmarshal([]byte(textContent.Text), &confirmation)
 			require.NoError(t, err)
-			textContent := getTextResult(t, result)
+			assert.Equal(t, float64(123), confirmation["comment_id"])
+			assert.Equal(t, "deleted", confirmation["status"])
+		})
+	}
+}
 
-			// Unmarshal and verify the result
-			var returnedIssue github.Issue
-			err = json.Unmarshal([]byte(textContent.Text), &returnedIssue)
-			require.NoError(t, err)
+func Test_AssignIssue(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := AssignIssue(stubGetClientFn(mockClient), translations.NullTranslationHelper)
 
-			assert.Equal(t, *tc.expectedIssue.Number, *returnedIssue.Number)
-			assert.Equal(t, *tc.expectedIssue.Title, *returnedIssue.Title)
-			assert.Equal(t, *tc.expectedIssue.State, *returnedIssue.State)
-			assert.Equal(t, *tc.expectedIssue.HTMLURL, *returnedIssue.HTMLURL)
+	assert.Equal(t, "assign_issue", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "issue_number")
+	assert.Contains(t, tool.InputSchema.Properties, "assignees")
+	assert.Contains(t, tool.InputSchema.Properties, "validate")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "issue_number", "assignees"})
 
-			if tc.expectedIssue.Body != nil {
-				assert.Equal(t, *tc.expectedIssue.Body, *returnedIssue.Body)
-			}
+	mockIssue := &github.Issue{
+		Number:    github.Ptr(42),
+		Assignees: []*github.User{{Login: github.Ptr("existing")}, {Login: github.Ptr("octocat")}},
+	}
 
-			// Check assignees if expected
-			if len(tc.expectedIssue.Assignees) > 0 {
-				assert.Equal(t, len(tc.expectedIssue.Assignees), len(returnedIssue.Assignees))
-				for i, assignee := range returnedIssue.Assignees {
-					assert.Equal(t, *tc.expectedIssue.Assignees[i].Login, *assignee.Login)
-				}
-			}
-
-			// Check labels if expected
-			if len(tc.expectedIssue.Labels) > 0 {
-				assert.Equal(t, len(tc.expectedIssue.Labels), len(returnedIssue.Labels))
-				for i, label := range returnedIssue.Labels {
-					assert.Equal(t, *tc.expectedIssue.Labels[i].Name, *label.Name)
-				}
-			}
-		})
-	}
-}
-
-func Test_ListIssues(t *testing.T) {
-	// Verify tool definition
-	mockClient := github.NewClient(nil)
-	tool, _ := ListIssues(stubGetClientFn(mockClient), translations.NullTranslationHelper)
-
-	assert.Equal(t, "list_issues", tool.Name)
-	assert.NotEmpty(t, tool.Description)
-	assert.Contains(t, tool.InputSchema.Properties, "owner")
-	assert.Contains(t, tool.InputSchema.Properties, "repo")
-	assert.Contains(t, tool.InputSchema.Properties, "state")
-	assert.Contains(t, tool.InputSchema.Properties, "labels")
-	assert.Contains(t, tool.InputSchema.Properties, "sort")
-	assert.Contains(t, tool.InputSchema.Properties, "direction")
-	assert.Contains(t, tool.InputSchema.Properties, "since")
-	assert.Contains(t, tool.InputSchema.Properties, "page")
-	assert.Contains(t, tool.InputSchema.Properties, "perPage")
-	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
-
-	// Setup mock issues for success case
-	mockIssues := []*github.Issue{
-		{
-			Number:    github.Ptr(123),
-			Title:     github.Ptr("First Issue"),
-			Body:      github.Ptr("This is the first test issue"),
-			State:     github.Ptr("open"),
-			HTMLURL:   github.Ptr("https://github.com/owner/repo/issues/123"),
-			CreatedAt: &github.Timestamp{Time: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)},
-		},
-		{
-			Number:    github.Ptr(456),
-			Title:     github.Ptr("Second Issue"),
-			Body:      github.Ptr("This is the second test issue"),
-			State:     github.Ptr("open"),
-			HTMLURL:   github.Ptr("https://github.com/owner/repo/issues/456"),
-			Labels:    []*github.Label{{Name: github.Ptr("bug")}},
-			CreatedAt: &github.Timestamp{Time: time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)},
-		},
-	}
+	// Only octocat can be assigned in the repository
+	checkAssignee := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/octocat") {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	})
 
 	tests := []struct {
 		name           string
 		mockedClient   *http.Client
 		requestArgs    map[string]interface{}
 		expectError    bool
-		expectedIssues []*github.Issue
+		expectedResult *issueAssignees
 		expectedErrMsg string
 	}{
 		{
-			name: "list issues with minimal parameters",
+			name: "assign without validation",
 			mockedClient: mock.NewMockedHTTPClient(
-				mock.WithRequestMatch(
-					mock.GetReposIssuesByOwnerByRepo,
-					mockIssues,
+				mock.WithRequestMatchHandler(
+					mock.PostReposIssuesAssigneesByOwnerByRepoByIssueNumber,
+					expectRequestBody(t, map[string]any{
+						"assignees": []any{"octocat"},
+					}).andThen(
+						mockResponse(t, http.StatusCreated, mockIssue),
+					),
 				),
 			),
 			requestArgs: map[string]interface{}{
-				"owner": "owner",
-				"repo":  "repo",
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"assignees":    []any{"octocat"},
+			},
+			expectError: false,
+			expectedResult: &issueAssignees{
+				IssueNumber: 42,
+				Assignees:   []string{"existing", "octocat"},
 			},
-			expectError:    false,
-			expectedIssues: mockIssues,
 		},
 		{
-			name: "list issues with all parameters",
+			name: "assign with validation skips invalid users",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
-					mock.GetReposIssuesByOwnerByRepo,
-					expectQueryParams(t, map[string]string{
-						"state":     "open",
-						"labels":    "bug,enhancement",
-						"sort":      "created",
-						"direction": "desc",
-						"since":     "2023-01-01T00:00:00Z",
-						"page":      "1",
-						"per_page":  "30",
+					mock.GetReposAssigneesByOwnerByRepoByAssignee,
+					checkAssignee,
+				),
+				mock.WithRequestMatchHandler(
+					mock.PostReposIssuesAssigneesByOwnerByRepoByIssueNumber,
+					expectRequestBody(t, map[string]any{
+						"assignees": []any{"octocat"},
 					}).andThen(
-						mockResponse(t, http.StatusOK, mockIssues),
+						mockResponse(t, http.StatusCreated, mockIssue),
 					),
 				),
 			),
 			requestArgs: map[string]interface{}{
-				"owner":     "owner",
-				"repo":      "repo",
-				"state":     "open",
-				"labels":    []any{"bug", "enhancement"},
-				"sort":      "created",
-				"direction": "desc",
-				"since":     "2023-01-01T00:00:00Z",
-				"page":      float64(1),
-				"perPage":   float64(30),
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"assignees":    []any{"octocat", "ghost"},
+				"validate":     true,
+			},
+			expectError: false,
+			expectedResult: &issueAssignees{
+				IssueNumber:      42,
+				Assignees:        []string{"existing", "octocat"},
+				InvalidAssignees: []string{"ghost"},
 			},
-			expectError:    false,
-			expectedIssues: mockIssues,
 		},
 		{
-			name: "invalid since parameter",
+			name: "assign with validation and no valid users",
 			mockedClient: mock.NewMockedHTTPClient(
-				mock.WithRequestMatch(
-					mock.GetReposIssuesByOwnerByRepo,
-					mockIssues,
+				mock.WithRequestMatchHandler(
+					mock.GetReposAssigneesByOwnerByRepoByAssignee,
+					checkAssignee,
 				),
 			),
 			requestArgs: map[string]interface{}{
-				"owner": "owner",
-				"repo":  "repo",
-				"since": "invalid-date",
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"assignees":    []any{"ghost", "nobody"},
+				"validate":     true,
 			},
-			expectError:    true,
-			expectedErrMsg: "invalid ISO 8601 timestamp",
+			expectError:    false,
+			expectedErrMsg: "none of the users can be assigned: ghost, nobody",
 		},
 		{
-			name: "list issues fails with error",
+			name:         "missing assignees",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"assignees":    []any{},
+			},
+			expectError:    false,
+			expectedErrMsg: "missing required parameter: assignees",
+		},
+		{
+			name: "issue not found",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
-					mock.GetReposIssuesByOwnerByRepo,
-					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
-						w.WriteHeader(http.StatusNotFound)
-						_, _ = w.Write([]byte(`{"message": "Repository not found"}`))
-					}),
+					mock.PostReposIssuesAssigneesByOwnerByRepoByIssueNumber,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
 				),
 			),
 			requestArgs: map[string]interface{}{
-				"owner": "nonexistent",
-				"repo":  "repo",
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(999),
+				"assignees":    []any{"octocat"},
 			},
-			expectError:    true,
-			expectedErrMsg: "failed to list issues",
+			expectError:    false,
+			expectedErrMsg: "failed to assign issue: Not Found",
 		},
 	}
 
@@ -698,7 +668,7 @@ func Test_ListIssues(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			// Setup client with mock
 			client := github.NewClient(tc.mockedClient)
-			_, handler := ListIssues(stubGetClientFn(client), translations.NullTranslationHelper)
+			_, handler := AssignIssue(stubGetClientFn(client), translations.NullTranslationHelper)
 
 			// Create call request
 			request := createMCPRequest(tc.requestArgs)
@@ -708,173 +678,113 @@ func Test_ListIssues(t *testing.T) {
 
 			// Verify results
 			if tc.expectError {
-				if err != nil {
-					assert.Contains(t, err.Error(), tc.expectedErrMsg)
-				} else {
-					// For errors returned as part of the result, not as an error
-					assert.NotNil(t, result)
-					textContent := getTextResult(t, result)
-					assert.Contains(t, textContent.Text, tc.expectedErrMsg)
-				}
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
 				return
 			}
 
 			require.NoError(t, err)
-
-			// Parse the result and get the text content if no error
 			textContent := getTextResult(t, result)
 
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
 			// Unmarshal and verify the result
-			var returnedIssues []*github.Issue
-			err = json.Unmarshal([]byte(textContent.Text), &returnedIssues)
+			var returned issueAssignees
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
 			require.NoError(t, err)
-
-			assert.Len(t, returnedIssues, len(tc.expectedIssues))
-			for i, issue := range returnedIssues {
-				assert.Equal(t, *tc.expectedIssues[i].Number, *issue.Number)
-				assert.Equal(t, *tc.expectedIssues[i].Title, *issue.Title)
-				assert.Equal(t, *tc.expectedIssues[i].State, *issue.State)
-				assert.Equal(t, *tc.expectedIssues[i].HTMLURL, *issue.HTMLURL)
-			}
+			assert.Equal(t, *tc.expectedResult, returned)
 		})
 	}
 }
 
-func Test_UpdateIssue(t *testing.T) {
-	// Verify tool definition
+func Test_UnassignIssue(t *testing.T) {
+	// Verify tool definition once
 	mockClient := github.NewClient(nil)
-	tool, _ := UpdateIssue(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	tool, _ := UnassignIssue(stubGetClientFn(mockClient), translations.NullTranslationHelper)
 
-	assert.Equal(t, "update_issue", tool.Name)
+	assert.Equal(t, "unassign_issue", tool.Name)
 	assert.NotEmpty(t, tool.Description)
 	assert.Contains(t, tool.InputSchema.Properties, "owner")
 	assert.Contains(t, tool.InputSchema.Properties, "repo")
 	assert.Contains(t, tool.InputSchema.Properties, "issue_number")
-	assert.Contains(t, tool.InputSchema.Properties, "title")
-	assert.Contains(t, tool.InputSchema.Properties, "body")
-	assert.Contains(t, tool.InputSchema.Properties, "state")
-	assert.Contains(t, tool.InputSchema.Properties, "labels")
 	assert.Contains(t, tool.InputSchema.Properties, "assignees")
-	assert.Contains(t, tool.InputSchema.Properties, "milestone")
-	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "issue_number"})
-
-	// Setup mock issue for success case
-	mockIssue := &github.Issue{
-		Number:    github.Ptr(123),
-		Title:     github.Ptr("Updated Issue Title"),
-		Body:      github.Ptr("Updated issue description"),
-		State:     github.Ptr("closed"),
-		HTMLURL:   github.Ptr("https://github.com/owner/repo/issues/123"),
-		Assignees: []*github.User{{Login: github.Ptr("assignee1")}, {Login: github.Ptr("assignee2")}},
-		Labels:    []*github.Label{{Name: github.Ptr("bug")}, {Name: github.Ptr("priority")}},
-		Milestone: &github.Milestone{Number: github.Ptr(5)},
-	}
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "issue_number", "assignees"})
 
 	tests := []struct {
 		name           string
 		mockedClient   *http.Client
 		requestArgs    map[string]interface{}
 		expectError    bool
-		expectedIssue  *github.Issue
+		expectedResult *issueAssignees
 		expectedErrMsg string
 	}{
 		{
-			name: "update issue with all fields",
+			name: "unassign users",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
-					mock.PatchReposIssuesByOwnerByRepoByIssueNumber,
+					mock.DeleteReposIssuesAssigneesByOwnerByRepoByIssueNumber,
 					expectRequestBody(t, map[string]any{
-						"title":     "Updated Issue Title",
-						"body":      "Updated issue description",
-						"state":     "closed",
-						"labels":    []any{"bug", "priority"},
-						"assignees": []any{"assignee1", "assignee2"},
-						"milestone": float64(5),
+						"assignees": []any{"octocat"},
 					}).andThen(
-						mockResponse(t, http.StatusOK, mockIssue),
+						mockResponse(t, http.StatusOK, &github.Issue{
+							Number:    github.Ptr(42),
+							Assignees: []*github.User{{Login: github.Ptr("existing")}},
+						}),
 					),
 				),
 			),
 			requestArgs: map[string]interface{}{
 				"owner":        "owner",
 				"repo":         "repo",
-				"issue_number": float64(123),
-				"title":        "Updated Issue Title",
-				"body":         "Updated issue description",
-				"state":        "closed",
-				"labels":       []any{"bug", "priority"},
-				"assignees":    []any{"assignee1", "assignee2"},
-				"milestone":    float64(5),
+				"issue_number": float64(42),
+				"assignees":    []any{"octocat"},
+			},
+			expectError: false,
+			expectedResult: &issueAssignees{
+				IssueNumber: 42,
+				Assignees:   []string{"existing"},
 			},
-			expectError:   false,
-			expectedIssue: mockIssue,
 		},
 		{
-			name: "update issue with minimal fields",
+			name: "unassign last user",
 			mockedClient: mock.NewMockedHTTPClient(
-				mock.WithRequestMatchHandler(
-					mock.PatchReposIssuesByOwnerByRepoByIssueNumber,
-					mockResponse(t, http.StatusOK, &github.Issue{
-						Number:  github.Ptr(123),
-						Title:   github.Ptr("Only Title Updated"),
-						HTMLURL: github.Ptr("https://github.com/owner/repo/issues/123"),
-						State:   github.Ptr("open"),
-					}),
+				mock.WithRequestMatch(
+					mock.DeleteReposIssuesAssigneesByOwnerByRepoByIssueNumber,
+					&github.Issue{Number: github.Ptr(42)},
 				),
 			),
 			requestArgs: map[string]interface{}{
 				"owner":        "owner",
 				"repo":         "repo",
-				"issue_number": float64(123),
-				"title":        "Only Title Updated",
+				"issue_number": float64(42),
+				"assignees":    []any{"existing"},
 			},
 			expectError: false,
-			expectedIssue: &github.Issue{
-				Number:  github.Ptr(123),
-				Title:   github.Ptr("Only Title Updated"),
-				HTMLURL: github.Ptr("https://github.com/owner/repo/issues/123"),
-				State:   github.Ptr("open"),
+			expectedResult: &issueAssignees{
+				IssueNumber: 42,
+				Assignees:   []string{},
 			},
 		},
 		{
-			name: "update issue fails with not found",
+			name: "issue not found",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
-					mock.PatchReposIssuesByOwnerByRepoByIssueNumber,
-					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
-						w.WriteHeader(http.StatusNotFound)
-						_, _ = w.Write([]byte(`{"message": "Issue not found"}`))
-					}),
+					mock.DeleteReposIssuesAssigneesByOwnerByRepoByIssueNumber,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
 				),
 			),
 			requestArgs: map[string]interface{}{
 				"owner":        "owner",
 				"repo":         "repo",
 				"issue_number": float64(999),
-				"title":        "This issue doesn't exist",
+				"assignees":    []any{"octocat"},
 			},
-			expectError:    true,
-			expectedErrMsg: "failed to update issue",
-		},
-		{
-			name: "update issue fails with validation error",
-			mockedClient: mock.NewMockedHTTPClient(
-				mock.WithRequestMatchHandler(
-					mock.PatchReposIssuesByOwnerByRepoByIssueNumber,
-					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
-						w.WriteHeader(http.StatusUnprocessableEntity)
-						_, _ = w.Write([]byte(`{"message": "Invalid state value"}`))
-					}),
-				),
-			),
-			requestArgs: map[string]interface{}{
-				"owner":        "owner",
-				"repo":         "repo",
-				"issue_number": float64(123),
-				"state":        "invalid_state",
-			},
-			expectError:    true,
-			expectedErrMsg: "failed to update issue",
+			expectError:    false,
+			expectedErrMsg: "failed to unassign issue: Not Found",
 		},
 	}
 
@@ -882,7 +792,7 @@ func Test_UpdateIssue(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			// Setup client with mock
 			client := github.NewClient(tc.mockedClient)
-			_, handler := UpdateIssue(stubGetClientFn(client), translations.NullTranslationHelper)
+			_, handler := UnassignIssue(stubGetClientFn(client), translations.NullTranslationHelper)
 
 			// Create call request
 			request := createMCPRequest(tc.requestArgs)
@@ -892,207 +802,210 @@ func Test_UpdateIssue(t *testing.T) {
 
 			// Verify results
 			if tc.expectError {
-				if err != nil {
-					assert.Contains(t, err.Error(), tc.expectedErrMsg)
-				} else {
-					// For errors returned as part of the result, not as an error
-					require.NotNil(t, result)
-					textContent := getTextResult(t, result)
-					assert.Contains(t, textContent.Text, tc.expectedErrMsg)
-				}
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
 				return
 			}
 
 			require.NoError(t, err)
-
-			// Parse the result and get the text content if no error
 			textContent := getTextResult(t, result)
 
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
 			// Unmarshal and verify the result
-			var returnedIssue github.Issue
-			err = json.Unmarshal([]byte(textContent.Text), &returnedIssue)
+			var returned issueAssignees
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
 			require.NoError(t, err)
+			assert.Equal(t, *tc.expectedResult, returned)
+		})
+	}
+}
 
-			assert.Equal(t, *tc.expectedIssue.Number, *returnedIssue.Number)
-			assert.Equal(t, *tc.expectedIssue.Title, *returnedIssue.Title)
-			assert.Equal(t, *tc.expectedIssue.State, *returnedIssue.State)
-			assert.Equal(t, *tc.expectedIssue.HTMLURL, *returnedIssue.HTMLURL)
-
-			if tc.expectedIssue.Body != nil {
-				assert.Equal(t, *tc.expectedIssue.Body, *returnedIssue.Body)
-			}
-
-			// Check assignees if expected
-			if len(tc.expectedIssue.Assignees) > 0 {
-				assert.Len(t, returnedIssue.Assignees, len(tc.expectedIssue.Assignees))
-				for i, assignee := range returnedIssue.Assignees {
-					assert.Equal(t, *tc.expectedIssue.Assignees[i].Login, *assignee.Login)
-				}
-			}
+func Test_AddLabelsToIssue(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := AddLabelsToIssue(stubGetClientFn(mockClient), translations.NullTranslationHelper)
 
-			// Check labels if expected
-			if len(tc.expectedIssue.Labels) > 0 {
-				assert.Len(t, returnedIssue.Labels, len(tc.expectedIssue.Labels))
-				for i, label := range returnedIssue.Labels {
-					assert.Equal(t, *tc.expectedIssue.Labels[i].Name, *label.Name)
-				}
-			}
+	assert.Equal(t, "add_labels_to_issue", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "issue_number")
+	assert.Contains(t, tool.InputSchema.Properties, "labels")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "issue_number", "labels"})
 
-			// Check milestone if expected
-			if tc.expectedIssue.Milestone != nil {
-				assert.NotNil(t, returnedIssue.Milestone)
-				assert.Equal(t, *tc.expectedIssue.Milestone.Number, *returnedIssue.Milestone.Number)
-			}
-		})
+	mockLabels := []*github.Label{
+		{Name: github.Ptr("bug")},
+		{Name: github.Ptr("good first issue")},
 	}
-}
 
-func Test_ParseISOTimestamp(t *testing.T) {
 	tests := []struct {
-		name         string
-		input        string
-		expectedErr  bool
-		expectedTime time.Time
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedLabels []*github.Label
+		expectedErrMsg string
 	}{
 		{
-			name:         "valid RFC3339 format",
-			input:        "2023-01-15T14:30:00Z",
-			expectedErr:  false,
-			expectedTime: time.Date(2023, 1, 15, 14, 30, 0, 0, time.UTC),
-		},
-		{
-			name:         "valid date only format",
-			input:        "2023-01-15",
-			expectedErr:  false,
-			expectedTime: time.Date(2023, 1, 15, 0, 0, 0, 0, time.UTC),
-		},
-		{
-			name:        "empty timestamp",
-			input:       "",
-			expectedErr: true,
+			name: "add labels",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposIssuesLabelsByOwnerByRepoByIssueNumber,
+					expectLabelNames(t, []string{"good first issue"}, mockResponse(t, http.StatusOK, mockLabels)),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"labels":       []any{"good first issue"},
+			},
+			expectError:    false,
+			expectedLabels: mockLabels,
 		},
 		{
-			name:        "invalid format",
-			input:       "15/01/2023",
-			expectedErr: true,
+			name:         "missing labels",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"labels":       []any{},
+			},
+			expectError:    false,
+			expectedErrMsg: "missing required parameter: labels",
 		},
 		{
-			name:        "invalid date",
-			input:       "2023-13-45",
-			expectedErr: true,
+			name: "issue not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposIssuesLabelsByOwnerByRepoByIssueNumber,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(999),
+				"labels":       []any{"bug"},
+			},
+			expectError:    false,
+			expectedErrMsg: "failed to add labels: Not Found",
 		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			parsedTime, err := parseISOTimestamp(tc.input)
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := AddLabelsToIssue(stubGetClientFn(client), translations.NullTranslationHelper)
 
-			if tc.expectedErr {
-				assert.Error(t, err)
-			} else {
-				assert.NoError(t, err)
-				assert.Equal(t, tc.expectedTime, parsedTime)
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedLabels []*github.Label
+			err = json.Unmarshal([]byte(textContent.Text), &returnedLabels)
+			require.NoError(t, err)
+			require.Len(t, returnedLabels, len(tc.expectedLabels))
+			for i, label := range returnedLabels {
+				assert.Equal(t, *tc.expectedLabels[i].Name, *label.Name)
 			}
 		})
 	}
 }
 
-func Test_GetIssueComments(t *testing.T) {
+func Test_SetIssueLabels(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
-	tool, _ := GetIssueComments(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	tool, _ := SetIssueLabels(stubGetClientFn(mockClient), translations.NullTranslationHelper)
 
-	assert.Equal(t, "get_issue_comments", tool.Name)
+	assert.Equal(t, "set_issue_labels", tool.Name)
 	assert.NotEmpty(t, tool.Description)
 	assert.Contains(t, tool.InputSchema.Properties, "owner")
 	assert.Contains(t, tool.InputSchema.Properties, "repo")
 	assert.Contains(t, tool.InputSchema.Properties, "issue_number")
-	assert.Contains(t, tool.InputSchema.Properties, "page")
-	assert.Contains(t, tool.InputSchema.Properties, "per_page")
-	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "issue_number"})
-
-	// Setup mock comments for success case
-	mockComments := []*github.IssueComment{
-		{
-			ID:   github.Ptr(int64(123)),
-			Body: github.Ptr("This is the first comment"),
-			User: &github.User{
-				Login: github.Ptr("user1"),
-			},
-			CreatedAt: &github.Timestamp{Time: time.Now().Add(-time.Hour * 24)},
-		},
-		{
-			ID:   github.Ptr(int64(456)),
-			Body: github.Ptr("This is the second comment"),
-			User: &github.User{
-				Login: github.Ptr("user2"),
-			},
-			CreatedAt: &github.Timestamp{Time: time.Now().Add(-time.Hour)},
-		},
-	}
+	assert.Contains(t, tool.InputSchema.Properties, "labels")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "issue_number", "labels"})
 
 	tests := []struct {
-		name             string
-		mockedClient     *http.Client
-		requestArgs      map[string]interface{}
-		expectError      bool
-		expectedComments []*github.IssueComment
-		expectedErrMsg   string
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedLabels []*github.Label
+		expectedErrMsg string
 	}{
 		{
-			name: "successful comments retrieval",
+			name: "replace labels",
 			mockedClient: mock.NewMockedHTTPClient(
-				mock.WithRequestMatch(
-					mock.GetReposIssuesCommentsByOwnerByRepoByIssueNumber,
-					mockComments,
+				mock.WithRequestMatchHandler(
+					mock.PutReposIssuesLabelsByOwnerByRepoByIssueNumber,
+					expectLabelNames(t, []string{"enhancement"}, mockResponse(t, http.StatusOK, []*github.Label{
+						{Name: github.Ptr("enhancement")},
+					})),
 				),
 			),
 			requestArgs: map[string]interface{}{
 				"owner":        "owner",
 				"repo":         "repo",
 				"issue_number": float64(42),
+				"labels":       []any{"enhancement"},
 			},
-			expectError:      false,
-			expectedComments: mockComments,
+			expectError:    false,
+			expectedLabels: []*github.Label{{Name: github.Ptr("enhancement")}},
 		},
 		{
-			name: "successful comments retrieval with pagination",
+			name: "remove all labels",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
-					mock.GetReposIssuesCommentsByOwnerByRepoByIssueNumber,
-					expectQueryParams(t, map[string]string{
-						"page":     "2",
-						"per_page": "10",
-					}).andThen(
-						mockResponse(t, http.StatusOK, mockComments),
-					),
+					mock.PutReposIssuesLabelsByOwnerByRepoByIssueNumber,
+					expectLabelNames(t, []string{}, mockResponse(t, http.StatusOK, []*github.Label{})),
 				),
 			),
 			requestArgs: map[string]interface{}{
 				"owner":        "owner",
 				"repo":         "repo",
 				"issue_number": float64(42),
-				"page":         float64(2),
-				"per_page":     float64(10),
+				"labels":       []any{},
 			},
-			expectError:      false,
-			expectedComments: mockComments,
+			expectError:    false,
+			expectedLabels: []*github.Label{},
 		},
 		{
-			name: "issue not found",
-			mockedClient: mock.NewMockedHTTPClient(
-				mock.WithRequestMatchHandler(
-					mock.GetReposIssuesCommentsByOwnerByRepoByIssueNumber,
-					mockResponse(t, http.StatusNotFound, `{"message": "Issue not found"}`),
-				),
-			),
+			name:         "missing labels",
+			mockedClient: mock.NewMockedHTTPClient(),
 			requestArgs: map[string]interface{}{
 				"owner":        "owner",
 				"repo":         "repo",
-				"issue_number": float64(999),
+				"issue_number": float64(42),
 			},
-			expectError:    true,
-			expectedErrMsg: "failed to get issue comments",
+			expectError:    false,
+			expectedErrMsg: "missing required parameter: labels",
 		},
 	}
 
@@ -1100,7 +1013,7 @@ func Test_GetIssueComments(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			// Setup client with mock
 			client := github.NewClient(tc.mockedClient)
-			_, handler := GetIssueComments(stubGetClientFn(client), translations.NullTranslationHelper)
+			_, handler := SetIssueLabels(stubGetClientFn(client), translations.NullTranslationHelper)
 
 			// Create call request
 			request := createMCPRequest(tc.requestArgs)
@@ -1118,15 +1031,3557 @@ func Test_GetIssueComments(t *testing.T) {
 			require.NoError(t, err)
 			textContent := getTextResult(t, result)
 
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
 			// Unmarshal and verify the result
-			var returnedComments []*github.IssueComment
-			err = json.Unmarshal([]byte(textContent.Text), &returnedComments)
+			var returnedLabels []*github.Label
+			err = json.Unmarshal([]byte(textContent.Text), &returnedLabels)
 			require.NoError(t, err)
-			assert.Equal(t, len(tc.expectedComments), len(returnedComments))
-			if len(returnedComments) > 0 {
-				assert.Equal(t, *tc.expectedComments[0].Body, *returnedComments[0].Body)
-				assert.Equal(t, *tc.expectedComments[0].User.Login, *returnedComments[0].User.Login)
+			require.Len(t, returnedLabels, len(tc.expectedLabels))
+			for i, label := range returnedLabels {
+				assert.Equal(t, *tc.expectedLabels[i].Name, *label.Name)
 			}
 		})
 	}
 }
+
+func Test_RemoveLabelFromIssue(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := RemoveLabelFromIssue(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "remove_label_from_issue", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "issue_number")
+	assert.Contains(t, tool.InputSchema.Properties, "label")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "issue_number", "label"})
+
+	remainingLabels := []*github.Label{{Name: github.Ptr("bug")}}
+
+	// Label names may contain slashes, so match the rest of the path as the name
+	deleteIssueLabel := mock.EndpointPattern{
+		Pattern: "/repos/{owner}/{repo}/issues/{issue_number}/labels/{name:.+}",
+		Method:  http.MethodDelete,
+	}
+
+	expectEscapedPath := func(path string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, path, r.URL.EscapedPath())
+			mockResponse(t, http.StatusOK, remainingLabels).ServeHTTP(w, r)
+		}
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedLabels []*github.Label
+		expectedErrMsg string
+	}{
+		{
+			name: "remove label with a space",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.DeleteReposIssuesLabelsByOwnerByRepoByIssueNumberByName,
+					expectEscapedPath("/repos/owner/repo/issues/42/labels/good%20first%20issue"),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"label":        "good first issue",
+			},
+			expectError:    false,
+			expectedLabels: remainingLabels,
+		},
+		{
+			name: "remove label with a slash",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					deleteIssueLabel,
+					expectEscapedPath("/repos/owner/repo/issues/42/labels/area%2Fapi"),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"label":        "area/api",
+			},
+			expectError:    false,
+			expectedLabels: remainingLabels,
+		},
+		{
+			name: "label not on issue",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.DeleteReposIssuesLabelsByOwnerByRepoByIssueNumberByName,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Label does not exist"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"label":        "wontfix",
+			},
+			expectError:    false,
+			expectedErrMsg: "failed to remove label: Label does not exist",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := RemoveLabelFromIssue(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedLabels []*github.Label
+			err = json.Unmarshal([]byte(textContent.Text), &returnedLabels)
+			require.NoError(t, err)
+			require.Len(t, returnedLabels, len(tc.expectedLabels))
+			for i, label := range returnedLabels {
+				assert.Equal(t, *tc.expectedLabels[i].Name, *label.Name)
+			}
+		})
+	}
+}
+
+// expectLabelNames returns a handler asserting that the request body is the given
+// array of label names, as sent by the issue label endpoints.
+func expectLabelNames(t *testing.T, names []string, next http.Handler) http.HandlerFunc {
+	t.Helper()
+	return func(w http.ResponseWriter, r *http.Request) {
+		var got []string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		require.Equal(t, names, got)
+		next.ServeHTTP(w, r)
+	}
+}
+
+func Test_SearchIssues(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := SearchIssues(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "search_issues", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "q")
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "state")
+	assert.Contains(t, tool.InputSchema.Properties, "labels")
+	assert.Contains(t, tool.InputSchema.Properties, "assignee")
+	assert.Contains(t, tool.InputSchema.Properties, "author")
+	assert.Contains(t, tool.InputSchema.Properties, "created_after")
+	assert.Contains(t, tool.InputSchema.Properties, "created_before")
+	assert.Contains(t, tool.InputSchema.Properties, "updated_after")
+	assert.Contains(t, tool.InputSchema.Properties, "updated_before")
+	assert.Contains(t, tool.InputSchema.Properties, "is_pr")
+	assert.Contains(t, tool.InputSchema.Properties, "sort")
+	assert.Contains(t, tool.InputSchema.Properties, "order")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"q"})
+
+	updatedAt := &github.Timestamp{Time: time.Date(2024, 3, 5, 10, 0, 0, 0, time.UTC)}
+
+	// Setup mock search results
+	mockSearchResult := &github.IssuesSearchResult{
+		Total:             github.Ptr(2),
+		IncompleteResults: github.Ptr(false),
+		Issues: []*github.Issue{
+			{
+				Number:    github.Ptr(42),
+				Title:     github.Ptr("Bug: Something is broken"),
+				Body:      github.Ptr("This is a bug report"),
+				State:     github.Ptr("open"),
+				HTMLURL:   github.Ptr("https://github.com/owner/repo/issues/42"),
+				Comments:  github.Ptr(5),
+				UpdatedAt: updatedAt,
+				Labels:    []*github.Label{{Name: github.Ptr("bug")}, {Name: github.Ptr("good first issue")}},
+				User: &github.User{
+					Login: github.Ptr("user1"),
+				},
+			},
+			{
+				Number:    github.Ptr(43),
+				Title:     github.Ptr("Feature: Add new functionality"),
+				Body:      github.Ptr("This is a feature request"),
+				State:     github.Ptr("open"),
+				HTMLURL:   github.Ptr("https://github.com/owner/repo/issues/43"),
+				Comments:  github.Ptr(3),
+				UpdatedAt: updatedAt,
+				User: &github.User{
+					Login: github.Ptr("user2"),
+				},
+			},
+		},
+	}
+
+	expectedSearchResult := &issueSearchResult{
+		TotalCount:        2,
+		IncompleteResults: false,
+		Items: []issueSearchItem{
+			{
+				Number:    42,
+				Title:     "Bug: Something is broken",
+				State:     "open",
+				Labels:    []string{"bug", "good first issue"},
+				URL:       "https://github.com/owner/repo/issues/42",
+				UpdatedAt: updatedAt,
+			},
+			{
+				Number:    43,
+				Title:     "Feature: Add new functionality",
+				State:     "open",
+				Labels:    []string{},
+				URL:       "https://github.com/owner/repo/issues/43",
+				UpdatedAt: updatedAt,
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedResult *issueSearchResult
+		expectedErrMsg string
+	}{
+		{
+			name: "successful issues search with all parameters",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetSearchIssues,
+					expectQueryParams(
+						t,
+						map[string]string{
+							"q":        "repo:owner/repo is:issue is:open",
+							"sort":     "created",
+							"order":    "desc",
+							"page":     "1",
+							"per_page": "30",
+						},
+					).andThen(
+						mockResponse(t, http.StatusOK, mockSearchResult),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"q":       "repo:owner/repo is:issue is:open",
+				"sort":    "created",
+				"order":   "desc",
+				"page":    float64(1),
+				"perPage": float64(30),
+			},
+			expectError:    false,
+			expectedResult: expectedSearchResult,
+		},
+		{
+			name: "issues search with minimal parameters",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetSearchIssues,
+					mockSearchResult,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"q": "repo:owner/repo is:issue is:open",
+			},
+			expectError:    false,
+			expectedResult: expectedSearchResult,
+		},
+		{
+			name: "issues search with structured filters",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetSearchIssues,
+					expectQueryParams(
+						t,
+						map[string]string{
+							"q":        `crash repo:owner/repo is:issue state:open assignee:octocat author:hubot label:bug label:"good first issue" created:2024-01-01..2024-02-01 updated:>=2024-03-01`,
+							"page":     "1",
+							"per_page": "30",
+						},
+					).andThen(
+						mockResponse(t, http.StatusOK, mockSearchResult),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"q":              "crash",
+				"owner":          "owner",
+				"repo":           "repo",
+				"state":          "open",
+				"labels":         []any{"bug", "good first issue"},
+				"assignee":       "octocat",
+				"author":         "hubot",
+				"created_after":  "2024-01-01",
+				"created_before": "2024-02-01",
+				"updated_after":  "2024-03-01",
+				"is_pr":          false,
+			},
+			expectError:    false,
+			expectedResult: expectedSearchResult,
+		},
+		{
+			name: "pull requests of an owner",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetSearchIssues,
+					expectQueryParams(
+						t,
+						map[string]string{
+							"q":        "flaky test user:owner is:pr updated:<=2024-03-01",
+							"page":     "1",
+							"per_page": "30",
+						},
+					).andThen(
+						mockResponse(t, http.StatusOK, mockSearchResult),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"q":              "flaky test",
+				"owner":          "owner",
+				"updated_before": "2024-03-01",
+				"is_pr":          true,
+			},
+			expectError:    false,
+			expectedResult: expectedSearchResult,
+		},
+		{
+			name:         "repo without owner",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"q":    "crash",
+				"repo": "repo",
+			},
+			expectError:    false,
+			expectedErrMsg: "repo can only be used together with owner",
+		},
+		{
+			name:         "invalid created_after",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"q":             "crash",
+				"created_after": "last week",
+			},
+			expectError:    false,
+			expectedErrMsg: "invalid created_after: invalid ISO 8601 timestamp",
+		},
+		{
+			name: "search issues fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetSearchIssues,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusBadRequest)
+						_, _ = w.Write([]byte(`{"message": "Validation Failed"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"q": "invalid:query",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to search issues",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := SearchIssues(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+
+			// Parse the result and get the text content if no error
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedResult issueSearchResult
+			err = json.Unmarshal([]byte(textContent.Text), &returnedResult)
+			require.NoError(t, err)
+			assert.Equal(t, *tc.expectedResult, returnedResult)
+		})
+	}
+}
+
+func Test_CreateIssue(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CreateIssue(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "create_issue", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "title")
+	assert.Contains(t, tool.InputSchema.Properties, "body")
+	assert.Contains(t, tool.InputSchema.Properties, "assignees")
+	assert.Contains(t, tool.InputSchema.Properties, "labels")
+	assert.Contains(t, tool.InputSchema.Properties, "milestone")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "title"})
+
+	// Setup mock issue for success case
+	mockIssue := &github.Issue{
+		Number:    github.Ptr(123),
+		Title:     github.Ptr("Test Issue"),
+		Body:      github.Ptr("This is a test issue"),
+		State:     github.Ptr("open"),
+		HTMLURL:   github.Ptr("https://github.com/owner/repo/issues/123"),
+		Assignees: []*github.User{{Login: github.Ptr("user1")}, {Login: github.Ptr("user2")}},
+		Labels:    []*github.Label{{Name: github.Ptr("bug")}, {Name: github.Ptr("help wanted")}},
+		Milestone: &github.Milestone{Number: github.Ptr(5)},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedIssue  *github.Issue
+		expectedErrMsg string
+	}{
+		{
+			name: "successful issue creation with all fields",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposIssuesByOwnerByRepo,
+					expectRequestBody(t, map[string]any{
+						"title":     "Test Issue",
+						"body":      "This is a test issue",
+						"labels":    []any{"bug", "help wanted"},
+						"assignees": []any{"user1", "user2"},
+						"milestone": float64(5),
+					}).andThen(
+						mockResponse(t, http.StatusCreated, mockIssue),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":     "owner",
+				"repo":      "repo",
+				"title":     "Test Issue",
+				"body":      "This is a test issue",
+				"assignees": []any{"user1", "user2"},
+				"labels":    []any{"bug", "help wanted"},
+				"milestone": float64(5),
+			},
+			expectError:   false,
+			expectedIssue: mockIssue,
+		},
+		{
+			name: "successful issue creation with minimal fields",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposIssuesByOwnerByRepo,
+					expectRequestBody(t, map[string]any{
+						"title": "Minimal Issue",
+					}).andThen(
+						mockResponse(t, http.StatusCreated, &github.Issue{
+							Number:  github.Ptr(124),
+							Title:   github.Ptr("Minimal Issue"),
+							HTMLURL: github.Ptr("https://github.com/owner/repo/issues/124"),
+							State:   github.Ptr("open"),
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":     "owner",
+				"repo":      "repo",
+				"title":     "Minimal Issue",
+				"assignees": nil, // Expect no failure with nil optional value.
+			},
+			expectError: false,
+			expectedIssue: &github.Issue{
+				Number:  github.Ptr(124),
+				Title:   github.Ptr("Minimal Issue"),
+				HTMLURL: github.Ptr("https://github.com/owner/repo/issues/124"),
+				State:   github.Ptr("open"),
+			},
+		},
+		{
+			name: "successful issue creation with labels only",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposIssuesByOwnerByRepo,
+					expectRequestBody(t, map[string]any{
+						"title":  "Labeled Issue",
+						"labels": []any{"bug"},
+					}).andThen(
+						mockResponse(t, http.StatusCreated, &github.Issue{
+							Number:  github.Ptr(125),
+							Title:   github.Ptr("Labeled Issue"),
+							HTMLURL: github.Ptr("https://github.com/owner/repo/issues/125"),
+							State:   github.Ptr("open"),
+							Labels:  []*github.Label{{Name: github.Ptr("bug")}},
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"title":  "Labeled Issue",
+				"labels": []any{"bug"},
+			},
+			expectError: false,
+			expectedIssue: &github.Issue{
+				Number:  github.Ptr(125),
+				Title:   github.Ptr("Labeled Issue"),
+				HTMLURL: github.Ptr("https://github.com/owner/repo/issues/125"),
+				State:   github.Ptr("open"),
+				Labels:  []*github.Label{{Name: github.Ptr("bug")}},
+			},
+		},
+		{
+			name: "issue creation with unknown assignee",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposIssuesByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusUnprocessableEntity)
+						_, _ = w.Write([]byte(`{"message": "Validation Failed", "errors": [{"value": "ghost", "resource": "Issue", "field": "assignees", "code": "invalid"}]}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":     "owner",
+				"repo":      "repo",
+				"title":     "Test Issue",
+				"assignees": []any{"ghost"},
+			},
+			expectError:    false,
+			expectedErrMsg: "failed to create issue: Validation Failed; invalid error caused by assignees field on Issue resource",
+		},
+		{
+			name: "issue creation fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposIssuesByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusUnprocessableEntity)
+						_, _ = w.Write([]byte(`{"message": "Validation failed"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"title": "",
+			},
+			expectError:    false,
+			expectedErrMsg: "missing required parameter: title",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := CreateIssue(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			if tc.expectedErrMsg != "" {
+				require.NotNil(t, result)
+				assert.True(t, result.IsError)
+				textContent := getTextResult(t, result)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			// Unmarshal and verify the result
+			var returnedIssue github.Issue
+			err = json.Unmarshal([]byte(textContent.Text), &returnedIssue)
+			require.NoError(t, err)
+
+			assert.Equal(t, *tc.expectedIssue.Number, *returnedIssue.Number)
+			assert.Equal(t, *tc.expectedIssue.Title, *returnedIssue.Title)
+			assert.Equal(t, *tc.expectedIssue.State, *returnedIssue.State)
+			assert.Equal(t, *tc.expectedIssue.HTMLURL, *returnedIssue.HTMLURL)
+
+			if tc.expectedIssue.Body != nil {
+				assert.Equal(t, *tc.expectedIssue.Body, *returnedIssue.Body)
+			}
+
+			// Check assignees if expected
+			if len(tc.expectedIssue.Assignees) > 0 {
+				assert.Equal(t, len(tc.expectedIssue.Assignees), len(returnedIssue.Assignees))
+				for i, assignee := range returnedIssue.Assignees {
+					assert.Equal(t, *tc.expectedIssue.Assignees[i].Login, *assignee.Login)
+				}
+			}
+
+			// Check labels if expected
+			if len(tc.expectedIssue.Labels) > 0 {
+				assert.Equal(t, len(tc.expectedIssue.Labels), len(returnedIssue.Labels))
+				for i, label := range returnedIssue.Labels {
+					assert.Equal(t, *tc.expectedIssue.Labels[i].Name, *label.Name)
+				}
+			}
+		})
+	}
+}
+
+func Test_ListIssues(t *testing.T) {
+	// Verify tool definition
+	mockClient := github.NewClient(nil)
+	tool, _ := ListIssues(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_issues", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "state")
+	assert.Contains(t, tool.InputSchema.Properties, "labels")
+	assert.Contains(t, tool.InputSchema.Properties, "assignee")
+	assert.Contains(t, tool.InputSchema.Properties, "creator")
+	assert.Contains(t, tool.InputSchema.Properties, "mentioned")
+	assert.Contains(t, tool.InputSchema.Properties, "sort")
+	assert.Contains(t, tool.InputSchema.Properties, "direction")
+	assert.Contains(t, tool.InputSchema.Properties, "since")
+	assert.Contains(t, tool.InputSchema.Properties, "include_pull_requests")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	// Setup mock issues for success case
+	mockIssues := []*github.Issue{
+		{
+			Number:    github.Ptr(123),
+			Title:     github.Ptr("First Issue"),
+			Body:      github.Ptr("This is the first test issue"),
+			State:     github.Ptr("open"),
+			HTMLURL:   github.Ptr("https://github.com/owner/repo/issues/123"),
+			CreatedAt: &github.Timestamp{Time: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			Number:    github.Ptr(456),
+			Title:     github.Ptr("Second Issue"),
+			Body:      github.Ptr("This is the second test issue"),
+			State:     github.Ptr("open"),
+			HTMLURL:   github.Ptr("https://github.com/owner/repo/issues/456"),
+			Labels:    []*github.Label{{Name: github.Ptr("bug")}},
+			CreatedAt: &github.Timestamp{Time: time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)},
+		},
+	}
+
+	// The issues endpoint returns pull requests alongside issues
+	mockPullRequest := &github.Issue{
+		Number:           github.Ptr(789),
+		Title:            github.Ptr("A Pull Request"),
+		State:            github.Ptr("open"),
+		HTMLURL:          github.Ptr("https://github.com/owner/repo/pull/789"),
+		PullRequestLinks: &github.PullRequestLinks{URL: github.Ptr("https://api.github.com/repos/owner/repo/pulls/789")},
+	}
+	mockIssuesAndPullRequests := []*github.Issue{mockIssues[0], mockPullRequest, mockIssues[1]}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedIssues []*github.Issue
+		expectedErrMsg string
+	}{
+		{
+			name: "list issues with minimal parameters",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposIssuesByOwnerByRepo,
+					mockIssues,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    false,
+			expectedIssues: mockIssues,
+		},
+		{
+			name: "list issues with all parameters",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposIssuesByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"state":     "open",
+						"labels":    "bug,enhancement",
+						"assignee":  "octocat",
+						"creator":   "hubot",
+						"mentioned": "monalisa",
+						"sort":      "created",
+						"direction": "desc",
+						"since":     "2023-01-01T00:00:00Z",
+						"page":      "1",
+						"per_page":  "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockIssues),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":     "owner",
+				"repo":      "repo",
+				"state":     "open",
+				"labels":    []any{"bug", "enhancement"},
+				"assignee":  "octocat",
+				"creator":   "hubot",
+				"mentioned": "monalisa",
+				"sort":      "created",
+				"direction": "desc",
+				"since":     "2023-01-01T00:00:00Z",
+				"page":      float64(1),
+				"perPage":   float64(30),
+			},
+			expectError:    false,
+			expectedIssues: mockIssues,
+		},
+		{
+			name: "pull requests are filtered out by default",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposIssuesByOwnerByRepo,
+					mockIssuesAndPullRequests,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    false,
+			expectedIssues: mockIssues,
+		},
+		{
+			name: "pull requests are included on request",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposIssuesByOwnerByRepo,
+					mockIssuesAndPullRequests,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":                 "owner",
+				"repo":                  "repo",
+				"include_pull_requests": true,
+			},
+			expectError:    false,
+			expectedIssues: mockIssuesAndPullRequests,
+		},
+		{
+			name: "invalid since parameter",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposIssuesByOwnerByRepo,
+					mockIssues,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"since": "invalid-date",
+			},
+			expectError:    true,
+			expectedErrMsg: "invalid ISO 8601 timestamp",
+		},
+		{
+			name: "list issues fails with error",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposIssuesByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Repository not found"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "nonexistent",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list issues",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListIssues(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				if err != nil {
+					assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				} else {
+					// For errors returned as part of the result, not as an error
+					assert.NotNil(t, result)
+					textContent := getTextResult(t, result)
+					assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				}
+				return
+			}
+
+			require.NoError(t, err)
+
+			// Parse the result and get the text content if no error
+			textContent := getTextResult(t, result)
+
+			// Unmarshal and verify the result
+			var returnedIssues []*github.Issue
+			err = json.Unmarshal([]byte(textContent.Text), &returnedIssues)
+			require.NoError(t, err)
+
+			assert.Len(t, returnedIssues, len(tc.expectedIssues))
+			for i, issue := range returnedIssues {
+				assert.Equal(t, *tc.expectedIssues[i].Number, *issue.Number)
+				assert.Equal(t, *tc.expectedIssues[i].Title, *issue.Title)
+				assert.Equal(t, *tc.expectedIssues[i].State, *issue.State)
+				assert.Equal(t, *tc.expectedIssues[i].HTMLURL, *issue.HTMLURL)
+			}
+		})
+	}
+}
+
+func Test_GetIssueTimeline(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetIssueTimeline(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_issue_timeline", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "issue_number")
+	assert.Contains(t, tool.InputSchema.Properties, "event_types")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "issue_number"})
+
+	createdAt := &github.Timestamp{Time: time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)}
+	mockTimeline := []*github.Timeline{
+		{
+			Event:     github.Ptr("labeled"),
+			Actor:     &github.User{Login: github.Ptr("maintainer")},
+			CreatedAt: createdAt,
+			Label:     &github.Label{Name: github.Ptr("bug")},
+		},
+		{
+			Event:     github.Ptr("cross-referenced"),
+			Actor:     &github.User{Login: github.Ptr("contributor")},
+			CreatedAt: createdAt,
+			Source: &github.Source{
+				Type: github.Ptr("issue"),
+				Issue: &github.Issue{
+					Number:           github.Ptr(99),
+					HTMLURL:          github.Ptr("https://github.com/other/repo/pull/99"),
+					RepositoryURL:    github.Ptr("https://api.github.com/repos/other/repo"),
+					PullRequestLinks: &github.PullRequestLinks{URL: github.Ptr("https://api.github.com/repos/other/repo/pulls/99")},
+				},
+			},
+		},
+		{
+			Event:     github.Ptr("commented"),
+			User:      &github.User{Login: github.Ptr("commenter")},
+			CreatedAt: createdAt,
+			Body:      github.Ptr("A long comment that is left out of the timeline"),
+		},
+		{
+			Event:     github.Ptr("closed"),
+			Actor:     &github.User{Login: github.Ptr("maintainer")},
+			CreatedAt: createdAt,
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedEvents []*issueTimelineEvent
+		expectedErrMsg string
+	}{
+		{
+			name: "get full timeline",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposIssuesTimelineByOwnerByRepoByIssueNumber,
+					expectQueryParams(t, map[string]string{
+						"page":     "2",
+						"per_page": "10",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockTimeline),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"page":         float64(2),
+				"perPage":      float64(10),
+			},
+			expectError: false,
+			expectedEvents: []*issueTimelineEvent{
+				{Event: "labeled", Actor: "maintainer", CreatedAt: createdAt, Label: "bug"},
+				{
+					Event:     "cross-referenced",
+					Actor:     "contributor",
+					CreatedAt: createdAt,
+					Source: &issueTimelineEventSource{
+						Type:       "pull_request",
+						Number:     99,
+						Repository: "other/repo",
+						URL:        "https://github.com/other/repo/pull/99",
+					},
+				},
+				{Event: "commented", Actor: "commenter", CreatedAt: createdAt},
+				{Event: "closed", Actor: "maintainer", CreatedAt: createdAt},
+			},
+		},
+		{
+			name: "filter by event type",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposIssuesTimelineByOwnerByRepoByIssueNumber,
+					mockTimeline,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"event_types":  []any{"closed", "reopened"},
+			},
+			expectError: false,
+			expectedEvents: []*issueTimelineEvent{
+				{Event: "closed", Actor: "maintainer", CreatedAt: createdAt},
+			},
+		},
+		{
+			name: "issue not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposIssuesTimelineByOwnerByRepoByIssueNumber,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(999),
+			},
+			expectError:    false,
+			expectedErrMsg: "failed to get issue timeline: Not Found",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetIssueTimeline(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedEvents []*issueTimelineEvent
+			err = json.Unmarshal([]byte(textContent.Text), &returnedEvents)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedEvents, returnedEvents)
+		})
+	}
+}
+
+func Test_UpdateIssue(t *testing.T) {
+	// Verify tool definition
+	mockClient := github.NewClient(nil)
+	tool, _ := UpdateIssue(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "update_issue", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "issue_number")
+	assert.Contains(t, tool.InputSchema.Properties, "title")
+	assert.Contains(t, tool.InputSchema.Properties, "body")
+	assert.Contains(t, tool.InputSchema.Properties, "state")
+	assert.Contains(t, tool.InputSchema.Properties, "labels")
+	assert.Contains(t, tool.InputSchema.Properties, "assignees")
+	assert.Contains(t, tool.InputSchema.Properties, "state_reason")
+	assert.Contains(t, tool.InputSchema.Properties, "milestone")
+	assert.Contains(t, tool.InputSchema.Properties, "milestone_title")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "issue_number"})
+
+	// Setup mock issue for success case
+	mockIssue := &github.Issue{
+		Number:    github.Ptr(123),
+		Title:     github.Ptr("Updated Issue Title"),
+		Body:      github.Ptr("Updated issue description"),
+		State:     github.Ptr("closed"),
+		HTMLURL:   github.Ptr("https://github.com/owner/repo/issues/123"),
+		Assignees: []*github.User{{Login: github.Ptr("assignee1")}, {Login: github.Ptr("assignee2")}},
+		Labels:    []*github.Label{{Name: github.Ptr("bug")}, {Name: github.Ptr("priority")}},
+		Milestone: &github.Milestone{Number: github.Ptr(5)},
+	}
+
+	mockMilestones := []*github.Milestone{
+		{Number: github.Ptr(4), Title: github.Ptr("v1.0")},
+		{Number: github.Ptr(5), Title: github.Ptr("v1.1")},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedIssue  *github.Issue
+		expectedErrMsg string
+	}{
+		{
+			name: "update issue with all fields",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposIssuesByOwnerByRepoByIssueNumber,
+					expectRequestBody(t, map[string]any{
+						"title":     "Updated Issue Title",
+						"body":      "Updated issue description",
+						"state":     "closed",
+						"labels":    []any{"bug", "priority"},
+						"assignees": []any{"assignee1", "assignee2"},
+						"milestone": float64(5),
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockIssue),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(123),
+				"title":        "Updated Issue Title",
+				"body":         "Updated issue description",
+				"state":        "closed",
+				"labels":       []any{"bug", "priority"},
+				"assignees":    []any{"assignee1", "assignee2"},
+				"milestone":    float64(5),
+			},
+			expectError:   false,
+			expectedIssue: mockIssue,
+		},
+		{
+			name: "update issue with minimal fields",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposIssuesByOwnerByRepoByIssueNumber,
+					expectRequestBody(t, map[string]any{
+						"title": "Only Title Updated",
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.Issue{
+							Number:  github.Ptr(123),
+							Title:   github.Ptr("Only Title Updated"),
+							HTMLURL: github.Ptr("https://github.com/owner/repo/issues/123"),
+							State:   github.Ptr("open"),
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(123),
+				"title":        "Only Title Updated",
+			},
+			expectError: false,
+			expectedIssue: &github.Issue{
+				Number:  github.Ptr(123),
+				Title:   github.Ptr("Only Title Updated"),
+				HTMLURL: github.Ptr("https://github.com/owner/repo/issues/123"),
+				State:   github.Ptr("open"),
+			},
+		},
+		{
+			name: "update issue clearing the milestone",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposIssuesByOwnerByRepoByIssueNumber,
+					expectRequestBody(t, map[string]any{
+						"state":     "closed",
+						"milestone": nil,
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.Issue{
+							Number:  github.Ptr(123),
+							Title:   github.Ptr("Unscheduled Issue"),
+							HTMLURL: github.Ptr("https://github.com/owner/repo/issues/123"),
+							State:   github.Ptr("closed"),
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(123),
+				"state":        "closed",
+				"milestone":    float64(0),
+			},
+			expectError: false,
+			expectedIssue: &github.Issue{
+				Number:  github.Ptr(123),
+				Title:   github.Ptr("Unscheduled Issue"),
+				HTMLURL: github.Ptr("https://github.com/owner/repo/issues/123"),
+				State:   github.Ptr("closed"),
+			},
+		},
+		{
+			name: "close issue as not planned",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposIssuesByOwnerByRepoByIssueNumber,
+					expectRequestBody(t, map[string]any{
+						"state":        "closed",
+						"state_reason": "not_planned",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockIssue),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(123),
+				"state":        "closed",
+				"state_reason": "not_planned",
+			},
+			expectError:   false,
+			expectedIssue: mockIssue,
+		},
+		{
+			name:         "state reason without state",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(123),
+				"state_reason": "completed",
+			},
+			expectError:    true,
+			expectedErrMsg: "state_reason can only be set together with state",
+		},
+		{
+			name:         "reopened state reason when closing",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(123),
+				"state":        "closed",
+				"state_reason": "reopened",
+			},
+			expectError:    true,
+			expectedErrMsg: "state_reason 'reopened' requires state 'open'",
+		},
+		{
+			name: "update issue with milestone title",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposMilestonesByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"state":    "all",
+						"per_page": "100",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockMilestones),
+					),
+				),
+				mock.WithRequestMatchHandler(
+					mock.PatchReposIssuesByOwnerByRepoByIssueNumber,
+					expectRequestBody(t, map[string]any{
+						"milestone": float64(5),
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockIssue),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":           "owner",
+				"repo":            "repo",
+				"issue_number":    float64(123),
+				"milestone_title": "v1.1",
+			},
+			expectError:   false,
+			expectedIssue: mockIssue,
+		},
+		{
+			name: "update issue with unknown milestone title",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposMilestonesByOwnerByRepo,
+					mockMilestones,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":           "owner",
+				"repo":            "repo",
+				"issue_number":    float64(123),
+				"milestone_title": "v2.0",
+			},
+			expectError:    true,
+			expectedErrMsg: `milestone "v2.0" not found, available milestones: v1.0, v1.1`,
+		},
+		{
+			name:         "update issue with milestone and milestone title",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":           "owner",
+				"repo":            "repo",
+				"issue_number":    float64(123),
+				"milestone":       float64(5),
+				"milestone_title": "v1.1",
+			},
+			expectError:    true,
+			expectedErrMsg: "only one of milestone and milestone_title can be provided",
+		},
+		{
+			name: "update issue fails with not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposIssuesByOwnerByRepoByIssueNumber,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Issue not found"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(999),
+				"title":        "This issue doesn't exist",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to update issue",
+		},
+		{
+			name: "update issue fails with validation error",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposIssuesByOwnerByRepoByIssueNumber,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusUnprocessableEntity)
+						_, _ = w.Write([]byte(`{"message": "Invalid state value"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(123),
+				"state":        "invalid_state",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to update issue",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := UpdateIssue(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				if err != nil {
+					assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				} else {
+					// For errors returned as part of the result, not as an error
+					require.NotNil(t, result)
+					textContent := getTextResult(t, result)
+					assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				}
+				return
+			}
+
+			require.NoError(t, err)
+
+			// Parse the result and get the text content if no error
+			textContent := getTextResult(t, result)
+
+			// Unmarshal and verify the result
+			var returnedIssue github.Issue
+			err = json.Unmarshal([]byte(textContent.Text), &returnedIssue)
+			require.NoError(t, err)
+
+			assert.Equal(t, *tc.expectedIssue.Number, *returnedIssue.Number)
+			assert.Equal(t, *tc.expectedIssue.Title, *returnedIssue.Title)
+			assert.Equal(t, *tc.expectedIssue.State, *returnedIssue.State)
+			assert.Equal(t, *tc.expectedIssue.HTMLURL, *returnedIssue.HTMLURL)
+
+			if tc.expectedIssue.Body != nil {
+				assert.Equal(t, *tc.expectedIssue.Body, *returnedIssue.Body)
+			}
+
+			// Check assignees if expected
+			if len(tc.expectedIssue.Assignees) > 0 {
+				assert.Len(t, returnedIssue.Assignees, len(tc.expectedIssue.Assignees))
+				for i, assignee := range returnedIssue.Assignees {
+					assert.Equal(t, *tc.expectedIssue.Assignees[i].Login, *assignee.Login)
+				}
+			}
+
+			// Check labels if expected
+			if len(tc.expectedIssue.Labels) > 0 {
+				assert.Len(t, returnedIssue.Labels, len(tc.expectedIssue.Labels))
+				for i, label := range returnedIssue.Labels {
+					assert.Equal(t, *tc.expectedIssue.Labels[i].Name, *label.Name)
+				}
+			}
+
+			// Check milestone if expected
+			if tc.expectedIssue.Milestone != nil {
+				assert.NotNil(t, returnedIssue.Milestone)
+				assert.Equal(t, *tc.expectedIssue.Milestone.Number, *returnedIssue.Milestone.Number)
+			}
+		})
+	}
+}
+
+func Test_CloseIssue(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CloseIssue(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "close_issue", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "issue_number")
+	assert.Contains(t, tool.InputSchema.Properties, "state_reason")
+	assert.Contains(t, tool.InputSchema.Properties, "comment")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "issue_number"})
+
+	mockIssue := &github.Issue{
+		Number:      github.Ptr(42),
+		Title:       github.Ptr("Duplicate Issue"),
+		State:       github.Ptr("closed"),
+		StateReason: github.Ptr("duplicate"),
+		HTMLURL:     github.Ptr("https://github.com/owner/repo/issues/42"),
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedIssue  *github.Issue
+		expectedErrMsg string
+	}{
+		{
+			name: "close issue with defaults",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposIssuesByOwnerByRepoByIssueNumber,
+					expectRequestBody(t, map[string]any{
+						"state":        "closed",
+						"state_reason": "completed",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockIssue),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+			},
+			expectError:   false,
+			expectedIssue: mockIssue,
+		},
+		{
+			name: "close issue as duplicate with comment",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposIssuesCommentsByOwnerByRepoByIssueNumber,
+					expectRequestBody(t, map[string]any{
+						"body": "Duplicate of #7",
+					}).andThen(
+						mockResponse(t, http.StatusCreated, &github.IssueComment{ID: github.Ptr(int64(1))}),
+					),
+				),
+				mock.WithRequestMatchHandler(
+					mock.PatchReposIssuesByOwnerByRepoByIssueNumber,
+					expectRequestBody(t, map[string]any{
+						"state":        "closed",
+						"state_reason": "duplicate",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockIssue),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"state_reason": "duplicate",
+				"comment":      "Duplicate of #7",
+			},
+			expectError:   false,
+			expectedIssue: mockIssue,
+		},
+		{
+			name: "issue is left open when the comment fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposIssuesCommentsByOwnerByRepoByIssueNumber,
+					mockResponse(t, http.StatusForbidden, map[string]string{"message": "Issue is locked"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"comment":      "Closing this",
+			},
+			expectError:    false,
+			expectedErrMsg: "failed to add closing comment: Issue is locked",
+		},
+		{
+			name:         "reopened is not a reason to close",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"state_reason": "reopened",
+			},
+			expectError:    false,
+			expectedErrMsg: "state_reason 'reopened' requires state 'open'",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := CloseIssue(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedIssue github.Issue
+			err = json.Unmarshal([]byte(textContent.Text), &returnedIssue)
+			require.NoError(t, err)
+			assert.Equal(t, *tc.expectedIssue.Number, *returnedIssue.Number)
+			assert.Equal(t, *tc.expectedIssue.State, *returnedIssue.State)
+			assert.Equal(t, *tc.expectedIssue.StateReason, *returnedIssue.StateReason)
+		})
+	}
+}
+
+func Test_ParseISOTimestamp(t *testing.T) {
+	tests := []struct {
+		name         string
+		input        string
+		expectedErr  bool
+		expectedTime time.Time
+	}{
+		{
+			name:         "valid RFC3339 format",
+			input:        "2023-01-15T14:30:00Z",
+			expectedErr:  false,
+			expectedTime: time.Date(2023, 1, 15, 14, 30, 0, 0, time.UTC),
+		},
+		{
+			name:         "valid date only format",
+			input:        "2023-01-15",
+			expectedErr:  false,
+			expectedTime: time.Date(2023, 1, 15, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:        "empty timestamp",
+			input:       "",
+			expectedErr: true,
+		},
+		{
+			name:        "invalid format",
+			input:       "15/01/2023",
+			expectedErr: true,
+		},
+		{
+			name:        "invalid date",
+			input:       "2023-13-45",
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			parsedTime, err := parseISOTimestamp(tc.input)
+
+			if tc.expectedErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedTime, parsedTime)
+			}
+		})
+	}
+}
+
+func Test_GetIssueComments(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetIssueComments(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_issue_comments", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "issue_number")
+	assert.Contains(t, tool.InputSchema.Properties, "since")
+	assert.Contains(t, tool.InputSchema.Properties, "sort")
+	assert.Contains(t, tool.InputSchema.Properties, "direction")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "per_page")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "issue_number"})
+
+	// Setup mock comments for success case
+	mockComments := []*github.IssueComment{
+		{
+			ID:   github.Ptr(int64(123)),
+			Body: github.Ptr("This is the first comment"),
+			User: &github.User{
+				Login: github.Ptr("user1"),
+			},
+			CreatedAt: &github.Timestamp{Time: time.Now().Add(-time.Hour * 24)},
+		},
+		{
+			ID:   github.Ptr(int64(456)),
+			Body: github.Ptr("This is the second comment"),
+			User: &github.User{
+				Login: github.Ptr("user2"),
+			},
+			CreatedAt: &github.Timestamp{Time: time.Now().Add(-time.Hour)},
+		},
+	}
+
+	tests := []struct {
+		name             string
+		mockedClient     *http.Client
+		requestArgs      map[string]interface{}
+		expectError      bool
+		expectedComments []*github.IssueComment
+		expectedErrMsg   string
+	}{
+		{
+			name: "successful comments retrieval",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposIssuesCommentsByOwnerByRepoByIssueNumber,
+					mockComments,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+			},
+			expectError:      false,
+			expectedComments: mockComments,
+		},
+		{
+			name: "successful comments retrieval with pagination",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposIssuesCommentsByOwnerByRepoByIssueNumber,
+					expectQueryParams(t, map[string]string{
+						"page":     "2",
+						"per_page": "10",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockComments),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"page":         float64(2),
+				"per_page":     float64(10),
+			},
+			expectError:      false,
+			expectedComments: mockComments,
+		},
+		{
+			name: "successful comments retrieval with since and sort",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposIssuesCommentsByOwnerByRepoByIssueNumber,
+					expectQueryParams(t, map[string]string{
+						"since":     "2023-01-01T00:00:00Z",
+						"sort":      "updated",
+						"direction": "asc",
+						"page":      "1",
+						"per_page":  "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockComments),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"since":        "2023-01-01T00:00:00Z",
+				"sort":         "updated",
+				"direction":    "asc",
+			},
+			expectError:      false,
+			expectedComments: mockComments,
+		},
+		{
+			name:         "invalid since parameter",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"since":        "last week",
+			},
+			expectError:    false,
+			expectedErrMsg: "invalid since: invalid ISO 8601 timestamp: last week",
+		},
+		{
+			name: "issue not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposIssuesCommentsByOwnerByRepoByIssueNumber,
+					mockResponse(t, http.StatusNotFound, `{"message": "Issue not found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(999),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get issue comments",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetIssueComments(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedComments []*github.IssueComment
+			err = json.Unmarshal([]byte(textContent.Text), &returnedComments)
+			require.NoError(t, err)
+			assert.Equal(t, len(tc.expectedComments), len(returnedComments))
+			if len(returnedComments) > 0 {
+				assert.Equal(t, *tc.expectedComments[0].Body, *returnedComments[0].Body)
+				assert.Equal(t, *tc.expectedComments[0].User.Login, *returnedComments[0].User.Login)
+			}
+		})
+	}
+}
+
+func Test_GetIssueEvents(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetIssueEvents(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_issue_events", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "issue_number")
+	assert.Contains(t, tool.InputSchema.Properties, "event")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "issue_number"})
+
+	createdAt := &github.Timestamp{Time: time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)}
+	labeled := &github.IssueEvent{
+		ID:        github.Ptr(int64(1)),
+		Event:     github.Ptr("labeled"),
+		Actor:     &github.User{Login: github.Ptr("user1")},
+		Label:     &github.Label{Name: github.Ptr("bug")},
+		CreatedAt: createdAt,
+	}
+	assigned := &github.IssueEvent{
+		ID:        github.Ptr(int64(2)),
+		Event:     github.Ptr("assigned"),
+		Actor:     &github.User{Login: github.Ptr("user1")},
+		Assignee:  &github.User{Login: github.Ptr("user2")},
+		CreatedAt: createdAt,
+	}
+	referenced := &github.IssueEvent{
+		ID:        github.Ptr(int64(3)),
+		Event:     github.Ptr("referenced"),
+		Actor:     &github.User{Login: github.Ptr("user2")},
+		CommitID:  github.Ptr("abc123"),
+		CreatedAt: createdAt,
+	}
+	closed := &github.IssueEvent{
+		ID:        github.Ptr(int64(4)),
+		Event:     github.Ptr("closed"),
+		Actor:     &github.User{Login: github.Ptr("user2")},
+		CommitID:  github.Ptr("def456"),
+		CreatedAt: createdAt,
+	}
+	referencedAgain := &github.IssueEvent{
+		ID:        github.Ptr(int64(5)),
+		Event:     github.Ptr("referenced"),
+		Actor:     &github.User{Login: github.Ptr("user1")},
+		CommitID:  github.Ptr("789abc"),
+		CreatedAt: createdAt,
+	}
+
+	// A referenced event on the first page, followed by more labeled events than are
+	// fetched to filter them.
+	truncatedPages := make([]any, 0, issueEventListLimit/100+1)
+	for i := 0; i <= issueEventListLimit/100; i++ {
+		page := make([]*github.IssueEvent, 100)
+		for j := range page {
+			page[j] = labeled
+		}
+		if i == 0 {
+			page[0] = referenced
+		}
+		truncatedPages = append(truncatedPages, page)
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectedEvents []*issueEvent
+		truncated      bool
+		expectedErrMsg string
+	}{
+		{
+			name: "successful events retrieval",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposIssuesEventsByOwnerByRepoByIssueNumber,
+					[]*github.IssueEvent{labeled, assigned, closed},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+			},
+			expectedEvents: []*issueEvent{
+				{Event: "labeled", Actor: "user1", CreatedAt: createdAt, Label: "bug"},
+				{Event: "assigned", Actor: "user1", CreatedAt: createdAt, Assignee: "user2"},
+				{Event: "closed", Actor: "user2", CreatedAt: createdAt, CommitID: "def456"},
+			},
+		},
+		{
+			name: "successful events retrieval with pagination",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposIssuesEventsByOwnerByRepoByIssueNumber,
+					expectQueryParams(t, map[string]string{
+						"page":     "2",
+						"per_page": "10",
+					}).andThen(
+						mockResponse(t, http.StatusOK, []*github.IssueEvent{referenced}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"page":         float64(2),
+				"perPage":      float64(10),
+			},
+			expectedEvents: []*issueEvent{
+				{Event: "referenced", Actor: "user2", CreatedAt: createdAt, CommitID: "abc123"},
+			},
+		},
+		{
+			name: "event filter applies across pages",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchPages(
+					mock.GetReposIssuesEventsByOwnerByRepoByIssueNumber,
+					[]*github.IssueEvent{labeled, referenced},
+					[]*github.IssueEvent{closed, referencedAgain},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"event":        "referenced",
+			},
+			expectedEvents: []*issueEvent{
+				{Event: "referenced", Actor: "user2", CreatedAt: createdAt, CommitID: "abc123"},
+				{Event: "referenced", Actor: "user1", CreatedAt: createdAt, CommitID: "789abc"},
+			},
+		},
+		{
+			name: "event filter with pagination",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchPages(
+					mock.GetReposIssuesEventsByOwnerByRepoByIssueNumber,
+					[]*github.IssueEvent{labeled, referenced},
+					[]*github.IssueEvent{closed, referencedAgain},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"event":        "referenced",
+				"page":         float64(2),
+				"perPage":      float64(1),
+			},
+			expectedEvents: []*issueEvent{
+				{Event: "referenced", Actor: "user1", CreatedAt: createdAt, CommitID: "789abc"},
+			},
+		},
+		{
+			name: "event filter stops at the limit",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchPages(
+					mock.GetReposIssuesEventsByOwnerByRepoByIssueNumber,
+					truncatedPages...,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"event":        "referenced",
+			},
+			expectedEvents: []*issueEvent{
+				{Event: "referenced", Actor: "user2", CreatedAt: createdAt, CommitID: "abc123"},
+			},
+			truncated: true,
+		},
+		{
+			name: "event filter with negative page",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposIssuesEventsByOwnerByRepoByIssueNumber,
+					[]*github.IssueEvent{labeled, referenced},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"event":        "referenced",
+				"page":         float64(-1),
+			},
+			expectedErrMsg: "page must be at least 1",
+		},
+		{
+			name: "issue not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposIssuesEventsByOwnerByRepoByIssueNumber,
+					mockResponse(t, http.StatusNotFound, `{"message": "Issue not found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(999),
+			},
+			expectedErrMsg: "failed to list issue events",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetIssueEvents(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+			require.False(t, result.IsError, textContent.Text)
+
+			// Unmarshal and verify the result
+			var returnedList issueEventList
+			err = json.Unmarshal([]byte(textContent.Text), &returnedList)
+			require.NoError(t, err)
+			assert.Equal(t, tc.truncated, returnedList.Truncated)
+			returnedEvents := returnedList.Events
+			require.Len(t, returnedEvents, len(tc.expectedEvents))
+			for i, expected := range tc.expectedEvents {
+				assert.Equal(t, expected.Event, returnedEvents[i].Event)
+				assert.Equal(t, expected.Actor, returnedEvents[i].Actor)
+				assert.True(t, expected.CreatedAt.Equal(*returnedEvents[i].CreatedAt))
+				assert.Equal(t, expected.Label, returnedEvents[i].Label)
+				assert.Equal(t, expected.Assignee, returnedEvents[i].Assignee)
+				assert.Equal(t, expected.CommitID, returnedEvents[i].CommitID)
+			}
+		})
+	}
+}
+
+var (
+	getSubIssues = mock.EndpointPattern{
+		Pattern: "/repos/{owner}/{repo}/issues/{issue_number}/sub_issues",
+		Method:  http.MethodGet,
+	}
+	postSubIssues = mock.EndpointPattern{
+		Pattern: "/repos/{owner}/{repo}/issues/{issue_number}/sub_issues",
+		Method:  http.MethodPost,
+	}
+	deleteSubIssue = mock.EndpointPattern{
+		Pattern: "/repos/{owner}/{repo}/issues/{issue_number}/sub_issue",
+		Method:  http.MethodDelete,
+	}
+	patchSubIssuesPriority = mock.EndpointPattern{
+		Pattern: "/repos/{owner}/{repo}/issues/{issue_number}/sub_issues/priority",
+		Method:  http.MethodPatch,
+	}
+)
+
+func Test_ListSubIssues(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListSubIssues(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_sub_issues", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "issue_number")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "issue_number"})
+
+	mockSubIssues := []*github.Issue{
+		{ID: github.Ptr(int64(1001)), Number: github.Ptr(43), Title: github.Ptr("First step")},
+		{ID: github.Ptr(int64(1002)), Number: github.Ptr(44), Title: github.Ptr("Second step")},
+	}
+
+	tests := []struct {
+		name              string
+		mockedClient      *http.Client
+		requestArgs       map[string]interface{}
+		expectError       bool
+		expectedSubIssues []*github.Issue
+		expectedErrMsg    string
+	}{
+		{
+			name: "list sub-issues",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					getSubIssues,
+					expectQueryParams(t, map[string]string{
+						"page":     "1",
+						"per_page": "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockSubIssues),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+			},
+			expectError:       false,
+			expectedSubIssues: mockSubIssues,
+		},
+		{
+			name: "issue not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					getSubIssues,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(999),
+			},
+			expectError:    false,
+			expectedErrMsg: "failed to list sub-issues: Not Found",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListSubIssues(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedSubIssues []*github.Issue
+			err = json.Unmarshal([]byte(textContent.Text), &returnedSubIssues)
+			require.NoError(t, err)
+			require.Len(t, returnedSubIssues, len(tc.expectedSubIssues))
+			for i, subIssue := range returnedSubIssues {
+				assert.Equal(t, *tc.expectedSubIssues[i].ID, *subIssue.ID)
+				assert.Equal(t, *tc.expectedSubIssues[i].Number, *subIssue.Number)
+				assert.Equal(t, *tc.expectedSubIssues[i].Title, *subIssue.Title)
+			}
+		})
+	}
+}
+
+func Test_AddSubIssue(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := AddSubIssue(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "add_sub_issue", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "issue_number")
+	assert.Contains(t, tool.InputSchema.Properties, "sub_issue_id")
+	assert.Contains(t, tool.InputSchema.Properties, "replace_parent")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "issue_number", "sub_issue_id"})
+
+	mockParent := &subIssueParent{
+		Number:           42,
+		HTMLURL:          "https://github.com/owner/repo/issues/42",
+		SubIssuesSummary: subIssuesSummary{Total: 3, Completed: 1, PercentCompleted: 33},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedParent *subIssueParent
+		expectedErrMsg string
+	}{
+		{
+			name: "add sub-issue",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postSubIssues,
+					expectRequestBody(t, map[string]any{
+						"sub_issue_id": float64(1001),
+					}).andThen(
+						mockResponse(t, http.StatusCreated, mockParent),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"sub_issue_id": float64(1001),
+			},
+			expectError:    false,
+			expectedParent: mockParent,
+		},
+		{
+			name: "move sub-issue from its current parent",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postSubIssues,
+					expectRequestBody(t, map[string]any{
+						"sub_issue_id":   float64(1001),
+						"replace_parent": true,
+					}).andThen(
+						mockResponse(t, http.StatusCreated, mockParent),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":          "owner",
+				"repo":           "repo",
+				"issue_number":   float64(42),
+				"sub_issue_id":   float64(1001),
+				"replace_parent": true,
+			},
+			expectError:    false,
+			expectedParent: mockParent,
+		},
+		{
+			name: "maximum sub-issues reached",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postSubIssues,
+					mockResponse(t, http.StatusUnprocessableEntity, map[string]any{
+						"message": "Validation Failed",
+						"errors": []map[string]string{
+							{"resource": "Issue", "code": "custom", "message": "Parent issue has reached the maximum number of sub-issues"},
+						},
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"sub_issue_id": float64(1001),
+			},
+			expectError:    false,
+			expectedErrMsg: "failed to add sub-issue: the parent issue has reached the maximum number of sub-issues",
+		},
+		{
+			name: "sub-issue already has a parent",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postSubIssues,
+					mockResponse(t, http.StatusUnprocessableEntity, map[string]any{
+						"message": "Validation Failed",
+						"errors": []map[string]string{
+							{"resource": "Issue", "code": "custom", "message": "Sub-issue may only have one parent"},
+						},
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"sub_issue_id": float64(1001),
+			},
+			expectError:    false,
+			expectedErrMsg: "failed to add sub-issue: Validation Failed; Sub-issue may only have one parent",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := AddSubIssue(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedParent subIssueParent
+			err = json.Unmarshal([]byte(textContent.Text), &returnedParent)
+			require.NoError(t, err)
+			assert.Equal(t, *tc.expectedParent, returnedParent)
+		})
+	}
+}
+
+func Test_RemoveSubIssue(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := RemoveSubIssue(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "remove_sub_issue", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "issue_number")
+	assert.Contains(t, tool.InputSchema.Properties, "sub_issue_id")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "issue_number", "sub_issue_id"})
+
+	mockParent := &subIssueParent{
+		Number:           42,
+		HTMLURL:          "https://github.com/owner/repo/issues/42",
+		SubIssuesSummary: subIssuesSummary{Total: 2, Completed: 1, PercentCompleted: 50},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedParent *subIssueParent
+		expectedErrMsg string
+	}{
+		{
+			name: "remove sub-issue",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					deleteSubIssue,
+					expectRequestBody(t, map[string]any{
+						"sub_issue_id": float64(1001),
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockParent),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"sub_issue_id": float64(1001),
+			},
+			expectError:    false,
+			expectedParent: mockParent,
+		},
+		{
+			name: "sub-issue not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					deleteSubIssue,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"sub_issue_id": float64(9999),
+			},
+			expectError:    false,
+			expectedErrMsg: "failed to remove sub-issue: Not Found",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := RemoveSubIssue(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedParent subIssueParent
+			err = json.Unmarshal([]byte(textContent.Text), &returnedParent)
+			require.NoError(t, err)
+			assert.Equal(t, *tc.expectedParent, returnedParent)
+		})
+	}
+}
+
+func Test_ReprioritizeSubIssue(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ReprioritizeSubIssue(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "reprioritize_sub_issue", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "issue_number")
+	assert.Contains(t, tool.InputSchema.Properties, "sub_issue_id")
+	assert.Contains(t, tool.InputSchema.Properties, "after_id")
+	assert.Contains(t, tool.InputSchema.Properties, "before_id")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "issue_number", "sub_issue_id"})
+
+	mockParent := &subIssueParent{
+		Number:           42,
+		HTMLURL:          "https://github.com/owner/repo/issues/42",
+		SubIssuesSummary: subIssuesSummary{Total: 3, Completed: 0, PercentCompleted: 0},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedParent *subIssueParent
+		expectedErrMsg string
+	}{
+		{
+			name: "move after another sub-issue",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					patchSubIssuesPriority,
+					expectRequestBody(t, map[string]any{
+						"sub_issue_id": float64(1001),
+						"after_id":     float64(1003),
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockParent),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"sub_issue_id": float64(1001),
+				"after_id":     float64(1003),
+			},
+			expectError:    false,
+			expectedParent: mockParent,
+		},
+		{
+			name: "move before another sub-issue",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					patchSubIssuesPriority,
+					expectRequestBody(t, map[string]any{
+						"sub_issue_id": float64(1003),
+						"before_id":    float64(1001),
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockParent),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"sub_issue_id": float64(1003),
+				"before_id":    float64(1001),
+			},
+			expectError:    false,
+			expectedParent: mockParent,
+		},
+		{
+			name:         "both after_id and before_id",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"sub_issue_id": float64(1001),
+				"after_id":     float64(1002),
+				"before_id":    float64(1003),
+			},
+			expectError:    false,
+			expectedErrMsg: "exactly one of after_id or before_id must be provided",
+		},
+		{
+			name:         "neither after_id nor before_id",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"sub_issue_id": float64(1001),
+			},
+			expectError:    false,
+			expectedErrMsg: "exactly one of after_id or before_id must be provided",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ReprioritizeSubIssue(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedParent subIssueParent
+			err = json.Unmarshal([]byte(textContent.Text), &returnedParent)
+			require.NoError(t, err)
+			assert.Equal(t, *tc.expectedParent, returnedParent)
+		})
+	}
+}
+
+func Test_TransferIssue(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := TransferIssue(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "transfer_issue", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "issue_number")
+	assert.Contains(t, tool.InputSchema.Properties, "new_repo")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "issue_number", "new_repo"})
+
+	idsExchange := graphQLExchange{
+		query: "IssueAndTargetRepositoryIDs",
+		expectedVariables: map[string]any{
+			"owner":   "owner",
+			"repo":    "repo",
+			"number":  float64(42),
+			"newRepo": "other-repo",
+		},
+		data: map[string]any{
+			"repository": map[string]any{"issue": map[string]any{"id": "I_42"}},
+			"target":     map[string]any{"id": "R_other"},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedResult map[string]any
+		expectedErrMsg string
+	}{
+		{
+			name: "transfer by repository name",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockGraphQL(t,
+						idsExchange,
+						graphQLExchange{
+							query: "TransferIssue(",
+							expectedVariables: map[string]any{
+								"issueId":      "I_42",
+								"repositoryId": "R_other",
+							},
+							data: map[string]any{
+								"transferIssue": map[string]any{
+									"issue": map[string]any{"number": 7, "url": "https://github.com/owner/other-repo/issues/7"},
+								},
+							},
+						},
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"new_repo":     "other-repo",
+			},
+			expectError: false,
+			expectedResult: map[string]any{
+				"repository":   "owner/other-repo",
+				"issue_number": float64(7),
+				"url":          "https://github.com/owner/other-repo/issues/7",
+			},
+		},
+		{
+			name: "transfer by owner and name",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockGraphQL(t,
+						idsExchange,
+						graphQLExchange{
+							query: "TransferIssue(",
+							data: map[string]any{
+								"transferIssue": map[string]any{
+									"issue": map[string]any{"number": 7, "url": "https://github.com/owner/other-repo/issues/7"},
+								},
+							},
+						},
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"new_repo":     "Owner/other-repo",
+			},
+			expectError: false,
+			expectedResult: map[string]any{
+				"repository":   "owner/other-repo",
+				"issue_number": float64(7),
+				"url":          "https://github.com/owner/other-repo/issues/7",
+			},
+		},
+		{
+			name:         "target in another owner",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"new_repo":     "someone-else/other-repo",
+			},
+			expectError:    false,
+			expectedErrMsg: "issues can only be transferred within the same owner",
+		},
+		{
+			name: "target has issues disabled",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockGraphQL(t,
+						idsExchange,
+						graphQLExchange{
+							query: "TransferIssue(",
+							errors: []map[string]any{
+								{"type": "UNPROCESSABLE", "message": "Cannot transfer issue to a repository with issues disabled"},
+							},
+						},
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+				"new_repo":     "other-repo",
+			},
+			expectError:    false,
+			expectedErrMsg: "failed to transfer issue: Cannot transfer issue to a repository with issues disabled",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := TransferIssue(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedResult map[string]any
+			err = json.Unmarshal([]byte(textContent.Text), &returnedResult)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, returnedResult)
+		})
+	}
+}
+
+func Test_PinIssue(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := PinIssue(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "pin_issue", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "issue_number")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "issue_number"})
+
+	issueIDExchange := graphQLExchange{
+		query: "IssueID",
+		expectedVariables: map[string]any{
+			"owner":  "owner",
+			"repo":   "repo",
+			"number": float64(42),
+		},
+		data: map[string]any{
+			"repository": map[string]any{"issue": map[string]any{"id": "I_42"}},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedResult map[string]any
+		expectedErrMsg []string
+	}{
+		{
+			name: "pin issue",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockGraphQL(t,
+						issueIDExchange,
+						graphQLExchange{
+							query:             "PinIssue(",
+							expectedVariables: map[string]any{"issueId": "I_42"},
+							data: map[string]any{
+								"pinIssue": map[string]any{"issue": map[string]any{"isPinned": true}},
+							},
+						},
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+			},
+			expectError:    false,
+			expectedResult: map[string]any{"issue_number": float64(42), "pinned": true},
+		},
+		{
+			name: "pinned issues limit reached",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockGraphQL(t,
+						issueIDExchange,
+						graphQLExchange{
+							query: "PinIssue(",
+							errors: []map[string]any{
+								{"type": "UNPROCESSABLE", "message": "You can't pin more than 3 issues"},
+							},
+						},
+						graphQLExchange{
+							query:             "PinnedIssues",
+							expectedVariables: map[string]any{"owner": "owner", "repo": "repo"},
+							data: map[string]any{
+								"repository": map[string]any{
+									"pinnedIssues": map[string]any{
+										"nodes": []map[string]any{
+											{"issue": map[string]any{"number": 1, "title": "Roadmap", "url": "https://github.com/owner/repo/issues/1"}},
+											{"issue": map[string]any{"number": 2, "title": "Release notes", "url": "https://github.com/owner/repo/issues/2"}},
+											{"issue": map[string]any{"number": 3, "title": "Known issues", "url": "https://github.com/owner/repo/issues/3"}},
+										},
+									},
+								},
+							},
+						},
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(42),
+			},
+			expectError: false,
+			expectedErrMsg: []string{
+				"failed to pin issue: You can't pin more than 3 issues; currently pinned issues:",
+				`{"number":1,"title":"Roadmap","url":"https://github.com/owner/repo/issues/1"}`,
+				`{"number":3,"title":"Known issues","url":"https://github.com/owner/repo/issues/3"}`,
+			},
+		},
+		{
+			name: "issue not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockGraphQL(t, graphQLExchange{
+						query: "IssueID",
+						data:  map[string]any{"repository": map[string]any{"issue": nil}},
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"issue_number": float64(999),
+			},
+			expectError:    false,
+			expectedErrMsg: []string{"failed to get issue: issue 999 not found"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := PinIssue(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if len(tc.expectedErrMsg) > 0 {
+				assert.True(t, result.IsError)
+				for _, msg := range tc.expectedErrMsg {
+					assert.Contains(t, textContent.Text, msg)
+				}
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedResult map[string]any
+			err = json.Unmarshal([]byte(textContent.Text), &returnedResult)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, returnedResult)
+		})
+	}
+}
+
+func Test_UnpinIssue(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := UnpinIssue(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "unpin_issue", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "issue_number"})
+
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			postGraphQL,
+			mockGraphQL(t,
+				graphQLExchange{
+					query: "IssueID",
+					data:  map[string]any{"repository": map[string]any{"issue": map[string]any{"id": "I_42"}}},
+				},
+				graphQLExchange{
+					query:             "UnpinIssue(",
+					expectedVariables: map[string]any{"issueId": "I_42"},
+					data: map[string]any{
+						"unpinIssue": map[string]any{"issue": map[string]any{"isPinned": false}},
+					},
+				},
+			),
+		),
+	)
+
+	client := github.NewClient(mockedClient)
+	_, handler := UnpinIssue(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner":        "owner",
+		"repo":         "repo",
+		"issue_number": float64(42),
+	}))
+	require.NoError(t, err)
+	textContent := getTextResult(t, result)
+
+	var returnedResult map[string]any
+	err = json.Unmarshal([]byte(textContent.Text), &returnedResult)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"issue_number": float64(42), "pinned": false}, returnedResult)
+}
+
+func Test_ListMyIssues(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListMyIssues(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_my_issues", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "filter")
+	assert.Contains(t, tool.InputSchema.Properties, "org")
+	assert.Contains(t, tool.InputSchema.Properties, "state")
+	assert.Contains(t, tool.InputSchema.Properties, "labels")
+	assert.Contains(t, tool.InputSchema.Properties, "since")
+	assert.Contains(t, tool.InputSchema.Properties, "sort")
+	assert.Contains(t, tool.InputSchema.Properties, "direction")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.Empty(t, tool.InputSchema.Required)
+
+	mockIssues := []*github.Issue{
+		{
+			Number:     github.Ptr(42),
+			Title:      github.Ptr("Fix the build"),
+			State:      github.Ptr("open"),
+			HTMLURL:    github.Ptr("https://github.com/owner/repo/issues/42"),
+			Labels:     []*github.Label{{Name: github.Ptr("bug")}},
+			Repository: &github.Repository{FullName: github.Ptr("owner/repo")},
+		},
+		{
+			Number:        github.Ptr(7),
+			Title:         github.Ptr("Write docs"),
+			State:         github.Ptr("open"),
+			HTMLURL:       github.Ptr("https://github.com/other/docs/issues/7"),
+			RepositoryURL: github.Ptr("https://api.github.com/repos/other/docs"),
+		},
+	}
+
+	expectedIssues := []userIssue{
+		{
+			Repository: "owner/repo",
+			issueSearchItem: issueSearchItem{
+				Number: 42,
+				Title:  "Fix the build",
+				State:  "open",
+				Labels: []string{"bug"},
+				URL:    "https://github.com/owner/repo/issues/42",
+			},
+		},
+		{
+			Repository: "other/docs",
+			issueSearchItem: issueSearchItem{
+				Number: 7,
+				Title:  "Write docs",
+				State:  "open",
+				Labels: []string{},
+				URL:    "https://github.com/other/docs/issues/7",
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedIssues []userIssue
+		expectedErrMsg string
+	}{
+		{
+			name: "list assigned issues across repositories",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetIssues,
+					expectQueryParams(t, map[string]string{
+						"filter":    "assigned",
+						"state":     "open",
+						"labels":    "bug,urgent",
+						"since":     "2024-01-01T00:00:00Z",
+						"sort":      "updated",
+						"direction": "desc",
+						"page":      "1",
+						"per_page":  "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockIssues),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"filter":    "assigned",
+				"state":     "open",
+				"labels":    []any{"bug", "urgent"},
+				"since":     "2024-01-01",
+				"sort":      "updated",
+				"direction": "desc",
+			},
+			expectError:    false,
+			expectedIssues: expectedIssues,
+		},
+		{
+			name: "list issues of an organization",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetOrgsIssuesByOrg,
+					expectQueryParams(t, map[string]string{
+						"filter":   "created",
+						"page":     "2",
+						"per_page": "10",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockIssues),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"filter":  "created",
+				"org":     "owner",
+				"page":    float64(2),
+				"perPage": float64(10),
+			},
+			expectError:    false,
+			expectedIssues: expectedIssues,
+		},
+		{
+			name:         "invalid since",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"since": "yesterday",
+			},
+			expectError:    false,
+			expectedErrMsg: "invalid since: invalid ISO 8601 timestamp",
+		},
+		{
+			name: "organization not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetOrgsIssuesByOrg,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"org": "missing",
+			},
+			expectError:    false,
+			expectedErrMsg: "failed to list issues: Not Found",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListMyIssues(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedIssues []userIssue
+			err = json.Unmarshal([]byte(textContent.Text), &returnedIssues)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedIssues, returnedIssues)
+		})
+	}
+}
+
+func Test_ListIssuesMentioningUser(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListIssuesMentioningUser(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_issues_mentioning_user", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "username")
+	assert.Contains(t, tool.InputSchema.Properties, "org")
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "state")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"username"})
+
+	mockSearchResult := &github.IssuesSearchResult{
+		Total:             github.Ptr(1),
+		IncompleteResults: github.Ptr(false),
+		Issues: []*github.Issue{
+			{
+				Number:        github.Ptr(42),
+				Title:         github.Ptr("Deploy fails"),
+				State:         github.Ptr("open"),
+				HTMLURL:       github.Ptr("https://github.com/org/repo/issues/42"),
+				RepositoryURL: github.Ptr("https://api.github.com/repos/org/repo"),
+			},
+		},
+	}
+
+	expectedItems := []userIssue{
+		{
+			Repository: "org/repo",
+			issueSearchItem: issueSearchItem{
+				Number: 42,
+				Title:  "Deploy fails",
+				State:  "open",
+				Labels: []string{},
+				URL:    "https://github.com/org/repo/issues/42",
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectedResult mentioningIssuesResult
+		expectedErrMsg string
+	}{
+		{
+			name: "issues mentioning user in an organization",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetSearchIssues,
+					expectQueryParams(t, map[string]string{
+						"q":        "mentions:octocat is:issue org:org state:open",
+						"page":     "2",
+						"per_page": "10",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockSearchResult),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"username": "octocat",
+				"org":      "org",
+				"state":    "open",
+				"page":     float64(2),
+				"perPage":  float64(10),
+			},
+			expectedResult: mentioningIssuesResult{
+				TotalCount: 1,
+				Items:      expectedItems,
+			},
+		},
+		{
+			name: "repository scope takes precedence over organization",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetSearchIssues,
+					expectQueryParams(t, map[string]string{
+						"q":        "mentions:octocat is:issue repo:org/repo",
+						"page":     "1",
+						"per_page": "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockSearchResult),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"username": "octocat",
+				"org":      "other",
+				"owner":    "org",
+				"repo":     "repo",
+			},
+			expectedResult: mentioningIssuesResult{
+				TotalCount: 1,
+				Items:      expectedItems,
+				Warnings:   []string{`org "other" was ignored because repo org/repo is more specific`},
+			},
+		},
+		{
+			name:         "repo without owner",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"username": "octocat",
+				"repo":     "repo",
+			},
+			expectedErrMsg: "owner and repo must be used together",
+		},
+		{
+			name: "search fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetSearchIssues,
+					mockResponse(t, http.StatusUnprocessableEntity, map[string]string{"message": "Validation Failed"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"username": "missing-user",
+			},
+			expectedErrMsg: "failed to search issues: Validation Failed",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListIssuesMentioningUser(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+			require.False(t, result.IsError, textContent.Text)
+
+			// Unmarshal and verify the result
+			var returnedResult mentioningIssuesResult
+			err = json.Unmarshal([]byte(textContent.Text), &returnedResult)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, returnedResult)
+		})
+	}
+}
+
+func Test_BulkUpdateIssues(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := BulkUpdateIssues(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "bulk_update_issues", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "issue_numbers")
+	assert.Contains(t, tool.InputSchema.Properties, "state")
+	assert.Contains(t, tool.InputSchema.Properties, "state_reason")
+	assert.Contains(t, tool.InputSchema.Properties, "labels")
+	assert.Contains(t, tool.InputSchema.Properties, "assignees")
+	assert.Contains(t, tool.InputSchema.Properties, "milestone")
+	assert.Contains(t, tool.InputSchema.Properties, "milestone_title")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "issue_numbers"})
+
+	// failIssue answers with an error for one issue and updates all others.
+	failIssue := func(number string, next http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			if strings.HasSuffix(r.URL.Path, "/issues/"+number) {
+				mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}).ServeHTTP(w, r)
+				return
+			}
+			next.ServeHTTP(w, r)
+		}
+	}
+
+	tooMany := make([]any, bulkUpdateLimit+1)
+	for i := range tooMany {
+		tooMany[i] = float64(i + 1)
+	}
+
+	tests := []struct {
+		name            string
+		mockedClient    *http.Client
+		requestArgs     map[string]interface{}
+		expectedResults []bulkIssueResult
+		expectedErrMsg  string
+	}{
+		{
+			name: "one issue fails mid-batch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposIssuesByOwnerByRepoByIssueNumber,
+					failIssue("2", expectRequestBody(t, map[string]any{
+						"state":        "closed",
+						"state_reason": "not_planned",
+						"labels":       []any{"stale"},
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.Issue{State: github.Ptr("closed")}),
+					)),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":         "owner",
+				"repo":          "repo",
+				"issue_numbers": []any{float64(1), float64(2), float64(3)},
+				"state":         "closed",
+				"state_reason":  "not_planned",
+				"labels":        []any{"stale"},
+			},
+			expectedResults: []bulkIssueResult{
+				{Number: 1, Status: "updated"},
+				{Number: 2, Status: "failed", Error: "Not Found"},
+				{Number: 3, Status: "updated"},
+			},
+		},
+		{
+			name: "resolve milestone title once",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposMilestonesByOwnerByRepo,
+					[]*github.Milestone{{Number: github.Ptr(3), Title: github.Ptr("v1.0")}},
+				),
+				mock.WithRequestMatchHandler(
+					mock.PatchReposIssuesByOwnerByRepoByIssueNumber,
+					expectRequestBody(t, map[string]any{
+						"milestone": float64(3),
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.Issue{}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":           "owner",
+				"repo":            "repo",
+				"issue_numbers":   []any{float64(4), float64(5)},
+				"milestone_title": "v1.0",
+			},
+			expectedResults: []bulkIssueResult{
+				{Number: 4, Status: "updated"},
+				{Number: 5, Status: "updated"},
+			},
+		},
+		{
+			name:         "too many issues",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":         "owner",
+				"repo":          "repo",
+				"issue_numbers": tooMany,
+				"state":         "closed",
+			},
+			expectedErrMsg: "at most 50 issues can be updated at once, got 51",
+		},
+		{
+			name:         "no issue numbers",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":         "owner",
+				"repo":          "repo",
+				"issue_numbers": []any{},
+				"state":         "closed",
+			},
+			expectedErrMsg: "missing required parameter: issue_numbers",
+		},
+		{
+			name:         "nothing to update",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":         "owner",
+				"repo":          "repo",
+				"issue_numbers": []any{float64(1), float64(2)},
+			},
+			expectedErrMsg: "at least one of state, labels, assignees, milestone, or milestone_title must be provided",
+		},
+		{
+			name: "duplicate issue numbers updated once",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposIssuesByOwnerByRepoByIssueNumber,
+					mockResponse(t, http.StatusOK, &github.Issue{State: github.Ptr("closed")}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":         "owner",
+				"repo":          "repo",
+				"issue_numbers": []any{float64(7), float64(8), float64(7)},
+				"state":         "closed",
+			},
+			expectedResults: []bulkIssueResult{
+				{Number: 7, Status: "updated"},
+				{Number: 8, Status: "updated"},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := BulkUpdateIssues(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedResults []bulkIssueResult
+			err = json.Unmarshal([]byte(textContent.Text), &returnedResults)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResults, returnedResults)
+		})
+	}
+}
+
+func Test_BulkUpdateIssues_SecondaryRateLimit(t *testing.T) {
+	var requests atomic.Int32
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.PatchReposIssuesByOwnerByRepoByIssueNumber,
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests.Add(1)
+				mockResponse(t, http.StatusForbidden, map[string]string{
+					"message":           "You have exceeded a secondary rate limit.",
+					"documentation_url": "https://docs.github.com/rest/overview/rate-limits-for-the-rest-api#about-secondary-rate-limits",
+				}).ServeHTTP(w, r)
+			}),
+		),
+	)
+
+	issueNumbers := make([]any, 3*bulkUpdateWorkers)
+	for i := range issueNumbers {
+		issueNumbers[i] = float64(i + 1)
+	}
+
+	client := github.NewClient(mockedClient)
+	_, handler := BulkUpdateIssues(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner":         "owner",
+		"repo":          "repo",
+		"issue_numbers": issueNumbers,
+		"state":         "closed",
+	}))
+	require.NoError(t, err)
+
+	var returnedResults []bulkIssueResult
+	err = json.Unmarshal([]byte(getTextResult(t, result).Text), &returnedResults)
+	require.NoError(t, err)
+	require.Len(t, returnedResults, len(issueNumbers))
+
+	// Each worker stops after its first rate limited request
+	assert.LessOrEqual(t, int(requests.Load()), bulkUpdateWorkers)
+	var failed int
+	for i, r := range returnedResults {
+		assert.Equal(t, i+1, r.Number)
+		switch r.Status {
+		case "failed":
+			failed++
+			assert.Contains(t, r.Error, "secondary rate limit exceeded")
+		case "skipped":
+			assert.Equal(t, "not attempted: secondary rate limit exceeded", r.Error)
+		default:
+			t.Errorf("unexpected status %q for issue %d", r.Status, r.Number)
+		}
+	}
+	assert.Equal(t, int(requests.Load()), failed)
+}
+
+func Test_BulkUpdateIssues_Canceled(t *testing.T) {
+	client := github.NewClient(mock.NewMockedHTTPClient())
+	_, handler := BulkUpdateIssues(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	result, err := handler(ctx, createMCPRequest(map[string]interface{}{
+		"owner":         "owner",
+		"repo":          "repo",
+		"issue_numbers": []any{float64(1), float64(2)},
+		"state":         "closed",
+	}))
+	require.NoError(t, err)
+
+	var returnedResults []bulkIssueResult
+	err = json.Unmarshal([]byte(getTextResult(t, result).Text), &returnedResults)
+	require.NoError(t, err)
+	assert.Equal(t, []bulkIssueResult{
+		{Number: 1, Status: "skipped", Error: "not attempted: context canceled"},
+		{Number: 2, Status: "skipped", Error: "not attempted: context canceled"},
+	}, returnedResults)
+}
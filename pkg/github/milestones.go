@@ -0,0 +1,382 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// milestoneLookupLimit bounds the number of milestones searched when resolving a milestone by title.
+const milestoneLookupLimit = 500
+
+// findMilestoneByTitle returns the number of the open or closed milestone with the given title,
+// preferring an exact match over a case-insensitive one. When no milestone matches, the number
+// is 0 and the titles of the available milestones are returned instead.
+func findMilestoneByTitle(ctx context.Context, client *github.Client, owner, repo, title string) (int, []string, error) {
+	opts := &github.MilestoneListOptions{
+		State:       "all",
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+	milestones, _, err := fetchAllPages(ctx, &opts.ListOptions, milestoneLookupLimit, func() ([]*github.Milestone, *github.Response, error) {
+		return client.Issues.ListMilestones(ctx, owner, repo, opts)
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+
+	number := 0
+	titles := make([]string, 0, len(milestones))
+	for _, milestone := range milestones {
+		switch {
+		case milestone.GetTitle() == title:
+			return milestone.GetNumber(), nil, nil
+		case number == 0 && strings.EqualFold(milestone.GetTitle(), title):
+			number = milestone.GetNumber()
+		}
+		titles = append(titles, milestone.GetTitle())
+	}
+	if number != 0 {
+		return number, nil, nil
+	}
+	return 0, titles, nil
+}
+
+// milestoneFields sets the optional description and due_on parameters of the request on milestone.
+// It reports whether any of them was provided.
+func milestoneFields(request mcp.CallToolRequest, milestone *github.Milestone) (bool, error) {
+	provided := false
+
+	if description, ok, err := OptionalParamOK[string](request, "description"); err != nil {
+		return false, err
+	} else if ok {
+		milestone.Description = github.Ptr(description)
+		provided = true
+	}
+
+	dueOn, err := OptionalParam[string](request, "due_on")
+	if err != nil {
+		return false, err
+	}
+	if dueOn != "" {
+		timestamp, err := parseISOTimestamp(dueOn)
+		if err != nil {
+			return false, fmt.Errorf("invalid due_on: %w", err)
+		}
+		milestone.DueOn = &github.Timestamp{Time: timestamp}
+		provided = true
+	}
+
+	return provided, nil
+}
+
+// ListMilestones creates a tool to list the milestones of a repository.
+func ListMilestones(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_milestones",
+			mcp.WithDescription(t("TOOL_LIST_MILESTONES_DESCRIPTION", "List the milestones of a GitHub repository")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("state",
+				mcp.Description("Filter by state"),
+				mcp.Enum("open", "closed", "all"),
+			),
+			mcp.WithString("sort",
+				mcp.Description("Sort by due date or by the share of closed issues"),
+				mcp.Enum("due_on", "completeness"),
+			),
+			mcp.WithString("direction",
+				mcp.Description("Sort direction"),
+				mcp.Enum("asc", "desc"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts := &github.MilestoneListOptions{}
+
+			opts.State, err = OptionalParam[string](request, "state")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			opts.Sort, err = OptionalParam[string](request, "sort")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			opts.Direction, err = OptionalParam[string](request, "direction")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			opts.ListOptions = github.ListOptions{
+				Page:    pagination.page,
+				PerPage: pagination.perPage,
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			milestones, resp, err := client.Issues.ListMilestones(ctx, owner, repo, opts)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list milestones: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list milestones: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(milestones)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// CreateMilestone creates a tool to create a milestone in a repository.
+func CreateMilestone(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_milestone",
+			mcp.WithDescription(t("TOOL_CREATE_MILESTONE_DESCRIPTION", "Create a milestone in a GitHub repository")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("title",
+				mcp.Required(),
+				mcp.Description("Milestone title"),
+			),
+			mcp.WithString("description",
+				mcp.Description("Milestone description"),
+			),
+			mcp.WithString("due_on",
+				mcp.Description("Due date (ISO 8601 timestamp or YYYY-MM-DD)"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			title, err := requiredParam[string](request, "title")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			milestone := &github.Milestone{
+				Title: github.Ptr(title),
+			}
+			if _, err := milestoneFields(request, milestone); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			createdMilestone, resp, err := client.Issues.CreateMilestone(ctx, owner, repo, milestone)
+			if err != nil {
+				if result := apiErrorResult("failed to create milestone", err, http.StatusNotFound, http.StatusForbidden, http.StatusUnprocessableEntity); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to create milestone: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusCreated {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to create milestone: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(createdMilestone)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// UpdateMilestone creates a tool to update a milestone in a repository.
+func UpdateMilestone(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("update_milestone",
+			mcp.WithDescription(t("TOOL_UPDATE_MILESTONE_DESCRIPTION", "Update a milestone in a GitHub repository")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("milestone_number",
+				mcp.Required(),
+				mcp.Description("Milestone number"),
+			),
+			mcp.WithString("title",
+				mcp.Description("New title"),
+			),
+			mcp.WithString("description",
+				mcp.Description("New description"),
+			),
+			mcp.WithString("due_on",
+				mcp.Description("New due date (ISO 8601 timestamp or YYYY-MM-DD)"),
+			),
+			mcp.WithString("state",
+				mcp.Description("New state"),
+				mcp.Enum("open", "closed"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			milestoneNumber, err := RequiredInt(request, "milestone_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			milestone := &github.Milestone{}
+			updated, err := milestoneFields(request, milestone)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			title, err := OptionalParam[string](request, "title")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if title != "" {
+				milestone.Title = github.Ptr(title)
+				updated = true
+			}
+
+			state, err := OptionalParam[string](request, "state")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if state != "" {
+				milestone.State = github.Ptr(state)
+				updated = true
+			}
+
+			if !updated {
+				return mcp.NewToolResultError("at least one of title, description, due_on, or state must be provided"), nil
+			}
+
+			return editMilestone(ctx, getClient, owner, repo, milestoneNumber, milestone)
+		}
+}
+
+// CloseMilestone creates a tool to close a milestone in a repository.
+func CloseMilestone(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("close_milestone",
+			mcp.WithDescription(t("TOOL_CLOSE_MILESTONE_DESCRIPTION", "Close a milestone in a GitHub repository")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("milestone_number",
+				mcp.Required(),
+				mcp.Description("Milestone number"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			milestoneNumber, err := RequiredInt(request, "milestone_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			return editMilestone(ctx, getClient, owner, repo, milestoneNumber, &github.Milestone{
+				State: github.Ptr("closed"),
+			})
+		}
+}
+
+// editMilestone applies the given changes to a milestone and returns the updated milestone.
+func editMilestone(ctx context.Context, getClient GetClientFn, owner, repo string, milestoneNumber int, milestone *github.Milestone) (*mcp.CallToolResult, error) {
+	client, err := getClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+	}
+	updatedMilestone, resp, err := client.Issues.EditMilestone(ctx, owner, repo, milestoneNumber, milestone)
+	if err != nil {
+		if result := apiErrorResult("failed to update milestone", err, http.StatusNotFound, http.StatusForbidden, http.StatusUnprocessableEntity); result != nil {
+			return result, nil
+		}
+		return nil, fmt.Errorf("failed to update milestone: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read response body: %w", err)
+		}
+		return mcp.NewToolResultError(fmt.Sprintf("failed to update milestone: %s", string(body))), nil
+	}
+
+	r, err := json.Marshal(updatedMilestone)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal response: %w", err)
+	}
+
+	return mcp.NewToolResultText(string(r)), nil
+}
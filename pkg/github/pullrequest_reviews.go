@@ -0,0 +1,1425 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// findPendingReview looks up the pending review of the authenticated user on a pull request.
+// It returns nil when the user has no pending review.
+func findPendingReview(ctx context.Context, client *github.Client, owner, repo string, pullNumber int) (*github.PullRequestReview, error) {
+	user, resp, err := client.Users.Get(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get authenticated user: %w", err)
+	}
+	_ = resp.Body.Close()
+
+	opts := &github.ListOptions{PerPage: 100}
+	reviews, _, err := fetchAllPages(ctx, opts, reviewListLimit, func() ([]*github.PullRequestReview, *github.Response, error) {
+		return client.PullRequests.ListReviews(ctx, owner, repo, pullNumber, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, review := range reviews {
+		if review.GetState() == "PENDING" && strings.EqualFold(review.GetUser().GetLogin(), user.GetLogin()) {
+			return review, nil
+		}
+	}
+	return nil, nil
+}
+
+// noPendingReviewResult is the tool result error for a pull request without a pending review
+// of the authenticated user.
+func noPendingReviewResult(message string, pullNumber int) *mcp.CallToolResult {
+	return mcp.NewToolResultError(fmt.Sprintf("%s: no pending review found on pull request #%d, create one with create_pending_pull_request_review first", message, pullNumber))
+}
+
+// marshalPullRequestReview trims a review down to a pullRequestReview and marshals it.
+func marshalPullRequestReview(review *github.PullRequestReview) (*mcp.CallToolResult, error) {
+	r, err := json.Marshal(pullRequestReview{
+		ID:          review.GetID(),
+		User:        review.GetUser().GetLogin(),
+		State:       review.GetState(),
+		Body:        review.GetBody(),
+		SubmittedAt: review.SubmittedAt,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal response: %w", err)
+	}
+
+	return mcp.NewToolResultText(string(r)), nil
+}
+
+// CreatePendingPullRequestReview creates a tool to start a pending review on a pull request, to
+// which comments can be added before it is submitted.
+func CreatePendingPullRequestReview(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_pending_pull_request_review",
+			mcp.WithDescription(t("TOOL_CREATE_PENDING_PULL_REQUEST_REVIEW_DESCRIPTION", "Start a pending review on a pull request. Add comments to it with add_comment_to_pending_review and submit it with submit_pending_pull_request_review")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+			mcp.WithString("commitId",
+				mcp.Description("SHA of commit to review, defaults to the latest commit"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			commitID, err := OptionalParam[string](request, "commitId")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			// A review without an event stays pending until it is submitted.
+			reviewRequest := &github.PullRequestReviewRequest{}
+			if commitID != "" {
+				reviewRequest.CommitID = github.Ptr(commitID)
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			review, resp, err := client.PullRequests.CreateReview(ctx, owner, repo, pullNumber, reviewRequest)
+			if err != nil {
+				if result := apiErrorResult("failed to create pending review", err, http.StatusNotFound, http.StatusUnprocessableEntity); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to create pending review: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return marshalPullRequestReview(review)
+		}
+}
+
+// pendingReviewComment is a comment added to a pending review.
+type pendingReviewComment struct {
+	ThreadID  string `json:"thread_id"`
+	CommentID int64  `json:"comment_id"`
+	URL       string `json:"url"`
+}
+
+// AddCommentToPendingReview creates a tool to add a comment to the pending review of the
+// authenticated user on a pull request.
+func AddCommentToPendingReview(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("add_comment_to_pending_review",
+			mcp.WithDescription(t("TOOL_ADD_COMMENT_TO_PENDING_REVIEW_DESCRIPTION", "Add a comment on a line, a range of lines, or a whole file to your pending review on a pull request")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+			mcp.WithString("path",
+				mcp.Required(),
+				mcp.Description("Path of the file to comment on"),
+			),
+			mcp.WithString("body",
+				mcp.Required(),
+				mcp.Description("Comment text"),
+			),
+			mcp.WithString("subject_type",
+				mcp.Description("Whether the comment is on lines or on the whole file ('line', 'file'), defaults to 'line'"),
+				mcp.Enum("line", "file"),
+			),
+			mcp.WithNumber("line",
+				mcp.Description("Line to comment on. For multi-line comments, the last line of the range. Required for line comments"),
+			),
+			mcp.WithString("side",
+				mcp.Description("Side of the diff of the line ('LEFT' for deletions, 'RIGHT' for additions and context), defaults to 'RIGHT'"),
+				mcp.Enum("LEFT", "RIGHT"),
+			),
+			mcp.WithNumber("start_line",
+				mcp.Description("First line of the range for multi-line comments"),
+			),
+			mcp.WithString("start_side",
+				mcp.Description("Side of the diff of the first line for multi-line comments, defaults to side"),
+				mcp.Enum("LEFT", "RIGHT"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			path, err := requiredParam[string](request, "path")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			body, err := requiredParam[string](request, "body")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			subjectType, err := OptionalParam[string](request, "subject_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			line, err := OptionalIntParam(request, "line")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			side, err := OptionalParam[string](request, "side")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			startLine, err := OptionalIntParam(request, "start_line")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			startSide, err := OptionalParam[string](request, "start_side")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			input := map[string]interface{}{
+				"path": path,
+				"body": body,
+			}
+			switch {
+			case subjectType == "file":
+				if line != 0 || startLine != 0 {
+					return mcp.NewToolResultError("line and start_line cannot be used for file comments"), nil
+				}
+				input["subjectType"] = "FILE"
+			case line == 0:
+				return mcp.NewToolResultError("line is required for line comments"), nil
+			case startLine != 0 && startLine >= line:
+				return mcp.NewToolResultError("start_line must be before line"), nil
+			default:
+				input["subjectType"] = "LINE"
+				input["line"] = line
+				if side != "" {
+					input["side"] = side
+				}
+				if startLine != 0 {
+					input["startLine"] = startLine
+					if startSide != "" {
+						input["startSide"] = startSide
+					}
+				}
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			review, err := findPendingReview(ctx, client, owner, repo, pullNumber)
+			if err != nil {
+				if result := apiErrorResult("failed to add review comment", err, http.StatusNotFound); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to add review comment: %w", err)
+			}
+			if review == nil {
+				return noPendingReviewResult("failed to add review comment", pullNumber), nil
+			}
+			input["pullRequestReviewId"] = review.GetNodeID()
+
+			var mutation struct {
+				AddPullRequestReviewThread struct {
+					Thread *struct {
+						ID       string `json:"id"`
+						Comments struct {
+							Nodes []struct {
+								DatabaseID int64  `json:"databaseId"`
+								URL        string `json:"url"`
+							} `json:"nodes"`
+						} `json:"comments"`
+					} `json:"thread"`
+				} `json:"addPullRequestReviewThread"`
+			}
+			err = executeGraphQL(ctx, client, addPullRequestReviewThreadMutation, map[string]interface{}{
+				"input": input,
+			}, &mutation)
+			if err != nil {
+				if result := graphQLErrorResult("failed to add review comment", err); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to add review comment: %w", err)
+			}
+			thread := mutation.AddPullRequestReviewThread.Thread
+			if thread == nil || len(thread.Comments.Nodes) == 0 {
+				return mcp.NewToolResultError("failed to add review comment: the comment could not be placed on the diff"), nil
+			}
+
+			r, err := json.Marshal(pendingReviewComment{
+				ThreadID:  thread.ID,
+				CommentID: thread.Comments.Nodes[0].DatabaseID,
+				URL:       thread.Comments.Nodes[0].URL,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// SubmitPendingPullRequestReview creates a tool to submit the pending review of the
+// authenticated user on a pull request.
+func SubmitPendingPullRequestReview(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("submit_pending_pull_request_review",
+			mcp.WithDescription(t("TOOL_SUBMIT_PENDING_PULL_REQUEST_REVIEW_DESCRIPTION", "Submit your pending review on a pull request")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+			mcp.WithString("event",
+				mcp.Required(),
+				mcp.Description("Review action ('APPROVE', 'REQUEST_CHANGES', 'COMMENT')"),
+				mcp.Enum("APPROVE", "REQUEST_CHANGES", "COMMENT"),
+			),
+			mcp.WithString("body",
+				mcp.Description("Review comment text"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			event, err := requiredParam[string](request, "event")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			body, err := OptionalParam[string](request, "body")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			reviewRequest := &github.PullRequestReviewRequest{
+				Event: github.Ptr(event),
+			}
+			if body != "" {
+				reviewRequest.Body = github.Ptr(body)
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			pending, err := findPendingReview(ctx, client, owner, repo, pullNumber)
+			if err != nil {
+				if result := apiErrorResult("failed to submit pending review", err, http.StatusNotFound); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to submit pending review: %w", err)
+			}
+			if pending == nil {
+				return noPendingReviewResult("failed to submit pending review", pullNumber), nil
+			}
+
+			review, resp, err := client.PullRequests.SubmitReview(ctx, owner, repo, pullNumber, pending.GetID(), reviewRequest)
+			if err != nil {
+				if result := apiErrorResult("failed to submit pending review", err, http.StatusUnprocessableEntity); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to submit pending review: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return marshalPullRequestReview(review)
+		}
+}
+
+// DeletePendingPullRequestReview creates a tool to abandon the pending review of the
+// authenticated user on a pull request, together with its comments.
+func DeletePendingPullRequestReview(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("delete_pending_pull_request_review",
+			mcp.WithDescription(t("TOOL_DELETE_PENDING_PULL_REQUEST_REVIEW_DESCRIPTION", "Delete your pending review on a pull request, together with its comments")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			pending, err := findPendingReview(ctx, client, owner, repo, pullNumber)
+			if err != nil {
+				if result := apiErrorResult("failed to delete pending review", err, http.StatusNotFound); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to delete pending review: %w", err)
+			}
+			if pending == nil {
+				return noPendingReviewResult("failed to delete pending review", pullNumber), nil
+			}
+
+			_, resp, err := client.PullRequests.DeletePendingReview(ctx, owner, repo, pullNumber, pending.GetID())
+			if err != nil {
+				if result := apiErrorResult("failed to delete pending review", err, http.StatusUnprocessableEntity); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to delete pending review: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			r, err := json.Marshal(map[string]interface{}{
+				"review_id": pending.GetID(),
+				"status":    "deleted",
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// CreateAndSubmitPullRequestReview creates a tool to create and submit a review on a pull
+// request, with inline comments, in a single call.
+func CreateAndSubmitPullRequestReview(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_and_submit_pull_request_review",
+			mcp.WithDescription(t("TOOL_CREATE_AND_SUBMIT_PULL_REQUEST_REVIEW_DESCRIPTION", "Create and submit a review on a pull request in a single call, optionally with comments on lines of the diff")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+			mcp.WithString("event",
+				mcp.Required(),
+				mcp.Description("Review action ('APPROVE', 'REQUEST_CHANGES', 'COMMENT')"),
+				mcp.Enum("APPROVE", "REQUEST_CHANGES", "COMMENT"),
+			),
+			mcp.WithString("body",
+				mcp.Description("Review comment text"),
+			),
+			mcp.WithArray("comments",
+				mcp.Items(
+					map[string]interface{}{
+						"type":                 "object",
+						"additionalProperties": false,
+						"required":             []string{"path", "line", "body"},
+						"properties": map[string]interface{}{
+							"path": map[string]interface{}{
+								"type":        "string",
+								"description": "path to the file",
+							},
+							"line": map[string]interface{}{
+								"type":        "number",
+								"description": "line number in the file to comment on",
+								"minimum":     1,
+							},
+							"side": map[string]interface{}{
+								"type":        "string",
+								"description": "side of the diff on which the line resides, defaults to RIGHT",
+								"enum":        []string{"LEFT", "RIGHT"},
+							},
+							"body": map[string]interface{}{
+								"type":        "string",
+								"description": "comment body",
+							},
+						},
+					},
+				),
+				mcp.Description("Comments on lines of the diff"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			event, err := requiredParam[string](request, "event")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			body, err := OptionalParam[string](request, "body")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			comments, err := reviewLineComments(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			reviewRequest := &github.PullRequestReviewRequest{
+				Event:    github.Ptr(event),
+				Comments: comments,
+			}
+			if body != "" {
+				reviewRequest.Body = github.Ptr(body)
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			review, resp, err := client.PullRequests.CreateReview(ctx, owner, repo, pullNumber, reviewRequest)
+			if err != nil {
+				result := apiErrorResult("failed to create pull request review", err, http.StatusNotFound, http.StatusUnprocessableEntity)
+				if result == nil {
+					return nil, fmt.Errorf("failed to create pull request review: %w", err)
+				}
+				// GitHub does not say which comment it rejected, so find the first one
+				// outside of the diff.
+				var errorResponse *github.ErrorResponse
+				if errors.As(err, &errorResponse) && errorResponse.Response.StatusCode == http.StatusUnprocessableEntity && len(comments) > 0 {
+					index, reason, err := findCommentOutsideDiff(ctx, client, owner, repo, pullNumber, comments)
+					if err != nil {
+						return nil, fmt.Errorf("failed to get pull request files: %w", err)
+					}
+					if index >= 0 {
+						return mcp.NewToolResultError(fmt.Sprintf("failed to create pull request review: %s; comments[%d]: %s",
+							apiErrorDetails(errorResponse), index, reason)), nil
+					}
+				}
+				return result, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return marshalPullRequestReview(review)
+		}
+}
+
+// reviewLineComments parses the comments parameter of create_and_submit_pull_request_review.
+func reviewLineComments(request mcp.CallToolRequest) ([]*github.DraftReviewComment, error) {
+	if _, ok := request.Params.Arguments["comments"]; !ok {
+		return nil, nil
+	}
+	items, ok := request.Params.Arguments["comments"].([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("parameter comments is not of type array")
+	}
+
+	comments := make([]*github.DraftReviewComment, 0, len(items))
+	for i, item := range items {
+		commentMap, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("comments[%d]: must be an object with path, line and body", i)
+		}
+		path, _ := commentMap["path"].(string)
+		if path == "" {
+			return nil, fmt.Errorf("comments[%d]: path is required", i)
+		}
+		body, _ := commentMap["body"].(string)
+		if body == "" {
+			return nil, fmt.Errorf("comments[%d]: body is required", i)
+		}
+		line, ok := commentMap["line"].(float64)
+		if !ok || line < 1 || line != float64(int(line)) {
+			return nil, fmt.Errorf("comments[%d]: line must be a positive integer", i)
+		}
+
+		comment := &github.DraftReviewComment{
+			Path: github.Ptr(path),
+			Body: github.Ptr(body),
+			Line: github.Ptr(int(line)),
+		}
+		if side, ok := commentMap["side"]; ok {
+			if side != "LEFT" && side != "RIGHT" {
+				return nil, fmt.Errorf("comments[%d]: side must be 'LEFT' or 'RIGHT'", i)
+			}
+			comment.Side = github.Ptr(side.(string))
+		}
+		comments = append(comments, comment)
+	}
+	return comments, nil
+}
+
+// diffHunkHeader matches the header of a hunk of a unified diff, capturing the start and
+// length of the hunk on the left and right side.
+var diffHunkHeader = regexp.MustCompile(`(?m)^@@ -(\d+)(?:,(\d+))? \+(\d+)(?:,(\d+))? @@`)
+
+// findCommentOutsideDiff returns the index of the first comment on a file or line that is not
+// part of the diff of a pull request, and why, or -1 when all comments are in the diff.
+func findCommentOutsideDiff(ctx context.Context, client *github.Client, owner, repo string, pullNumber int, comments []*github.DraftReviewComment) (int, string, error) {
+	opts := &github.ListOptions{PerPage: 100}
+	files, _, err := fetchAllPages(ctx, opts, pullRequestFileListLimit, func() ([]*github.CommitFile, *github.Response, error) {
+		return client.PullRequests.ListFiles(ctx, owner, repo, pullNumber, opts)
+	})
+	if err != nil {
+		return -1, "", err
+	}
+	patches := make(map[string]string, len(files))
+	for _, file := range files {
+		patches[file.GetFilename()] = file.GetPatch()
+	}
+
+	for i, comment := range comments {
+		patch, ok := patches[comment.GetPath()]
+		if !ok {
+			return i, fmt.Sprintf("%s is not changed in the pull request", comment.GetPath()), nil
+		}
+		// Without a patch, as for binary and very large files, the lines cannot be checked.
+		if patch == "" {
+			continue
+		}
+		side := comment.GetSide()
+		if side == "" {
+			side = "RIGHT"
+		}
+		if !diffContainsLine(patch, side, comment.GetLine()) {
+			return i, fmt.Sprintf("line %d of %s is not part of the diff on the %s side", comment.GetLine(), comment.GetPath(), side), nil
+		}
+	}
+	return -1, "", nil
+}
+
+// diffContainsLine reports whether a hunk of a patch covers a line on the given side.
+func diffContainsLine(patch, side string, line int) bool {
+	for _, match := range diffHunkHeader.FindAllStringSubmatch(patch, -1) {
+		start, length := match[3], match[4]
+		if side == "LEFT" {
+			start, length = match[1], match[2]
+		}
+		first, _ := strconv.Atoi(start)
+		count := 1
+		if length != "" {
+			count, _ = strconv.Atoi(length)
+		}
+		if line >= first && line < first+count {
+			return true
+		}
+	}
+	return false
+}
+
+// requestedReviewers are the users and teams requested to review a pull request.
+type requestedReviewers struct {
+	RequestedReviewers []string `json:"requested_reviewers"`
+	RequestedTeams     []string `json:"requested_teams"`
+}
+
+// newRequestedReviewers trims requested users and teams down to their logins and slugs.
+func newRequestedReviewers(users []*github.User, teams []*github.Team) requestedReviewers {
+	result := requestedReviewers{
+		RequestedReviewers: make([]string, 0, len(users)),
+		RequestedTeams:     make([]string, 0, len(teams)),
+	}
+	for _, user := range users {
+		result.RequestedReviewers = append(result.RequestedReviewers, user.GetLogin())
+	}
+	for _, team := range teams {
+		result.RequestedTeams = append(result.RequestedTeams, team.GetSlug())
+	}
+	return result
+}
+
+// reviewersRequestParams reads the reviewers and team_reviewers parameters, at least one of
+// which must be given.
+func reviewersRequestParams(request mcp.CallToolRequest) (github.ReviewersRequest, error) {
+	reviewers, err := OptionalStringArrayParam(request, "reviewers")
+	if err != nil {
+		return github.ReviewersRequest{}, err
+	}
+	teamReviewers, err := OptionalStringArrayParam(request, "team_reviewers")
+	if err != nil {
+		return github.ReviewersRequest{}, err
+	}
+	if len(reviewers) == 0 && len(teamReviewers) == 0 {
+		return github.ReviewersRequest{}, errors.New("at least one of reviewers or team_reviewers is required")
+	}
+	return github.ReviewersRequest{
+		Reviewers:     reviewers,
+		TeamReviewers: teamReviewers,
+	}, nil
+}
+
+// RequestReviewers creates a tool to request reviews of a pull request from users and teams.
+func RequestReviewers(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("request_reviewers",
+			mcp.WithDescription(t("TOOL_REQUEST_REVIEWERS_DESCRIPTION", "Request reviews of a pull request from users and teams")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+			mcp.WithArray("reviewers",
+				mcp.Description("Logins of users to request reviews from"),
+				mcp.Items(
+					map[string]interface{}{
+						"type": "string",
+					},
+				),
+			),
+			mcp.WithArray("team_reviewers",
+				mcp.Description("Slugs of teams to request reviews from"),
+				mcp.Items(
+					map[string]interface{}{
+						"type": "string",
+					},
+				),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			reviewersRequest, err := reviewersRequestParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			pr, resp, err := client.PullRequests.RequestReviewers(ctx, owner, repo, pullNumber, reviewersRequest)
+			if err != nil {
+				var errorResponse *github.ErrorResponse
+				if errors.As(err, &errorResponse) && errorResponse.Response.StatusCode == http.StatusUnprocessableEntity &&
+					strings.Contains(apiErrorDetails(errorResponse), "pull request author") {
+					return mcp.NewToolResultError("failed to request reviewers: the author of a pull request cannot review it, remove them from reviewers"), nil
+				}
+				if result := apiErrorResult("failed to request reviewers", err, http.StatusNotFound, http.StatusUnprocessableEntity); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to request reviewers: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			r, err := json.Marshal(newRequestedReviewers(pr.RequestedReviewers, pr.RequestedTeams))
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// RemoveRequestedReviewers creates a tool to withdraw review requests of a pull request from
+// users and teams.
+func RemoveRequestedReviewers(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("remove_requested_reviewers",
+			mcp.WithDescription(t("TOOL_REMOVE_REQUESTED_REVIEWERS_DESCRIPTION", "Remove requested reviewers from a pull request")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+			mcp.WithArray("reviewers",
+				mcp.Description("Logins of users to remove"),
+				mcp.Items(
+					map[string]interface{}{
+						"type": "string",
+					},
+				),
+			),
+			mcp.WithArray("team_reviewers",
+				mcp.Description("Slugs of teams to remove"),
+				mcp.Items(
+					map[string]interface{}{
+						"type": "string",
+					},
+				),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			reviewersRequest, err := reviewersRequestParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			resp, err := client.PullRequests.RemoveReviewers(ctx, owner, repo, pullNumber, reviewersRequest)
+			if err != nil {
+				if result := apiErrorResult("failed to remove requested reviewers", err, http.StatusNotFound, http.StatusUnprocessableEntity); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to remove requested reviewers: %w", err)
+			}
+			_ = resp.Body.Close()
+
+			// The removal does not return the pull request, so list the remaining requests.
+			reviewers, resp, err := client.PullRequests.ListReviewers(ctx, owner, repo, pullNumber, &github.ListOptions{PerPage: 100})
+			if err != nil {
+				return nil, fmt.Errorf("failed to list requested reviewers: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			r, err := json.Marshal(newRequestedReviewers(reviewers.Users, reviewers.Teams))
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// skippedReviewer is a reviewer whose review was not re-requested.
+type skippedReviewer struct {
+	Login  string `json:"login"`
+	Reason string `json:"reason"`
+}
+
+// reRequestedReviews are the reviewers whose reviews were re-requested on a pull request.
+type reRequestedReviews struct {
+	Requested []string          `json:"requested"`
+	Skipped   []skippedReviewer `json:"skipped"`
+	Note      string            `json:"note,omitempty"`
+}
+
+// ReRequestReview creates a tool to request new reviews from reviewers who requested changes
+// or whose reviews were dismissed.
+func ReRequestReview(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("re_request_review",
+			mcp.WithDescription(t("TOOL_RE_REQUEST_REVIEW_DESCRIPTION", "Request new reviews of a pull request from reviewers whose latest review requested changes or was dismissed, e.g. after pushing fixes")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+			mcp.WithArray("reviewers",
+				mcp.Description("Logins of the reviewers to re-request, defaults to all eligible reviewers"),
+				mcp.Items(
+					map[string]interface{}{
+						"type": "string",
+					},
+				),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			reviewers, err := OptionalStringArrayParam(request, "reviewers")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			pr, resp, err := client.PullRequests.Get(ctx, owner, repo, pullNumber)
+			if err != nil {
+				if result := apiErrorResult("failed to get pull request", err, http.StatusNotFound); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to get pull request: %w", err)
+			}
+			_ = resp.Body.Close()
+
+			opts := &github.ListOptions{PerPage: 100}
+			reviews, _, err := fetchAllPages(ctx, opts, reviewListLimit, func() ([]*github.PullRequestReview, *github.Response, error) {
+				return client.PullRequests.ListReviews(ctx, owner, repo, pullNumber, opts)
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to get pull request reviews: %w", err)
+			}
+			summary := summarizeReviews(reviews)
+
+			// Without explicit reviewers, consider everyone who reviewed.
+			if len(reviewers) == 0 {
+				for login := range summary.LatestStates {
+					reviewers = append(reviewers, login)
+				}
+				sort.Strings(reviewers)
+			}
+			// Logins are case-insensitive, so the states are looked up by lowercased login.
+			latestStates := make(map[string]string, len(summary.LatestStates))
+			for login, state := range summary.LatestStates {
+				latestStates[strings.ToLower(login)] = state
+			}
+			requested := make(map[string]bool, len(pr.RequestedReviewers))
+			for _, user := range pr.RequestedReviewers {
+				requested[strings.ToLower(user.GetLogin())] = true
+			}
+
+			result := reRequestedReviews{
+				Requested: []string{},
+				Skipped:   []skippedReviewer{},
+			}
+			for _, login := range reviewers {
+				var reason string
+				switch state := latestStates[strings.ToLower(login)]; {
+				case strings.EqualFold(login, pr.GetUser().GetLogin()):
+					reason = "author of the pull request"
+				case requested[strings.ToLower(login)]:
+					reason = "review already requested"
+				case state == "":
+					reason = "has not reviewed the pull request"
+				case state != "CHANGES_REQUESTED" && state != "DISMISSED":
+					reason = fmt.Sprintf("latest review is %s", state)
+				default:
+					result.Requested = append(result.Requested, login)
+					continue
+				}
+				result.Skipped = append(result.Skipped, skippedReviewer{Login: login, Reason: reason})
+			}
+
+			if len(result.Requested) == 0 {
+				result.Note = "no reviewers with changes requested or dismissed reviews to re-request"
+			} else {
+				_, resp, err := client.PullRequests.RequestReviewers(ctx, owner, repo, pullNumber, github.ReviewersRequest{
+					Reviewers: result.Requested,
+				})
+				if err != nil {
+					if result := apiErrorResult("failed to request reviewers", err, http.StatusUnprocessableEntity); result != nil {
+						return result, nil
+					}
+					return nil, fmt.Errorf("failed to request reviewers: %w", err)
+				}
+				_ = resp.Body.Close()
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// DismissPullRequestReview creates a tool to dismiss an approving or change requesting review
+// of a pull request.
+func DismissPullRequestReview(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("dismiss_pull_request_review",
+			mcp.WithDescription(t("TOOL_DISMISS_PULL_REQUEST_REVIEW_DESCRIPTION", "Dismiss an approving or change requesting review of a pull request")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+			mcp.WithNumber("review_id",
+				mcp.Required(),
+				mcp.Description("ID of the review to dismiss"),
+			),
+			mcp.WithString("message",
+				mcp.Required(),
+				mcp.Description("Reason for dismissing the review"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			reviewID, err := RequiredInt(request, "review_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			message, err := requiredParam[string](request, "message")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			review, resp, err := client.PullRequests.DismissReview(ctx, owner, repo, pullNumber, int64(reviewID), &github.PullRequestReviewDismissalRequest{
+				Message: github.Ptr(message),
+			})
+			if err != nil {
+				result := apiErrorResult("failed to dismiss review", err, http.StatusNotFound, http.StatusUnprocessableEntity)
+				if result == nil {
+					return nil, fmt.Errorf("failed to dismiss review: %w", err)
+				}
+				// GitHub does not say why a review cannot be dismissed, which usually is
+				// because of its state.
+				var errorResponse *github.ErrorResponse
+				if errors.As(err, &errorResponse) && errorResponse.Response.StatusCode == http.StatusUnprocessableEntity {
+					current, resp, err := client.PullRequests.GetReview(ctx, owner, repo, pullNumber, int64(reviewID))
+					if err == nil {
+						_ = resp.Body.Close()
+						if state := current.GetState(); state != "APPROVED" && state != "CHANGES_REQUESTED" {
+							return mcp.NewToolResultError(fmt.Sprintf("failed to dismiss review: review %d is %s, only APPROVED and CHANGES_REQUESTED reviews can be dismissed",
+								reviewID, state)), nil
+						}
+					}
+				}
+				return result, nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return marshalPullRequestReview(review)
+		}
+}
+
+// ReplyToReviewComment creates a tool to reply to a review comment thread on a pull request.
+func ReplyToReviewComment(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("reply_to_pull_request_review_comment",
+			mcp.WithDescription(t("TOOL_REPLY_TO_PULL_REQUEST_REVIEW_COMMENT_DESCRIPTION", "Reply to a review comment thread on a pull request")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+			mcp.WithNumber("comment_id",
+				mcp.Required(),
+				mcp.Description("ID of the review comment that starts the thread"),
+			),
+			mcp.WithString("body",
+				mcp.Required(),
+				mcp.Description("Reply text"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			commentID, err := RequiredInt(request, "comment_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			body, err := requiredParam[string](request, "body")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			comment, resp, err := replyToReviewComment(ctx, client, owner, repo, pullNumber, int64(commentID), body)
+			if err != nil {
+				// The comment is not found as well when it belongs to another pull request.
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("failed to reply to review comment: review comment %d not found on pull request #%d", commentID, pullNumber)), nil
+				}
+				if result := apiErrorResult("failed to reply to review comment", err, http.StatusUnprocessableEntity); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to reply to review comment: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			r, err := json.Marshal(comment)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// replyToReviewComment replies to a review comment thread through the replies endpoint, which
+// go-github does not provide.
+func replyToReviewComment(ctx context.Context, client *github.Client, owner, repo string, pullNumber int, commentID int64, body string) (*github.PullRequestComment, *github.Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/pulls/%d/comments/%d/replies", owner, repo, pullNumber, commentID)
+	req, err := client.NewRequest(http.MethodPost, u, map[string]string{"body": body})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	comment := new(github.PullRequestComment)
+	resp, err := client.Do(ctx, req, comment)
+	if err != nil {
+		return nil, resp, err
+	}
+	return comment, resp, nil
+}
+
+// suggestedChange is a review comment that suggests a change to a pull request.
+type suggestedChange struct {
+	ID      int64  `json:"id"`
+	HTMLURL string `json:"html_url"`
+}
+
+// AddSuggestedChange creates a tool to suggest a replacement for lines changed by a pull request.
+func AddSuggestedChange(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("add_suggested_change",
+			mcp.WithDescription(t("TOOL_ADD_SUGGESTED_CHANGE_DESCRIPTION", "Suggest a replacement for one or more lines of a pull request, which its author can apply with a click. The lines must be part of the diff")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+			mcp.WithString("path",
+				mcp.Required(),
+				mcp.Description("Path of the file to change"),
+			),
+			mcp.WithNumber("line",
+				mcp.Description("Line to replace, use start_line and end_line to replace a range of lines instead"),
+				mcp.Min(1),
+			),
+			mcp.WithNumber("start_line",
+				mcp.Description("First line of the range to replace"),
+				mcp.Min(1),
+			),
+			mcp.WithNumber("end_line",
+				mcp.Description("Last line of the range to replace"),
+				mcp.Min(1),
+			),
+			mcp.WithString("side",
+				mcp.Description("Side of the diff the lines are on, defaults to RIGHT"),
+				mcp.Enum("LEFT", "RIGHT"),
+			),
+			mcp.WithString("replacement",
+				mcp.Required(),
+				mcp.Description("Text that replaces the lines, without a suggestion block. An empty string deletes the lines"),
+			),
+			mcp.WithString("body",
+				mcp.Description("Explanation shown above the suggested change"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			path, err := requiredParam[string](request, "path")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			// An empty replacement is valid, it suggests deleting the lines.
+			replacement, ok := request.Params.Arguments["replacement"].(string)
+			if !ok {
+				return mcp.NewToolResultError("missing required parameter: replacement"), nil
+			}
+			body, err := OptionalParam[string](request, "body")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			side, err := OptionalParam[string](request, "side")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if side == "" {
+				side = "RIGHT"
+			}
+			startLine, endLine, err := suggestedLineRange(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			replacement = strings.TrimSuffix(replacement, "\n")
+			if replacementLines := strings.Count(replacement, "\n") + 1; startLine == endLine && replacementLines > 1 {
+				return mcp.NewToolResultError(fmt.Sprintf("replacement has %d lines but targets the single line %d, set start_line and end_line to the range of lines it replaces", replacementLines, endLine)), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			pr, resp, err := client.PullRequests.Get(ctx, owner, repo, pullNumber)
+			if err != nil {
+				if result := apiErrorResult("failed to get pull request", err, http.StatusNotFound); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to get pull request: %w", err)
+			}
+			_ = resp.Body.Close()
+
+			reason, err := findLineRangeOutsideDiff(ctx, client, owner, repo, pullNumber, path, side, startLine, endLine)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get pull request files: %w", err)
+			}
+			if reason != "" {
+				return mcp.NewToolResultError(fmt.Sprintf("cannot suggest a change: %s", reason)), nil
+			}
+
+			comment := &github.PullRequestComment{
+				Body:     github.Ptr(suggestionBody(body, replacement)),
+				CommitID: github.Ptr(pr.GetHead().GetSHA()),
+				Path:     github.Ptr(path),
+				Line:     github.Ptr(endLine),
+				Side:     github.Ptr(side),
+			}
+			if startLine != endLine {
+				comment.StartLine = github.Ptr(startLine)
+				comment.StartSide = github.Ptr(side)
+			}
+			created, resp, err := client.PullRequests.CreateComment(ctx, owner, repo, pullNumber, comment)
+			if err != nil {
+				if result := apiErrorResult("failed to create suggested change", err, http.StatusUnprocessableEntity); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to create suggested change: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			r, err := json.Marshal(suggestedChange{
+				ID:      created.GetID(),
+				HTMLURL: created.GetHTMLURL(),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// suggestedLineRange returns the first and last line a suggested change replaces, from either
+// the line parameter or the start_line and end_line parameters.
+func suggestedLineRange(request mcp.CallToolRequest) (int, int, error) {
+	line, err := OptionalIntParam(request, "line")
+	if err != nil {
+		return 0, 0, err
+	}
+	startLine, err := OptionalIntParam(request, "start_line")
+	if err != nil {
+		return 0, 0, err
+	}
+	endLine, err := OptionalIntParam(request, "end_line")
+	if err != nil {
+		return 0, 0, err
+	}
+
+	switch {
+	case line != 0 && (startLine != 0 || endLine != 0):
+		return 0, 0, errors.New("line cannot be combined with start_line and end_line")
+	case line != 0:
+		return line, line, nil
+	case startLine == 0 || endLine == 0:
+		return 0, 0, errors.New("either line or both start_line and end_line are required")
+	case startLine > endLine:
+		return 0, 0, fmt.Errorf("start_line %d is after end_line %d", startLine, endLine)
+	}
+	return startLine, endLine, nil
+}
+
+// findLineRangeOutsideDiff returns why a range of lines of a file is not part of the diff of a
+// pull request, or an empty string when all of it is.
+func findLineRangeOutsideDiff(ctx context.Context, client *github.Client, owner, repo string, pullNumber int, path, side string, startLine, endLine int) (string, error) {
+	opts := &github.ListOptions{PerPage: 100}
+	files, _, err := fetchAllPages(ctx, opts, pullRequestFileListLimit, func() ([]*github.CommitFile, *github.Response, error) {
+		return client.PullRequests.ListFiles(ctx, owner, repo, pullNumber, opts)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	for _, file := range files {
+		if file.GetFilename() != path {
+			continue
+		}
+		// Without a patch, as for binary and very large files, the lines cannot be checked.
+		if file.GetPatch() == "" {
+			return "", nil
+		}
+		for line := startLine; line <= endLine; line++ {
+			if !diffContainsLine(file.GetPatch(), side, line) {
+				return fmt.Sprintf("line %d of %s is not part of the diff on the %s side", line, path, side), nil
+			}
+		}
+		return "", nil
+	}
+	return fmt.Sprintf("%s is not changed in the pull request", path), nil
+}
+
+// suggestionBody formats a replacement as a suggestion block below an optional explanation. The
+// fence is made longer than any run of backticks in the replacement so it cannot end the block.
+func suggestionBody(body, replacement string) string {
+	fence := "```"
+	for strings.Contains(replacement, fence) {
+		fence += "`"
+	}
+
+	var b strings.Builder
+	if body != "" {
+		b.WriteString(body)
+		b.WriteString("\n\n")
+	}
+	b.WriteString(fence + "suggestion\n")
+	if replacement != "" {
+		b.WriteString(replacement + "\n")
+	}
+	b.WriteString(fence)
+	return b.String()
+}
+
+const addPullRequestReviewThreadMutation = `mutation AddPullRequestReviewThread($input: AddPullRequestReviewThreadInput!) {
+  addPullRequestReviewThread(input: $input) {
+    thread {
+      id
+      comments(first: 1) { nodes { databaseId url } }
+    }
+  }
+}`
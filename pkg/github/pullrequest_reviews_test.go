@@ -0,0 +1,1707 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pendingReviewMocks are the mocked requests that find the pending review of the authenticated
+// user "reviewer" among the given reviews.
+func pendingReviewMocks(reviews ...*github.PullRequestReview) []mock.MockBackendOption {
+	return []mock.MockBackendOption{
+		mock.WithRequestMatch(
+			mock.GetUser,
+			&github.User{Login: github.Ptr("reviewer")},
+		),
+		mock.WithRequestMatch(
+			mock.GetReposPullsReviewsByOwnerByRepoByPullNumber,
+			reviews,
+		),
+	}
+}
+
+func Test_CreatePendingPullRequestReview(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CreatePendingPullRequestReview(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "create_pending_pull_request_review", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
+	assert.Contains(t, tool.InputSchema.Properties, "commitId")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+
+	mockReview := &github.PullRequestReview{
+		ID:    github.Ptr(int64(301)),
+		State: github.Ptr("PENDING"),
+		User:  &github.User{Login: github.Ptr("reviewer")},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectedReview pullRequestReview
+		expectedErrMsg string
+	}{
+		{
+			name: "successful pending review creation",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposPullsReviewsByOwnerByRepoByPullNumber,
+					expectRequestBody(t, map[string]interface{}{
+						"commit_id": "abcdef123456",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockReview),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"commitId":   "abcdef123456",
+			},
+			expectedReview: pullRequestReview{ID: 301, User: "reviewer", State: "PENDING"},
+		},
+		{
+			name: "pending review already exists",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposPullsReviewsByOwnerByRepoByPullNumber,
+					mockResponse(t, http.StatusUnprocessableEntity, map[string]interface{}{
+						"message": "Unprocessable Entity",
+						"errors":  []string{"User can only have one pending review per pull request"},
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectedErrMsg: "failed to create pending review: Unprocessable Entity",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := CreatePendingPullRequestReview(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+			require.False(t, result.IsError, textContent.Text)
+
+			// Unmarshal and verify the result
+			var returnedReview pullRequestReview
+			err = json.Unmarshal([]byte(textContent.Text), &returnedReview)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedReview, returnedReview)
+		})
+	}
+}
+
+func Test_AddCommentToPendingReview(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := AddCommentToPendingReview(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "add_comment_to_pending_review", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
+	assert.Contains(t, tool.InputSchema.Properties, "path")
+	assert.Contains(t, tool.InputSchema.Properties, "body")
+	assert.Contains(t, tool.InputSchema.Properties, "subject_type")
+	assert.Contains(t, tool.InputSchema.Properties, "line")
+	assert.Contains(t, tool.InputSchema.Properties, "side")
+	assert.Contains(t, tool.InputSchema.Properties, "start_line")
+	assert.Contains(t, tool.InputSchema.Properties, "start_side")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber", "path", "body"})
+
+	submittedReview := &github.PullRequestReview{
+		ID:     github.Ptr(int64(300)),
+		NodeID: github.Ptr("PRR_submitted"),
+		State:  github.Ptr("COMMENTED"),
+		User:   &github.User{Login: github.Ptr("reviewer")},
+	}
+	pendingReview := &github.PullRequestReview{
+		ID:     github.Ptr(int64(301)),
+		NodeID: github.Ptr("PRR_pending"),
+		State:  github.Ptr("PENDING"),
+		User:   &github.User{Login: github.Ptr("reviewer")},
+	}
+	threadData := map[string]any{
+		"addPullRequestReviewThread": map[string]any{
+			"thread": map[string]any{
+				"id": "PRRT_1",
+				"comments": map[string]any{
+					"nodes": []any{
+						map[string]any{"databaseId": 401, "url": "https://github.com/owner/repo/pull/42#discussion_r401"},
+					},
+				},
+			},
+		},
+	}
+	expectedComment := pendingReviewComment{
+		ThreadID:  "PRRT_1",
+		CommentID: 401,
+		URL:       "https://github.com/owner/repo/pull/42#discussion_r401",
+	}
+
+	tests := []struct {
+		name            string
+		mockedClient    *http.Client
+		requestArgs     map[string]interface{}
+		expectedComment pendingReviewComment
+		expectedErrMsg  string
+	}{
+		{
+			name: "comment on a line",
+			mockedClient: mock.NewMockedHTTPClient(
+				append(pendingReviewMocks(submittedReview, pendingReview),
+					mock.WithRequestMatchHandler(
+						postGraphQL,
+						mockGraphQL(t, graphQLExchange{
+							query: "AddPullRequestReviewThread",
+							expectedVariables: map[string]any{
+								"input": map[string]any{
+									"pullRequestReviewId": "PRR_pending",
+									"path":                "main.go",
+									"body":                "Handle the error",
+									"subjectType":         "LINE",
+									"line":                float64(12),
+									"side":                "RIGHT",
+								},
+							},
+							data: threadData,
+						}),
+					),
+				)...,
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"path":       "main.go",
+				"body":       "Handle the error",
+				"line":       float64(12),
+				"side":       "RIGHT",
+			},
+			expectedComment: expectedComment,
+		},
+		{
+			name: "comment on a range of lines",
+			mockedClient: mock.NewMockedHTTPClient(
+				append(pendingReviewMocks(pendingReview),
+					mock.WithRequestMatchHandler(
+						postGraphQL,
+						mockGraphQL(t, graphQLExchange{
+							query: "AddPullRequestReviewThread",
+							expectedVariables: map[string]any{
+								"input": map[string]any{
+									"pullRequestReviewId": "PRR_pending",
+									"path":                "main.go",
+									"body":                "Extract a function",
+									"subjectType":         "LINE",
+									"line":                float64(20),
+									"startLine":           float64(10),
+									"startSide":           "LEFT",
+								},
+							},
+							data: threadData,
+						}),
+					),
+				)...,
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"path":       "main.go",
+				"body":       "Extract a function",
+				"line":       float64(20),
+				"start_line": float64(10),
+				"start_side": "LEFT",
+			},
+			expectedComment: expectedComment,
+		},
+		{
+			name: "comment on a file",
+			mockedClient: mock.NewMockedHTTPClient(
+				append(pendingReviewMocks(pendingReview),
+					mock.WithRequestMatchHandler(
+						postGraphQL,
+						mockGraphQL(t, graphQLExchange{
+							query: "AddPullRequestReviewThread",
+							expectedVariables: map[string]any{
+								"input": map[string]any{
+									"pullRequestReviewId": "PRR_pending",
+									"path":                "README.md",
+									"body":                "Needs a section on configuration",
+									"subjectType":         "FILE",
+								},
+							},
+							data: threadData,
+						}),
+					),
+				)...,
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"pullNumber":   float64(42),
+				"path":         "README.md",
+				"body":         "Needs a section on configuration",
+				"subject_type": "file",
+			},
+			expectedComment: expectedComment,
+		},
+		{
+			name:         "no pending review",
+			mockedClient: mock.NewMockedHTTPClient(pendingReviewMocks(submittedReview)...),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"path":       "main.go",
+				"body":       "Handle the error",
+				"line":       float64(12),
+			},
+			expectedErrMsg: "failed to add review comment: no pending review found on pull request #42, create one with create_pending_pull_request_review first",
+		},
+		{
+			name:         "line comment without line",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"path":       "main.go",
+				"body":       "Handle the error",
+			},
+			expectedErrMsg: "line is required for line comments",
+		},
+		{
+			name:         "range ending before it starts",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"path":       "main.go",
+				"body":       "Handle the error",
+				"line":       float64(10),
+				"start_line": float64(12),
+			},
+			expectedErrMsg: "start_line must be before line",
+		},
+		{
+			name: "line outside of the diff",
+			mockedClient: mock.NewMockedHTTPClient(
+				append(pendingReviewMocks(pendingReview),
+					mock.WithRequestMatchHandler(
+						postGraphQL,
+						mockGraphQL(t, graphQLExchange{
+							query: "AddPullRequestReviewThread",
+							data: map[string]any{
+								"addPullRequestReviewThread": map[string]any{"thread": nil},
+							},
+							errors: []map[string]any{
+								{"message": "Line could not be resolved"},
+							},
+						}),
+					),
+				)...,
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"path":       "main.go",
+				"body":       "Handle the error",
+				"line":       float64(999),
+			},
+			expectedErrMsg: "failed to add review comment: Line could not be resolved",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := AddCommentToPendingReview(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+			require.False(t, result.IsError, textContent.Text)
+
+			// Unmarshal and verify the result
+			var returnedComment pendingReviewComment
+			err = json.Unmarshal([]byte(textContent.Text), &returnedComment)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedComment, returnedComment)
+		})
+	}
+}
+
+func Test_SubmitPendingPullRequestReview(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := SubmitPendingPullRequestReview(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "submit_pending_pull_request_review", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
+	assert.Contains(t, tool.InputSchema.Properties, "event")
+	assert.Contains(t, tool.InputSchema.Properties, "body")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber", "event"})
+
+	pendingReview := &github.PullRequestReview{
+		ID:    github.Ptr(int64(301)),
+		State: github.Ptr("PENDING"),
+		User:  &github.User{Login: github.Ptr("reviewer")},
+	}
+	submittedReview := &github.PullRequestReview{
+		ID:    github.Ptr(int64(301)),
+		State: github.Ptr("APPROVED"),
+		Body:  github.Ptr("Looks good"),
+		User:  &github.User{Login: github.Ptr("reviewer")},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectedReview pullRequestReview
+		expectedErrMsg string
+	}{
+		{
+			name: "successful submission",
+			mockedClient: mock.NewMockedHTTPClient(
+				append(pendingReviewMocks(pendingReview),
+					mock.WithRequestMatchHandler(
+						mock.PostReposPullsReviewsEventsByOwnerByRepoByPullNumberByReviewId,
+						expectRequestBody(t, map[string]interface{}{
+							"event": "APPROVE",
+							"body":  "Looks good",
+						}).andThen(
+							mockResponse(t, http.StatusOK, submittedReview),
+						),
+					),
+				)...,
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"event":      "APPROVE",
+				"body":       "Looks good",
+			},
+			expectedReview: pullRequestReview{ID: 301, User: "reviewer", State: "APPROVED", Body: "Looks good"},
+		},
+		{
+			name:         "no pending review",
+			mockedClient: mock.NewMockedHTTPClient(pendingReviewMocks()...),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"event":      "APPROVE",
+			},
+			expectedErrMsg: "failed to submit pending review: no pending review found on pull request #42",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := SubmitPendingPullRequestReview(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+			require.False(t, result.IsError, textContent.Text)
+
+			// Unmarshal and verify the result
+			var returnedReview pullRequestReview
+			err = json.Unmarshal([]byte(textContent.Text), &returnedReview)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedReview, returnedReview)
+		})
+	}
+}
+
+func Test_DeletePendingPullRequestReview(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := DeletePendingPullRequestReview(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "delete_pending_pull_request_review", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+
+	pendingReview := &github.PullRequestReview{
+		ID:    github.Ptr(int64(301)),
+		State: github.Ptr("PENDING"),
+		User:  &github.User{Login: github.Ptr("reviewer")},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectedText   string
+		expectedErrMsg string
+	}{
+		{
+			name: "successful deletion",
+			mockedClient: mock.NewMockedHTTPClient(
+				append(pendingReviewMocks(pendingReview),
+					mock.WithRequestMatch(
+						mock.DeleteReposPullsReviewsByOwnerByRepoByPullNumberByReviewId,
+						pendingReview,
+					),
+				)...,
+			),
+			expectedText: `{"review_id":301,"status":"deleted"}`,
+		},
+		{
+			name:           "no pending review",
+			mockedClient:   mock.NewMockedHTTPClient(pendingReviewMocks()...),
+			expectedErrMsg: "failed to delete pending review: no pending review found on pull request #42",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := DeletePendingPullRequestReview(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			})
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+			require.False(t, result.IsError, textContent.Text)
+			assert.JSONEq(t, tc.expectedText, textContent.Text)
+		})
+	}
+}
+
+func Test_CreateAndSubmitPullRequestReview(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CreateAndSubmitPullRequestReview(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "create_and_submit_pull_request_review", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
+	assert.Contains(t, tool.InputSchema.Properties, "event")
+	assert.Contains(t, tool.InputSchema.Properties, "body")
+	assert.Contains(t, tool.InputSchema.Properties, "comments")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber", "event"})
+
+	mockReview := &github.PullRequestReview{
+		ID:    github.Ptr(int64(302)),
+		State: github.Ptr("CHANGES_REQUESTED"),
+		Body:  github.Ptr("A few things to fix"),
+		User:  &github.User{Login: github.Ptr("reviewer")},
+	}
+	mockFiles := []*github.CommitFile{
+		{
+			Filename: github.Ptr("main.go"),
+			Patch:    github.Ptr("@@ -10,4 +10,6 @@ func main() {\n context\n-old\n+new\n+more\n context\n@@ -40 +42,2 @@\n-x\n+y\n+z"),
+		},
+		{
+			Filename: github.Ptr("logo.png"),
+		},
+	}
+	unprocessable := mockResponse(t, http.StatusUnprocessableEntity, map[string]interface{}{
+		"message": "Unprocessable Entity",
+		"errors":  []string{"Line could not be resolved"},
+	})
+	comments := func(lines ...interface{}) []interface{} {
+		var result []interface{}
+		for i := 0; i < len(lines); i += 2 {
+			result = append(result, map[string]interface{}{
+				"path": lines[i],
+				"line": lines[i+1],
+				"body": "Comment",
+			})
+		}
+		return result
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectedReview pullRequestReview
+		expectedErrMsg string
+	}{
+		{
+			name: "review with comments",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposPullsReviewsByOwnerByRepoByPullNumber,
+					expectRequestBody(t, map[string]interface{}{
+						"event": "REQUEST_CHANGES",
+						"body":  "A few things to fix",
+						"comments": []interface{}{
+							map[string]interface{}{"path": "main.go", "line": float64(12), "body": "Handle the error"},
+							map[string]interface{}{"path": "main.go", "line": float64(11), "side": "LEFT", "body": "Why was this removed?"},
+						},
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockReview),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"event":      "REQUEST_CHANGES",
+				"body":       "A few things to fix",
+				"comments": []interface{}{
+					map[string]interface{}{"path": "main.go", "line": float64(12), "body": "Handle the error"},
+					map[string]interface{}{"path": "main.go", "line": float64(11), "side": "LEFT", "body": "Why was this removed?"},
+				},
+			},
+			expectedReview: pullRequestReview{ID: 302, User: "reviewer", State: "CHANGES_REQUESTED", Body: "A few things to fix"},
+		},
+		{
+			name: "comment without line",
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"event":      "COMMENT",
+				"comments": []interface{}{
+					map[string]interface{}{"path": "main.go", "line": float64(12), "body": "Handle the error"},
+					map[string]interface{}{"path": "main.go", "body": "Where?"},
+				},
+			},
+			expectedErrMsg: "comments[1]: line must be a positive integer",
+		},
+		{
+			name: "comment on a file outside of the pull request",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(mock.PostReposPullsReviewsByOwnerByRepoByPullNumber, unprocessable),
+				mock.WithRequestMatch(mock.GetReposPullsFilesByOwnerByRepoByPullNumber, mockFiles),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"event":      "COMMENT",
+				"comments":   comments("logo.png", float64(1), "main.go", float64(43), "util.go", float64(3)),
+			},
+			expectedErrMsg: "failed to create pull request review: Unprocessable Entity; Line could not be resolved; comments[2]: util.go is not changed in the pull request",
+		},
+		{
+			name: "comment on a line outside of the diff",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(mock.PostReposPullsReviewsByOwnerByRepoByPullNumber, unprocessable),
+				mock.WithRequestMatch(mock.GetReposPullsFilesByOwnerByRepoByPullNumber, mockFiles),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"event":      "COMMENT",
+				"comments":   comments("main.go", float64(15), "main.go", float64(30)),
+			},
+			expectedErrMsg: "comments[1]: line 30 of main.go is not part of the diff on the RIGHT side",
+		},
+		{
+			name: "unprocessable review without comments",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposPullsReviewsByOwnerByRepoByPullNumber,
+					mockResponse(t, http.StatusUnprocessableEntity, map[string]interface{}{
+						"message": "Unprocessable Entity",
+						"errors":  []string{"Can not approve your own pull request"},
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"event":      "APPROVE",
+			},
+			expectedErrMsg: "failed to create pull request review: Unprocessable Entity; Can not approve your own pull request",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := CreateAndSubmitPullRequestReview(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+			require.False(t, result.IsError, textContent.Text)
+
+			// Unmarshal and verify the result
+			var returnedReview pullRequestReview
+			err = json.Unmarshal([]byte(textContent.Text), &returnedReview)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedReview, returnedReview)
+		})
+	}
+}
+
+func Test_RequestReviewers(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := RequestReviewers(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "request_reviewers", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
+	assert.Contains(t, tool.InputSchema.Properties, "reviewers")
+	assert.Contains(t, tool.InputSchema.Properties, "team_reviewers")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+
+	mockPR := &github.PullRequest{
+		Number:             github.Ptr(42),
+		RequestedReviewers: []*github.User{{Login: github.Ptr("alice")}, {Login: github.Ptr("bob")}},
+		RequestedTeams:     []*github.Team{{Slug: github.Ptr("maintainers")}},
+	}
+
+	tests := []struct {
+		name             string
+		mockedClient     *http.Client
+		requestArgs      map[string]interface{}
+		expectedResponse requestedReviewers
+		expectedErrMsg   string
+	}{
+		{
+			name: "request users and teams",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposPullsRequestedReviewersByOwnerByRepoByPullNumber,
+					expectRequestBody(t, map[string]interface{}{
+						"reviewers":      []interface{}{"bob"},
+						"team_reviewers": []interface{}{"maintainers"},
+					}).andThen(
+						mockResponse(t, http.StatusCreated, mockPR),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":          "owner",
+				"repo":           "repo",
+				"pullNumber":     float64(42),
+				"reviewers":      []interface{}{"bob"},
+				"team_reviewers": []interface{}{"maintainers"},
+			},
+			expectedResponse: requestedReviewers{
+				RequestedReviewers: []string{"alice", "bob"},
+				RequestedTeams:     []string{"maintainers"},
+			},
+		},
+		{
+			name: "request users only",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposPullsRequestedReviewersByOwnerByRepoByPullNumber,
+					expectRequestBody(t, map[string]interface{}{
+						"reviewers": []interface{}{"alice", "bob"},
+					}).andThen(
+						mockResponse(t, http.StatusCreated, &github.PullRequest{
+							RequestedReviewers: mockPR.RequestedReviewers,
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"reviewers":  []interface{}{"alice", "bob"},
+			},
+			expectedResponse: requestedReviewers{
+				RequestedReviewers: []string{"alice", "bob"},
+				RequestedTeams:     []string{},
+			},
+		},
+		{
+			name: "request teams only",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposPullsRequestedReviewersByOwnerByRepoByPullNumber,
+					expectRequestBody(t, map[string]interface{}{
+						"team_reviewers": []interface{}{"maintainers"},
+					}).andThen(
+						mockResponse(t, http.StatusCreated, &github.PullRequest{
+							RequestedTeams: mockPR.RequestedTeams,
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":          "owner",
+				"repo":           "repo",
+				"pullNumber":     float64(42),
+				"team_reviewers": []interface{}{"maintainers"},
+			},
+			expectedResponse: requestedReviewers{
+				RequestedReviewers: []string{},
+				RequestedTeams:     []string{"maintainers"},
+			},
+		},
+		{
+			name:         "no reviewers",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectedErrMsg: "at least one of reviewers or team_reviewers is required",
+		},
+		{
+			name: "request the author",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposPullsRequestedReviewersByOwnerByRepoByPullNumber,
+					mockResponse(t, http.StatusUnprocessableEntity, map[string]interface{}{
+						"message": "Review cannot be requested from pull request author.",
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"reviewers":  []interface{}{"author"},
+			},
+			expectedErrMsg: "failed to request reviewers: the author of a pull request cannot review it",
+		},
+		{
+			name: "unknown reviewer",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposPullsRequestedReviewersByOwnerByRepoByPullNumber,
+					mockResponse(t, http.StatusUnprocessableEntity, map[string]interface{}{
+						"message": "Reviews may only be requested from collaborators. One or more of the users or teams you specified is not a collaborator of the owner/repo repository.",
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"reviewers":  []interface{}{"stranger"},
+			},
+			expectedErrMsg: "failed to request reviewers: Reviews may only be requested from collaborators",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := RequestReviewers(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+			require.False(t, result.IsError, textContent.Text)
+
+			// Unmarshal and verify the result
+			var returned requestedReviewers
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResponse, returned)
+		})
+	}
+}
+
+func Test_RemoveRequestedReviewers(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := RemoveRequestedReviewers(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "remove_requested_reviewers", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
+	assert.Contains(t, tool.InputSchema.Properties, "reviewers")
+	assert.Contains(t, tool.InputSchema.Properties, "team_reviewers")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+
+	tests := []struct {
+		name             string
+		mockedClient     *http.Client
+		requestArgs      map[string]interface{}
+		expectedResponse requestedReviewers
+		expectedErrMsg   string
+	}{
+		{
+			name: "remove a team",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.DeleteReposPullsRequestedReviewersByOwnerByRepoByPullNumber,
+					expectRequestBody(t, map[string]interface{}{
+						"reviewers":      []interface{}{},
+						"team_reviewers": []interface{}{"maintainers"},
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.PullRequest{}),
+					),
+				),
+				mock.WithRequestMatch(
+					mock.GetReposPullsRequestedReviewersByOwnerByRepoByPullNumber,
+					&github.Reviewers{
+						Users: []*github.User{{Login: github.Ptr("alice")}},
+					},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":          "owner",
+				"repo":           "repo",
+				"pullNumber":     float64(42),
+				"team_reviewers": []interface{}{"maintainers"},
+			},
+			expectedResponse: requestedReviewers{
+				RequestedReviewers: []string{"alice"},
+				RequestedTeams:     []string{},
+			},
+		},
+		{
+			name:         "no reviewers",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectedErrMsg: "at least one of reviewers or team_reviewers is required",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := RemoveRequestedReviewers(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+			require.False(t, result.IsError, textContent.Text)
+
+			// Unmarshal and verify the result
+			var returned requestedReviewers
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResponse, returned)
+		})
+	}
+}
+
+func Test_ReRequestReview(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ReRequestReview(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "re_request_review", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
+	assert.Contains(t, tool.InputSchema.Properties, "reviewers")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+
+	mockPR := &github.PullRequest{
+		Number:             github.Ptr(42),
+		User:               &github.User{Login: github.Ptr("author")},
+		RequestedReviewers: []*github.User{{Login: github.Ptr("dave")}},
+	}
+	mockReviews := []*github.PullRequestReview{
+		{User: &github.User{Login: github.Ptr("alice")}, State: github.Ptr("CHANGES_REQUESTED")},
+		{User: &github.User{Login: github.Ptr("bob")}, State: github.Ptr("APPROVED")},
+		{User: &github.User{Login: github.Ptr("carol")}, State: github.Ptr("DISMISSED")},
+		{User: &github.User{Login: github.Ptr("dave")}, State: github.Ptr("CHANGES_REQUESTED")},
+		{User: &github.User{Login: github.Ptr("author")}, State: github.Ptr("COMMENTED")},
+	}
+
+	tests := []struct {
+		name             string
+		mockedClient     *http.Client
+		requestArgs      map[string]interface{}
+		expectedResponse reRequestedReviews
+		expectedErrMsg   string
+	}{
+		{
+			name: "re-request all eligible reviewers",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					mockPR,
+				),
+				mock.WithRequestMatch(
+					mock.GetReposPullsReviewsByOwnerByRepoByPullNumber,
+					mockReviews,
+				),
+				mock.WithRequestMatchHandler(
+					mock.PostReposPullsRequestedReviewersByOwnerByRepoByPullNumber,
+					expectRequestBody(t, map[string]interface{}{
+						"reviewers": []interface{}{"alice", "carol"},
+					}).andThen(
+						mockResponse(t, http.StatusCreated, mockPR),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectedResponse: reRequestedReviews{
+				Requested: []string{"alice", "carol"},
+				Skipped: []skippedReviewer{
+					{Login: "author", Reason: "author of the pull request"},
+					{Login: "bob", Reason: "latest review is APPROVED"},
+					{Login: "dave", Reason: "review already requested"},
+				},
+			},
+		},
+		{
+			name: "re-request specific reviewers",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					mockPR,
+				),
+				mock.WithRequestMatch(
+					mock.GetReposPullsReviewsByOwnerByRepoByPullNumber,
+					mockReviews,
+				),
+				mock.WithRequestMatchHandler(
+					mock.PostReposPullsRequestedReviewersByOwnerByRepoByPullNumber,
+					expectRequestBody(t, map[string]interface{}{
+						"reviewers": []interface{}{"carol"},
+					}).andThen(
+						mockResponse(t, http.StatusCreated, mockPR),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"reviewers":  []interface{}{"carol", "erin"},
+			},
+			expectedResponse: reRequestedReviews{
+				Requested: []string{"carol"},
+				Skipped: []skippedReviewer{
+					{Login: "erin", Reason: "has not reviewed the pull request"},
+				},
+			},
+		},
+		{
+			name: "lowercase review states",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					mockPR,
+				),
+				mock.WithRequestMatch(
+					mock.GetReposPullsReviewsByOwnerByRepoByPullNumber,
+					[]*github.PullRequestReview{
+						{User: &github.User{Login: github.Ptr("alice")}, State: github.Ptr("changes_requested")},
+						{User: &github.User{Login: github.Ptr("alice")}, State: github.Ptr("commented")},
+						{User: &github.User{Login: github.Ptr("bob")}, State: github.Ptr("approved")},
+					},
+				),
+				mock.WithRequestMatchHandler(
+					mock.PostReposPullsRequestedReviewersByOwnerByRepoByPullNumber,
+					expectRequestBody(t, map[string]interface{}{
+						"reviewers": []interface{}{"alice"},
+					}).andThen(
+						mockResponse(t, http.StatusCreated, mockPR),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectedResponse: reRequestedReviews{
+				Requested: []string{"alice"},
+				Skipped: []skippedReviewer{
+					{Login: "bob", Reason: "latest review is APPROVED"},
+				},
+			},
+		},
+		{
+			name: "reviewers spelled in another case",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					mockPR,
+				),
+				mock.WithRequestMatch(
+					mock.GetReposPullsReviewsByOwnerByRepoByPullNumber,
+					mockReviews,
+				),
+				mock.WithRequestMatchHandler(
+					mock.PostReposPullsRequestedReviewersByOwnerByRepoByPullNumber,
+					expectRequestBody(t, map[string]interface{}{
+						"reviewers": []interface{}{"Alice"},
+					}).andThen(
+						mockResponse(t, http.StatusCreated, mockPR),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"reviewers":  []interface{}{"Alice", "BOB"},
+			},
+			expectedResponse: reRequestedReviews{
+				Requested: []string{"Alice"},
+				Skipped: []skippedReviewer{
+					{Login: "BOB", Reason: "latest review is APPROVED"},
+				},
+			},
+		},
+		{
+			name: "no eligible reviewers",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					mockPR,
+				),
+				mock.WithRequestMatch(
+					mock.GetReposPullsReviewsByOwnerByRepoByPullNumber,
+					[]*github.PullRequestReview{
+						{User: &github.User{Login: github.Ptr("bob")}, State: github.Ptr("APPROVED")},
+					},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectedResponse: reRequestedReviews{
+				Requested: []string{},
+				Skipped: []skippedReviewer{
+					{Login: "bob", Reason: "latest review is APPROVED"},
+				},
+				Note: "no reviewers with changes requested or dismissed reviews to re-request",
+			},
+		},
+		{
+			name: "pull request not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(999),
+			},
+			expectedErrMsg: "failed to get pull request",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ReRequestReview(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+			require.False(t, result.IsError, textContent.Text)
+
+			// Unmarshal and verify the result
+			var returned reRequestedReviews
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResponse, returned)
+		})
+	}
+}
+
+func Test_DismissPullRequestReview(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := DismissPullRequestReview(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "dismiss_pull_request_review", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
+	assert.Contains(t, tool.InputSchema.Properties, "review_id")
+	assert.Contains(t, tool.InputSchema.Properties, "message")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber", "review_id", "message"})
+
+	dismissedReview := &github.PullRequestReview{
+		ID:    github.Ptr(int64(303)),
+		State: github.Ptr("DISMISSED"),
+		Body:  github.Ptr("LGTM"),
+		User:  &github.User{Login: github.Ptr("reviewer")},
+	}
+	unprocessable := mockResponse(t, http.StatusUnprocessableEntity, map[string]interface{}{
+		"message": "Unprocessable Entity",
+	})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectedReview pullRequestReview
+		expectedErrMsg string
+	}{
+		{
+			name: "successful dismissal",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposPullsReviewsDismissalsByOwnerByRepoByPullNumberByReviewId,
+					expectRequestBody(t, map[string]interface{}{
+						"message": "Outdated after the latest push",
+					}).andThen(
+						mockResponse(t, http.StatusOK, dismissedReview),
+					),
+				),
+			),
+			expectedReview: pullRequestReview{ID: 303, User: "reviewer", State: "DISMISSED", Body: "LGTM"},
+		},
+		{
+			name: "review that cannot be dismissed",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(mock.PutReposPullsReviewsDismissalsByOwnerByRepoByPullNumberByReviewId, unprocessable),
+				mock.WithRequestMatch(
+					mock.GetReposPullsReviewsByOwnerByRepoByPullNumberByReviewId,
+					&github.PullRequestReview{ID: github.Ptr(int64(303)), State: github.Ptr("COMMENTED")},
+				),
+			),
+			expectedErrMsg: "failed to dismiss review: review 303 is COMMENTED, only APPROVED and CHANGES_REQUESTED reviews can be dismissed",
+		},
+		{
+			name: "dismissal rejected for another reason",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(mock.PutReposPullsReviewsDismissalsByOwnerByRepoByPullNumberByReviewId, unprocessable),
+				mock.WithRequestMatch(
+					mock.GetReposPullsReviewsByOwnerByRepoByPullNumberByReviewId,
+					&github.PullRequestReview{ID: github.Ptr(int64(303)), State: github.Ptr("APPROVED")},
+				),
+			),
+			expectedErrMsg: "failed to dismiss review: Unprocessable Entity",
+		},
+		{
+			name: "review not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposPullsReviewsDismissalsByOwnerByRepoByPullNumberByReviewId,
+					mockResponse(t, http.StatusNotFound, map[string]interface{}{"message": "Not Found"}),
+				),
+			),
+			expectedErrMsg: "failed to dismiss review: Not Found",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := DismissPullRequestReview(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"review_id":  float64(303),
+				"message":    "Outdated after the latest push",
+			})
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+			require.False(t, result.IsError, textContent.Text)
+
+			// Unmarshal and verify the result
+			var returnedReview pullRequestReview
+			err = json.Unmarshal([]byte(textContent.Text), &returnedReview)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedReview, returnedReview)
+		})
+	}
+}
+
+func Test_ReplyToReviewComment(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ReplyToReviewComment(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "reply_to_pull_request_review_comment", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
+	assert.Contains(t, tool.InputSchema.Properties, "comment_id")
+	assert.Contains(t, tool.InputSchema.Properties, "body")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber", "comment_id", "body"})
+
+	mockReply := &github.PullRequestComment{
+		ID:        github.Ptr(int64(402)),
+		InReplyTo: github.Ptr(int64(401)),
+		Body:      github.Ptr("Fixed in the latest commit"),
+		Path:      github.Ptr("main.go"),
+		User:      &github.User{Login: github.Ptr("author")},
+		HTMLURL:   github.Ptr("https://github.com/owner/repo/pull/42#discussion_r402"),
+	}
+
+	tests := []struct {
+		name            string
+		mockedClient    *http.Client
+		expectedComment *github.PullRequestComment
+		expectedErrMsg  string
+	}{
+		{
+			name: "successful reply",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposPullsCommentsRepliesByOwnerByRepoByPullNumberByCommentId,
+					expectRequestBody(t, map[string]interface{}{
+						"body": "Fixed in the latest commit",
+					}).andThen(
+						mockResponse(t, http.StatusCreated, mockReply),
+					),
+				),
+			),
+			expectedComment: mockReply,
+		},
+		{
+			name: "comment on another pull request",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposPullsCommentsRepliesByOwnerByRepoByPullNumberByCommentId,
+					mockResponse(t, http.StatusNotFound, map[string]interface{}{"message": "Not Found"}),
+				),
+			),
+			expectedErrMsg: "failed to reply to review comment: review comment 401 not found on pull request #42",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ReplyToReviewComment(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"comment_id": float64(401),
+				"body":       "Fixed in the latest commit",
+			})
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+			require.False(t, result.IsError, textContent.Text)
+
+			// Unmarshal and verify the result
+			var returnedComment github.PullRequestComment
+			err = json.Unmarshal([]byte(textContent.Text), &returnedComment)
+			require.NoError(t, err)
+			assert.Equal(t, *tc.expectedComment, returnedComment)
+			assert.Contains(t, textContent.Text, `"in_reply_to_id":401`)
+		})
+	}
+}
+
+func Test_AddSuggestedChange(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := AddSuggestedChange(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "add_suggested_change", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
+	assert.Contains(t, tool.InputSchema.Properties, "path")
+	assert.Contains(t, tool.InputSchema.Properties, "line")
+	assert.Contains(t, tool.InputSchema.Properties, "start_line")
+	assert.Contains(t, tool.InputSchema.Properties, "end_line")
+	assert.Contains(t, tool.InputSchema.Properties, "side")
+	assert.Contains(t, tool.InputSchema.Properties, "replacement")
+	assert.Contains(t, tool.InputSchema.Properties, "body")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber", "path", "replacement"})
+
+	mockPR := &github.PullRequest{
+		Number: github.Ptr(42),
+		Head:   &github.PullRequestBranch{SHA: github.Ptr("abcd1234")},
+	}
+	mockFiles := []*github.CommitFile{
+		{
+			Filename: github.Ptr("main.go"),
+			Patch:    github.Ptr("@@ -10,3 +10,4 @@\n context\n-old\n+new\n+added\n context"),
+		},
+	}
+	mockComment := &github.PullRequestComment{
+		ID:      github.Ptr(int64(501)),
+		HTMLURL: github.Ptr("https://github.com/owner/repo/pull/42#discussion_r501"),
+	}
+
+	tests := []struct {
+		name             string
+		mockedClient     *http.Client
+		requestArgs      map[string]interface{}
+		expectedResponse suggestedChange
+		expectedErrMsg   string
+	}{
+		{
+			name: "suggest a change to a single line",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					mockPR,
+				),
+				mock.WithRequestMatch(
+					mock.GetReposPullsFilesByOwnerByRepoByPullNumber,
+					mockFiles,
+				),
+				mock.WithRequestMatchHandler(
+					mock.PostReposPullsCommentsByOwnerByRepoByPullNumber,
+					expectRequestBody(t, map[string]interface{}{
+						"body":      "Use a clearer name\n\n```suggestion\nnewer\n```",
+						"commit_id": "abcd1234",
+						"path":      "main.go",
+						"line":      float64(11),
+						"side":      "RIGHT",
+					}).andThen(
+						mockResponse(t, http.StatusCreated, mockComment),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"pullNumber":  float64(42),
+				"path":        "main.go",
+				"line":        float64(11),
+				"replacement": "newer\n",
+				"body":        "Use a clearer name",
+			},
+			expectedResponse: suggestedChange{
+				ID:      501,
+				HTMLURL: "https://github.com/owner/repo/pull/42#discussion_r501",
+			},
+		},
+		{
+			name: "suggest a change to a range of lines",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					mockPR,
+				),
+				mock.WithRequestMatch(
+					mock.GetReposPullsFilesByOwnerByRepoByPullNumber,
+					mockFiles,
+				),
+				mock.WithRequestMatchHandler(
+					mock.PostReposPullsCommentsByOwnerByRepoByPullNumber,
+					expectRequestBody(t, map[string]interface{}{
+						"body":       "```suggestion\nnew\nadded\n```",
+						"commit_id":  "abcd1234",
+						"path":       "main.go",
+						"start_line": float64(11),
+						"start_side": "RIGHT",
+						"line":       float64(12),
+						"side":       "RIGHT",
+					}).andThen(
+						mockResponse(t, http.StatusCreated, mockComment),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"pullNumber":  float64(42),
+				"path":        "main.go",
+				"start_line":  float64(11),
+				"end_line":    float64(12),
+				"replacement": "new\nadded",
+			},
+			expectedResponse: suggestedChange{
+				ID:      501,
+				HTMLURL: "https://github.com/owner/repo/pull/42#discussion_r501",
+			},
+		},
+		{
+			name:         "multi-line replacement of a single line",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"pullNumber":  float64(42),
+				"path":        "main.go",
+				"line":        float64(11),
+				"replacement": "new\nadded",
+			},
+			expectedErrMsg: "replacement has 2 lines but targets the single line 11",
+		},
+		{
+			name:         "no line",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"pullNumber":  float64(42),
+				"path":        "main.go",
+				"start_line":  float64(11),
+				"replacement": "new",
+			},
+			expectedErrMsg: "either line or both start_line and end_line are required",
+		},
+		{
+			name: "range outside the diff",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					mockPR,
+				),
+				mock.WithRequestMatch(
+					mock.GetReposPullsFilesByOwnerByRepoByPullNumber,
+					mockFiles,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"pullNumber":  float64(42),
+				"path":        "main.go",
+				"start_line":  float64(12),
+				"end_line":    float64(14),
+				"replacement": "new",
+			},
+			expectedErrMsg: "cannot suggest a change: line 14 of main.go is not part of the diff on the RIGHT side",
+		},
+		{
+			name: "file not in the pull request",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					mockPR,
+				),
+				mock.WithRequestMatch(
+					mock.GetReposPullsFilesByOwnerByRepoByPullNumber,
+					mockFiles,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"pullNumber":  float64(42),
+				"path":        "other.go",
+				"line":        float64(1),
+				"replacement": "new",
+			},
+			expectedErrMsg: "cannot suggest a change: other.go is not changed in the pull request",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := AddSuggestedChange(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+			require.False(t, result.IsError, textContent.Text)
+
+			// Unmarshal and verify the result
+			var returned suggestedChange
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResponse, returned)
+		})
+	}
+}
+
+func Test_SuggestionBody(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        string
+		replacement string
+		expected    string
+	}{
+		{
+			name:        "replacement only",
+			replacement: "x := 1",
+			expected:    "```suggestion\nx := 1\n```",
+		},
+		{
+			name:        "deletion",
+			body:        "Not needed",
+			replacement: "",
+			expected:    "Not needed\n\n```suggestion\n```",
+		},
+		{
+			name:        "replacement with a fence",
+			replacement: "```go\nx := 1\n```",
+			expected:    "````suggestion\n```go\nx := 1\n```\n````",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, suggestionBody(tc.body, tc.replacement))
+		})
+	}
+}
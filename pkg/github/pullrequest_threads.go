@@ -0,0 +1,334 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// reviewThreadListLimit is the maximum number of review threads fetched from a pull request.
+const reviewThreadListLimit = 1000
+
+// reviewThread is a trimmed review thread of a pull request. The first comment is only set
+// when listing threads.
+type reviewThread struct {
+	ID           string `json:"id"`
+	IsResolved   bool   `json:"is_resolved"`
+	IsOutdated   bool   `json:"is_outdated"`
+	Path         string `json:"path"`
+	Line         *int   `json:"line,omitempty"`
+	StartLine    *int   `json:"start_line,omitempty"`
+	CommentID    int64  `json:"comment_id,omitempty"`
+	Author       string `json:"author,omitempty"`
+	Body         string `json:"body,omitempty"`
+	CommentCount int    `json:"comment_count,omitempty"`
+}
+
+// reviewThreadNode is a review thread as returned by the GraphQL API.
+type reviewThreadNode struct {
+	ID         string `json:"id"`
+	IsResolved bool   `json:"isResolved"`
+	IsOutdated bool   `json:"isOutdated"`
+	Path       string `json:"path"`
+	Line       *int   `json:"line"`
+	StartLine  *int   `json:"startLine"`
+	Comments   struct {
+		TotalCount int `json:"totalCount"`
+		Nodes      []struct {
+			DatabaseID int64  `json:"databaseId"`
+			Body       string `json:"body"`
+			Author     struct {
+				Login string `json:"login"`
+			} `json:"author"`
+		} `json:"nodes"`
+	} `json:"comments"`
+}
+
+// newReviewThread trims a review thread node down to a reviewThread.
+func newReviewThread(node reviewThreadNode) reviewThread {
+	thread := reviewThread{
+		ID:           node.ID,
+		IsResolved:   node.IsResolved,
+		IsOutdated:   node.IsOutdated,
+		Path:         node.Path,
+		Line:         node.Line,
+		StartLine:    node.StartLine,
+		CommentCount: node.Comments.TotalCount,
+	}
+	if len(node.Comments.Nodes) > 0 {
+		thread.CommentID = node.Comments.Nodes[0].DatabaseID
+		thread.Author = node.Comments.Nodes[0].Author.Login
+		thread.Body = node.Comments.Nodes[0].Body
+	}
+	return thread
+}
+
+// listReviewThreads fetches the review threads of a pull request, up to reviewThreadListLimit.
+// A missing pull request is reported as a NOT_FOUND graphQLErrors.
+func listReviewThreads(ctx context.Context, client *github.Client, owner, repo string, pullNumber int) ([]reviewThreadNode, error) {
+	var threads []reviewThreadNode
+	var cursor *string
+	for len(threads) < reviewThreadListLimit {
+		var query struct {
+			Repository struct {
+				PullRequest *struct {
+					ReviewThreads struct {
+						Nodes    []reviewThreadNode `json:"nodes"`
+						PageInfo struct {
+							HasNextPage bool   `json:"hasNextPage"`
+							EndCursor   string `json:"endCursor"`
+						} `json:"pageInfo"`
+					} `json:"reviewThreads"`
+				} `json:"pullRequest"`
+			} `json:"repository"`
+		}
+		err := executeGraphQL(ctx, client, pullRequestReviewThreadsQuery, map[string]interface{}{
+			"owner":  owner,
+			"repo":   repo,
+			"number": pullNumber,
+			"after":  cursor,
+		}, &query)
+		if err != nil {
+			return nil, err
+		}
+
+		pr := query.Repository.PullRequest
+		if pr == nil {
+			return nil, graphQLErrors{{Type: "NOT_FOUND", Message: fmt.Sprintf("pull request %d not found", pullNumber)}}
+		}
+		threads = append(threads, pr.ReviewThreads.Nodes...)
+		if !pr.ReviewThreads.PageInfo.HasNextPage {
+			break
+		}
+		cursor = github.Ptr(pr.ReviewThreads.PageInfo.EndCursor)
+	}
+	return threads, nil
+}
+
+// ListReviewThreads creates a tool to list the unresolved review threads of a pull request.
+func ListReviewThreads(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_review_threads",
+			mcp.WithDescription(t("TOOL_LIST_REVIEW_THREADS_DESCRIPTION", "List the unresolved review threads of a pull request with their first comment")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			nodes, err := listReviewThreads(ctx, client, owner, repo, pullNumber)
+			if err != nil {
+				if result := graphQLErrorResult("failed to list review threads", err); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to list review threads: %w", err)
+			}
+
+			threads := make([]reviewThread, 0, len(nodes))
+			for _, node := range nodes {
+				if !node.IsResolved {
+					threads = append(threads, newReviewThread(node))
+				}
+			}
+
+			r, err := json.Marshal(threads)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// ResolveReviewThread creates a tool to resolve a review thread of a pull request.
+func ResolveReviewThread(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return reviewThreadResolutionTool(getClient, t, true)
+}
+
+// UnresolveReviewThread creates a tool to unresolve a review thread of a pull request.
+func UnresolveReviewThread(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return reviewThreadResolutionTool(getClient, t, false)
+}
+
+// reviewThreadResolutionTool creates the resolve_review_thread or unresolve_review_thread tool.
+func reviewThreadResolutionTool(getClient GetClientFn, t translations.TranslationHelperFunc, resolve bool) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	var name, action, description, mutation, field string
+	if resolve {
+		name, action = "resolve_review_thread", "resolve"
+		description = t("TOOL_RESOLVE_REVIEW_THREAD_DESCRIPTION", "Mark a review thread of a pull request as resolved")
+		mutation, field = resolveReviewThreadMutation, "resolveReviewThread"
+	} else {
+		name, action = "unresolve_review_thread", "unresolve"
+		description = t("TOOL_UNRESOLVE_REVIEW_THREAD_DESCRIPTION", "Mark a resolved review thread of a pull request as unresolved")
+		mutation, field = unresolveReviewThreadMutation, "unresolveReviewThread"
+	}
+
+	return mcp.NewTool(name,
+			mcp.WithDescription(description),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+			mcp.WithString("thread_id",
+				mcp.Description("Node ID of the review thread. Either thread_id or comment_id is required"),
+			),
+			mcp.WithNumber("comment_id",
+				mcp.Description("ID of a review comment in the thread. Either thread_id or comment_id is required"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			threadID, err := OptionalParam[string](request, "thread_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			commentID, err := OptionalIntParam(request, "comment_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if (threadID == "") == (commentID == 0) {
+				return mcp.NewToolResultError("exactly one of thread_id or comment_id is required"), nil
+			}
+
+			message := fmt.Sprintf("failed to %s review thread", action)
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			if threadID == "" {
+				threadID, err = findReviewThreadID(ctx, client, owner, repo, pullNumber, int64(commentID))
+				if err != nil {
+					var errorResponse *github.ErrorResponse
+					if errors.As(err, &errorResponse) && errorResponse.Response.StatusCode == http.StatusNotFound {
+						return mcp.NewToolResultError(fmt.Sprintf("%s: review comment %d not found", message, commentID)), nil
+					}
+					if result := graphQLErrorResult(message, err); result != nil {
+						return result, nil
+					}
+					return nil, fmt.Errorf("%s: %w", message, err)
+				}
+			}
+
+			var response map[string]struct {
+				Thread reviewThreadNode `json:"thread"`
+			}
+			err = executeGraphQL(ctx, client, mutation, map[string]interface{}{
+				"threadId": threadID,
+			}, &response)
+			if err != nil {
+				if result := graphQLErrorResult(message, err); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("%s: %w", message, err)
+			}
+
+			r, err := json.Marshal(newReviewThread(response[field].Thread))
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// findReviewThreadID returns the node ID of the review thread of a pull request containing the
+// review comment with the given ID. Replies point to the first comment of their thread, which
+// is matched against the first comment of each thread.
+func findReviewThreadID(ctx context.Context, client *github.Client, owner, repo string, pullNumber int, commentID int64) (string, error) {
+	comment, resp, err := client.PullRequests.GetComment(ctx, owner, repo, commentID)
+	if err != nil {
+		return "", err
+	}
+	_ = resp.Body.Close()
+	rootID := commentID
+	if comment.InReplyTo != nil {
+		rootID = comment.GetInReplyTo()
+	}
+
+	threads, err := listReviewThreads(ctx, client, owner, repo, pullNumber)
+	if err != nil {
+		return "", err
+	}
+	for _, thread := range threads {
+		if len(thread.Comments.Nodes) > 0 && thread.Comments.Nodes[0].DatabaseID == rootID {
+			return thread.ID, nil
+		}
+	}
+	return "", graphQLErrors{{Type: "NOT_FOUND", Message: fmt.Sprintf("review comment %d is not in a review thread of pull request #%d", commentID, pullNumber)}}
+}
+
+const pullRequestReviewThreadsQuery = `query PullRequestReviewThreads($owner: String!, $repo: String!, $number: Int!, $after: String) {
+  repository(owner: $owner, name: $repo) {
+    pullRequest(number: $number) {
+      reviewThreads(first: 100, after: $after) {
+        nodes {
+          id isResolved isOutdated path line startLine
+          comments(first: 1) { totalCount nodes { databaseId body author { login } } }
+        }
+        pageInfo { hasNextPage endCursor }
+      }
+    }
+  }
+}`
+
+const resolveReviewThreadMutation = `mutation ResolveReviewThread($threadId: ID!) {
+  resolveReviewThread(input: {threadId: $threadId}) {
+    thread { id isResolved isOutdated path line startLine }
+  }
+}`
+
+const unresolveReviewThreadMutation = `mutation UnresolveReviewThread($threadId: ID!) {
+  unresolveReviewThread(input: {threadId: $threadId}) {
+    thread { id isResolved isOutdated path line startLine }
+  }
+}`
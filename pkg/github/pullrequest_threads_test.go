@@ -0,0 +1,364 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// reviewThreadsPage is the data of a page of review threads of a pull request.
+func reviewThreadsPage(nextCursor string, threads ...map[string]any) map[string]any {
+	nodes := make([]any, len(threads))
+	for i, thread := range threads {
+		nodes[i] = thread
+	}
+	return map[string]any{
+		"repository": map[string]any{
+			"pullRequest": map[string]any{
+				"reviewThreads": map[string]any{
+					"nodes": nodes,
+					"pageInfo": map[string]any{
+						"hasNextPage": nextCursor != "",
+						"endCursor":   nextCursor,
+					},
+				},
+			},
+		},
+	}
+}
+
+// reviewThreadData is a review thread node with a first comment.
+func reviewThreadData(id string, resolved bool, line int, commentID int64, body string) map[string]any {
+	return map[string]any{
+		"id":         id,
+		"isResolved": resolved,
+		"isOutdated": false,
+		"path":       "main.go",
+		"line":       line,
+		"startLine":  nil,
+		"comments": map[string]any{
+			"totalCount": 2,
+			"nodes": []any{
+				map[string]any{"databaseId": commentID, "body": body, "author": map[string]any{"login": "reviewer"}},
+			},
+		},
+	}
+}
+
+func Test_ListReviewThreads(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListReviewThreads(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_review_threads", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+
+	tests := []struct {
+		name            string
+		mockedClient    *http.Client
+		expectedThreads []reviewThread
+		expectedErrMsg  string
+	}{
+		{
+			name: "unresolved threads across pages",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockGraphQL(t,
+						graphQLExchange{
+							query: "PullRequestReviewThreads",
+							expectedVariables: map[string]any{
+								"owner":  "owner",
+								"repo":   "repo",
+								"number": float64(42),
+								"after":  nil,
+							},
+							data: reviewThreadsPage("cursor1",
+								reviewThreadData("PRRT_1", false, 12, 401, "Handle the error"),
+								reviewThreadData("PRRT_2", true, 20, 402, "Typo"),
+							),
+						},
+						graphQLExchange{
+							query: "PullRequestReviewThreads",
+							expectedVariables: map[string]any{
+								"after": "cursor1",
+							},
+							data: reviewThreadsPage("",
+								reviewThreadData("PRRT_3", false, 30, 403, "Add a test"),
+							),
+						},
+					),
+				),
+			),
+			expectedThreads: []reviewThread{
+				{ID: "PRRT_1", Path: "main.go", Line: github.Ptr(12), CommentID: 401, Author: "reviewer", Body: "Handle the error", CommentCount: 2},
+				{ID: "PRRT_3", Path: "main.go", Line: github.Ptr(30), CommentID: 403, Author: "reviewer", Body: "Add a test", CommentCount: 2},
+			},
+		},
+		{
+			name: "pull request not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockGraphQL(t, graphQLExchange{
+						query: "PullRequestReviewThreads",
+						data: map[string]any{
+							"repository": map[string]any{"pullRequest": nil},
+						},
+					}),
+				),
+			),
+			expectedErrMsg: "failed to list review threads: pull request 42 not found",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListReviewThreads(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			})
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+			require.False(t, result.IsError, textContent.Text)
+
+			// Unmarshal and verify the result
+			var returnedThreads []reviewThread
+			err = json.Unmarshal([]byte(textContent.Text), &returnedThreads)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedThreads, returnedThreads)
+		})
+	}
+}
+
+func Test_ResolveReviewThread(t *testing.T) {
+	// Verify tool definitions once
+	mockClient := github.NewClient(nil)
+	tool, _ := ResolveReviewThread(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "resolve_review_thread", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
+	assert.Contains(t, tool.InputSchema.Properties, "thread_id")
+	assert.Contains(t, tool.InputSchema.Properties, "comment_id")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+
+	unresolveTool, _ := UnresolveReviewThread(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	assert.Equal(t, "unresolve_review_thread", unresolveTool.Name)
+	assert.NotEqual(t, tool.Description, unresolveTool.Description)
+
+	resolvedData := func(field string, resolved bool) map[string]any {
+		return map[string]any{
+			field: map[string]any{
+				"thread": map[string]any{
+					"id":         "PRRT_1",
+					"isResolved": resolved,
+					"isOutdated": false,
+					"path":       "main.go",
+					"line":       14,
+					"startLine":  10,
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name           string
+		resolve        bool
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectedThread reviewThread
+		expectedErrMsg string
+	}{
+		{
+			name:    "resolve by thread id",
+			resolve: true,
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockGraphQL(t, graphQLExchange{
+						query:             "ResolveReviewThread",
+						expectedVariables: map[string]any{"threadId": "PRRT_1"},
+						data:              resolvedData("resolveReviewThread", true),
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"thread_id":  "PRRT_1",
+			},
+			expectedThread: reviewThread{ID: "PRRT_1", IsResolved: true, Path: "main.go", Line: github.Ptr(14), StartLine: github.Ptr(10)},
+		},
+		{
+			name:    "resolve by the id of a reply",
+			resolve: true,
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsCommentsByOwnerByRepoByCommentId,
+					&github.PullRequestComment{ID: github.Ptr(int64(501)), InReplyTo: github.Ptr(int64(401))},
+				),
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockGraphQL(t,
+						graphQLExchange{
+							query: "PullRequestReviewThreads",
+							data: reviewThreadsPage("",
+								reviewThreadData("PRRT_0", false, 3, 400, "Rename"),
+								reviewThreadData("PRRT_1", false, 14, 401, "Handle the error"),
+							),
+						},
+						graphQLExchange{
+							query:             "ResolveReviewThread",
+							expectedVariables: map[string]any{"threadId": "PRRT_1"},
+							data:              resolvedData("resolveReviewThread", true),
+						},
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"comment_id": float64(501),
+			},
+			expectedThread: reviewThread{ID: "PRRT_1", IsResolved: true, Path: "main.go", Line: github.Ptr(14), StartLine: github.Ptr(10)},
+		},
+		{
+			name:    "unresolve by thread id",
+			resolve: false,
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockGraphQL(t, graphQLExchange{
+						query:             "UnresolveReviewThread",
+						expectedVariables: map[string]any{"threadId": "PRRT_1"},
+						data:              resolvedData("unresolveReviewThread", false),
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"thread_id":  "PRRT_1",
+			},
+			expectedThread: reviewThread{ID: "PRRT_1", IsResolved: false, Path: "main.go", Line: github.Ptr(14), StartLine: github.Ptr(10)},
+		},
+		{
+			name:    "comment in another pull request",
+			resolve: true,
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsCommentsByOwnerByRepoByCommentId,
+					&github.PullRequestComment{ID: github.Ptr(int64(999))},
+				),
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockGraphQL(t, graphQLExchange{
+						query: "PullRequestReviewThreads",
+						data:  reviewThreadsPage("", reviewThreadData("PRRT_1", false, 14, 401, "Handle the error")),
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"comment_id": float64(999),
+			},
+			expectedErrMsg: "failed to resolve review thread: review comment 999 is not in a review thread of pull request #42",
+		},
+		{
+			name:    "comment not found",
+			resolve: true,
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposPullsCommentsByOwnerByRepoByCommentId,
+					mockResponse(t, http.StatusNotFound, map[string]interface{}{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"comment_id": float64(999),
+			},
+			expectedErrMsg: "failed to resolve review thread: review comment 999 not found",
+		},
+		{
+			name:         "both thread id and comment id",
+			resolve:      true,
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"thread_id":  "PRRT_1",
+				"comment_id": float64(401),
+			},
+			expectedErrMsg: "exactly one of thread_id or comment_id is required",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := UnresolveReviewThread(stubGetClientFn(client), translations.NullTranslationHelper)
+			if tc.resolve {
+				_, handler = ResolveReviewThread(stubGetClientFn(client), translations.NullTranslationHelper)
+			}
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+			require.False(t, result.IsError, textContent.Text)
+
+			// Unmarshal and verify the result
+			var returnedThread reviewThread
+			err = json.Unmarshal([]byte(textContent.Text), &returnedThread)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedThread, returnedThread)
+		})
+	}
+}
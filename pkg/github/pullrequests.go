@@ -3,9 +3,14 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/google/go-github/v69/github"
@@ -167,19 +172,884 @@ func UpdatePullRequest(getClient GetClientFn, t translations.TranslationHelperFu
 			}
 			pr, resp, err := client.PullRequests.Edit(ctx, owner, repo, pullNumber, update)
 			if err != nil {
+				// Validation errors, such as a base branch that does not exist, are reported as
+				// returned by the API.
+				if result := apiErrorResult("failed to update pull request", err, http.StatusNotFound, http.StatusUnprocessableEntity); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to update pull request: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to update pull request: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(pr)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// ConvertPullRequestToDraft creates a tool to convert a pull request to a draft.
+func ConvertPullRequestToDraft(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("convert_pull_request_to_draft",
+			mcp.WithDescription(t("TOOL_CONVERT_PULL_REQUEST_TO_DRAFT_DESCRIPTION", "Convert a pull request to a draft, which cannot be merged until it is marked ready for review")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			return setPullRequestDraft(ctx, client, owner, repo, pullNumber, true)
+		}
+}
+
+// MarkPullRequestReadyForReview creates a tool to mark a draft pull request ready for review.
+func MarkPullRequestReadyForReview(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("mark_pull_request_ready_for_review",
+			mcp.WithDescription(t("TOOL_MARK_PULL_REQUEST_READY_FOR_REVIEW_DESCRIPTION", "Mark a draft pull request ready for review")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			return setPullRequestDraft(ctx, client, owner, repo, pullNumber, false)
+		}
+}
+
+// setPullRequestDraft converts a pull request to a draft or marks it ready for review. The REST
+// API cannot change the draft state, so this runs a GraphQL mutation on the node ID of the pull
+// request. A pull request already in the requested state is left alone.
+func setPullRequestDraft(ctx context.Context, client *github.Client, owner, repo string, pullNumber int, draft bool) (*mcp.CallToolResult, error) {
+	mutation, field, action := convertPullRequestToDraftMutation, "convertPullRequestToDraft", "convert pull request to draft"
+	if !draft {
+		mutation, field, action = markPullRequestReadyForReviewMutation, "markPullRequestReadyForReview", "mark pull request ready for review"
+	}
+
+	pr, resp, err := client.PullRequests.Get(ctx, owner, repo, pullNumber)
+	if err != nil {
+		if result := apiErrorResult("failed to "+action, err, http.StatusNotFound); result != nil {
+			return result, nil
+		}
+		return nil, fmt.Errorf("failed to get pull request: %w", err)
+	}
+	_ = resp.Body.Close()
+
+	state := map[string]interface{}{
+		"pull_number": pullNumber,
+		"draft":       pr.GetDraft(),
+	}
+	if pr.GetDraft() == draft {
+		if draft {
+			state["note"] = "the pull request is already a draft"
+		} else {
+			state["note"] = "the pull request is already ready for review"
+		}
+	} else {
+		var response map[string]struct {
+			PullRequest struct {
+				IsDraft bool `json:"isDraft"`
+			} `json:"pullRequest"`
+		}
+		err = executeGraphQL(ctx, client, mutation, map[string]interface{}{
+			"pullRequestId": pr.GetNodeID(),
+		}, &response)
+		if err != nil {
+			if result := graphQLErrorResult("failed to "+action, err); result != nil {
+				return result, nil
+			}
+			return nil, fmt.Errorf("failed to %s: %w", action, err)
+		}
+		state["draft"] = response[field].PullRequest.IsDraft
+	}
+
+	r, err := json.Marshal(state)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal response: %w", err)
+	}
+
+	return mcp.NewToolResultText(string(r)), nil
+}
+
+// pullRequestState is the state of a pull request after closing or reopening it.
+type pullRequestState struct {
+	PullNumber    int    `json:"pull_number"`
+	State         string `json:"state"`
+	HTMLURL       string `json:"html_url"`
+	BranchDeleted *bool  `json:"branch_deleted,omitempty"`
+	Warning       string `json:"warning,omitempty"`
+}
+
+// ClosePullRequest creates a tool to close a pull request without merging it.
+func ClosePullRequest(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("close_pull_request",
+			mcp.WithDescription(t("TOOL_CLOSE_PULL_REQUEST_DESCRIPTION", "Close a pull request without merging it, optionally explaining why and deleting its branch")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number to close"),
+			),
+			mcp.WithString("comment",
+				mcp.Description("Comment to post on the pull request before closing it"),
+			),
+			mcp.WithBoolean("delete_branch",
+				mcp.Description("Delete the head branch after closing the pull request. Branches of forks, protected branches, and the default branch are never deleted"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			comment, err := OptionalParam[string](request, "comment")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			deleteBranch, err := OptionalParam[bool](request, "delete_branch")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			// Post the comment first, so that the pull request is left open if it cannot be added.
+			if comment != "" {
+				_, resp, err := client.Issues.CreateComment(ctx, owner, repo, pullNumber, &github.IssueComment{
+					Body: github.Ptr(comment),
+				})
+				if err != nil {
+					if result := apiErrorResult("failed to add closing comment", err, http.StatusNotFound, http.StatusForbidden); result != nil {
+						return result, nil
+					}
+					return nil, fmt.Errorf("failed to add closing comment: %w", err)
+				}
+				_ = resp.Body.Close()
+			}
+
+			pr, resp, err := client.PullRequests.Edit(ctx, owner, repo, pullNumber, &github.PullRequest{
+				State: github.Ptr("closed"),
+			})
+			if err != nil {
+				if result := apiErrorResult("failed to close pull request", err, http.StatusNotFound, http.StatusForbidden, http.StatusUnprocessableEntity); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to close pull request: %w", err)
+			}
+			_ = resp.Body.Close()
+
+			result := pullRequestState{
+				PullNumber:    pullNumber,
+				State:         pr.GetState(),
+				HTMLURL:       pr.GetHTMLURL(),
+				BranchDeleted: github.Ptr(false),
+			}
+			if deleteBranch {
+				warning, err := deletePullRequestBranch(ctx, client, owner, repo, pr)
+				if err != nil {
+					return nil, err
+				}
+				result.BranchDeleted = github.Ptr(warning == "")
+				result.Warning = warning
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// deletePullRequestBranch deletes the head branch of a pull request, unless it is in a fork,
+// protected, or the default branch. It returns why the branch was not deleted, or an empty
+// string when it was.
+func deletePullRequestBranch(ctx context.Context, client *github.Client, owner, repo string, pr *github.PullRequest) (string, error) {
+	head := pr.GetHead()
+	branch := head.GetRef()
+	if head.GetRepo().GetFullName() != pr.GetBase().GetRepo().GetFullName() {
+		if head.GetRepo() == nil {
+			return fmt.Sprintf("the branch %s was not deleted because the fork it belongs to no longer exists", branch), nil
+		}
+		return fmt.Sprintf("the branch %s was not deleted because it belongs to the fork %s", branch, head.GetRepo().GetFullName()), nil
+	}
+	if branch == head.GetRepo().GetDefaultBranch() {
+		return fmt.Sprintf("the branch %s was not deleted because it is the default branch", branch), nil
+	}
+
+	headBranch, resp, err := client.Repositories.GetBranch(ctx, owner, repo, branch, 1)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return fmt.Sprintf("the branch %s was not deleted because it no longer exists", branch), nil
+		}
+		return "", fmt.Errorf("failed to get branch %s: %w", branch, err)
+	}
+	_ = resp.Body.Close()
+	if headBranch.GetProtected() {
+		return fmt.Sprintf("the branch %s was not deleted because it is protected", branch), nil
+	}
+
+	resp, err = client.Git.DeleteRef(ctx, owner, repo, "refs/heads/"+branch)
+	if err != nil {
+		// The pull request is closed already, so report why the branch is left as a warning.
+		var errorResponse *github.ErrorResponse
+		if errors.As(err, &errorResponse) && errorResponse.Response != nil {
+			return fmt.Sprintf("failed to delete the branch %s: %s", branch, apiErrorDetails(errorResponse)), nil
+		}
+		return "", fmt.Errorf("failed to delete branch %s: %w", branch, err)
+	}
+	_ = resp.Body.Close()
+	return "", nil
+}
+
+// ReopenPullRequest creates a tool to reopen a closed pull request.
+func ReopenPullRequest(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("reopen_pull_request",
+			mcp.WithDescription(t("TOOL_REOPEN_PULL_REQUEST_DESCRIPTION", "Reopen a closed pull request that was not merged")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number to reopen"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			pr, resp, err := client.PullRequests.Edit(ctx, owner, repo, pullNumber, &github.PullRequest{
+				State: github.Ptr("open"),
+			})
+			if err != nil {
+				// Merged pull requests and pull requests whose branch was deleted cannot be reopened.
+				if result := apiErrorResult("failed to reopen pull request", err, http.StatusNotFound, http.StatusForbidden, http.StatusUnprocessableEntity); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to reopen pull request: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			r, err := json.Marshal(pullRequestState{
+				PullNumber: pullNumber,
+				State:      pr.GetState(),
+				HTMLURL:    pr.GetHTMLURL(),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// pullRequestMilestone is the milestone of a pull request.
+type pullRequestMilestone struct {
+	Number int    `json:"number"`
+	Title  string `json:"title"`
+}
+
+// pullRequestMetadata are the labels, assignees and milestone of a pull request.
+type pullRequestMetadata struct {
+	PullNumber int                   `json:"pull_number"`
+	Labels     []string              `json:"labels"`
+	Assignees  []string              `json:"assignees"`
+	Milestone  *pullRequestMilestone `json:"milestone"`
+	HTMLURL    string                `json:"html_url"`
+}
+
+func newPullRequestMetadata(pullNumber int, issue *github.Issue) pullRequestMetadata {
+	metadata := pullRequestMetadata{
+		PullNumber: pullNumber,
+		Labels:     make([]string, 0, len(issue.Labels)),
+		Assignees:  make([]string, 0, len(issue.Assignees)),
+		HTMLURL:    issue.GetHTMLURL(),
+	}
+	for _, label := range issue.Labels {
+		metadata.Labels = append(metadata.Labels, label.GetName())
+	}
+	for _, assignee := range issue.Assignees {
+		metadata.Assignees = append(metadata.Assignees, assignee.GetLogin())
+	}
+	if issue.Milestone != nil {
+		metadata.Milestone = &pullRequestMilestone{
+			Number: issue.Milestone.GetNumber(),
+			Title:  issue.Milestone.GetTitle(),
+		}
+	}
+	return metadata
+}
+
+// UpdatePullRequestMetadata creates a tool to change the labels, assignees and milestone of a
+// pull request. These are kept on the issue underlying the pull request, so they are changed
+// through the Issues API.
+func UpdatePullRequestMetadata(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("update_pull_request_metadata",
+			mcp.WithDescription(t("TOOL_UPDATE_PULL_REQUEST_METADATA_DESCRIPTION", "Update the labels, assignees and milestone of a pull request. Only the provided fields are changed")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number to update"),
+			),
+			mcp.WithArray("labels",
+				mcp.Description("Names of the labels the pull request should have, replacing its current labels. An empty list removes all labels"),
+				mcp.Items(
+					map[string]interface{}{
+						"type": "string",
+					},
+				),
+			),
+			mcp.WithArray("assignees",
+				mcp.Description("Usernames the pull request should be assigned to, replacing its current assignees. An empty list removes all assignees"),
+				mcp.Items(
+					map[string]interface{}{
+						"type": "string",
+					},
+				),
+			),
+			mcp.WithNumber("milestone",
+				mcp.Description("New milestone number, or 0 to remove the milestone"),
+			),
+			mcp.WithString("milestone_title",
+				mcp.Description("Title of the new milestone, as an alternative to milestone"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			update, err := parsePullRequestMetadataUpdate(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			// Issue numbers are shared with pull requests, so make sure an issue is not updated
+			// by mistake.
+			_, resp, err := client.PullRequests.Get(ctx, owner, repo, pullNumber)
+			if err != nil {
+				if result := apiErrorResult("failed to get pull request", err, http.StatusNotFound); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to get pull request: %w", err)
+			}
+			_ = resp.Body.Close()
+
+			if update.milestoneTitle != "" {
+				result, err := resolveMilestoneTitle(ctx, client, owner, repo, update)
+				if result != nil || err != nil {
+					return result, err
+				}
+			}
+			issue, resp, err := editIssue(ctx, client, owner, repo, pullNumber, update)
+			if err != nil {
+				// Labels, assignees and milestones that cannot be used are validation errors.
+				if result := apiErrorResult("failed to update pull request", err, http.StatusForbidden, http.StatusUnprocessableEntity); result != nil {
+					return result, nil
+				}
 				return nil, fmt.Errorf("failed to update pull request: %w", err)
 			}
 			defer func() { _ = resp.Body.Close() }()
 
+			r, err := json.Marshal(newPullRequestMetadata(pullNumber, issue))
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// parsePullRequestMetadataUpdate reads the parameters of update_pull_request_metadata. Unlike
+// update_issue, an empty list of labels or assignees is applied, as it is the only way to remove
+// all of them.
+func parsePullRequestMetadataUpdate(request mcp.CallToolRequest) (*issueUpdate, error) {
+	update := &issueUpdate{request: &github.IssueRequest{}}
+
+	if _, ok := request.Params.Arguments["labels"]; ok {
+		labels, err := OptionalStringArrayParam(request, "labels")
+		if err != nil {
+			return nil, err
+		}
+		update.request.Labels = &labels
+	}
+
+	if _, ok := request.Params.Arguments["assignees"]; ok {
+		assignees, err := OptionalStringArrayParam(request, "assignees")
+		if err != nil {
+			return nil, err
+		}
+		update.request.Assignees = &assignees
+	}
+
+	milestone, hasMilestone, err := OptionalParamOK[float64](request, "milestone")
+	if err != nil {
+		return nil, err
+	}
+	update.clearMilestone = hasMilestone && milestone == 0
+	if hasMilestone && milestone != 0 {
+		update.request.Milestone = github.Ptr(int(milestone))
+	}
+
+	update.milestoneTitle, err = OptionalParam[string](request, "milestone_title")
+	if err != nil {
+		return nil, err
+	}
+	if update.milestoneTitle != "" && hasMilestone {
+		return nil, errors.New("only one of milestone and milestone_title can be provided")
+	}
+
+	if update.request.Labels == nil && update.request.Assignees == nil && !hasMilestone && update.milestoneTitle == "" {
+		return nil, errors.New("at least one of labels, assignees, milestone and milestone_title must be provided")
+	}
+	return update, nil
+}
+
+// autoMergeRequest is the auto-merge configuration of a pull request.
+type autoMergeRequest struct {
+	EnabledAt     string `json:"enabled_at"`
+	EnabledBy     string `json:"enabled_by"`
+	MergeMethod   string `json:"merge_method"`
+	CommitTitle   string `json:"commit_title,omitempty"`
+	CommitMessage string `json:"commit_message,omitempty"`
+}
+
+// EnablePullRequestAutoMerge creates a tool to merge a pull request automatically once its
+// requirements are met.
+func EnablePullRequestAutoMerge(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("enable_pull_request_auto_merge",
+			mcp.WithDescription(t("TOOL_ENABLE_PULL_REQUEST_AUTO_MERGE_DESCRIPTION", "Enable auto-merge on a pull request, so that it is merged once all required reviews and checks pass")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+			mcp.WithString("merge_method",
+				mcp.Description("Merge method ('merge', 'squash', 'rebase'), defaults to 'merge'"),
+				mcp.Enum("merge", "squash", "rebase"),
+			),
+			mcp.WithString("commit_title",
+				mcp.Description("Title for merge commit"),
+			),
+			mcp.WithString("commit_message",
+				mcp.Description("Extra detail for merge commit"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			mergeMethod, err := OptionalParam[string](request, "merge_method")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			commitTitle, err := OptionalParam[string](request, "commit_title")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			commitMessage, err := OptionalParam[string](request, "commit_message")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			variables := map[string]interface{}{}
+			if mergeMethod != "" {
+				variables["mergeMethod"] = strings.ToUpper(mergeMethod)
+			}
+			if commitTitle != "" {
+				variables["commitHeadline"] = commitTitle
+			}
+			if commitMessage != "" {
+				variables["commitBody"] = commitMessage
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			return setPullRequestAutoMerge(ctx, client, owner, repo, pullNumber, variables, true)
+		}
+}
+
+// DisablePullRequestAutoMerge creates a tool to disable auto-merge on a pull request.
+func DisablePullRequestAutoMerge(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("disable_pull_request_auto_merge",
+			mcp.WithDescription(t("TOOL_DISABLE_PULL_REQUEST_AUTO_MERGE_DESCRIPTION", "Disable auto-merge on a pull request")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			return setPullRequestAutoMerge(ctx, client, owner, repo, pullNumber, map[string]interface{}{}, false)
+		}
+}
+
+// setPullRequestAutoMerge enables or disables auto-merge on a pull request with a GraphQL
+// mutation on its node ID, and returns the resulting auto-merge configuration, which is null
+// when auto-merge is disabled.
+func setPullRequestAutoMerge(ctx context.Context, client *github.Client, owner, repo string, pullNumber int, variables map[string]interface{}, enable bool) (*mcp.CallToolResult, error) {
+	mutation, field, action := enablePullRequestAutoMergeMutation, "enablePullRequestAutoMerge", "enable auto-merge"
+	if !enable {
+		mutation, field, action = disablePullRequestAutoMergeMutation, "disablePullRequestAutoMerge", "disable auto-merge"
+	}
+
+	pr, resp, err := client.PullRequests.Get(ctx, owner, repo, pullNumber)
+	if err != nil {
+		if result := apiErrorResult("failed to "+action, err, http.StatusNotFound); result != nil {
+			return result, nil
+		}
+		return nil, fmt.Errorf("failed to get pull request: %w", err)
+	}
+	_ = resp.Body.Close()
+	variables["pullRequestId"] = pr.GetNodeID()
+
+	var response map[string]struct {
+		PullRequest struct {
+			AutoMergeRequest *struct {
+				EnabledAt string `json:"enabledAt"`
+				EnabledBy struct {
+					Login string `json:"login"`
+				} `json:"enabledBy"`
+				MergeMethod    string `json:"mergeMethod"`
+				CommitHeadline string `json:"commitHeadline"`
+				CommitBody     string `json:"commitBody"`
+			} `json:"autoMergeRequest"`
+		} `json:"pullRequest"`
+	}
+	err = executeGraphQL(ctx, client, mutation, variables, &response)
+	if err != nil {
+		var gqlErrs graphQLErrors
+		if errors.As(err, &gqlErrs) && strings.Contains(strings.ToLower(gqlErrs.Error()), "auto merge is not allowed") {
+			return mcp.NewToolResultError(fmt.Sprintf("failed to %s: %s; check that 'Allow auto-merge' is enabled in the settings of the repository", action, gqlErrs.Error())), nil
+		}
+		if result := graphQLErrorResult("failed to "+action, err); result != nil {
+			return result, nil
+		}
+		return nil, fmt.Errorf("failed to %s: %w", action, err)
+	}
+
+	var autoMerge *autoMergeRequest
+	if request := response[field].PullRequest.AutoMergeRequest; request != nil {
+		autoMerge = &autoMergeRequest{
+			EnabledAt:     request.EnabledAt,
+			EnabledBy:     request.EnabledBy.Login,
+			MergeMethod:   strings.ToLower(request.MergeMethod),
+			CommitTitle:   request.CommitHeadline,
+			CommitMessage: request.CommitBody,
+		}
+	}
+
+	r, err := json.Marshal(map[string]interface{}{
+		"pull_number": pullNumber,
+		"auto_merge":  autoMerge,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal response: %w", err)
+	}
+
+	return mcp.NewToolResultText(string(r)), nil
+}
+
+// pullRequestSummary is a trimmed down pull request, as full pull requests are large.
+type pullRequestSummary struct {
+	Number    int               `json:"number"`
+	Title     string            `json:"title"`
+	State     string            `json:"state"`
+	Draft     bool              `json:"draft"`
+	User      string            `json:"user"`
+	Base      string            `json:"base"`
+	Head      string            `json:"head"`
+	UpdatedAt *github.Timestamp `json:"updated_at,omitempty"`
+	HTMLURL   string            `json:"html_url"`
+}
+
+func newPullRequestSummary(pr *github.PullRequest) pullRequestSummary {
+	return pullRequestSummary{
+		Number:    pr.GetNumber(),
+		Title:     pr.GetTitle(),
+		State:     pr.GetState(),
+		Draft:     pr.GetDraft(),
+		User:      pr.GetUser().GetLogin(),
+		Base:      pr.GetBase().GetRef(),
+		Head:      pr.GetHead().GetRef(),
+		UpdatedAt: pr.UpdatedAt,
+		HTMLURL:   pr.GetHTMLURL(),
+	}
+}
+
+// ListPullRequests creates a tool to list and filter repository pull requests.
+func ListPullRequests(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_pull_requests",
+			mcp.WithDescription(t("TOOL_LIST_PULL_REQUESTS_DESCRIPTION", "List and filter repository pull requests")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("state",
+				mcp.Description("Filter by state ('open', 'closed', 'all')"),
+				mcp.Enum("open", "closed", "all"),
+			),
+			mcp.WithString("head",
+				mcp.Description("Filter by head user or organization and branch, in the format 'user:branch'"),
+			),
+			mcp.WithString("base",
+				mcp.Description("Filter by base branch"),
+			),
+			mcp.WithString("sort",
+				mcp.Description("Sort by ('created', 'updated', 'popularity', 'long-running')"),
+				mcp.Enum("created", "updated", "popularity", "long-running"),
+			),
+			mcp.WithString("direction",
+				mcp.Description("Sort direction ('asc', 'desc')"),
+				mcp.Enum("asc", "desc"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			state, err := OptionalParam[string](request, "state")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if state != "" && !slices.Contains([]string{"open", "closed", "all"}, state) {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid state %q: must be one of open, closed, all", state)), nil
+			}
+			head, err := OptionalParam[string](request, "head")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if head != "" && !strings.Contains(head, ":") {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid head %q: must be in the format user:branch", head)), nil
+			}
+			base, err := OptionalParam[string](request, "base")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			sort, err := OptionalParam[string](request, "sort")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if sort != "" && !slices.Contains([]string{"created", "updated", "popularity", "long-running"}, sort) {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid sort %q: must be one of created, updated, popularity, long-running", sort)), nil
+			}
+			direction, err := OptionalParam[string](request, "direction")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if direction != "" && direction != "asc" && direction != "desc" {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid direction %q: must be one of asc, desc", direction)), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts := &github.PullRequestListOptions{
+				State:     state,
+				Head:      head,
+				Base:      base,
+				Sort:      sort,
+				Direction: direction,
+				ListOptions: github.ListOptions{
+					PerPage: pagination.perPage,
+					Page:    pagination.page,
+				},
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			prs, resp, err := client.PullRequests.List(ctx, owner, repo, opts)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list pull requests: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
 			if resp.StatusCode != http.StatusOK {
 				body, err := io.ReadAll(resp.Body)
 				if err != nil {
 					return nil, fmt.Errorf("failed to read response body: %w", err)
 				}
-				return mcp.NewToolResultError(fmt.Sprintf("failed to update pull request: %s", string(body))), nil
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list pull requests: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(pr)
+			trimmed := make([]pullRequestSummary, 0, len(prs))
+			for _, pr := range prs {
+				trimmed = append(trimmed, newPullRequestSummary(pr))
+			}
+
+			r, err := json.Marshal(trimmed)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
@@ -188,10 +1058,19 @@ func UpdatePullRequest(getClient GetClientFn, t translations.TranslationHelperFu
 		}
 }
 
-// ListPullRequests creates a tool to list and filter repository pull requests.
-func ListPullRequests(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
-	return mcp.NewTool("list_pull_requests",
-			mcp.WithDescription(t("TOOL_LIST_PULL_REQUESTS_DESCRIPTION", "List and filter repository pull requests")),
+// commitSHAPattern matches abbreviated and full commit SHAs.
+var commitSHAPattern = regexp.MustCompile(`^[0-9a-fA-F]{7,40}$`)
+
+// commitPullRequest is a trimmed down pull request associated with a commit.
+type commitPullRequest struct {
+	pullRequestSummary
+	MergedAt *github.Timestamp `json:"merged_at"`
+}
+
+// ListPullRequestsForCommit creates a tool to list the pull requests associated with a commit.
+func ListPullRequestsForCommit(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_pull_requests_for_commit",
+			mcp.WithDescription(t("TOOL_LIST_PULL_REQUESTS_FOR_COMMIT_DESCRIPTION", "List the pull requests that contain a commit, such as the pull request that introduced it")),
 			mcp.WithString("owner",
 				mcp.Required(),
 				mcp.Description("Repository owner"),
@@ -200,20 +1079,9 @@ func ListPullRequests(getClient GetClientFn, t translations.TranslationHelperFun
 				mcp.Required(),
 				mcp.Description("Repository name"),
 			),
-			mcp.WithString("state",
-				mcp.Description("Filter by state ('open', 'closed', 'all')"),
-			),
-			mcp.WithString("head",
-				mcp.Description("Filter by head user/org and branch"),
-			),
-			mcp.WithString("base",
-				mcp.Description("Filter by base branch"),
-			),
-			mcp.WithString("sort",
-				mcp.Description("Sort by ('created', 'updated', 'popularity', 'long-running')"),
-			),
-			mcp.WithString("direction",
-				mcp.Description("Sort direction ('asc', 'desc')"),
+			mcp.WithString("commit_sha",
+				mcp.Required(),
+				mcp.Description("SHA of the commit, full or abbreviated to at least 7 characters"),
 			),
 			WithPagination(),
 		),
@@ -226,15 +1094,139 @@ func ListPullRequests(getClient GetClientFn, t translations.TranslationHelperFun
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			state, err := OptionalParam[string](request, "state")
+			sha, err := requiredParam[string](request, "commit_sha")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			head, err := OptionalParam[string](request, "head")
+			if !commitSHAPattern.MatchString(sha) {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid commit_sha %q: must be 7 to 40 hexadecimal characters", sha)), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			base, err := OptionalParam[string](request, "base")
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			prs, resp, err := client.PullRequests.ListPullRequestsWithCommit(ctx, owner, repo, sha, &github.ListOptions{
+				PerPage: pagination.perPage,
+				Page:    pagination.page,
+			})
+			if err != nil {
+				if result := apiErrorResult("failed to list pull requests for commit", err, http.StatusNotFound, http.StatusUnprocessableEntity); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to list pull requests for commit: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			trimmed := make([]commitPullRequest, 0, len(prs))
+			for _, pr := range prs {
+				trimmed = append(trimmed, commitPullRequest{
+					pullRequestSummary: newPullRequestSummary(pr),
+					MergedAt:           pr.MergedAt,
+				})
+			}
+
+			r, err := json.Marshal(trimmed)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// pullRequestSearchResult is a trimmed down result of a pull request search.
+type pullRequestSearchResult struct {
+	TotalCount        int                     `json:"total_count"`
+	IncompleteResults bool                    `json:"incomplete_results"`
+	Items             []pullRequestSearchItem `json:"items"`
+}
+
+// pullRequestSearchItem is a pull request matched by a search.
+type pullRequestSearchItem struct {
+	Repository string            `json:"repository"`
+	Number     int               `json:"number"`
+	Title      string            `json:"title"`
+	State      string            `json:"state"`
+	Draft      bool              `json:"draft"`
+	Merged     bool              `json:"merged"`
+	MergedAt   *github.Timestamp `json:"merged_at,omitempty"`
+	User       string            `json:"user"`
+	URL        string            `json:"url"`
+	UpdatedAt  *github.Timestamp `json:"updated_at,omitempty"`
+}
+
+// newPullRequestSearchItem trims a pull request matched by a search. Search results are issues,
+// which still carry the draft flag and the merge time of pull requests, so no further requests
+// are needed for them.
+func newPullRequestSearchItem(issue *github.Issue) pullRequestSearchItem {
+	mergedAt := issue.GetPullRequestLinks().MergedAt
+	return pullRequestSearchItem{
+		Repository: issueRepositoryFullName(issue),
+		Number:     issue.GetNumber(),
+		Title:      issue.GetTitle(),
+		State:      issue.GetState(),
+		Draft:      issue.GetDraft(),
+		Merged:     mergedAt != nil,
+		MergedAt:   mergedAt,
+		User:       issue.GetUser().GetLogin(),
+		URL:        issue.GetHTMLURL(),
+		UpdatedAt:  issue.UpdatedAt,
+	}
+}
+
+// SearchPullRequests creates a tool to search for pull requests.
+func SearchPullRequests(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("search_pull_requests",
+			mcp.WithDescription(t("TOOL_SEARCH_PULL_REQUESTS_DESCRIPTION", "Search for pull requests across GitHub repositories, returning a trimmed list of matches")),
+			mcp.WithString("query",
+				mcp.Description("Search query using GitHub issues search syntax, combined with the qualifiers below. Only pull requests are matched"),
+			),
+			mcp.WithString("owner",
+				mcp.Description("Only search repositories of this user or organization"),
+			),
+			mcp.WithString("repo",
+				mcp.Description("Only search this repository, requires owner"),
+			),
+			mcp.WithString("state",
+				mcp.Description("Filter by state, closed includes merged pull requests"),
+				mcp.Enum("open", "closed", "merged"),
+			),
+			mcp.WithString("author",
+				mcp.Description("Filter by author username"),
+			),
+			mcp.WithString("reviewed_by",
+				mcp.Description("Filter by the username of a reviewer"),
+			),
+			mcp.WithString("review",
+				mcp.Description("Filter by review status"),
+				mcp.Enum("none", "required", "approved", "changes_requested"),
+			),
+			mcp.WithString("base",
+				mcp.Description("Filter by base branch name"),
+			),
+			mcp.WithString("merged_after",
+				mcp.Description("Only match pull requests merged at or after this time (ISO 8601 timestamp)"),
+			),
+			mcp.WithString("merged_before",
+				mcp.Description("Only match pull requests merged at or before this time (ISO 8601 timestamp)"),
+			),
+			mcp.WithString("sort",
+				mcp.Description("Sort field"),
+				mcp.Enum("comments", "reactions", "interactions", "created", "updated"),
+			),
+			mcp.WithString("order",
+				mcp.Description("Sort order ('asc' or 'desc')"),
+				mcp.Enum("asc", "desc"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			query, err := pullRequestSearchQuery(request)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
@@ -242,7 +1234,7 @@ func ListPullRequests(getClient GetClientFn, t translations.TranslationHelperFun
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			direction, err := OptionalParam[string](request, "direction")
+			order, err := OptionalParam[string](request, "order")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
@@ -251,12 +1243,9 @@ func ListPullRequests(getClient GetClientFn, t translations.TranslationHelperFun
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			opts := &github.PullRequestListOptions{
-				State:     state,
-				Head:      head,
-				Base:      base,
-				Sort:      sort,
-				Direction: direction,
+			opts := &github.SearchOptions{
+				Sort:  sort,
+				Order: order,
 				ListOptions: github.ListOptions{
 					PerPage: pagination.perPage,
 					Page:    pagination.page,
@@ -267,9 +1256,314 @@ func ListPullRequests(getClient GetClientFn, t translations.TranslationHelperFun
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
-			prs, resp, err := client.PullRequests.List(ctx, owner, repo, opts)
+			result, resp, err := client.Search.Issues(ctx, query, opts)
+			if err != nil {
+				if result := apiErrorResult("failed to search pull requests", err, http.StatusUnprocessableEntity); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to search pull requests: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			trimmed := pullRequestSearchResult{
+				TotalCount:        result.GetTotal(),
+				IncompleteResults: result.GetIncompleteResults(),
+				Items:             make([]pullRequestSearchItem, 0, len(result.Issues)),
+			}
+			for _, issue := range result.Issues {
+				trimmed.Items = append(trimmed.Items, newPullRequestSearchItem(issue))
+			}
+
+			r, err := json.Marshal(trimmed)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// pullRequestSearchQuery builds the query of the search_pull_requests tool from its query and
+// structured filters. The query is restricted to pull requests with a single is:pr qualifier,
+// dropping the ones the given query may already have.
+func pullRequestSearchQuery(request mcp.CallToolRequest) (string, error) {
+	query, err := OptionalParam[string](request, "query")
+	if err != nil {
+		return "", err
+	}
+	terms := []string{"is:pr"}
+	for _, term := range strings.Fields(query) {
+		switch strings.ToLower(term) {
+		case "is:pr", "type:pr", "is:pull-request", "type:pull-request":
+			continue
+		case "is:issue", "type:issue":
+			return "", fmt.Errorf("query cannot contain %s, only pull requests are searched", term)
+		}
+		terms = append(terms, term)
+	}
+
+	owner, err := OptionalParam[string](request, "owner")
+	if err != nil {
+		return "", err
+	}
+	repo, err := OptionalParam[string](request, "repo")
+	if err != nil {
+		return "", err
+	}
+	switch {
+	case repo != "" && owner == "":
+		return "", errors.New("repo can only be used together with owner")
+	case repo != "":
+		terms = append(terms, searchQualifier("repo", owner+"/"+repo))
+	case owner != "":
+		terms = append(terms, searchQualifier("user", owner))
+	}
+
+	state, err := OptionalParam[string](request, "state")
+	if err != nil {
+		return "", err
+	}
+	if state == "merged" {
+		terms = append(terms, "is:merged")
+	} else if state != "" {
+		terms = append(terms, searchQualifier("state", state))
+	}
+
+	for _, filter := range []struct{ param, qualifier string }{
+		{"author", "author"},
+		{"reviewed_by", "reviewed-by"},
+		{"review", "review"},
+		{"base", "base"},
+	} {
+		value, err := OptionalParam[string](request, filter.param)
+		if err != nil {
+			return "", err
+		}
+		if value != "" {
+			terms = append(terms, searchQualifier(filter.qualifier, value))
+		}
+	}
+
+	after, err := OptionalParam[string](request, "merged_after")
+	if err != nil {
+		return "", err
+	}
+	before, err := OptionalParam[string](request, "merged_before")
+	if err != nil {
+		return "", err
+	}
+	if after != "" {
+		if _, err := parseISOTimestamp(after); err != nil {
+			return "", fmt.Errorf("invalid merged_after: %w", err)
+		}
+	}
+	if before != "" {
+		if _, err := parseISOTimestamp(before); err != nil {
+			return "", fmt.Errorf("invalid merged_before: %w", err)
+		}
+	}
+	switch {
+	case after != "" && before != "":
+		terms = append(terms, fmt.Sprintf("merged:%s..%s", after, before))
+	case after != "":
+		terms = append(terms, fmt.Sprintf("merged:>=%s", after))
+	case before != "":
+		terms = append(terms, fmt.Sprintf("merged:<=%s", before))
+	}
+
+	return strings.Join(terms, " "), nil
+}
+
+// MergePullRequest creates a tool to merge a pull request.
+func MergePullRequest(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("merge_pull_request",
+			mcp.WithDescription(t("TOOL_MERGE_PULL_REQUEST_DESCRIPTION", "Merge a pull request")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+			mcp.WithString("commit_title",
+				mcp.Description("Title for merge commit"),
+			),
+			mcp.WithString("commit_message",
+				mcp.Description("Extra detail for merge commit"),
+			),
+			mcp.WithString("merge_method",
+				mcp.Description("Merge method ('merge', 'squash', 'rebase')"),
+				mcp.Enum("merge", "squash", "rebase"),
+			),
+			mcp.WithString("sha",
+				mcp.Description("SHA that the head of the pull request must match to be merged, to avoid merging changes pushed after it was reviewed"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			commitTitle, err := OptionalParam[string](request, "commit_title")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			commitMessage, err := OptionalParam[string](request, "commit_message")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			mergeMethod, err := OptionalParam[string](request, "merge_method")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			sha, err := OptionalParam[string](request, "sha")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			options := &github.PullRequestOptions{
+				CommitTitle: commitTitle,
+				SHA:         sha,
+				MergeMethod: mergeMethod,
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			result, resp, err := client.PullRequests.Merge(ctx, owner, repo, pullNumber, commitMessage, options)
+			if err != nil {
+				// A pull request that is not mergeable, or whose head no longer matches sha,
+				// is reported with the reason given by the API.
+				if result := apiErrorResult("failed to merge pull request", err, http.StatusMethodNotAllowed, http.StatusConflict); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to merge pull request: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to merge pull request: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// defaultPatchLimit is the default maximum size in bytes of a patch returned for a file.
+const defaultPatchLimit = 4096
+
+// pullRequestFile is a trimmed down file changed in a pull request.
+type pullRequestFile struct {
+	Filename         string `json:"filename"`
+	Status           string `json:"status"`
+	Additions        int    `json:"additions"`
+	Deletions        int    `json:"deletions"`
+	PreviousFilename string `json:"previous_filename,omitempty"`
+	SHA              string `json:"sha"`
+	Patch            string `json:"patch,omitempty"`
+	PatchTruncated   bool   `json:"patch_truncated,omitempty"`
+}
+
+// truncatePatch shortens patch to at most limit bytes without splitting a character, and
+// reports whether it was truncated.
+func truncatePatch(patch string, limit int) (string, bool) {
+	if len(patch) <= limit {
+		return patch, false
+	}
+	for limit > 0 && !utf8.RuneStart(patch[limit]) {
+		limit--
+	}
+	return patch[:limit], true
+}
+
+// GetPullRequestFiles creates a tool to get the list of files changed in a pull request.
+func GetPullRequestFiles(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_pull_request_files",
+			mcp.WithDescription(t("TOOL_GET_PULL_REQUEST_FILES_DESCRIPTION", "Get the list of files changed in a pull request")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+			mcp.WithBoolean("include_patch",
+				mcp.Description("Include the patch of each file, which can be large (default false)"),
+			),
+			mcp.WithNumber("patch_limit",
+				mcp.Description(fmt.Sprintf("Maximum size of each patch in bytes, longer patches are truncated (default %d)", defaultPatchLimit)),
+				mcp.Min(1),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			includePatch, err := OptionalParam[bool](request, "include_patch")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			patchLimit, err := OptionalIntParamWithDefault(request, "patch_limit", defaultPatchLimit)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if patchLimit < 1 {
+				return mcp.NewToolResultError("patch_limit must be at least 1"), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			opts := &github.ListOptions{
+				Page:    pagination.page,
+				PerPage: pagination.perPage,
+			}
+			files, resp, err := client.PullRequests.ListFiles(ctx, owner, repo, pullNumber, opts)
 			if err != nil {
-				return nil, fmt.Errorf("failed to list pull requests: %w", err)
+				return nil, fmt.Errorf("failed to get pull request files: %w", err)
 			}
 			defer func() { _ = resp.Body.Close() }()
 
@@ -278,10 +1572,26 @@ func ListPullRequests(getClient GetClientFn, t translations.TranslationHelperFun
 				if err != nil {
 					return nil, fmt.Errorf("failed to read response body: %w", err)
 				}
-				return mcp.NewToolResultError(fmt.Sprintf("failed to list pull requests: %s", string(body))), nil
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get pull request files: %s", string(body))), nil
+			}
+
+			trimmed := make([]pullRequestFile, 0, len(files))
+			for _, file := range files {
+				trimmedFile := pullRequestFile{
+					Filename:         file.GetFilename(),
+					Status:           file.GetStatus(),
+					Additions:        file.GetAdditions(),
+					Deletions:        file.GetDeletions(),
+					PreviousFilename: file.GetPreviousFilename(),
+					SHA:              file.GetSHA(),
+				}
+				if includePatch {
+					trimmedFile.Patch, trimmedFile.PatchTruncated = truncatePatch(file.GetPatch(), patchLimit)
+				}
+				trimmed = append(trimmed, trimmedFile)
 			}
 
-			r, err := json.Marshal(prs)
+			r, err := json.Marshal(trimmed)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
@@ -290,10 +1600,16 @@ func ListPullRequests(getClient GetClientFn, t translations.TranslationHelperFun
 		}
 }
 
-// MergePullRequest creates a tool to merge a pull request.
-func MergePullRequest(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
-	return mcp.NewTool("merge_pull_request",
-			mcp.WithDescription(t("TOOL_MERGE_PULL_REQUEST_DESCRIPTION", "Merge a pull request")),
+// defaultDiffLimit is the default maximum size in bytes of a pull request diff.
+const defaultDiffLimit = 100 * 1024
+
+// pullRequestFileListLimit is the maximum number of files the API lists for a pull request.
+const pullRequestFileListLimit = 3000
+
+// GetPullRequestDiff creates a tool to get the unified diff of a pull request.
+func GetPullRequestDiff(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_pull_request_diff",
+			mcp.WithDescription(t("TOOL_GET_PULL_REQUEST_DIFF_DESCRIPTION", "Get the unified diff of a pull request as plain text")),
 			mcp.WithString("owner",
 				mcp.Required(),
 				mcp.Description("Repository owner"),
@@ -306,14 +1622,9 @@ func MergePullRequest(getClient GetClientFn, t translations.TranslationHelperFun
 				mcp.Required(),
 				mcp.Description("Pull request number"),
 			),
-			mcp.WithString("commit_title",
-				mcp.Description("Title for merge commit"),
-			),
-			mcp.WithString("commit_message",
-				mcp.Description("Extra detail for merge commit"),
-			),
-			mcp.WithString("merge_method",
-				mcp.Description("Merge method ('merge', 'squash', 'rebase')"),
+			mcp.WithNumber("max_bytes",
+				mcp.Description(fmt.Sprintf("Maximum size of the diff in bytes, longer diffs are truncated (default %d)", defaultDiffLimit)),
+				mcp.Min(1),
 			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -329,55 +1640,139 @@ func MergePullRequest(getClient GetClientFn, t translations.TranslationHelperFun
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			commitTitle, err := OptionalParam[string](request, "commit_title")
-			if err != nil {
-				return mcp.NewToolResultError(err.Error()), nil
-			}
-			commitMessage, err := OptionalParam[string](request, "commit_message")
-			if err != nil {
-				return mcp.NewToolResultError(err.Error()), nil
-			}
-			mergeMethod, err := OptionalParam[string](request, "merge_method")
+			maxBytes, err := OptionalIntParamWithDefault(request, "max_bytes", defaultDiffLimit)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-
-			options := &github.PullRequestOptions{
-				CommitTitle: commitTitle,
-				MergeMethod: mergeMethod,
+			if maxBytes < 1 {
+				return mcp.NewToolResultError("max_bytes must be at least 1"), nil
 			}
 
 			client, err := getClient(ctx)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
-			result, resp, err := client.PullRequests.Merge(ctx, owner, repo, pullNumber, commitMessage, options)
+			diff, resp, err := client.PullRequests.GetRaw(ctx, owner, repo, pullNumber, github.RawOptions{Type: github.Diff})
 			if err != nil {
-				return nil, fmt.Errorf("failed to merge pull request: %w", err)
+				// The API refuses to render diffs that are too large, so list the changed
+				// files instead.
+				var errorResponse *github.ErrorResponse
+				if errors.As(err, &errorResponse) && errorResponse.Response != nil && errorResponse.Response.StatusCode == http.StatusNotAcceptable {
+					return pullRequestFilesFallback(ctx, client, owner, repo, pullNumber, errorResponse.Message)
+				}
+				if result := apiErrorResult("failed to get pull request diff", err, http.StatusNotFound); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to get pull request diff: %w", err)
 			}
 			defer func() { _ = resp.Body.Close() }()
 
-			if resp.StatusCode != http.StatusOK {
-				body, err := io.ReadAll(resp.Body)
-				if err != nil {
-					return nil, fmt.Errorf("failed to read response body: %w", err)
-				}
-				return mcp.NewToolResultError(fmt.Sprintf("failed to merge pull request: %s", string(body))), nil
-			}
+			return mcp.NewToolResultText(truncateDiff(diff, maxBytes)), nil
+		}
+}
 
-			r, err := json.Marshal(result)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal response: %w", err)
-			}
+// truncateDiff shortens a unified diff to at most limit bytes, followed by a marker listing the
+// files whose changes were cut.
+func truncateDiff(diff string, limit int) string {
+	truncated, ok := truncatePatch(diff, limit)
+	if !ok {
+		return diff
+	}
+
+	// Find where the changes of each file start.
+	var starts []int
+	for offset := 0; offset < len(diff); {
+		if strings.HasPrefix(diff[offset:], "diff --git ") {
+			starts = append(starts, offset)
+		}
+		next := strings.IndexByte(diff[offset:], '\n')
+		if next < 0 {
+			break
+		}
+		offset += next + 1
+	}
+
+	var summary strings.Builder
+	fmt.Fprintf(&summary, "\n\n[diff truncated: showing %d of %d bytes]\nFiles not shown in full:\n", len(truncated), len(diff))
+	for k, start := range starts {
+		end := len(diff)
+		if k+1 < len(starts) {
+			end = starts[k+1]
+		}
+		switch {
+		case start >= len(truncated):
+			fmt.Fprintf(&summary, "- %s (omitted)\n", diffFileName(diff[start:end]))
+		case end > len(truncated):
+			fmt.Fprintf(&summary, "- %s (truncated)\n", diffFileName(diff[start:end]))
+		}
+	}
+	return truncated + summary.String()
+}
 
-			return mcp.NewToolResultText(string(r)), nil
+// diffFileName returns the path of the file of the changes starting with a
+// "diff --git a/path b/path" header line.
+func diffFileName(changes string) string {
+	header, _, _ := strings.Cut(changes, "\n")
+	header = strings.TrimPrefix(header, "diff --git ")
+	if i := strings.LastIndex(header, " b/"); i >= 0 {
+		return header[i+len(" b/"):]
+	}
+	return header
+}
+
+// pullRequestFilesFallback lists the files changed in a pull request whose diff is too large
+// to be returned.
+func pullRequestFilesFallback(ctx context.Context, client *github.Client, owner, repo string, pullNumber int, reason string) (*mcp.CallToolResult, error) {
+	opts := &github.ListOptions{PerPage: 100}
+	files, _, err := fetchAllPages(ctx, opts, pullRequestFileListLimit, func() ([]*github.CommitFile, *github.Response, error) {
+		return client.PullRequests.ListFiles(ctx, owner, repo, pullNumber, opts)
+	})
+	if err != nil {
+		if result := apiErrorResult("failed to get pull request files", err, http.StatusNotFound); result != nil {
+			return result, nil
 		}
+		return nil, fmt.Errorf("failed to get pull request files: %w", err)
+	}
+
+	var text strings.Builder
+	fmt.Fprintf(&text, "The diff is too large to be returned (%s). Files changed:\n", reason)
+	for _, file := range files {
+		fmt.Fprintf(&text, "- %s (%s, +%d -%d)\n", file.GetFilename(), file.GetStatus(), file.GetAdditions(), file.GetDeletions())
+	}
+	return mcp.NewToolResultText(text.String()), nil
 }
 
-// GetPullRequestFiles creates a tool to get the list of files changed in a pull request.
-func GetPullRequestFiles(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
-	return mcp.NewTool("get_pull_request_files",
-			mcp.WithDescription(t("TOOL_GET_PULL_REQUEST_FILES_DESCRIPTION", "Get the list of files changed in a pull request")),
+// conflictsHeuristicNote labels the conflicts found by get_pull_request_conflicts.
+const conflictsHeuristicNote = "Heuristic: conflicts lists the files changed on both the base and the head branch since their merge base, " +
+	"which may conflict. Changes to different parts of a file do not conflict, and at most 300 changed files per branch are compared."
+
+// fileChange is the change of a file on one side of a pull request.
+type fileChange struct {
+	Status    string `json:"status"`
+	Additions int    `json:"additions"`
+	Deletions int    `json:"deletions"`
+}
+
+// conflictCandidate is a file changed on both the base and head branch of a pull request.
+type conflictCandidate struct {
+	Filename string     `json:"filename"`
+	Base     fileChange `json:"base"`
+	Head     fileChange `json:"head"`
+}
+
+// pullRequestConflicts are the files that may keep a pull request from being merged.
+type pullRequestConflicts struct {
+	Mergeable      *bool               `json:"mergeable"`
+	MergeableState string              `json:"mergeable_state"`
+	Conflicts      []conflictCandidate `json:"conflicts"`
+	Note           string              `json:"note,omitempty"`
+}
+
+// GetPullRequestMergeConflicts creates a tool to find the files that may conflict when merging
+// a pull request.
+func GetPullRequestMergeConflicts(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_pull_request_conflicts",
+			mcp.WithDescription(t("TOOL_GET_PULL_REQUEST_CONFLICTS_DESCRIPTION", "Find the files that may conflict when merging a pull request that cannot be merged, by comparing the files changed on the base and head branch")),
 			mcp.WithString("owner",
 				mcp.Required(),
 				mcp.Description("Repository owner"),
@@ -409,22 +1804,37 @@ func GetPullRequestFiles(getClient GetClientFn, t translations.TranslationHelper
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
-			opts := &github.ListOptions{}
-			files, resp, err := client.PullRequests.ListFiles(ctx, owner, repo, pullNumber, opts)
+			pr, resp, err := client.PullRequests.Get(ctx, owner, repo, pullNumber)
 			if err != nil {
-				return nil, fmt.Errorf("failed to get pull request files: %w", err)
+				if result := apiErrorResult("failed to get pull request", err, http.StatusNotFound); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to get pull request: %w", err)
 			}
-			defer func() { _ = resp.Body.Close() }()
+			_ = resp.Body.Close()
 
-			if resp.StatusCode != http.StatusOK {
-				body, err := io.ReadAll(resp.Body)
+			state := pullRequestConflicts{
+				Mergeable:      pr.Mergeable,
+				MergeableState: pr.GetMergeableState(),
+				Conflicts:      []conflictCandidate{},
+			}
+			switch {
+			case state.MergeableState == "dirty":
+				conflicts, err := findConflictCandidates(ctx, client, owner, repo, pr)
 				if err != nil {
-					return nil, fmt.Errorf("failed to read response body: %w", err)
+					if result := apiErrorResult("failed to compare branches", err, http.StatusNotFound); result != nil {
+						return result, nil
+					}
+					return nil, fmt.Errorf("failed to compare branches: %w", err)
 				}
-				return mcp.NewToolResultError(fmt.Sprintf("failed to get pull request files: %s", string(body))), nil
+				state.Conflicts = conflicts
+				state.Note = conflictsHeuristicNote
+			case pr.Mergeable == nil || state.MergeableState == "unknown":
+				// GitHub computes mergeability in the background after the pull request changes.
+				state.Note = "GitHub is still checking whether the pull request can be merged, try again shortly"
 			}
 
-			r, err := json.Marshal(files)
+			r, err := json.Marshal(state)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
@@ -433,10 +1843,137 @@ func GetPullRequestFiles(getClient GetClientFn, t translations.TranslationHelper
 		}
 }
 
-// GetPullRequestStatus creates a tool to get the combined status of all status checks for a pull request.
+// findConflictCandidates compares the files changed on the head branch of a pull request with
+// the files changed on its base branch since their merge base.
+func findConflictCandidates(ctx context.Context, client *github.Client, owner, repo string, pr *github.PullRequest) ([]conflictCandidate, error) {
+	headComparison, resp, err := client.Repositories.CompareCommits(ctx, owner, repo, pr.GetBase().GetSHA(), pr.GetHead().GetSHA(), nil)
+	if err != nil {
+		return nil, err
+	}
+	_ = resp.Body.Close()
+
+	baseComparison, resp, err := client.Repositories.CompareCommits(ctx, owner, repo, headComparison.GetMergeBaseCommit().GetSHA(), pr.GetBase().GetSHA(), nil)
+	if err != nil {
+		return nil, err
+	}
+	_ = resp.Body.Close()
+
+	baseChanges := make(map[string]*github.CommitFile, len(baseComparison.Files))
+	for _, file := range baseComparison.Files {
+		baseChanges[file.GetFilename()] = file
+	}
+	conflicts := []conflictCandidate{}
+	for _, headFile := range headComparison.Files {
+		baseFile, ok := baseChanges[headFile.GetFilename()]
+		if !ok {
+			continue
+		}
+		conflicts = append(conflicts, conflictCandidate{
+			Filename: headFile.GetFilename(),
+			Base: fileChange{
+				Status:    baseFile.GetStatus(),
+				Additions: baseFile.GetAdditions(),
+				Deletions: baseFile.GetDeletions(),
+			},
+			Head: fileChange{
+				Status:    headFile.GetStatus(),
+				Additions: headFile.GetAdditions(),
+				Deletions: headFile.GetDeletions(),
+			},
+		})
+	}
+	return conflicts, nil
+}
+
+// checkRunListLimit is the maximum number of check runs fetched for a commit.
+const checkRunListLimit = 1000
+
+// statusCheck is a commit status or check run of a commit.
+type statusCheck struct {
+	Name       string `json:"name"`
+	Source     string `json:"source"`
+	State      string `json:"state"`
+	Conclusion string `json:"conclusion,omitempty"`
+	DetailsURL string `json:"details_url,omitempty"`
+}
+
+// pullRequestStatus is the overall state of the status checks of the head commit of a pull
+// request.
+type pullRequestStatus struct {
+	State  string        `json:"state"`
+	SHA    string        `json:"sha"`
+	Checks []statusCheck `json:"checks"`
+}
+
+// failedCheckConclusions are the conclusions of check runs that fail the overall state.
+var failedCheckConclusions = map[string]bool{
+	"failure":         true,
+	"cancelled":       true,
+	"timed_out":       true,
+	"action_required": true,
+	"startup_failure": true,
+}
+
+// newPullRequestStatus merges the commit statuses and check runs of a commit and rolls them
+// up into an overall state. A check run replaces a commit status of the same name, as CI
+// systems often report both. The state is "failure" when any check failed, otherwise
+// "pending" when any check has not completed yet, otherwise "success".
+func newPullRequestStatus(sha string, statuses []*github.RepoStatus, checkRuns []*github.CheckRun) pullRequestStatus {
+	result := pullRequestStatus{
+		State:  "success",
+		SHA:    sha,
+		Checks: []statusCheck{},
+	}
+	seen := make(map[string]bool)
+	for _, run := range checkRuns {
+		if seen[run.GetName()] {
+			continue
+		}
+		seen[run.GetName()] = true
+
+		detailsURL := run.GetDetailsURL()
+		if detailsURL == "" {
+			detailsURL = run.GetHTMLURL()
+		}
+		result.Checks = append(result.Checks, statusCheck{
+			Name:       run.GetName(),
+			Source:     "check",
+			State:      run.GetStatus(),
+			Conclusion: run.GetConclusion(),
+			DetailsURL: detailsURL,
+		})
+	}
+	for _, status := range statuses {
+		if seen[status.GetContext()] {
+			continue
+		}
+		seen[status.GetContext()] = true
+
+		result.Checks = append(result.Checks, statusCheck{
+			Name:       status.GetContext(),
+			Source:     "status",
+			State:      status.GetState(),
+			DetailsURL: status.GetTargetURL(),
+		})
+	}
+
+	for _, check := range result.Checks {
+		switch {
+		case check.Source == "check" && failedCheckConclusions[check.Conclusion],
+			check.Source == "status" && (check.State == "failure" || check.State == "error"):
+			result.State = "failure"
+		case result.State == "success" &&
+			(check.Source == "check" && check.State != "completed" || check.Source == "status" && check.State == "pending"):
+			result.State = "pending"
+		}
+	}
+	return result
+}
+
+// GetPullRequestStatus creates a tool to get the commit statuses and check runs of a pull request.
 func GetPullRequestStatus(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("get_pull_request_status",
-			mcp.WithDescription(t("TOOL_GET_PULL_REQUEST_STATUS_DESCRIPTION", "Get the combined status of all status checks for a pull request")),
+			mcp.WithDescription(t("TOOL_GET_PULL_REQUEST_STATUS_DESCRIPTION", "Get the status of the checks and commit statuses of the head commit of a pull request, with an overall state of success, failure or pending")),
 			mcp.WithString("owner",
 				mcp.Required(),
 				mcp.Description("Repository owner"),
@@ -482,8 +2019,10 @@ func GetPullRequestStatus(getClient GetClientFn, t translations.TranslationHelpe
 				return mcp.NewToolResultError(fmt.Sprintf("failed to get pull request: %s", string(body))), nil
 			}
 
+			sha := pr.GetHead().GetSHA()
+
 			// Get combined status for the head SHA
-			status, resp, err := client.Repositories.GetCombinedStatus(ctx, owner, repo, *pr.Head.SHA, nil)
+			status, resp, err := client.Repositories.GetCombinedStatus(ctx, owner, repo, sha, &github.ListOptions{PerPage: 100})
 			if err != nil {
 				return nil, fmt.Errorf("failed to get combined status: %w", err)
 			}
@@ -497,7 +2036,20 @@ func GetPullRequestStatus(getClient GetClientFn, t translations.TranslationHelpe
 				return mcp.NewToolResultError(fmt.Sprintf("failed to get combined status: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(status)
+			// Get the check runs for the head SHA
+			opts := &github.ListCheckRunsOptions{ListOptions: github.ListOptions{PerPage: 100}}
+			checkRuns, _, err := fetchAllPages(ctx, &opts.ListOptions, checkRunListLimit, func() ([]*github.CheckRun, *github.Response, error) {
+				result, resp, err := client.Checks.ListCheckRunsForRef(ctx, owner, repo, sha, opts)
+				if err != nil {
+					return nil, resp, err
+				}
+				return result.CheckRuns, resp, nil
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to get check runs: %w", err)
+			}
+
+			r, err := json.Marshal(newPullRequestStatus(sha, status.Statuses, checkRuns))
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
@@ -644,10 +2196,240 @@ func GetPullRequestComments(getClient GetClientFn, t translations.TranslationHel
 		}
 }
 
+// reviewComment is a trimmed down review comment, with its replies when comments are threaded.
+type reviewComment struct {
+	ID           int64             `json:"id"`
+	Path         string            `json:"path"`
+	Line         *int              `json:"line"`
+	OriginalLine *int              `json:"original_line"`
+	Side         string            `json:"side,omitempty"`
+	DiffHunk     string            `json:"diff_hunk,omitempty"`
+	Body         string            `json:"body"`
+	User         string            `json:"user"`
+	InReplyToID  int64             `json:"in_reply_to_id,omitempty"`
+	CreatedAt    *github.Timestamp `json:"created_at,omitempty"`
+	HTMLURL      string            `json:"html_url"`
+	Replies      []*reviewComment  `json:"replies,omitempty"`
+}
+
+func newReviewComment(comment *github.PullRequestComment, includeDiffHunk bool) *reviewComment {
+	c := &reviewComment{
+		ID:           comment.GetID(),
+		Path:         comment.GetPath(),
+		Line:         comment.Line,
+		OriginalLine: comment.OriginalLine,
+		Side:         comment.GetSide(),
+		Body:         comment.GetBody(),
+		User:         comment.GetUser().GetLogin(),
+		InReplyToID:  comment.GetInReplyTo(),
+		CreatedAt:    comment.CreatedAt,
+		HTMLURL:      comment.GetHTMLURL(),
+	}
+	if includeDiffHunk {
+		c.DiffHunk = comment.GetDiffHunk()
+	}
+	return c
+}
+
+// threadReviewComments nests replies under the comments they reply to. Replies to comments that
+// are not among the given ones, such as comments on another page, are kept at the top level.
+func threadReviewComments(comments []*reviewComment) []*reviewComment {
+	byID := make(map[int64]*reviewComment, len(comments))
+	for _, comment := range comments {
+		byID[comment.ID] = comment
+	}
+
+	threads := []*reviewComment{}
+	for _, comment := range comments {
+		if parent, ok := byID[comment.InReplyToID]; ok && comment.InReplyToID != 0 {
+			parent.Replies = append(parent.Replies, comment)
+			continue
+		}
+		threads = append(threads, comment)
+	}
+	return threads
+}
+
+// ListPullRequestReviewComments creates a tool to list the review comments on a pull request,
+// optionally threaded.
+func ListPullRequestReviewComments(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_pull_request_review_comments",
+			mcp.WithDescription(t("TOOL_GET_PULL_REQUEST_REVIEW_COMMENTS_DESCRIPTION", "List the review comments on the lines of a pull request, optionally with replies grouped under the comment they reply to")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+			mcp.WithString("sort",
+				mcp.Description("Sort by ('created', 'updated')"),
+				mcp.Enum("created", "updated"),
+			),
+			mcp.WithString("direction",
+				mcp.Description("Sort direction ('asc', 'desc')"),
+				mcp.Enum("asc", "desc"),
+			),
+			mcp.WithString("since",
+				mcp.Description("Only comments updated at or after this time (ISO 8601 timestamp)"),
+			),
+			mcp.WithBoolean("include_diff_hunk",
+				mcp.Description("Include the diff hunk each comment was made on, which can be long (default false)"),
+			),
+			mcp.WithBoolean("threaded",
+				mcp.Description("Group replies under the comment they reply to (default false)"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			sort, err := OptionalParam[string](request, "sort")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			direction, err := OptionalParam[string](request, "direction")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			since, err := OptionalParam[string](request, "since")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			includeDiffHunk, err := OptionalParam[bool](request, "include_diff_hunk")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			threaded, err := OptionalParam[bool](request, "threaded")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts := &github.PullRequestListCommentsOptions{
+				Sort:      sort,
+				Direction: direction,
+				ListOptions: github.ListOptions{
+					Page:    pagination.page,
+					PerPage: pagination.perPage,
+				},
+			}
+			if since != "" {
+				timestamp, err := parseISOTimestamp(since)
+				if err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("failed to list review comments: %s", err.Error())), nil
+				}
+				opts.Since = timestamp
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			comments, resp, err := client.PullRequests.ListComments(ctx, owner, repo, pullNumber, opts)
+			if err != nil {
+				if result := apiErrorResult("failed to list review comments", err, http.StatusNotFound); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to list review comments: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			trimmed := make([]*reviewComment, 0, len(comments))
+			for _, comment := range comments {
+				trimmed = append(trimmed, newReviewComment(comment, includeDiffHunk))
+			}
+			if threaded {
+				trimmed = threadReviewComments(trimmed)
+			}
+
+			r, err := json.Marshal(trimmed)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// reviewListLimit is the maximum number of reviews fetched to summarize the reviews of a pull
+// request.
+const reviewListLimit = 1000
+
+// pullRequestReview is a trimmed down pull request review.
+type pullRequestReview struct {
+	ID          int64             `json:"id"`
+	User        string            `json:"user"`
+	State       string            `json:"state"`
+	Body        string            `json:"body"`
+	SubmittedAt *github.Timestamp `json:"submitted_at,omitempty"`
+}
+
+// pullRequestReviews is a page of the reviews of a pull request, with a summary of all of them.
+type pullRequestReviews struct {
+	Reviews   []pullRequestReview `json:"reviews"`
+	Summary   reviewSummary       `json:"summary"`
+	Truncated bool                `json:"truncated,omitempty"`
+}
+
+// reviewSummary is the latest review state of each reviewer of a pull request.
+type reviewSummary struct {
+	LatestStates     map[string]string `json:"latest_states"`
+	Approvals        int               `json:"approvals"`
+	ChangesRequested int               `json:"changes_requested"`
+}
+
+// summarizeReviews determines the latest review state of each reviewer, in the way GitHub
+// decides whether a pull request is approved: a comment does not replace an earlier approval
+// or change request, and pending reviews are not submitted yet.
+func summarizeReviews(reviews []*github.PullRequestReview) reviewSummary {
+	summary := reviewSummary{LatestStates: make(map[string]string)}
+	for _, review := range reviews {
+		login := review.GetUser().GetLogin()
+		state := strings.ToUpper(review.GetState())
+		switch state {
+		case "PENDING":
+			continue
+		case "COMMENTED":
+			if _, ok := summary.LatestStates[login]; ok {
+				continue
+			}
+		}
+		summary.LatestStates[login] = state
+	}
+	for _, state := range summary.LatestStates {
+		switch state {
+		case "APPROVED":
+			summary.Approvals++
+		case "CHANGES_REQUESTED":
+			summary.ChangesRequested++
+		}
+	}
+	return summary
+}
+
 // GetPullRequestReviews creates a tool to get the reviews on a pull request.
 func GetPullRequestReviews(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("get_pull_request_reviews",
-			mcp.WithDescription(t("TOOL_GET_PULL_REQUEST_REVIEWS_DESCRIPTION", "Get the reviews on a pull request")),
+			mcp.WithDescription(t("TOOL_GET_PULL_REQUEST_REVIEWS_DESCRIPTION", "Get the reviews on a pull request, with the latest review state of each reviewer")),
 			mcp.WithString("owner",
 				mcp.Required(),
 				mcp.Description("Repository owner"),
@@ -660,6 +2442,7 @@ func GetPullRequestReviews(getClient GetClientFn, t translations.TranslationHelp
 				mcp.Required(),
 				mcp.Description("Pull request number"),
 			),
+			WithPagination(),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := requiredParam[string](request, "owner")
@@ -674,26 +2457,49 @@ func GetPullRequestReviews(getClient GetClientFn, t translations.TranslationHelp
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
 
 			client, err := getClient(ctx)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
-			reviews, resp, err := client.PullRequests.ListReviews(ctx, owner, repo, pullNumber, nil)
+
+			// The summary covers all reviews, so fetch all of them and page through them.
+			opts := &github.ListOptions{PerPage: 100}
+			reviews, truncated, err := fetchAllPages(ctx, opts, reviewListLimit, func() ([]*github.PullRequestReview, *github.Response, error) {
+				return client.PullRequests.ListReviews(ctx, owner, repo, pullNumber, opts)
+			})
 			if err != nil {
+				if result := apiErrorResult("failed to get pull request reviews", err, http.StatusNotFound); result != nil {
+					return result, nil
+				}
 				return nil, fmt.Errorf("failed to get pull request reviews: %w", err)
 			}
-			defer func() { _ = resp.Body.Close() }()
 
-			if resp.StatusCode != http.StatusOK {
-				body, err := io.ReadAll(resp.Body)
-				if err != nil {
-					return nil, fmt.Errorf("failed to read response body: %w", err)
-				}
-				return mcp.NewToolResultError(fmt.Sprintf("failed to get pull request reviews: %s", string(body))), nil
+			page, err := paginateSlice(reviews, pagination)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			result := pullRequestReviews{
+				Reviews:   make([]pullRequestReview, 0, len(page)),
+				Summary:   summarizeReviews(reviews),
+				Truncated: truncated,
+			}
+			for _, review := range page {
+				result.Reviews = append(result.Reviews, pullRequestReview{
+					ID:          review.GetID(),
+					User:        review.GetUser().GetLogin(),
+					State:       review.GetState(),
+					Body:        review.GetBody(),
+					SubmittedAt: review.SubmittedAt,
+				})
 			}
 
-			r, err := json.Marshal(reviews)
+			r, err := json.Marshal(result)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
@@ -919,7 +2725,7 @@ func CreatePullRequest(getClient GetClientFn, t translations.TranslationHelperFu
 			),
 			mcp.WithString("head",
 				mcp.Required(),
-				mcp.Description("Branch containing changes"),
+				mcp.Description("Branch containing changes, or 'user:branch' for a branch of a fork"),
 			),
 			mcp.WithString("base",
 				mcp.Required(),
@@ -953,6 +2759,9 @@ func CreatePullRequest(getClient GetClientFn, t translations.TranslationHelperFu
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			if err := validatePullRequestHead(head); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
 
 			body, err := OptionalParam[string](request, "body")
 			if err != nil {
@@ -988,6 +2797,18 @@ func CreatePullRequest(getClient GetClientFn, t translations.TranslationHelperFu
 			}
 			pr, resp, err := client.PullRequests.Create(ctx, owner, repo, newPR)
 			if err != nil {
+				// Validation errors, such as a branch without new commits or an existing pull
+				// request for the branch, are reported so the client can act on them.
+				var errorResponse *github.ErrorResponse
+				if errors.As(err, &errorResponse) && errorResponse.Response != nil && errorResponse.Response.StatusCode == http.StatusUnprocessableEntity {
+					message := "failed to create pull request: " + apiErrorDetails(errorResponse)
+					if strings.Contains(message, "A pull request already exists") {
+						if existing := findOpenPullRequest(ctx, client, owner, repo, head, base); existing != nil {
+							message += fmt.Sprintf("; existing pull request #%d: %s", existing.GetNumber(), existing.GetHTMLURL())
+						}
+					}
+					return mcp.NewToolResultError(message), nil
+				}
 				return nil, fmt.Errorf("failed to create pull request: %w", err)
 			}
 			defer func() { _ = resp.Body.Close() }()
@@ -1008,3 +2829,63 @@ func CreatePullRequest(getClient GetClientFn, t translations.TranslationHelperFu
 			return mcp.NewToolResultText(string(r)), nil
 		}
 }
+
+// validatePullRequestHead checks that the head of a new pull request is a branch name, or a
+// branch of a fork in the format "user:branch".
+func validatePullRequestHead(head string) error {
+	user, branch, found := strings.Cut(head, ":")
+	if strings.ContainsAny(head, " \t") || (found && (user == "" || branch == "" || strings.Contains(branch, ":"))) {
+		return fmt.Errorf("invalid head %q: must be a branch name or in the format 'user:branch'", head)
+	}
+	return nil
+}
+
+// findOpenPullRequest looks up the open pull request from head into base. It returns nil when
+// there is none or it cannot be looked up.
+func findOpenPullRequest(ctx context.Context, client *github.Client, owner, repo, head, base string) *github.PullRequest {
+	// Filtering by head requires the user or organization of the branch.
+	if !strings.Contains(head, ":") {
+		head = owner + ":" + head
+	}
+	prs, resp, err := client.PullRequests.List(ctx, owner, repo, &github.PullRequestListOptions{
+		State: "open",
+		Head:  head,
+		Base:  base,
+	})
+	if err != nil {
+		return nil
+	}
+	_ = resp.Body.Close()
+	if len(prs) == 0 {
+		return nil
+	}
+	return prs[0]
+}
+
+const convertPullRequestToDraftMutation = `mutation ConvertPullRequestToDraft($pullRequestId: ID!) {
+  convertPullRequestToDraft(input: {pullRequestId: $pullRequestId}) {
+    pullRequest { isDraft }
+  }
+}`
+
+const markPullRequestReadyForReviewMutation = `mutation MarkPullRequestReadyForReview($pullRequestId: ID!) {
+  markPullRequestReadyForReview(input: {pullRequestId: $pullRequestId}) {
+    pullRequest { isDraft }
+  }
+}`
+
+const enablePullRequestAutoMergeMutation = `mutation EnablePullRequestAutoMerge($pullRequestId: ID!, $mergeMethod: PullRequestMergeMethod, $commitHeadline: String, $commitBody: String) {
+  enablePullRequestAutoMerge(input: {pullRequestId: $pullRequestId, mergeMethod: $mergeMethod, commitHeadline: $commitHeadline, commitBody: $commitBody}) {
+    pullRequest {
+      autoMergeRequest { enabledAt enabledBy { login } mergeMethod commitHeadline commitBody }
+    }
+  }
+}`
+
+const disablePullRequestAutoMergeMutation = `mutation DisablePullRequestAutoMerge($pullRequestId: ID!) {
+  disablePullRequestAutoMerge(input: {pullRequestId: $pullRequestId}) {
+    pullRequest {
+      autoMergeRequest { enabledAt enabledBy { login } mergeMethod commitHeadline commitBody }
+    }
+  }
+}`
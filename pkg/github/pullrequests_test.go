@@ -3,7 +3,9 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -232,13 +234,34 @@ func Test_UpdatePullRequest(t *testing.T) {
 			expectedErrMsg: "No update parameters provided",
 		},
 		{
-			name: "PR update fails (API error)",
+			name: "successful PR update (body only)",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposPullsByOwnerByRepoByPullNumber,
+					expectRequestBody(t, map[string]interface{}{
+						"body": "Updated test PR body.",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockUpdatedPR),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"body":       "Updated test PR body.",
+			},
+			expectError: false,
+			expectedPR:  mockUpdatedPR,
+		},
+		{
+			name: "PR update fails (nonexistent base branch)",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
 					mock.PatchReposPullsByOwnerByRepoByPullNumber,
 					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
 						w.WriteHeader(http.StatusUnprocessableEntity)
-						_, _ = w.Write([]byte(`{"message": "Validation Failed"}`))
+						_, _ = w.Write([]byte(`{"message": "Validation Failed", "errors": [{"resource": "PullRequest", "field": "base", "code": "invalid", "message": "Proposed base branch 'missing' was not found"}]}`))
 					}),
 				),
 			),
@@ -246,10 +269,10 @@ func Test_UpdatePullRequest(t *testing.T) {
 				"owner":      "owner",
 				"repo":       "repo",
 				"pullNumber": float64(42),
-				"title":      "Invalid Title Causing Error",
+				"base":       "missing",
 			},
-			expectError:    true,
-			expectedErrMsg: "failed to update pull request",
+			expectError:    false,
+			expectedErrMsg: "failed to update pull request: Validation Failed; Proposed base branch 'missing' was not found",
 		},
 	}
 
@@ -327,27 +350,60 @@ func Test_ListPullRequests(t *testing.T) {
 	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
 
 	// Setup mock PRs for success case
+	updatedAt := &github.Timestamp{Time: time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)}
 	mockPRs := []*github.PullRequest{
 		{
-			Number:  github.Ptr(42),
-			Title:   github.Ptr("First PR"),
-			State:   github.Ptr("open"),
-			HTMLURL: github.Ptr("https://github.com/owner/repo/pull/42"),
+			Number:    github.Ptr(42),
+			Title:     github.Ptr("First PR"),
+			State:     github.Ptr("open"),
+			Draft:     github.Ptr(true),
+			User:      &github.User{Login: github.Ptr("user1")},
+			Base:      &github.PullRequestBranch{Ref: github.Ptr("main")},
+			Head:      &github.PullRequestBranch{Ref: github.Ptr("feature")},
+			Body:      github.Ptr("A long description"),
+			UpdatedAt: updatedAt,
+			HTMLURL:   github.Ptr("https://github.com/owner/repo/pull/42"),
 		},
 		{
 			Number:  github.Ptr(43),
 			Title:   github.Ptr("Second PR"),
 			State:   github.Ptr("closed"),
+			User:    &github.User{Login: github.Ptr("user2")},
+			Base:    &github.PullRequestBranch{Ref: github.Ptr("main")},
+			Head:    &github.PullRequestBranch{Ref: github.Ptr("fix")},
 			HTMLURL: github.Ptr("https://github.com/owner/repo/pull/43"),
 		},
 	}
 
+	expectedPRs := []pullRequestSummary{
+		{
+			Number:    42,
+			Title:     "First PR",
+			State:     "open",
+			Draft:     true,
+			User:      "user1",
+			Base:      "main",
+			Head:      "feature",
+			UpdatedAt: updatedAt,
+			HTMLURL:   "https://github.com/owner/repo/pull/42",
+		},
+		{
+			Number:  43,
+			Title:   "Second PR",
+			State:   "closed",
+			User:    "user2",
+			Base:    "main",
+			Head:    "fix",
+			HTMLURL: "https://github.com/owner/repo/pull/43",
+		},
+	}
+
 	tests := []struct {
 		name           string
 		mockedClient   *http.Client
 		requestArgs    map[string]interface{}
 		expectError    bool
-		expectedPRs    []*github.PullRequest
+		expectedPRs    []pullRequestSummary
 		expectedErrMsg string
 	}{
 		{
@@ -357,10 +413,12 @@ func Test_ListPullRequests(t *testing.T) {
 					mock.GetReposPullsByOwnerByRepo,
 					expectQueryParams(t, map[string]string{
 						"state":     "all",
-						"sort":      "created",
+						"head":      "owner:feature",
+						"base":      "main",
+						"sort":      "popularity",
 						"direction": "desc",
-						"per_page":  "30",
-						"page":      "1",
+						"per_page":  "10",
+						"page":      "2",
 					}).andThen(
 						mockResponse(t, http.StatusOK, mockPRs),
 					),
@@ -370,13 +428,15 @@ func Test_ListPullRequests(t *testing.T) {
 				"owner":     "owner",
 				"repo":      "repo",
 				"state":     "all",
-				"sort":      "created",
+				"head":      "owner:feature",
+				"base":      "main",
+				"sort":      "popularity",
 				"direction": "desc",
-				"perPage":   float64(30),
-				"page":      float64(1),
+				"perPage":   float64(10),
+				"page":      float64(2),
 			},
 			expectError: false,
-			expectedPRs: mockPRs,
+			expectedPRs: expectedPRs,
 		},
 		{
 			name: "PRs listing fails",
@@ -392,11 +452,51 @@ func Test_ListPullRequests(t *testing.T) {
 			requestArgs: map[string]interface{}{
 				"owner": "owner",
 				"repo":  "repo",
-				"state": "invalid",
+				"state": "open",
 			},
 			expectError:    true,
 			expectedErrMsg: "failed to list pull requests",
 		},
+		{
+			name:         "invalid state",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"state": "merged",
+			},
+			expectedErrMsg: `invalid state "merged": must be one of open, closed, all`,
+		},
+		{
+			name:         "head without owner",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"head":  "feature",
+			},
+			expectedErrMsg: `invalid head "feature": must be in the format user:branch`,
+		},
+		{
+			name:         "invalid sort",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"sort":  "comments",
+			},
+			expectedErrMsg: `invalid sort "comments": must be one of created, updated, popularity, long-running`,
+		},
+		{
+			name:         "invalid direction",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":     "owner",
+				"repo":      "repo",
+				"direction": "up",
+			},
+			expectedErrMsg: `invalid direction "up": must be one of asc, desc`,
+		},
 	}
 
 	for _, tc := range tests {
@@ -423,17 +523,242 @@ func Test_ListPullRequests(t *testing.T) {
 			// Parse the result and get the text content if no error
 			textContent := getTextResult(t, result)
 
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
 			// Unmarshal and verify the result
-			var returnedPRs []*github.PullRequest
+			var returnedPRs []pullRequestSummary
 			err = json.Unmarshal([]byte(textContent.Text), &returnedPRs)
 			require.NoError(t, err)
-			assert.Len(t, returnedPRs, 2)
-			assert.Equal(t, *tc.expectedPRs[0].Number, *returnedPRs[0].Number)
-			assert.Equal(t, *tc.expectedPRs[0].Title, *returnedPRs[0].Title)
-			assert.Equal(t, *tc.expectedPRs[0].State, *returnedPRs[0].State)
-			assert.Equal(t, *tc.expectedPRs[1].Number, *returnedPRs[1].Number)
-			assert.Equal(t, *tc.expectedPRs[1].Title, *returnedPRs[1].Title)
-			assert.Equal(t, *tc.expectedPRs[1].State, *returnedPRs[1].State)
+			assert.Equal(t, tc.expectedPRs, returnedPRs)
+		})
+	}
+}
+
+func Test_SearchPullRequests(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := SearchPullRequests(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "search_pull_requests", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "query")
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "state")
+	assert.Contains(t, tool.InputSchema.Properties, "author")
+	assert.Contains(t, tool.InputSchema.Properties, "reviewed_by")
+	assert.Contains(t, tool.InputSchema.Properties, "review")
+	assert.Contains(t, tool.InputSchema.Properties, "base")
+	assert.Contains(t, tool.InputSchema.Properties, "merged_after")
+	assert.Contains(t, tool.InputSchema.Properties, "merged_before")
+	assert.Contains(t, tool.InputSchema.Properties, "sort")
+	assert.Contains(t, tool.InputSchema.Properties, "order")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.Empty(t, tool.InputSchema.Required)
+
+	mergedAt := &github.Timestamp{Time: time.Date(2025, 3, 2, 10, 0, 0, 0, time.UTC)}
+	mockSearchResult := &github.IssuesSearchResult{
+		Total:             github.Ptr(2),
+		IncompleteResults: github.Ptr(false),
+		Issues: []*github.Issue{
+			{
+				Number:           github.Ptr(42),
+				Title:            github.Ptr("Fix flaky test"),
+				State:            github.Ptr("closed"),
+				User:             &github.User{Login: github.Ptr("octocat")},
+				HTMLURL:          github.Ptr("https://github.com/owner/repo/pull/42"),
+				RepositoryURL:    github.Ptr("https://api.github.com/repos/owner/repo"),
+				PullRequestLinks: &github.PullRequestLinks{MergedAt: mergedAt},
+			},
+			{
+				Number:           github.Ptr(43),
+				Title:            github.Ptr("WIP: new parser"),
+				State:            github.Ptr("open"),
+				Draft:            github.Ptr(true),
+				User:             &github.User{Login: github.Ptr("octocat")},
+				HTMLURL:          github.Ptr("https://github.com/owner/repo/pull/43"),
+				RepositoryURL:    github.Ptr("https://api.github.com/repos/owner/repo"),
+				PullRequestLinks: &github.PullRequestLinks{},
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectedResult pullRequestSearchResult
+		expectedErrMsg string
+	}{
+		{
+			name: "search with filters",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetSearchIssues,
+					expectQueryParams(t, map[string]string{
+						"q":        "is:pr flaky repo:owner/repo author:octocat",
+						"sort":     "updated",
+						"order":    "desc",
+						"page":     "1",
+						"per_page": "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockSearchResult),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"query":  "flaky is:pr",
+				"owner":  "owner",
+				"repo":   "repo",
+				"author": "octocat",
+				"sort":   "updated",
+				"order":  "desc",
+			},
+			expectedResult: pullRequestSearchResult{
+				TotalCount: 2,
+				Items: []pullRequestSearchItem{
+					{
+						Repository: "owner/repo",
+						Number:     42,
+						Title:      "Fix flaky test",
+						State:      "closed",
+						Merged:     true,
+						MergedAt:   mergedAt,
+						User:       "octocat",
+						URL:        "https://github.com/owner/repo/pull/42",
+					},
+					{
+						Repository: "owner/repo",
+						Number:     43,
+						Title:      "WIP: new parser",
+						State:      "open",
+						Draft:      true,
+						User:       "octocat",
+						URL:        "https://github.com/owner/repo/pull/43",
+					},
+				},
+			},
+		},
+		{
+			name:         "query for issues",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"query": "flaky is:issue",
+			},
+			expectedErrMsg: "query cannot contain is:issue, only pull requests are searched",
+		},
+		{
+			name: "invalid query",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetSearchIssues,
+					mockResponse(t, http.StatusUnprocessableEntity, map[string]string{
+						"message": "Validation Failed",
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"query": "author:",
+			},
+			expectedErrMsg: "failed to search pull requests: Validation Failed",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := SearchPullRequests(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+			require.False(t, result.IsError, textContent.Text)
+
+			// Unmarshal and verify the result
+			var returned pullRequestSearchResult
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
+
+func Test_PullRequestSearchQuery(t *testing.T) {
+	tests := []struct {
+		name           string
+		args           map[string]interface{}
+		expectedQuery  string
+		expectedErrMsg string
+	}{
+		{
+			name:          "no filters",
+			args:          map[string]interface{}{},
+			expectedQuery: "is:pr",
+		},
+		{
+			name:          "query already restricted to pull requests",
+			args:          map[string]interface{}{"query": "is:pr fix IS:PR type:pr parser is:pull-request"},
+			expectedQuery: "is:pr fix parser",
+		},
+		{
+			name: "all filters",
+			args: map[string]interface{}{
+				"query":         "parser",
+				"owner":         "owner",
+				"state":         "merged",
+				"author":        "octocat",
+				"reviewed_by":   "hubot",
+				"review":        "approved",
+				"base":          "release 1.x",
+				"merged_after":  "2025-01-01",
+				"merged_before": "2025-02-01",
+			},
+			expectedQuery: `is:pr parser user:owner is:merged author:octocat reviewed-by:hubot review:approved base:"release 1.x" merged:2025-01-01..2025-02-01`,
+		},
+		{
+			name:          "open pull requests merged before",
+			args:          map[string]interface{}{"state": "open", "merged_before": "2025-02-01T00:00:00Z"},
+			expectedQuery: "is:pr state:open merged:<=2025-02-01T00:00:00Z",
+		},
+		{
+			name:           "repo without owner",
+			args:           map[string]interface{}{"repo": "repo"},
+			expectedErrMsg: "repo can only be used together with owner",
+		},
+		{
+			name:           "invalid merge time",
+			args:           map[string]interface{}{"merged_after": "last week"},
+			expectedErrMsg: "invalid merged_after",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			query, err := pullRequestSearchQuery(createMCPRequest(tc.args))
+			if tc.expectedErrMsg != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedQuery, query)
+			assert.Equal(t, 1, strings.Count(strings.ToLower(query), "is:pr"))
 		})
 	}
 }
@@ -451,6 +776,7 @@ func Test_MergePullRequest(t *testing.T) {
 	assert.Contains(t, tool.InputSchema.Properties, "commit_title")
 	assert.Contains(t, tool.InputSchema.Properties, "commit_message")
 	assert.Contains(t, tool.InputSchema.Properties, "merge_method")
+	assert.Contains(t, tool.InputSchema.Properties, "sha")
 	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
 
 	// Setup mock merge result for success case
@@ -494,7 +820,30 @@ func Test_MergePullRequest(t *testing.T) {
 			expectedMergeResult: mockMergeResult,
 		},
 		{
-			name: "merge fails",
+			name: "successful rebase at expected sha",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposPullsMergeByOwnerByRepoByPullNumber,
+					expectRequestBody(t, map[string]interface{}{
+						"merge_method": "rebase",
+						"sha":          "abcd1234",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockMergeResult),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"pullNumber":   float64(42),
+				"merge_method": "rebase",
+				"sha":          "abcd1234",
+			},
+			expectError:         false,
+			expectedMergeResult: mockMergeResult,
+		},
+		{
+			name: "merge fails (not mergeable)",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
 					mock.PutReposPullsMergeByOwnerByRepoByPullNumber,
@@ -509,8 +858,28 @@ func Test_MergePullRequest(t *testing.T) {
 				"repo":       "repo",
 				"pullNumber": float64(42),
 			},
-			expectError:    true,
-			expectedErrMsg: "failed to merge pull request",
+			expectError:    false,
+			expectedErrMsg: "failed to merge pull request: Pull request cannot be merged",
+		},
+		{
+			name: "merge fails (sha mismatch)",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposPullsMergeByOwnerByRepoByPullNumber,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusConflict)
+						_, _ = w.Write([]byte(`{"message": "Head branch was modified. Review and try the merge again."}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"sha":        "outdated",
+			},
+			expectError:    false,
+			expectedErrMsg: "failed to merge pull request: Head branch was modified. Review and try the merge again.",
 		},
 	}
 
@@ -538,6 +907,12 @@ func Test_MergePullRequest(t *testing.T) {
 			// Parse the result and get the text content if no error
 			textContent := getTextResult(t, result)
 
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
 			// Unmarshal and verify the result
 			var returnedResult github.PullRequestMergeResult
 			err = json.Unmarshal([]byte(textContent.Text), &returnedResult)
@@ -559,11 +934,16 @@ func Test_GetPullRequestFiles(t *testing.T) {
 	assert.Contains(t, tool.InputSchema.Properties, "owner")
 	assert.Contains(t, tool.InputSchema.Properties, "repo")
 	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
+	assert.Contains(t, tool.InputSchema.Properties, "include_patch")
+	assert.Contains(t, tool.InputSchema.Properties, "patch_limit")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
 	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
 
 	// Setup mock PR files for success case
 	mockFiles := []*github.CommitFile{
 		{
+			SHA:       github.Ptr("a1b2"),
 			Filename:  github.Ptr("file1.go"),
 			Status:    github.Ptr("modified"),
 			Additions: github.Ptr(10),
@@ -572,6 +952,7 @@ func Test_GetPullRequestFiles(t *testing.T) {
 			Patch:     github.Ptr("@@ -1,5 +1,10 @@"),
 		},
 		{
+			SHA:       github.Ptr("b2c3"),
 			Filename:  github.Ptr("file2.go"),
 			Status:    github.Ptr("added"),
 			Additions: github.Ptr(20),
@@ -579,6 +960,16 @@ func Test_GetPullRequestFiles(t *testing.T) {
 			Changes:   github.Ptr(20),
 			Patch:     github.Ptr("@@ -0,0 +1,20 @@"),
 		},
+		{
+			SHA:              github.Ptr("c3d4"),
+			Filename:         github.Ptr("renamed.go"),
+			PreviousFilename: github.Ptr("original.go"),
+			Status:           github.Ptr("renamed"),
+			Additions:        github.Ptr(1),
+			Deletions:        github.Ptr(1),
+			Changes:          github.Ptr(2),
+			Patch:            github.Ptr("@@ -1 +1 @@\n-old line\n+new line"),
+		},
 	}
 
 	tests := []struct {
@@ -586,7 +977,7 @@ func Test_GetPullRequestFiles(t *testing.T) {
 		mockedClient   *http.Client
 		requestArgs    map[string]interface{}
 		expectError    bool
-		expectedFiles  []*github.CommitFile
+		expectedFiles  []pullRequestFile
 		expectedErrMsg string
 	}{
 		{
@@ -602,8 +993,41 @@ func Test_GetPullRequestFiles(t *testing.T) {
 				"repo":       "repo",
 				"pullNumber": float64(42),
 			},
-			expectError:   false,
-			expectedFiles: mockFiles,
+			expectError: false,
+			expectedFiles: []pullRequestFile{
+				{Filename: "file1.go", Status: "modified", Additions: 10, Deletions: 5, SHA: "a1b2"},
+				{Filename: "file2.go", Status: "added", Additions: 20, SHA: "b2c3"},
+				{Filename: "renamed.go", Status: "renamed", Additions: 1, Deletions: 1, PreviousFilename: "original.go", SHA: "c3d4"},
+			},
+		},
+		{
+			name: "successful files fetch with truncated patches",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposPullsFilesByOwnerByRepoByPullNumber,
+					expectQueryParams(t, map[string]string{
+						"page":     "2",
+						"per_page": "3",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockFiles),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":         "owner",
+				"repo":          "repo",
+				"pullNumber":    float64(42),
+				"include_patch": true,
+				"patch_limit":   float64(16),
+				"page":          float64(2),
+				"perPage":       float64(3),
+			},
+			expectError: false,
+			expectedFiles: []pullRequestFile{
+				{Filename: "file1.go", Status: "modified", Additions: 10, Deletions: 5, SHA: "a1b2", Patch: "@@ -1,5 +1,10 @@"},
+				{Filename: "file2.go", Status: "added", Additions: 20, SHA: "b2c3", Patch: "@@ -0,0 +1,20 @@"},
+				{Filename: "renamed.go", Status: "renamed", Additions: 1, Deletions: 1, PreviousFilename: "original.go", SHA: "c3d4", Patch: "@@ -1 +1 @@\n-old", PatchTruncated: true},
+			},
 		},
 		{
 			name: "files fetch fails",
@@ -624,6 +1048,18 @@ func Test_GetPullRequestFiles(t *testing.T) {
 			expectError:    true,
 			expectedErrMsg: "failed to get pull request files",
 		},
+		{
+			name:         "negative patch limit",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":         "owner",
+				"repo":          "repo",
+				"pullNumber":    float64(42),
+				"include_patch": true,
+				"patch_limit":   float64(-1),
+			},
+			expectedErrMsg: "patch_limit must be at least 1",
+		},
 	}
 
 	for _, tc := range tests {
@@ -649,77 +1085,248 @@ func Test_GetPullRequestFiles(t *testing.T) {
 
 			// Parse the result and get the text content if no error
 			textContent := getTextResult(t, result)
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
 
 			// Unmarshal and verify the result
-			var returnedFiles []*github.CommitFile
+			var returnedFiles []pullRequestFile
 			err = json.Unmarshal([]byte(textContent.Text), &returnedFiles)
 			require.NoError(t, err)
-			assert.Len(t, returnedFiles, len(tc.expectedFiles))
-			for i, file := range returnedFiles {
-				assert.Equal(t, *tc.expectedFiles[i].Filename, *file.Filename)
-				assert.Equal(t, *tc.expectedFiles[i].Status, *file.Status)
-				assert.Equal(t, *tc.expectedFiles[i].Additions, *file.Additions)
-				assert.Equal(t, *tc.expectedFiles[i].Deletions, *file.Deletions)
-			}
+			assert.Equal(t, tc.expectedFiles, returnedFiles)
 		})
 	}
 }
 
-func Test_GetPullRequestStatus(t *testing.T) {
+func Test_TruncatePatch(t *testing.T) {
+	patch, truncated := truncatePatch("@@ -1 +1 @@", 100)
+	assert.Equal(t, "@@ -1 +1 @@", patch)
+	assert.False(t, truncated)
+
+	// The limit falls inside the two byte "é", which is left out
+	patch, truncated = truncatePatch("+café", 5)
+	assert.Equal(t, "+caf", patch)
+	assert.True(t, truncated)
+}
+
+func Test_GetPullRequestDiff(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
-	tool, _ := GetPullRequestStatus(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	tool, _ := GetPullRequestDiff(stubGetClientFn(mockClient), translations.NullTranslationHelper)
 
-	assert.Equal(t, "get_pull_request_status", tool.Name)
+	assert.Equal(t, "get_pull_request_diff", tool.Name)
 	assert.NotEmpty(t, tool.Description)
 	assert.Contains(t, tool.InputSchema.Properties, "owner")
 	assert.Contains(t, tool.InputSchema.Properties, "repo")
 	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
+	assert.Contains(t, tool.InputSchema.Properties, "max_bytes")
 	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
 
-	// Setup mock PR for successful PR fetch
-	mockPR := &github.PullRequest{
-		Number:  github.Ptr(42),
-		Title:   github.Ptr("Test PR"),
-		HTMLURL: github.Ptr("https://github.com/owner/repo/pull/42"),
-		Head: &github.PullRequestBranch{
-			SHA: github.Ptr("abcd1234"),
-			Ref: github.Ptr("feature-branch"),
+	fileDiff := func(path string) string {
+		return fmt.Sprintf("diff --git a/%[1]s b/%[1]s\nindex 83db48f..bf269f4 100644\n--- a/%[1]s\n+++ b/%[1]s\n@@ -1 +1 @@\n-old line\n+new line\n", path)
+	}
+	mockDiff := fileDiff("a.go") + fileDiff("b.go") + fileDiff("c.go")
+	limit := len(fileDiff("a.go")) + 20
+
+	serveDiff := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/vnd.github.v3.diff", r.Header.Get("Accept"))
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(mockDiff))
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectedText   string
+		expectedErrMsg string
+	}{
+		{
+			name: "successful diff fetch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					http.HandlerFunc(serveDiff),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectedText: mockDiff,
+		},
+		{
+			name: "diff is truncated",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					http.HandlerFunc(serveDiff),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"max_bytes":  float64(limit),
+			},
+			expectedText: mockDiff[:limit] + fmt.Sprintf("\n\n[diff truncated: showing %d of %d bytes]\n", limit, len(mockDiff)) +
+				"Files not shown in full:\n- b.go (truncated)\n- c.go (omitted)\n",
+		},
+		{
+			name: "diff too large falls back to files",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					mockResponse(t, http.StatusNotAcceptable, map[string]string{
+						"message": "Sorry, the diff exceeded the maximum number of lines (20000)",
+					}),
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposPullsFilesByOwnerByRepoByPullNumber,
+					expectQueryParams(t, map[string]string{
+						"per_page": "100",
+					}).andThen(
+						mockResponse(t, http.StatusOK, []*github.CommitFile{
+							{Filename: github.Ptr("a.go"), Status: github.Ptr("modified"), Additions: github.Ptr(15000), Deletions: github.Ptr(10)},
+							{Filename: github.Ptr("b.go"), Status: github.Ptr("added"), Additions: github.Ptr(9000), Deletions: github.Ptr(0)},
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectedText: "The diff is too large to be returned (Sorry, the diff exceeded the maximum number of lines (20000)). Files changed:\n" +
+				"- a.go (modified, +15000 -10)\n- b.go (added, +9000 -0)\n",
+		},
+		{
+			name: "pull request not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(999),
+			},
+			expectedErrMsg: "failed to get pull request diff: Not Found",
+		},
+		{
+			name:         "negative max bytes",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"max_bytes":  float64(-1),
+			},
+			expectedErrMsg: "max_bytes must be at least 1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetPullRequestDiff(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError, textContent.Text)
+			assert.Equal(t, tc.expectedText, textContent.Text)
+		})
+	}
+}
+
+func Test_GetPullRequestStatus(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetPullRequestStatus(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_pull_request_status", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+
+	// Setup mock PR for successful PR fetch
+	mockPR := &github.PullRequest{
+		Number:  github.Ptr(42),
+		Title:   github.Ptr("Test PR"),
+		HTMLURL: github.Ptr("https://github.com/owner/repo/pull/42"),
+		Head: &github.PullRequestBranch{
+			SHA: github.Ptr("abcd1234"),
+			Ref: github.Ptr("feature-branch"),
 		},
 	}
 
-	// Setup mock status for success case
+	// Setup mock statuses and check runs, where the CI reports both a status and a check run
 	mockStatus := &github.CombinedStatus{
 		State:      github.Ptr("success"),
-		TotalCount: github.Ptr(3),
+		TotalCount: github.Ptr(2),
 		Statuses: []*github.RepoStatus{
 			{
-				State:       github.Ptr("success"),
-				Context:     github.Ptr("continuous-integration/travis-ci"),
-				Description: github.Ptr("Build succeeded"),
-				TargetURL:   github.Ptr("https://travis-ci.org/owner/repo/builds/123"),
+				State:     github.Ptr("success"),
+				Context:   github.Ptr("ci/build"),
+				TargetURL: github.Ptr("https://ci.example.com/builds/123"),
+			},
+			{
+				State:     github.Ptr("success"),
+				Context:   github.Ptr("codecov/patch"),
+				TargetURL: github.Ptr("https://codecov.io/gh/owner/repo/pull/42"),
 			},
+		},
+	}
+	mockCheckRuns := &github.ListCheckRunsResults{
+		Total: github.Ptr(2),
+		CheckRuns: []*github.CheckRun{
 			{
-				State:       github.Ptr("success"),
-				Context:     github.Ptr("codecov/patch"),
-				Description: github.Ptr("Coverage increased"),
-				TargetURL:   github.Ptr("https://codecov.io/gh/owner/repo/pull/42"),
+				Name:       github.Ptr("ci/build"),
+				Status:     github.Ptr("completed"),
+				Conclusion: github.Ptr("success"),
+				DetailsURL: github.Ptr("https://github.com/owner/repo/actions/runs/1"),
 			},
 			{
-				State:       github.Ptr("success"),
-				Context:     github.Ptr("lint/golangci-lint"),
-				Description: github.Ptr("No issues found"),
-				TargetURL:   github.Ptr("https://golangci.com/r/owner/repo/pull/42"),
+				Name:       github.Ptr("lint"),
+				Status:     github.Ptr("completed"),
+				Conclusion: github.Ptr("skipped"),
+				HTMLURL:    github.Ptr("https://github.com/owner/repo/runs/2"),
 			},
 		},
 	}
+	expectedChecks := []statusCheck{
+		{Name: "ci/build", Source: "check", State: "completed", Conclusion: "success", DetailsURL: "https://github.com/owner/repo/actions/runs/1"},
+		{Name: "lint", Source: "check", State: "completed", Conclusion: "skipped", DetailsURL: "https://github.com/owner/repo/runs/2"},
+		{Name: "codecov/patch", Source: "status", State: "success", DetailsURL: "https://codecov.io/gh/owner/repo/pull/42"},
+	}
 
 	tests := []struct {
 		name           string
 		mockedClient   *http.Client
 		requestArgs    map[string]interface{}
 		expectError    bool
-		expectedStatus *github.CombinedStatus
+		expectedStatus pullRequestStatus
 		expectedErrMsg string
 	}{
 		{
@@ -733,14 +1340,102 @@ func Test_GetPullRequestStatus(t *testing.T) {
 					mock.GetReposCommitsStatusByOwnerByRepoByRef,
 					mockStatus,
 				),
+				mock.WithRequestMatch(
+					mock.GetReposCommitsCheckRunsByOwnerByRepoByRef,
+					mockCheckRuns,
+				),
 			),
 			requestArgs: map[string]interface{}{
 				"owner":      "owner",
 				"repo":       "repo",
 				"pullNumber": float64(42),
 			},
-			expectError:    false,
-			expectedStatus: mockStatus,
+			expectError: false,
+			expectedStatus: pullRequestStatus{
+				State:  "success",
+				SHA:    "abcd1234",
+				Checks: expectedChecks,
+			},
+		},
+		{
+			name: "pending and failed checks",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					mockPR,
+				),
+				mock.WithRequestMatch(
+					mock.GetReposCommitsStatusByOwnerByRepoByRef,
+					&github.CombinedStatus{
+						Statuses: []*github.RepoStatus{
+							{State: github.Ptr("pending"), Context: github.Ptr("deploy/preview")},
+							{State: github.Ptr("error"), Context: github.Ptr("codecov/patch")},
+						},
+					},
+				),
+				mock.WithRequestMatch(
+					mock.GetReposCommitsCheckRunsByOwnerByRepoByRef,
+					&github.ListCheckRunsResults{
+						CheckRuns: []*github.CheckRun{
+							{Name: github.Ptr("test"), Status: github.Ptr("in_progress")},
+							{Name: github.Ptr("lint"), Status: github.Ptr("completed"), Conclusion: github.Ptr("failure")},
+						},
+					},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectedStatus: pullRequestStatus{
+				State: "failure",
+				SHA:   "abcd1234",
+				Checks: []statusCheck{
+					{Name: "test", Source: "check", State: "in_progress"},
+					{Name: "lint", Source: "check", State: "completed", Conclusion: "failure"},
+					{Name: "deploy/preview", Source: "status", State: "pending"},
+					{Name: "codecov/patch", Source: "status", State: "error"},
+				},
+			},
+		},
+		{
+			name: "pending checks",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					mockPR,
+				),
+				mock.WithRequestMatch(
+					mock.GetReposCommitsStatusByOwnerByRepoByRef,
+					&github.CombinedStatus{
+						Statuses: []*github.RepoStatus{
+							{State: github.Ptr("pending"), Context: github.Ptr("deploy/preview")},
+						},
+					},
+				),
+				mock.WithRequestMatch(
+					mock.GetReposCommitsCheckRunsByOwnerByRepoByRef,
+					&github.ListCheckRunsResults{
+						CheckRuns: []*github.CheckRun{
+							{Name: github.Ptr("lint"), Status: github.Ptr("completed"), Conclusion: github.Ptr("success")},
+						},
+					},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectedStatus: pullRequestStatus{
+				State: "pending",
+				SHA:   "abcd1234",
+				Checks: []statusCheck{
+					{Name: "lint", Source: "check", State: "completed", Conclusion: "success"},
+					{Name: "deploy/preview", Source: "status", State: "pending"},
+				},
+			},
 		},
 		{
 			name: "PR fetch fails",
@@ -769,7 +1464,7 @@ func Test_GetPullRequestStatus(t *testing.T) {
 					mockPR,
 				),
 				mock.WithRequestMatchHandler(
-					mock.GetReposCommitsStatusesByOwnerByRepoByRef,
+					mock.GetReposCommitsStatusByOwnerByRepoByRef,
 					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
 						w.WriteHeader(http.StatusNotFound)
 						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
@@ -784,6 +1479,33 @@ func Test_GetPullRequestStatus(t *testing.T) {
 			expectError:    true,
 			expectedErrMsg: "failed to get combined status",
 		},
+		{
+			name: "check runs fetch fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					mockPR,
+				),
+				mock.WithRequestMatch(
+					mock.GetReposCommitsStatusByOwnerByRepoByRef,
+					mockStatus,
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsCheckRunsByOwnerByRepoByRef,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusForbidden)
+						_, _ = w.Write([]byte(`{"message": "Resource not accessible by integration"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get check runs",
+		},
 	}
 
 	for _, tc := range tests {
@@ -811,17 +1533,10 @@ func Test_GetPullRequestStatus(t *testing.T) {
 			textContent := getTextResult(t, result)
 
 			// Unmarshal and verify the result
-			var returnedStatus github.CombinedStatus
+			var returnedStatus pullRequestStatus
 			err = json.Unmarshal([]byte(textContent.Text), &returnedStatus)
 			require.NoError(t, err)
-			assert.Equal(t, *tc.expectedStatus.State, *returnedStatus.State)
-			assert.Equal(t, *tc.expectedStatus.TotalCount, *returnedStatus.TotalCount)
-			assert.Len(t, returnedStatus.Statuses, len(tc.expectedStatus.Statuses))
-			for i, status := range returnedStatus.Statuses {
-				assert.Equal(t, *tc.expectedStatus.Statuses[i].State, *status.State)
-				assert.Equal(t, *tc.expectedStatus.Statuses[i].Context, *status.Context)
-				assert.Equal(t, *tc.expectedStatus.Statuses[i].Description, *status.Description)
-			}
+			assert.Equal(t, tc.expectedStatus, returnedStatus)
 		})
 	}
 }
@@ -1069,86 +1784,158 @@ func Test_GetPullRequestComments(t *testing.T) {
 	}
 }
 
-func Test_GetPullRequestReviews(t *testing.T) {
+func Test_ListPullRequestReviewComments(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
-	tool, _ := GetPullRequestReviews(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	tool, _ := ListPullRequestReviewComments(stubGetClientFn(mockClient), translations.NullTranslationHelper)
 
-	assert.Equal(t, "get_pull_request_reviews", tool.Name)
+	assert.Equal(t, "get_pull_request_review_comments", tool.Name)
 	assert.NotEmpty(t, tool.Description)
 	assert.Contains(t, tool.InputSchema.Properties, "owner")
 	assert.Contains(t, tool.InputSchema.Properties, "repo")
 	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
+	assert.Contains(t, tool.InputSchema.Properties, "sort")
+	assert.Contains(t, tool.InputSchema.Properties, "direction")
+	assert.Contains(t, tool.InputSchema.Properties, "since")
+	assert.Contains(t, tool.InputSchema.Properties, "include_diff_hunk")
+	assert.Contains(t, tool.InputSchema.Properties, "threaded")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
 	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
 
-	// Setup mock PR reviews for success case
-	mockReviews := []*github.PullRequestReview{
-		{
-			ID:      github.Ptr(int64(201)),
-			State:   github.Ptr("APPROVED"),
-			Body:    github.Ptr("LGTM"),
-			HTMLURL: github.Ptr("https://github.com/owner/repo/pull/42#pullrequestreview-201"),
-			User: &github.User{
-				Login: github.Ptr("approver"),
-			},
-			CommitID:    github.Ptr("abcdef123456"),
-			SubmittedAt: &github.Timestamp{Time: time.Now().Add(-24 * time.Hour)},
-		},
-		{
-			ID:      github.Ptr(int64(202)),
-			State:   github.Ptr("CHANGES_REQUESTED"),
-			Body:    github.Ptr("Please address the following issues"),
-			HTMLURL: github.Ptr("https://github.com/owner/repo/pull/42#pullrequestreview-202"),
-			User: &github.User{
-				Login: github.Ptr("reviewer"),
-			},
-			CommitID:    github.Ptr("abcdef123456"),
-			SubmittedAt: &github.Timestamp{Time: time.Now().Add(-12 * time.Hour)},
-		},
+	// A comment with a chain of two replies, a comment without replies, and a reply to a
+	// comment on another page.
+	mockComment := func(id, inReplyTo int64, login, body string) *github.PullRequestComment {
+		comment := &github.PullRequestComment{
+			ID:           github.Ptr(id),
+			Path:         github.Ptr("main.go"),
+			Line:         github.Ptr(12),
+			OriginalLine: github.Ptr(10),
+			Side:         github.Ptr("RIGHT"),
+			DiffHunk:     github.Ptr("@@ -8,3 +8,5 @@\n func main() {\n+\tinit()"),
+			Body:         github.Ptr(body),
+			User:         &github.User{Login: github.Ptr(login)},
+			HTMLURL:      github.Ptr(fmt.Sprintf("https://github.com/owner/repo/pull/42#discussion_r%d", id)),
+		}
+		if inReplyTo != 0 {
+			comment.InReplyTo = github.Ptr(inReplyTo)
+		}
+		return comment
+	}
+	mockComments := []*github.PullRequestComment{
+		mockComment(1, 0, "reviewer", "Why is this needed?"),
+		mockComment(2, 1, "author", "It sets up logging"),
+		mockComment(3, 2, "reviewer", "Makes sense"),
+		mockComment(4, 0, "reviewer", "Typo"),
+		mockComment(5, 99, "author", "Done"),
+	}
+	expectedComment := func(id, inReplyTo int64, login, body string, replies ...*reviewComment) *reviewComment {
+		return &reviewComment{
+			ID:           id,
+			Path:         "main.go",
+			Line:         github.Ptr(12),
+			OriginalLine: github.Ptr(10),
+			Side:         "RIGHT",
+			Body:         body,
+			User:         login,
+			InReplyToID:  inReplyTo,
+			HTMLURL:      fmt.Sprintf("https://github.com/owner/repo/pull/42#discussion_r%d", id),
+			Replies:      replies,
+		}
 	}
 
 	tests := []struct {
-		name            string
-		mockedClient    *http.Client
-		requestArgs     map[string]interface{}
-		expectError     bool
-		expectedReviews []*github.PullRequestReview
-		expectedErrMsg  string
+		name             string
+		mockedClient     *http.Client
+		requestArgs      map[string]interface{}
+		expectedComments []*reviewComment
+		expectedErrMsg   string
 	}{
 		{
-			name: "successful reviews fetch",
+			name: "threaded comments",
 			mockedClient: mock.NewMockedHTTPClient(
-				mock.WithRequestMatch(
-					mock.GetReposPullsReviewsByOwnerByRepoByPullNumber,
-					mockReviews,
+				mock.WithRequestMatchHandler(
+					mock.GetReposPullsCommentsByOwnerByRepoByPullNumber,
+					expectQueryParams(t, map[string]string{
+						"sort":      "created",
+						"direction": "asc",
+						"since":     "2025-03-01T00:00:00Z",
+						"page":      "1",
+						"per_page":  "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockComments),
+					),
 				),
 			),
 			requestArgs: map[string]interface{}{
 				"owner":      "owner",
 				"repo":       "repo",
 				"pullNumber": float64(42),
+				"sort":       "created",
+				"direction":  "asc",
+				"since":      "2025-03-01T00:00:00Z",
+				"threaded":   true,
+			},
+			expectedComments: []*reviewComment{
+				expectedComment(1, 0, "reviewer", "Why is this needed?",
+					expectedComment(2, 1, "author", "It sets up logging",
+						expectedComment(3, 2, "reviewer", "Makes sense"),
+					),
+				),
+				expectedComment(4, 0, "reviewer", "Typo"),
+				expectedComment(5, 99, "author", "Done"),
 			},
-			expectError:     false,
-			expectedReviews: mockReviews,
 		},
 		{
-			name: "reviews fetch fails",
+			name: "flat comments with diff hunks",
 			mockedClient: mock.NewMockedHTTPClient(
-				mock.WithRequestMatchHandler(
-					mock.GetReposPullsReviewsByOwnerByRepoByPullNumber,
-					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
-						w.WriteHeader(http.StatusNotFound)
-						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
-					}),
+				mock.WithRequestMatch(
+					mock.GetReposPullsCommentsByOwnerByRepoByPullNumber,
+					mockComments[:2],
 				),
 			),
 			requestArgs: map[string]interface{}{
-				"owner":      "owner",
-				"repo":       "repo",
-				"pullNumber": float64(999),
+				"owner":             "owner",
+				"repo":              "repo",
+				"pullNumber":        float64(42),
+				"include_diff_hunk": true,
 			},
-			expectError:    true,
-			expectedErrMsg: "failed to get pull request reviews",
+			expectedComments: func() []*reviewComment {
+				comments := []*reviewComment{
+					expectedComment(1, 0, "reviewer", "Why is this needed?"),
+					expectedComment(2, 1, "author", "It sets up logging"),
+				}
+				for _, comment := range comments {
+					comment.DiffHunk = "@@ -8,3 +8,5 @@\n func main() {\n+\tinit()"
+				}
+				return comments
+			}(),
+		},
+		{
+			name:         "invalid since",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"since":      "yesterday",
+			},
+			expectedErrMsg: "failed to list review comments",
+		},
+		{
+			name: "pull request not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposPullsCommentsByOwnerByRepoByPullNumber,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(999),
+			},
+			expectedErrMsg: "failed to list review comments: Not Found",
 		},
 	}
 
@@ -1156,38 +1943,235 @@ func Test_GetPullRequestReviews(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			// Setup client with mock
 			client := github.NewClient(tc.mockedClient)
-			_, handler := GetPullRequestReviews(stubGetClientFn(client), translations.NullTranslationHelper)
+			_, handler := ListPullRequestReviewComments(stubGetClientFn(client), translations.NullTranslationHelper)
 
 			// Create call request
 			request := createMCPRequest(tc.requestArgs)
 
 			// Call handler
 			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
 
-			// Verify results
-			if tc.expectError {
-				require.Error(t, err)
-				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
 				return
 			}
+			require.False(t, result.IsError, textContent.Text)
 
+			// Unmarshal and verify the result
+			var returned []*reviewComment
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
 			require.NoError(t, err)
+			assert.Equal(t, tc.expectedComments, returned)
+		})
+	}
+}
 
-			// Parse the result and get the text content if no error
+func Test_GetPullRequestReviews(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetPullRequestReviews(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_pull_request_reviews", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+
+	submittedAt := &github.Timestamp{Time: time.Date(2024, 4, 1, 9, 0, 0, 0, time.UTC)}
+	review := func(id int64, login, state, body string) *github.PullRequestReview {
+		return &github.PullRequestReview{
+			ID:          github.Ptr(id),
+			State:       github.Ptr(state),
+			Body:        github.Ptr(body),
+			HTMLURL:     github.Ptr(fmt.Sprintf("https://github.com/owner/repo/pull/42#pullrequestreview-%d", id)),
+			User:        &github.User{Login: github.Ptr(login)},
+			CommitID:    github.Ptr("abcdef123456"),
+			SubmittedAt: submittedAt,
+		}
+	}
+	trimmed := func(id int64, login, state, body string) pullRequestReview {
+		return pullRequestReview{ID: id, User: login, State: state, Body: body, SubmittedAt: submittedAt}
+	}
+
+	// Setup mock PR reviews for success case
+	mockReviews := []*github.PullRequestReview{
+		review(201, "approver", "APPROVED", "LGTM"),
+		review(202, "reviewer", "CHANGES_REQUESTED", "Please address the following issues"),
+		review(203, "approver", "COMMENTED", "One more nit"),
+		review(204, "reviewer", "APPROVED", "Thanks for the fixes"),
+		review(205, "commenter", "COMMENTED", "Interesting approach"),
+		review(206, "dismissed", "CHANGES_REQUESTED", "Not like this"),
+		review(207, "dismissed", "DISMISSED", "Not like this"),
+	}
+	expectedSummary := reviewSummary{
+		LatestStates: map[string]string{
+			"approver":  "APPROVED",
+			"reviewer":  "APPROVED",
+			"commenter": "COMMENTED",
+			"dismissed": "DISMISSED",
+		},
+		Approvals: 2,
+	}
+
+	tests := []struct {
+		name            string
+		mockedClient    *http.Client
+		requestArgs     map[string]interface{}
+		expectedReviews pullRequestReviews
+		expectedErrMsg  string
+	}{
+		{
+			name: "successful reviews fetch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsReviewsByOwnerByRepoByPullNumber,
+					mockReviews,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectedReviews: pullRequestReviews{
+				Reviews: []pullRequestReview{
+					trimmed(201, "approver", "APPROVED", "LGTM"),
+					trimmed(202, "reviewer", "CHANGES_REQUESTED", "Please address the following issues"),
+					trimmed(203, "approver", "COMMENTED", "One more nit"),
+					trimmed(204, "reviewer", "APPROVED", "Thanks for the fixes"),
+					trimmed(205, "commenter", "COMMENTED", "Interesting approach"),
+					trimmed(206, "dismissed", "CHANGES_REQUESTED", "Not like this"),
+					trimmed(207, "dismissed", "DISMISSED", "Not like this"),
+				},
+				Summary: expectedSummary,
+			},
+		},
+		{
+			name: "summary covers reviews of all pages",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchPages(
+					mock.GetReposPullsReviewsByOwnerByRepoByPullNumber,
+					mockReviews[:2],
+					mockReviews[2:],
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"page":       float64(2),
+				"perPage":    float64(2),
+			},
+			expectedReviews: pullRequestReviews{
+				Reviews: []pullRequestReview{
+					trimmed(203, "approver", "COMMENTED", "One more nit"),
+					trimmed(204, "reviewer", "APPROVED", "Thanks for the fixes"),
+				},
+				Summary: expectedSummary,
+			},
+		},
+		{
+			name: "changes requested",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsReviewsByOwnerByRepoByPullNumber,
+					[]*github.PullRequestReview{
+						review(201, "approver", "APPROVED", "LGTM"),
+						review(202, "reviewer", "CHANGES_REQUESTED", "Please address the following issues"),
+						review(203, "pending", "PENDING", ""),
+					},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"perPage":    float64(1),
+			},
+			expectedReviews: pullRequestReviews{
+				Reviews: []pullRequestReview{
+					trimmed(201, "approver", "APPROVED", "LGTM"),
+				},
+				Summary: reviewSummary{
+					LatestStates: map[string]string{
+						"approver": "APPROVED",
+						"reviewer": "CHANGES_REQUESTED",
+					},
+					Approvals:        1,
+					ChangesRequested: 1,
+				},
+			},
+		},
+		{
+			name: "reviews fetch fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposPullsReviewsByOwnerByRepoByPullNumber,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(999),
+			},
+			expectedErrMsg: "failed to get pull request reviews: Not Found",
+		},
+		{
+			name: "negative page",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsReviewsByOwnerByRepoByPullNumber,
+					mockReviews,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"page":       float64(-1),
+			},
+			expectedErrMsg: "page must be at least 1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetPullRequestReviews(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			// Parse the result and get the text content
 			textContent := getTextResult(t, result)
 
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+			require.False(t, result.IsError, textContent.Text)
+
 			// Unmarshal and verify the result
-			var returnedReviews []*github.PullRequestReview
+			var returnedReviews pullRequestReviews
 			err = json.Unmarshal([]byte(textContent.Text), &returnedReviews)
 			require.NoError(t, err)
-			assert.Len(t, returnedReviews, len(tc.expectedReviews))
-			for i, review := range returnedReviews {
-				assert.Equal(t, *tc.expectedReviews[i].ID, *review.ID)
-				assert.Equal(t, *tc.expectedReviews[i].State, *review.State)
-				assert.Equal(t, *tc.expectedReviews[i].Body, *review.Body)
-				assert.Equal(t, *tc.expectedReviews[i].User.Login, *review.User.Login)
-				assert.Equal(t, *tc.expectedReviews[i].HTMLURL, *review.HTMLURL)
-			}
+			assert.Equal(t, tc.expectedReviews, returnedReviews)
 		})
 	}
 }
@@ -1672,6 +2656,104 @@ func Test_CreatePullRequest(t *testing.T) {
 			expectError:    true,
 			expectedErrMsg: "failed to create pull request",
 		},
+		{
+			name: "successful cross-fork PR creation",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposPullsByOwnerByRepo,
+					expectRequestBody(t, map[string]interface{}{
+						"title":                 "Test PR",
+						"body":                  "This is a test PR",
+						"head":                  "contributor:feature-branch",
+						"base":                  "main",
+						"draft":                 true,
+						"maintainer_can_modify": false,
+					}).andThen(
+						mockResponse(t, http.StatusCreated, mockPR),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"title": "Test PR",
+				"body":  "This is a test PR",
+				"head":  "contributor:feature-branch",
+				"base":  "main",
+				"draft": true,
+			},
+			expectError: false,
+			expectedPR:  mockPR,
+		},
+		{
+			name:         "invalid head",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"title": "Test PR",
+				"head":  "contributor:",
+				"base":  "main",
+			},
+			expectError:    true,
+			expectedErrMsg: `invalid head "contributor:": must be a branch name or in the format 'user:branch'`,
+		},
+		{
+			name: "no commits between branches",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposPullsByOwnerByRepo,
+					mockResponse(t, http.StatusUnprocessableEntity, map[string]interface{}{
+						"message": "Validation Failed",
+						"errors": []map[string]string{
+							{"resource": "PullRequest", "code": "custom", "message": "No commits between main and feature-branch"},
+						},
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"title": "Test PR",
+				"head":  "feature-branch",
+				"base":  "main",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to create pull request: Validation Failed; No commits between main and feature-branch",
+		},
+		{
+			name: "pull request already exists",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposPullsByOwnerByRepo,
+					mockResponse(t, http.StatusUnprocessableEntity, map[string]interface{}{
+						"message": "Validation Failed",
+						"errors": []map[string]string{
+							{"resource": "PullRequest", "code": "custom", "message": "A pull request already exists for owner:feature-branch."},
+						},
+					}),
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposPullsByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"state": "open",
+						"head":  "owner:feature-branch",
+						"base":  "main",
+					}).andThen(
+						mockResponse(t, http.StatusOK, []*github.PullRequest{mockPR}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"title": "Test PR",
+				"head":  "feature-branch",
+				"base":  "main",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to create pull request: Validation Failed; A pull request already exists for owner:feature-branch.; existing pull request #42: https://github.com/owner/repo/pull/42",
+		},
 	}
 
 	for _, tc := range tests {
@@ -1719,3 +2801,1140 @@ func Test_CreatePullRequest(t *testing.T) {
 		})
 	}
 }
+
+func Test_ConvertPullRequestToDraft(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ConvertPullRequestToDraft(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "convert_pull_request_to_draft", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectedText   string
+		expectedErrMsg string
+	}{
+		{
+			name: "convert an open pull request",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					&github.PullRequest{Number: github.Ptr(42), NodeID: github.Ptr("PR_42"), Draft: github.Ptr(false)},
+				),
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockGraphQL(t, graphQLExchange{
+						query:             "ConvertPullRequestToDraft",
+						expectedVariables: map[string]any{"pullRequestId": "PR_42"},
+						data: map[string]any{
+							"convertPullRequestToDraft": map[string]any{"pullRequest": map[string]any{"isDraft": true}},
+						},
+					}),
+				),
+			),
+			expectedText: `{"pull_number":42,"draft":true}`,
+		},
+		{
+			name: "pull request already a draft",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					&github.PullRequest{Number: github.Ptr(42), NodeID: github.Ptr("PR_42"), Draft: github.Ptr(true)},
+				),
+			),
+			expectedText: `{"pull_number":42,"draft":true,"note":"the pull request is already a draft"}`,
+		},
+		{
+			name: "pull request not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					mockResponse(t, http.StatusNotFound, map[string]interface{}{"message": "Not Found"}),
+				),
+			),
+			expectedErrMsg: "failed to convert pull request to draft: Not Found",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ConvertPullRequestToDraft(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			})
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+			require.False(t, result.IsError, textContent.Text)
+			assert.JSONEq(t, tc.expectedText, textContent.Text)
+		})
+	}
+}
+
+func Test_MarkPullRequestReadyForReview(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := MarkPullRequestReadyForReview(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "mark_pull_request_ready_for_review", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectedText   string
+		expectedErrMsg string
+	}{
+		{
+			name: "mark a draft ready for review",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					&github.PullRequest{Number: github.Ptr(42), NodeID: github.Ptr("PR_42"), Draft: github.Ptr(true)},
+				),
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockGraphQL(t, graphQLExchange{
+						query:             "MarkPullRequestReadyForReview",
+						expectedVariables: map[string]any{"pullRequestId": "PR_42"},
+						data: map[string]any{
+							"markPullRequestReadyForReview": map[string]any{"pullRequest": map[string]any{"isDraft": false}},
+						},
+					}),
+				),
+			),
+			expectedText: `{"pull_number":42,"draft":false}`,
+		},
+		{
+			name: "pull request not a draft",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					&github.PullRequest{Number: github.Ptr(42), NodeID: github.Ptr("PR_42"), Draft: github.Ptr(false)},
+				),
+			),
+			expectedText: `{"pull_number":42,"draft":false,"note":"the pull request is already ready for review"}`,
+		},
+		{
+			name: "mutation fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					&github.PullRequest{Number: github.Ptr(42), NodeID: github.Ptr("PR_42"), Draft: github.Ptr(true)},
+				),
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockGraphQL(t, graphQLExchange{
+						query: "MarkPullRequestReadyForReview",
+						errors: []map[string]any{
+							{"type": "FORBIDDEN", "message": "Resource not accessible by integration"},
+						},
+					}),
+				),
+			),
+			expectedErrMsg: "failed to mark pull request ready for review: Resource not accessible by integration",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := MarkPullRequestReadyForReview(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			})
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+			require.False(t, result.IsError, textContent.Text)
+			assert.JSONEq(t, tc.expectedText, textContent.Text)
+		})
+	}
+}
+
+func Test_ClosePullRequest(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ClosePullRequest(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "close_pull_request", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
+	assert.Contains(t, tool.InputSchema.Properties, "comment")
+	assert.Contains(t, tool.InputSchema.Properties, "delete_branch")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+
+	baseRepo := &github.Repository{FullName: github.Ptr("owner/repo"), DefaultBranch: github.Ptr("main")}
+	closedPR := func(headRepo *github.Repository) *github.PullRequest {
+		return &github.PullRequest{
+			Number:  github.Ptr(42),
+			State:   github.Ptr("closed"),
+			HTMLURL: github.Ptr("https://github.com/owner/repo/pull/42"),
+			Head:    &github.PullRequestBranch{Ref: github.Ptr("feature"), Repo: headRepo},
+			Base:    &github.PullRequestBranch{Ref: github.Ptr("main"), Repo: baseRepo},
+		}
+	}
+	closeHandler := expectRequestBody(t, map[string]interface{}{
+		"state": "closed",
+	}).andThen(
+		mockResponse(t, http.StatusOK, closedPR(baseRepo)),
+	)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectedState  pullRequestState
+		expectedErrMsg string
+	}{
+		{
+			name: "close with a comment and delete the branch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposIssuesCommentsByOwnerByRepoByIssueNumber,
+					expectRequestBody(t, map[string]interface{}{
+						"body": "Superseded by #43",
+					}).andThen(
+						mockResponse(t, http.StatusCreated, &github.IssueComment{}),
+					),
+				),
+				mock.WithRequestMatchHandler(
+					mock.PatchReposPullsByOwnerByRepoByPullNumber,
+					closeHandler,
+				),
+				mock.WithRequestMatch(
+					mock.GetReposBranchesByOwnerByRepoByBranch,
+					&github.Branch{Name: github.Ptr("feature"), Protected: github.Ptr(false)},
+				),
+				mock.WithRequestMatchHandler(
+					mock.DeleteReposGitRefsByOwnerByRepoByRef,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						assert.Equal(t, "/repos/owner/repo/git/refs/heads/feature", r.URL.Path)
+						w.WriteHeader(http.StatusNoContent)
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":         "owner",
+				"repo":          "repo",
+				"pullNumber":    float64(42),
+				"comment":       "Superseded by #43",
+				"delete_branch": true,
+			},
+			expectedState: pullRequestState{
+				PullNumber:    42,
+				State:         "closed",
+				HTMLURL:       "https://github.com/owner/repo/pull/42",
+				BranchDeleted: github.Ptr(true),
+			},
+		},
+		{
+			name: "close without deleting the branch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposPullsByOwnerByRepoByPullNumber,
+					closeHandler,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectedState: pullRequestState{
+				PullNumber:    42,
+				State:         "closed",
+				HTMLURL:       "https://github.com/owner/repo/pull/42",
+				BranchDeleted: github.Ptr(false),
+			},
+		},
+		{
+			name: "branch of a fork is not deleted",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.PatchReposPullsByOwnerByRepoByPullNumber,
+					closedPR(&github.Repository{FullName: github.Ptr("contributor/repo")}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":         "owner",
+				"repo":          "repo",
+				"pullNumber":    float64(42),
+				"delete_branch": true,
+			},
+			expectedState: pullRequestState{
+				PullNumber:    42,
+				State:         "closed",
+				HTMLURL:       "https://github.com/owner/repo/pull/42",
+				BranchDeleted: github.Ptr(false),
+				Warning:       "the branch feature was not deleted because it belongs to the fork contributor/repo",
+			},
+		},
+		{
+			name: "protected branch is not deleted",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposPullsByOwnerByRepoByPullNumber,
+					closeHandler,
+				),
+				mock.WithRequestMatch(
+					mock.GetReposBranchesByOwnerByRepoByBranch,
+					&github.Branch{Name: github.Ptr("feature"), Protected: github.Ptr(true)},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":         "owner",
+				"repo":          "repo",
+				"pullNumber":    float64(42),
+				"delete_branch": true,
+			},
+			expectedState: pullRequestState{
+				PullNumber:    42,
+				State:         "closed",
+				HTMLURL:       "https://github.com/owner/repo/pull/42",
+				BranchDeleted: github.Ptr(false),
+				Warning:       "the branch feature was not deleted because it is protected",
+			},
+		},
+		{
+			name: "pull request not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposPullsByOwnerByRepoByPullNumber,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(999),
+			},
+			expectedErrMsg: "failed to close pull request",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ClosePullRequest(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+			require.False(t, result.IsError, textContent.Text)
+
+			// Unmarshal and verify the result
+			var returned pullRequestState
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedState, returned)
+		})
+	}
+}
+
+func Test_ReopenPullRequest(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ReopenPullRequest(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "reopen_pull_request", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectedState  pullRequestState
+		expectedErrMsg string
+	}{
+		{
+			name: "reopen pull request",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposPullsByOwnerByRepoByPullNumber,
+					expectRequestBody(t, map[string]interface{}{
+						"state": "open",
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.PullRequest{
+							Number:  github.Ptr(42),
+							State:   github.Ptr("open"),
+							HTMLURL: github.Ptr("https://github.com/owner/repo/pull/42"),
+						}),
+					),
+				),
+			),
+			expectedState: pullRequestState{
+				PullNumber: 42,
+				State:      "open",
+				HTMLURL:    "https://github.com/owner/repo/pull/42",
+			},
+		},
+		{
+			name: "merged pull request",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposPullsByOwnerByRepoByPullNumber,
+					mockResponse(t, http.StatusUnprocessableEntity, map[string]string{
+						"message": "Validation Failed",
+					}),
+				),
+			),
+			expectedErrMsg: "failed to reopen pull request: Validation Failed",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ReopenPullRequest(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			})
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+			require.False(t, result.IsError, textContent.Text)
+
+			// Unmarshal and verify the result
+			var returned pullRequestState
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedState, returned)
+		})
+	}
+}
+
+func Test_UpdatePullRequestMetadata(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := UpdatePullRequestMetadata(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "update_pull_request_metadata", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
+	assert.Contains(t, tool.InputSchema.Properties, "labels")
+	assert.Contains(t, tool.InputSchema.Properties, "assignees")
+	assert.Contains(t, tool.InputSchema.Properties, "milestone")
+	assert.Contains(t, tool.InputSchema.Properties, "milestone_title")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+
+	mockPR := &github.PullRequest{Number: github.Ptr(42)}
+	mockMilestones := []*github.Milestone{
+		{Number: github.Ptr(2), Title: github.Ptr("v1.0")},
+		{Number: github.Ptr(3), Title: github.Ptr("v1.1")},
+	}
+
+	tests := []struct {
+		name             string
+		mockedClient     *http.Client
+		requestArgs      map[string]interface{}
+		expectedMetadata pullRequestMetadata
+		expectedErrMsg   string
+	}{
+		{
+			name: "replace labels and set milestone by title",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					mockPR,
+				),
+				mock.WithRequestMatch(
+					mock.GetReposMilestonesByOwnerByRepo,
+					mockMilestones,
+				),
+				mock.WithRequestMatchHandler(
+					mock.PatchReposIssuesByOwnerByRepoByIssueNumber,
+					expectRequestBody(t, map[string]interface{}{
+						"labels":    []interface{}{"bug", "needs-review"},
+						"milestone": float64(3),
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.Issue{
+							Number:    github.Ptr(42),
+							HTMLURL:   github.Ptr("https://github.com/owner/repo/pull/42"),
+							Labels:    []*github.Label{{Name: github.Ptr("bug")}, {Name: github.Ptr("needs-review")}},
+							Assignees: []*github.User{{Login: github.Ptr("octocat")}},
+							Milestone: mockMilestones[1],
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":           "owner",
+				"repo":            "repo",
+				"pullNumber":      float64(42),
+				"labels":          []interface{}{"bug", "needs-review"},
+				"milestone_title": "V1.1",
+			},
+			expectedMetadata: pullRequestMetadata{
+				PullNumber: 42,
+				Labels:     []string{"bug", "needs-review"},
+				Assignees:  []string{"octocat"},
+				Milestone:  &pullRequestMilestone{Number: 3, Title: "v1.1"},
+				HTMLURL:    "https://github.com/owner/repo/pull/42",
+			},
+		},
+		{
+			name: "remove all assignees and the milestone",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					mockPR,
+				),
+				mock.WithRequestMatchHandler(
+					mock.PatchReposIssuesByOwnerByRepoByIssueNumber,
+					expectRequestBody(t, map[string]interface{}{
+						"assignees": []interface{}{},
+						"milestone": nil,
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.Issue{
+							Number:  github.Ptr(42),
+							HTMLURL: github.Ptr("https://github.com/owner/repo/pull/42"),
+							Labels:  []*github.Label{{Name: github.Ptr("bug")}},
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+				"assignees":  []interface{}{},
+				"milestone":  float64(0),
+			},
+			expectedMetadata: pullRequestMetadata{
+				PullNumber: 42,
+				Labels:     []string{"bug"},
+				Assignees:  []string{},
+				HTMLURL:    "https://github.com/owner/repo/pull/42",
+			},
+		},
+		{
+			name: "milestone title not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					mockPR,
+				),
+				mock.WithRequestMatch(
+					mock.GetReposMilestonesByOwnerByRepo,
+					mockMilestones,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":           "owner",
+				"repo":            "repo",
+				"pullNumber":      float64(42),
+				"milestone_title": "v2.0",
+			},
+			expectedErrMsg: `milestone "v2.0" not found, available milestones: v1.0, v1.1`,
+		},
+		{
+			name: "issue instead of pull request",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(7),
+				"labels":     []interface{}{"bug"},
+			},
+			expectedErrMsg: "failed to get pull request: Not Found",
+		},
+		{
+			name:         "nothing to update",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectedErrMsg: "at least one of labels, assignees, milestone and milestone_title must be provided",
+		},
+		{
+			name:         "milestone and milestone title",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":           "owner",
+				"repo":            "repo",
+				"pullNumber":      float64(42),
+				"milestone":       float64(2),
+				"milestone_title": "v1.1",
+			},
+			expectedErrMsg: "only one of milestone and milestone_title can be provided",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := UpdatePullRequestMetadata(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+			require.False(t, result.IsError, textContent.Text)
+
+			// Unmarshal and verify the result
+			var returned pullRequestMetadata
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedMetadata, returned)
+		})
+	}
+}
+
+func Test_EnablePullRequestAutoMerge(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := EnablePullRequestAutoMerge(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "enable_pull_request_auto_merge", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
+	assert.Contains(t, tool.InputSchema.Properties, "merge_method")
+	assert.Contains(t, tool.InputSchema.Properties, "commit_title")
+	assert.Contains(t, tool.InputSchema.Properties, "commit_message")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+
+	mockPR := &github.PullRequest{Number: github.Ptr(42), NodeID: github.Ptr("PR_42")}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectedText   string
+		expectedErrMsg string
+	}{
+		{
+			name: "enable squash auto-merge",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetReposPullsByOwnerByRepoByPullNumber, mockPR),
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockGraphQL(t, graphQLExchange{
+						query: "EnablePullRequestAutoMerge",
+						expectedVariables: map[string]any{
+							"pullRequestId":  "PR_42",
+							"mergeMethod":    "SQUASH",
+							"commitHeadline": "Add feature (#42)",
+						},
+						data: map[string]any{
+							"enablePullRequestAutoMerge": map[string]any{
+								"pullRequest": map[string]any{
+									"autoMergeRequest": map[string]any{
+										"enabledAt":      "2025-04-01T10:00:00Z",
+										"enabledBy":      map[string]any{"login": "maintainer"},
+										"mergeMethod":    "SQUASH",
+										"commitHeadline": "Add feature (#42)",
+										"commitBody":     "",
+									},
+								},
+							},
+						},
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"pullNumber":   float64(42),
+				"merge_method": "squash",
+				"commit_title": "Add feature (#42)",
+			},
+			expectedText: `{"pull_number":42,"auto_merge":{"enabled_at":"2025-04-01T10:00:00Z","enabled_by":"maintainer","merge_method":"squash","commit_title":"Add feature (#42)"}}`,
+		},
+		{
+			name: "auto-merge not allowed",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetReposPullsByOwnerByRepoByPullNumber, mockPR),
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockGraphQL(t, graphQLExchange{
+						query: "EnablePullRequestAutoMerge",
+						errors: []map[string]any{
+							{"type": "UNPROCESSABLE", "message": "Pull request Auto merge is not allowed for this repository"},
+						},
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectedErrMsg: "failed to enable auto-merge: Pull request Auto merge is not allowed for this repository; check that 'Allow auto-merge' is enabled in the settings of the repository",
+		},
+		{
+			name: "pull request ready to merge",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetReposPullsByOwnerByRepoByPullNumber, mockPR),
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockGraphQL(t, graphQLExchange{
+						query: "EnablePullRequestAutoMerge",
+						errors: []map[string]any{
+							{"type": "UNPROCESSABLE", "message": "Pull request is in clean status"},
+						},
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectedErrMsg: "failed to enable auto-merge: Pull request is in clean status",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := EnablePullRequestAutoMerge(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+			require.False(t, result.IsError, textContent.Text)
+			assert.JSONEq(t, tc.expectedText, textContent.Text)
+		})
+	}
+}
+
+func Test_DisablePullRequestAutoMerge(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := DisablePullRequestAutoMerge(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "disable_pull_request_auto_merge", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatch(
+			mock.GetReposPullsByOwnerByRepoByPullNumber,
+			&github.PullRequest{Number: github.Ptr(42), NodeID: github.Ptr("PR_42")},
+		),
+		mock.WithRequestMatchHandler(
+			postGraphQL,
+			mockGraphQL(t, graphQLExchange{
+				query:             "DisablePullRequestAutoMerge",
+				expectedVariables: map[string]any{"pullRequestId": "PR_42"},
+				data: map[string]any{
+					"disablePullRequestAutoMerge": map[string]any{
+						"pullRequest": map[string]any{"autoMergeRequest": nil},
+					},
+				},
+			}),
+		),
+	))
+	_, handler := DisablePullRequestAutoMerge(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner":      "owner",
+		"repo":       "repo",
+		"pullNumber": float64(42),
+	}))
+	require.NoError(t, err)
+	textContent := getTextResult(t, result)
+	require.False(t, result.IsError, textContent.Text)
+	assert.JSONEq(t, `{"pull_number":42,"auto_merge":null}`, textContent.Text)
+}
+
+func Test_GetPullRequestMergeConflicts(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetPullRequestMergeConflicts(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_pull_request_conflicts", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+
+	pullRequest := func(mergeable *bool, state string) *github.PullRequest {
+		return &github.PullRequest{
+			Number:         github.Ptr(42),
+			Mergeable:      mergeable,
+			MergeableState: github.Ptr(state),
+			Base:           &github.PullRequestBranch{SHA: github.Ptr("base123")},
+			Head:           &github.PullRequestBranch{SHA: github.Ptr("head456")},
+		}
+	}
+	comparisons := map[string]*github.CommitsComparison{
+		"/repos/owner/repo/compare/base123...head456": {
+			MergeBaseCommit: &github.RepositoryCommit{SHA: github.Ptr("merge789")},
+			Files: []*github.CommitFile{
+				{Filename: github.Ptr("go.mod"), Status: github.Ptr("modified"), Additions: github.Ptr(2), Deletions: github.Ptr(1)},
+				{Filename: github.Ptr("main.go"), Status: github.Ptr("modified"), Additions: github.Ptr(10), Deletions: github.Ptr(4)},
+				{Filename: github.Ptr("new.go"), Status: github.Ptr("added"), Additions: github.Ptr(30)},
+			},
+		},
+		"/repos/owner/repo/compare/merge789...base123": {
+			Files: []*github.CommitFile{
+				{Filename: github.Ptr("main.go"), Status: github.Ptr("modified"), Additions: github.Ptr(3), Deletions: github.Ptr(3)},
+				{Filename: github.Ptr("README.md"), Status: github.Ptr("modified"), Additions: github.Ptr(1)},
+				{Filename: github.Ptr("go.mod"), Status: github.Ptr("modified"), Additions: github.Ptr(1), Deletions: github.Ptr(1)},
+			},
+		},
+	}
+
+	tests := []struct {
+		name              string
+		mockedClient      *http.Client
+		expectedConflicts pullRequestConflicts
+		expectedErrMsg    string
+	}{
+		{
+			name: "mergeable pull request",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					pullRequest(github.Ptr(true), "clean"),
+				),
+			),
+			expectedConflicts: pullRequestConflicts{
+				Mergeable:      github.Ptr(true),
+				MergeableState: "clean",
+				Conflicts:      []conflictCandidate{},
+			},
+		},
+		{
+			name: "pull request with conflicts",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					pullRequest(github.Ptr(false), "dirty"),
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposCompareByOwnerByRepoByBasehead,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						comparison, ok := comparisons[r.URL.Path]
+						require.True(t, ok, "unexpected comparison %s", r.URL.Path)
+						mockResponse(t, http.StatusOK, comparison)(w, r)
+					}),
+				),
+			),
+			expectedConflicts: pullRequestConflicts{
+				Mergeable:      github.Ptr(false),
+				MergeableState: "dirty",
+				Conflicts: []conflictCandidate{
+					{
+						Filename: "go.mod",
+						Base:     fileChange{Status: "modified", Additions: 1, Deletions: 1},
+						Head:     fileChange{Status: "modified", Additions: 2, Deletions: 1},
+					},
+					{
+						Filename: "main.go",
+						Base:     fileChange{Status: "modified", Additions: 3, Deletions: 3},
+						Head:     fileChange{Status: "modified", Additions: 10, Deletions: 4},
+					},
+				},
+				Note: conflictsHeuristicNote,
+			},
+		},
+		{
+			name: "mergeability not computed yet",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					pullRequest(nil, "unknown"),
+				),
+			),
+			expectedConflicts: pullRequestConflicts{
+				MergeableState: "unknown",
+				Conflicts:      []conflictCandidate{},
+				Note:           "GitHub is still checking whether the pull request can be merged, try again shortly",
+			},
+		},
+		{
+			name: "pull request not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					mockResponse(t, http.StatusNotFound, map[string]interface{}{"message": "Not Found"}),
+				),
+			),
+			expectedErrMsg: "failed to get pull request: Not Found",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetPullRequestMergeConflicts(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			})
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+			require.False(t, result.IsError, textContent.Text)
+
+			// Unmarshal and verify the result
+			var returnedConflicts pullRequestConflicts
+			err = json.Unmarshal([]byte(textContent.Text), &returnedConflicts)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedConflicts, returnedConflicts)
+		})
+	}
+}
+
+func Test_ListPullRequestsForCommit(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListPullRequestsForCommit(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_pull_requests_for_commit", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "commit_sha")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "commit_sha"})
+
+	mergedAt := github.Timestamp{Time: time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)}
+	mockPRs := []*github.PullRequest{
+		{
+			Number:   github.Ptr(42),
+			Title:    github.Ptr("Fix crash on startup"),
+			State:    github.Ptr("closed"),
+			User:     &github.User{Login: github.Ptr("author")},
+			Base:     &github.PullRequestBranch{Ref: github.Ptr("main")},
+			Head:     &github.PullRequestBranch{Ref: github.Ptr("fix-crash")},
+			MergedAt: &mergedAt,
+			HTMLURL:  github.Ptr("https://github.com/owner/repo/pull/42"),
+		},
+		{
+			Number:  github.Ptr(57),
+			Title:   github.Ptr("Backport crash fix"),
+			State:   github.Ptr("open"),
+			User:    &github.User{Login: github.Ptr("maintainer")},
+			Base:    &github.PullRequestBranch{Ref: github.Ptr("release-1.2")},
+			Head:    &github.PullRequestBranch{Ref: github.Ptr("backport-42")},
+			HTMLURL: github.Ptr("https://github.com/owner/repo/pull/57"),
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectedPRs    []commitPullRequest
+		expectedErrMsg string
+	}{
+		{
+			name: "commit in several pull requests",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsPullsByOwnerByRepoByCommitSha,
+					expectQueryParams(t, map[string]string{
+						"page":     "1",
+						"per_page": "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockPRs),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"commit_sha": "8f2e1c9",
+			},
+			expectedPRs: []commitPullRequest{
+				{
+					pullRequestSummary: pullRequestSummary{Number: 42, Title: "Fix crash on startup", State: "closed", User: "author", Base: "main", Head: "fix-crash", HTMLURL: "https://github.com/owner/repo/pull/42"},
+					MergedAt:           &mergedAt,
+				},
+				{
+					pullRequestSummary: pullRequestSummary{Number: 57, Title: "Backport crash fix", State: "open", User: "maintainer", Base: "release-1.2", Head: "backport-42", HTMLURL: "https://github.com/owner/repo/pull/57"},
+				},
+			},
+		},
+		{
+			name: "commit in no pull request",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposCommitsPullsByOwnerByRepoByCommitSha,
+					[]*github.PullRequest{},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"commit_sha": "8f2e1c9b7a6d5e4f3a2b1c0d9e8f7a6b5c4d3e2f",
+			},
+			expectedPRs: []commitPullRequest{},
+		},
+		{
+			name:         "invalid sha",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"commit_sha": "main",
+			},
+			expectedErrMsg: `invalid commit_sha "main": must be 7 to 40 hexadecimal characters`,
+		},
+		{
+			name: "unknown commit",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsPullsByOwnerByRepoByCommitSha,
+					mockResponse(t, http.StatusUnprocessableEntity, map[string]interface{}{
+						"message": "No commit found for SHA: 8f2e1c9",
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"commit_sha": "8f2e1c9",
+			},
+			expectedErrMsg: "failed to list pull requests for commit: No commit found for SHA: 8f2e1c9",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListPullRequestsForCommit(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+			require.False(t, result.IsError, textContent.Text)
+
+			// Unmarshal and verify the result
+			var returnedPRs []commitPullRequest
+			err = json.Unmarshal([]byte(textContent.Text), &returnedPRs)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedPRs, returnedPRs)
+		})
+	}
+}
@@ -0,0 +1,438 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// graphQLReactionContent maps the reaction content names of the REST API to the
+// ReactionContent enum of the GraphQL API.
+var graphQLReactionContent = map[string]string{
+	"+1":       "THUMBS_UP",
+	"-1":       "THUMBS_DOWN",
+	"laugh":    "LAUGH",
+	"confused": "CONFUSED",
+	"heart":    "HEART",
+	"hooray":   "HOORAY",
+	"rocket":   "ROCKET",
+	"eyes":     "EYES",
+}
+
+// reactionListLimit is the maximum number of reactions fetched to summarize a subject.
+const reactionListLimit = 1000
+
+const addReactionMutation = `mutation AddReaction($subjectId: ID!, $content: ReactionContent!) {
+  addReaction(input: {subjectId: $subjectId, content: $content}) {
+    reaction {
+      id
+      databaseId
+      user { login }
+    }
+  }
+}`
+
+// AddReaction creates a tool to add a reaction to a discussion, issue, or comment.
+func AddReaction(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("add_reaction",
+			mcp.WithDescription(t("TOOL_ADD_REACTION_DESCRIPTION", "Add a reaction to a discussion, discussion comment, issue, issue comment, or pull request review comment")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("subject_type",
+				mcp.Required(),
+				mcp.Description("Type of the subject to react to"),
+				mcp.Enum("discussion", "discussion_comment", "issue", "issue_comment", "pr_comment"),
+			),
+			mcp.WithNumber("subject_id",
+				mcp.Required(),
+				mcp.Description("Discussion or issue number, or comment ID, depending on subject_type"),
+			),
+			mcp.WithNumber("discussion_number",
+				mcp.Description("Discussion number the comment belongs to (required when subject_type is 'discussion_comment')"),
+			),
+			mcp.WithString("content",
+				mcp.Required(),
+				mcp.Description("Reaction to add"),
+				mcp.Enum("+1", "-1", "laugh", "confused", "heart", "hooray", "rocket", "eyes"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			subjectType, err := requiredParam[string](request, "subject_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			subjectID, err := RequiredInt(request, "subject_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			discussionNumber, err := OptionalIntParam(request, "discussion_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			content, err := requiredParam[string](request, "content")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if _, ok := graphQLReactionContent[content]; !ok {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid reaction content: %s", content)), nil
+			}
+			if subjectType == "discussion_comment" && discussionNumber == 0 {
+				return mcp.NewToolResultError("discussion_number is required when subject_type is 'discussion_comment'"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			var reaction *github.Reaction
+			var resp *github.Response
+			switch subjectType {
+			case "discussion":
+				subjectNodeID, err := resolveDiscussionID(ctx, client, owner, repo, subjectID)
+				if err != nil {
+					if result := graphQLErrorResult("failed to get discussion", err); result != nil {
+						return result, nil
+					}
+					return nil, fmt.Errorf("failed to get discussion: %w", err)
+				}
+				return addGraphQLReaction(ctx, client, subjectNodeID, content)
+			case "discussion_comment":
+				node, err := resolveDiscussionComment(ctx, client, owner, repo, discussionNumber, int64(subjectID))
+				if err != nil {
+					if result := graphQLErrorResult("failed to get discussion comments", err); result != nil {
+						return result, nil
+					}
+					return nil, fmt.Errorf("failed to get discussion comments: %w", err)
+				}
+				return addGraphQLReaction(ctx, client, node.commentID, content)
+			case "issue":
+				reaction, resp, err = client.Reactions.CreateIssueReaction(ctx, owner, repo, subjectID, content)
+			case "issue_comment":
+				reaction, resp, err = client.Reactions.CreateIssueCommentReaction(ctx, owner, repo, int64(subjectID), content)
+			case "pr_comment":
+				reaction, resp, err = client.Reactions.CreatePullRequestCommentReaction(ctx, owner, repo, int64(subjectID), content)
+			default:
+				return mcp.NewToolResultError(fmt.Sprintf("invalid subject_type: %s", subjectType)), nil
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed to add reaction: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			// GitHub answers 200 instead of 201 when the reaction already exists.
+			if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to add reaction: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(reaction)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// addGraphQLReaction adds a reaction to the subject with the given node ID through the GraphQL
+// API, which is the only API supporting reactions on discussions. The result has the same
+// shape as the reactions returned by the REST API.
+func addGraphQLReaction(ctx context.Context, client *github.Client, subjectID, content string) (*mcp.CallToolResult, error) {
+	var response struct {
+		AddReaction struct {
+			Reaction struct {
+				ID         string `json:"id"`
+				DatabaseID int64  `json:"databaseId"`
+				User       *struct {
+					Login string `json:"login"`
+				} `json:"user"`
+			} `json:"reaction"`
+		} `json:"addReaction"`
+	}
+	err := executeGraphQL(ctx, client, addReactionMutation, map[string]interface{}{
+		"subjectId": subjectID,
+		"content":   graphQLReactionContent[content],
+	}, &response)
+	if err != nil {
+		if result := graphQLErrorResult("failed to add reaction", err); result != nil {
+			return result, nil
+		}
+		return nil, fmt.Errorf("failed to add reaction: %w", err)
+	}
+
+	created := response.AddReaction.Reaction
+	reaction := &github.Reaction{
+		ID:      github.Ptr(created.DatabaseID),
+		NodeID:  github.Ptr(created.ID),
+		Content: github.Ptr(content),
+	}
+	if created.User != nil {
+		reaction.User = &github.User{Login: github.Ptr(created.User.Login)}
+	}
+
+	r, err := json.Marshal(reaction)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal response: %w", err)
+	}
+
+	return mcp.NewToolResultText(string(r)), nil
+}
+
+const reactionsQuery = `query Reactions($subjectId: ID!, $after: String) {
+  node(id: $subjectId) {
+    ... on Reactable {
+      reactions(first: 100, after: $after) {
+        nodes {
+          databaseId
+          content
+          user { login }
+        }
+        pageInfo { hasNextPage endCursor }
+      }
+    }
+  }
+}`
+
+// reactionList is a page of the reactions to a subject, together with the number of
+// reactions of each content across all pages.
+type reactionList struct {
+	TotalCount int                `json:"total_count"`
+	Summary    map[string]int     `json:"summary"`
+	Truncated  bool               `json:"truncated,omitempty"`
+	Reactions  []*github.Reaction `json:"reactions"`
+}
+
+// ListReactions creates a tool to list the reactions to a discussion, issue, or comment.
+func ListReactions(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_reactions",
+			mcp.WithDescription(t("TOOL_LIST_REACTIONS_DESCRIPTION", "List the reactions to a discussion, discussion comment, issue, or issue comment, with the number of reactions of each content")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("subject_type",
+				mcp.Required(),
+				mcp.Description("Type of the subject whose reactions to list"),
+				mcp.Enum("issue", "issue_comment", "discussion", "discussion_comment"),
+			),
+			mcp.WithNumber("subject_id",
+				mcp.Required(),
+				mcp.Description("Discussion or issue number, or comment ID, depending on subject_type"),
+			),
+			mcp.WithNumber("discussion_number",
+				mcp.Description("Discussion number the comment belongs to (required when subject_type is 'discussion_comment')"),
+			),
+			mcp.WithString("content",
+				mcp.Description("Only list reactions with this content, the summary still counts all reactions"),
+				mcp.Enum("+1", "-1", "laugh", "confused", "heart", "hooray", "rocket", "eyes"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			subjectType, err := requiredParam[string](request, "subject_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			subjectID, err := RequiredInt(request, "subject_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			discussionNumber, err := OptionalIntParam(request, "discussion_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			content, err := OptionalParam[string](request, "content")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if _, ok := graphQLReactionContent[content]; content != "" && !ok {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid reaction content: %s", content)), nil
+			}
+			if subjectType == "discussion_comment" && discussionNumber == 0 {
+				return mcp.NewToolResultError("discussion_number is required when subject_type is 'discussion_comment'"), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			// All reactions are fetched so that the summary covers every page. The API does
+			// not filter by content either, so the requested page is cut out afterwards.
+			var reactions []*github.Reaction
+			var truncated bool
+			opts := &github.ListOptions{PerPage: 100}
+			switch subjectType {
+			case "issue":
+				reactions, truncated, err = fetchAllPages(ctx, opts, reactionListLimit, func() ([]*github.Reaction, *github.Response, error) {
+					return client.Reactions.ListIssueReactions(ctx, owner, repo, subjectID, opts)
+				})
+			case "issue_comment":
+				reactions, truncated, err = fetchAllPages(ctx, opts, reactionListLimit, func() ([]*github.Reaction, *github.Response, error) {
+					return client.Reactions.ListIssueCommentReactions(ctx, owner, repo, int64(subjectID), opts)
+				})
+			case "discussion":
+				subjectNodeID, err := resolveDiscussionID(ctx, client, owner, repo, subjectID)
+				if err != nil {
+					if result := graphQLErrorResult("failed to get discussion", err); result != nil {
+						return result, nil
+					}
+					return nil, fmt.Errorf("failed to get discussion: %w", err)
+				}
+				reactions, truncated, err = listGraphQLReactions(ctx, client, subjectNodeID)
+				if err != nil {
+					if result := graphQLErrorResult("failed to list reactions", err); result != nil {
+						return result, nil
+					}
+					return nil, fmt.Errorf("failed to list reactions: %w", err)
+				}
+			case "discussion_comment":
+				node, err := resolveDiscussionComment(ctx, client, owner, repo, discussionNumber, int64(subjectID))
+				if err != nil {
+					if result := graphQLErrorResult("failed to get discussion comments", err); result != nil {
+						return result, nil
+					}
+					return nil, fmt.Errorf("failed to get discussion comments: %w", err)
+				}
+				reactions, truncated, err = listGraphQLReactions(ctx, client, node.commentID)
+				if err != nil {
+					if result := graphQLErrorResult("failed to list reactions", err); result != nil {
+						return result, nil
+					}
+					return nil, fmt.Errorf("failed to list reactions: %w", err)
+				}
+			default:
+				return mcp.NewToolResultError(fmt.Sprintf("invalid subject_type: %s", subjectType)), nil
+			}
+			if err != nil {
+				if result := apiErrorResult("failed to list reactions", err, http.StatusNotFound); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to list reactions: %w", err)
+			}
+
+			list := reactionList{
+				Summary:   make(map[string]int),
+				Truncated: truncated,
+			}
+			matching := []*github.Reaction{}
+			for _, reaction := range reactions {
+				list.Summary[reaction.GetContent()]++
+				if content == "" || reaction.GetContent() == content {
+					matching = append(matching, reaction)
+				}
+			}
+			list.TotalCount = len(matching)
+			if list.Reactions, err = paginateSlice(matching, pagination); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			r, err := json.Marshal(list)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// listGraphQLReactions fetches up to reactionListLimit reactions to the subject with the given
+// node ID through the GraphQL API, converted to the shape of the reactions returned by the REST
+// API. It reports whether reactions were left out because of the limit.
+func listGraphQLReactions(ctx context.Context, client *github.Client, subjectID string) ([]*github.Reaction, bool, error) {
+	restContent := make(map[string]string, len(graphQLReactionContent))
+	for rest, graphQL := range graphQLReactionContent {
+		restContent[graphQL] = rest
+	}
+
+	reactions := make([]*github.Reaction, 0)
+	var cursor *string
+	for {
+		var query struct {
+			Node *struct {
+				Reactions struct {
+					Nodes []struct {
+						DatabaseID int64  `json:"databaseId"`
+						Content    string `json:"content"`
+						User       *struct {
+							Login string `json:"login"`
+						} `json:"user"`
+					} `json:"nodes"`
+					PageInfo struct {
+						HasNextPage bool   `json:"hasNextPage"`
+						EndCursor   string `json:"endCursor"`
+					} `json:"pageInfo"`
+				} `json:"reactions"`
+			} `json:"node"`
+		}
+		err := executeGraphQL(ctx, client, reactionsQuery, map[string]interface{}{
+			"subjectId": subjectID,
+			"after":     cursor,
+		}, &query)
+		if err != nil {
+			return reactions, false, err
+		}
+		if query.Node == nil {
+			return reactions, false, graphQLErrors{{Type: "NOT_FOUND", Message: fmt.Sprintf("subject %s not found", subjectID)}}
+		}
+
+		for _, node := range query.Node.Reactions.Nodes {
+			if len(reactions) == reactionListLimit {
+				return reactions, true, nil
+			}
+			reaction := &github.Reaction{
+				ID:      github.Ptr(node.DatabaseID),
+				Content: github.Ptr(restContent[node.Content]),
+			}
+			if node.User != nil {
+				reaction.User = &github.User{Login: github.Ptr(node.User.Login)}
+			}
+			reactions = append(reactions, reaction)
+		}
+		if !query.Node.Reactions.PageInfo.HasNextPage {
+			return reactions, false, nil
+		}
+		cursor = github.Ptr(query.Node.Reactions.PageInfo.EndCursor)
+	}
+}
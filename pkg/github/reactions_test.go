@@ -0,0 +1,434 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_AddReaction(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := AddReaction(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "add_reaction", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "subject_type")
+	assert.Contains(t, tool.InputSchema.Properties, "subject_id")
+	assert.Contains(t, tool.InputSchema.Properties, "discussion_number")
+	assert.Contains(t, tool.InputSchema.Properties, "content")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "subject_type", "subject_id", "content"})
+
+	// Invalid reactions must be rejected by the schema
+	contentSchema, ok := tool.InputSchema.Properties["content"].(map[string]interface{})
+	require.True(t, ok)
+	assert.ElementsMatch(t, contentSchema["enum"], []string{"+1", "-1", "laugh", "confused", "heart", "hooray", "rocket", "eyes"})
+
+	mockReaction := &github.Reaction{
+		ID:      github.Ptr(int64(1)),
+		NodeID:  github.Ptr("REA_1"),
+		Content: github.Ptr("+1"),
+		User:    &github.User{Login: github.Ptr("testuser")},
+	}
+
+	tests := []struct {
+		name             string
+		mockedClient     *http.Client
+		requestArgs      map[string]interface{}
+		expectError      bool
+		expectedErrMsg   string
+		expectedReaction *github.Reaction
+	}{
+		{
+			name: "react to issue",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposIssuesReactionsByOwnerByRepoByIssueNumber,
+					expectRequestBody(t, map[string]any{
+						"content": "+1",
+					}).andThen(
+						mockResponse(t, http.StatusCreated, mockReaction),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"subject_type": "issue",
+				"subject_id":   float64(42),
+				"content":      "+1",
+			},
+			expectError:      false,
+			expectedReaction: mockReaction,
+		},
+		{
+			name: "duplicate reaction to issue comment",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.PostReposIssuesCommentsReactionsByOwnerByRepoByCommentId,
+					mockReaction,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"subject_type": "issue_comment",
+				"subject_id":   float64(123),
+				"content":      "+1",
+			},
+			expectError:      false,
+			expectedReaction: mockReaction,
+		},
+		{
+			name: "react to pull request comment",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposPullsCommentsReactionsByOwnerByRepoByCommentId,
+					mockResponse(t, http.StatusCreated, mockReaction),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"subject_type": "pr_comment",
+				"subject_id":   float64(123),
+				"content":      "+1",
+			},
+			expectError:      false,
+			expectedReaction: mockReaction,
+		},
+		{
+			name: "react to discussion",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockGraphQL(t,
+						graphQLExchange{
+							query:             "query DiscussionID",
+							expectedVariables: map[string]any{"number": float64(42)},
+							data: map[string]any{
+								"repository": map[string]any{"discussion": map[string]any{"id": "D_kwDOA"}},
+							},
+						},
+						graphQLExchange{
+							query:             "mutation AddReaction",
+							expectedVariables: map[string]any{"subjectId": "D_kwDOA", "content": "HOORAY"},
+							data: map[string]any{
+								"addReaction": map[string]any{
+									"reaction": map[string]any{
+										"id":         "REA_2",
+										"databaseId": 2,
+										"user":       map[string]any{"login": "testuser"},
+									},
+								},
+							},
+						},
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"subject_type": "discussion",
+				"subject_id":   float64(42),
+				"content":      "hooray",
+			},
+			expectError: false,
+			expectedReaction: &github.Reaction{
+				ID:      github.Ptr(int64(2)),
+				NodeID:  github.Ptr("REA_2"),
+				Content: github.Ptr("hooray"),
+				User:    &github.User{Login: github.Ptr("testuser")},
+			},
+		},
+		{
+			name:         "discussion comment without discussion number",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"subject_type": "discussion_comment",
+				"subject_id":   float64(123),
+				"content":      "heart",
+			},
+			expectError:    false,
+			expectedErrMsg: "discussion_number is required when subject_type is 'discussion_comment'",
+		},
+		{
+			name: "reaction fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposIssuesReactionsByOwnerByRepoByIssueNumber,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"subject_type": "issue",
+				"subject_id":   float64(999),
+				"content":      "+1",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to add reaction",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := AddReaction(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedReaction github.Reaction
+			err = json.Unmarshal([]byte(textContent.Text), &returnedReaction)
+			require.NoError(t, err)
+			assert.Equal(t, *tc.expectedReaction.ID, *returnedReaction.ID)
+			assert.Equal(t, *tc.expectedReaction.NodeID, *returnedReaction.NodeID)
+			assert.Equal(t, *tc.expectedReaction.Content, *returnedReaction.Content)
+			assert.Equal(t, *tc.expectedReaction.User.Login, *returnedReaction.User.Login)
+		})
+	}
+}
+
+func Test_ListReactions(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListReactions(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_reactions", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "subject_type")
+	assert.Contains(t, tool.InputSchema.Properties, "subject_id")
+	assert.Contains(t, tool.InputSchema.Properties, "discussion_number")
+	assert.Contains(t, tool.InputSchema.Properties, "content")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "subject_type", "subject_id"})
+
+	reaction := func(id int64, content, login string) *github.Reaction {
+		return &github.Reaction{
+			ID:      github.Ptr(id),
+			Content: github.Ptr(content),
+			User:    &github.User{Login: github.Ptr(login)},
+		}
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectedErrMsg string
+		expectedList   *reactionList
+	}{
+		{
+			name: "summarize issue reactions across pages",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchPages(
+					mock.GetReposIssuesReactionsByOwnerByRepoByIssueNumber,
+					[]*github.Reaction{reaction(1, "+1", "alice"), reaction(2, "heart", "alice"), reaction(3, "+1", "bob")},
+					[]*github.Reaction{reaction(4, "-1", "carol"), reaction(5, "+1", "dave")},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"subject_type": "issue",
+				"subject_id":   float64(42),
+				"perPage":      float64(2),
+				"page":         float64(2),
+			},
+			expectedList: &reactionList{
+				TotalCount: 5,
+				Summary:    map[string]int{"+1": 3, "heart": 1, "-1": 1},
+				Reactions:  []*github.Reaction{reaction(3, "+1", "bob"), reaction(4, "-1", "carol")},
+			},
+		},
+		{
+			name: "filter issue comment reactions by content",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposIssuesCommentsReactionsByOwnerByRepoByCommentId,
+					[]*github.Reaction{reaction(1, "+1", "alice"), reaction(2, "rocket", "bob"), reaction(3, "+1", "carol")},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"subject_type": "issue_comment",
+				"subject_id":   float64(123),
+				"content":      "+1",
+			},
+			expectedList: &reactionList{
+				TotalCount: 2,
+				Summary:    map[string]int{"+1": 2, "rocket": 1},
+				Reactions:  []*github.Reaction{reaction(1, "+1", "alice"), reaction(3, "+1", "carol")},
+			},
+		},
+		{
+			name: "summarize discussion reactions",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockGraphQL(t,
+						graphQLExchange{
+							query: "DiscussionID",
+							data: map[string]any{
+								"repository": map[string]any{"discussion": map[string]any{"id": "D_1"}},
+							},
+						},
+						graphQLExchange{
+							query:             "Reactions(",
+							expectedVariables: map[string]any{"subjectId": "D_1", "after": nil},
+							data: map[string]any{
+								"node": map[string]any{
+									"reactions": map[string]any{
+										"nodes": []map[string]any{
+											{"databaseId": 1, "content": "THUMBS_UP", "user": map[string]any{"login": "alice"}},
+											{"databaseId": 2, "content": "HOORAY", "user": map[string]any{"login": "bob"}},
+										},
+										"pageInfo": map[string]any{"hasNextPage": true, "endCursor": "c1"},
+									},
+								},
+							},
+						},
+						graphQLExchange{
+							query:             "Reactions(",
+							expectedVariables: map[string]any{"subjectId": "D_1", "after": "c1"},
+							data: map[string]any{
+								"node": map[string]any{
+									"reactions": map[string]any{
+										"nodes": []map[string]any{
+											{"databaseId": 3, "content": "THUMBS_UP", "user": map[string]any{"login": "carol"}},
+										},
+										"pageInfo": map[string]any{"hasNextPage": false},
+									},
+								},
+							},
+						},
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"subject_type": "discussion",
+				"subject_id":   float64(1),
+			},
+			expectedList: &reactionList{
+				TotalCount: 3,
+				Summary:    map[string]int{"+1": 2, "hooray": 1},
+				Reactions:  []*github.Reaction{reaction(1, "+1", "alice"), reaction(2, "hooray", "bob"), reaction(3, "+1", "carol")},
+			},
+		},
+		{
+			name:         "discussion comment without discussion number",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"subject_type": "discussion_comment",
+				"subject_id":   float64(123),
+			},
+			expectedErrMsg: "discussion_number is required when subject_type is 'discussion_comment'",
+		},
+		{
+			name: "issue not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposIssuesReactionsByOwnerByRepoByIssueNumber,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"subject_type": "issue",
+				"subject_id":   float64(999),
+			},
+			expectedErrMsg: "failed to list reactions: Not Found",
+		},
+		{
+			name: "negative page",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposIssuesReactionsByOwnerByRepoByIssueNumber,
+					[]*github.Reaction{reaction(1, "+1", "alice")},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"subject_type": "issue",
+				"subject_id":   float64(42),
+				"page":         float64(-1),
+			},
+			expectedErrMsg: "page must be at least 1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListReactions(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedList reactionList
+			err = json.Unmarshal([]byte(textContent.Text), &returnedList)
+			require.NoError(t, err)
+			assert.Equal(t, *tc.expectedList, returnedList)
+		})
+	}
+}
@@ -1,11 +1,20 @@
 package github
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
 
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/google/go-github/v69/github"
@@ -13,6 +22,28 @@ import (
 	"github.com/mark3labs/mcp-go/server"
 )
 
+// commitStatsLimit is the maximum number of listed commits that are fetched one by one to
+// include their stats.
+const commitStatsLimit = 20
+
+// commitSummary is a trimmed down commit of a commit listing.
+type commitSummary struct {
+	comparedCommit
+	HTMLURL string              `json:"html_url"`
+	Stats   *github.CommitStats `json:"stats,omitempty"`
+}
+
+// repositoryEmpty reports whether err is GitHub refusing to list the commits of a repository
+// without any.
+func repositoryEmpty(err error) bool {
+	var errorResponse *github.ErrorResponse
+	if !errors.As(err, &errorResponse) || errorResponse.Response == nil {
+		return false
+	}
+	return errorResponse.Response.StatusCode == http.StatusConflict &&
+		strings.Contains(errorResponse.Message, "Git Repository is empty")
+}
+
 // ListCommits creates a tool to get commits of a branch in a repository.
 func ListCommits(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("list_commits",
@@ -26,7 +57,25 @@ func ListCommits(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 				mcp.Description("Repository name"),
 			),
 			mcp.WithString("sha",
-				mcp.Description("Branch name"),
+				mcp.Description("Branch name, tag, or commit SHA to list commits from, defaults to the default branch"),
+			),
+			mcp.WithString("branch",
+				mcp.Description("Branch name to list commits from, an alternative to sha"),
+			),
+			mcp.WithString("path",
+				mcp.Description("Only commits changing this file path"),
+			),
+			mcp.WithString("author",
+				mcp.Description("Only commits authored by this GitHub login or email address"),
+			),
+			mcp.WithString("since",
+				mcp.Description("Only commits after this date (ISO 8601 timestamp)"),
+			),
+			mcp.WithString("until",
+				mcp.Description("Only commits before this date (ISO 8601 timestamp)"),
+			),
+			mcp.WithBoolean("include_stats",
+				mcp.Description(fmt.Sprintf("Include the additions and deletions of each commit, fetched for the first %d commits only", commitStatsLimit)),
 			),
 			WithPagination(),
 		),
@@ -43,13 +92,50 @@ func ListCommits(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			branch, err := OptionalParam[string](request, "branch")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if sha != "" && branch != "" && sha != branch {
+				return mcp.NewToolResultError("only one of sha and branch can be provided"), nil
+			}
+			if sha == "" {
+				sha = branch
+			}
+			path, err := OptionalParam[string](request, "path")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			author, err := OptionalParam[string](request, "author")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			since, err := OptionalTimestampParam(request, "since")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			until, err := OptionalTimestampParam(request, "until")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if !since.IsZero() && !until.IsZero() && until.Before(since) {
+				return mcp.NewToolResultError("until must not be before since"), nil
+			}
+			includeStats, err := OptionalParam[bool](request, "include_stats")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
 			pagination, err := OptionalPaginationParams(request)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
 			opts := &github.CommitsListOptions{
-				SHA: sha,
+				SHA:    sha,
+				Path:   path,
+				Author: author,
+				Since:  since,
+				Until:  until,
 				ListOptions: github.ListOptions{
 					Page:    pagination.page,
 					PerPage: pagination.perPage,
@@ -62,6 +148,9 @@ func ListCommits(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 			}
 			commits, resp, err := client.Repositories.ListCommits(ctx, owner, repo, opts)
 			if err != nil {
+				if repositoryEmpty(err) {
+					return mcp.NewToolResultError(fmt.Sprintf("failed to list commits: %s/%s is empty, nothing has been pushed to it yet", owner, repo)), nil
+				}
 				return nil, fmt.Errorf("failed to list commits: %w", err)
 			}
 			defer func() { _ = resp.Body.Close() }()
@@ -74,7 +163,24 @@ func ListCommits(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 				return mcp.NewToolResultError(fmt.Sprintf("failed to list commits: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(commits)
+			result := make([]commitSummary, 0, len(commits))
+			for i, commit := range commits {
+				summary := commitSummary{
+					comparedCommit: newComparedCommit(commit),
+					HTMLURL:        commit.GetHTMLURL(),
+				}
+				if includeStats && i < commitStatsLimit {
+					detailed, resp, err := client.Repositories.GetCommit(ctx, owner, repo, commit.GetSHA(), nil)
+					if err != nil {
+						return nil, fmt.Errorf("failed to get commit %s: %w", commit.GetSHA(), err)
+					}
+					_ = resp.Body.Close()
+					summary.Stats = detailed.GetStats()
+				}
+				result = append(result, summary)
+			}
+
+			r, err := json.Marshal(result)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
@@ -83,37 +189,314 @@ func ListCommits(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 		}
 }
 
-// CreateOrUpdateFile creates a tool to create or update a file in a GitHub repository.
-func CreateOrUpdateFile(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
-	return mcp.NewTool("create_or_update_file",
-			mcp.WithDescription(t("TOOL_CREATE_OR_UPDATE_FILE_DESCRIPTION", "Create or update a single file in a GitHub repository")),
+// commitIdentity is the author or committer of a commit.
+type commitIdentity struct {
+	Login string            `json:"login,omitempty"`
+	Name  string            `json:"name"`
+	Email string            `json:"email"`
+	Date  *github.Timestamp `json:"date,omitempty"`
+}
+
+// commitVerification is the signature verification status of a commit.
+type commitVerification struct {
+	Verified bool   `json:"verified"`
+	Reason   string `json:"reason"`
+}
+
+// commitDetails is a trimmed down commit with the files it changes.
+type commitDetails struct {
+	SHA            string              `json:"sha"`
+	Message        string              `json:"message"`
+	Author         commitIdentity      `json:"author"`
+	Committer      commitIdentity      `json:"committer"`
+	Verification   commitVerification  `json:"verification"`
+	Parents        []string            `json:"parents"`
+	Stats          *github.CommitStats `json:"stats,omitempty"`
+	Files          []pullRequestFile   `json:"files"`
+	FilesTruncated bool                `json:"files_truncated"`
+	HTMLURL        string              `json:"html_url"`
+}
+
+// GetCommit creates a tool to get a single commit of a repository with the files it changes.
+func GetCommit(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_commit",
+			mcp.WithDescription(t("TOOL_GET_COMMIT_DESCRIPTION", "Get a commit of a GitHub repository with its author, verification status, stats and changed files")),
 			mcp.WithString("owner",
 				mcp.Required(),
-				mcp.Description("Repository owner (username or organization)"),
+				mcp.Description("Repository owner"),
 			),
 			mcp.WithString("repo",
 				mcp.Required(),
 				mcp.Description("Repository name"),
 			),
-			mcp.WithString("path",
+			mcp.WithString("sha",
 				mcp.Required(),
-				mcp.Description("Path where to create/update the file"),
+				mcp.Description("Commit SHA, or branch or tag name to get the head commit of"),
 			),
-			mcp.WithString("content",
+			mcp.WithBoolean("include_patch",
+				mcp.Description("Include the patch of each file, which can be large (default false)"),
+			),
+			mcp.WithNumber("patch_limit",
+				mcp.Description(fmt.Sprintf("Maximum size of each patch in bytes, longer patches are truncated (default %d)", defaultPatchLimit)),
+				mcp.Min(1),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			sha, err := requiredParam[string](request, "sha")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			includePatch, err := OptionalParam[bool](request, "include_patch")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			patchLimit, err := OptionalIntParamWithDefault(request, "patch_limit", defaultPatchLimit)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if patchLimit < 1 {
+				return mcp.NewToolResultError("patch_limit must be at least 1"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			commit, resp, err := client.Repositories.GetCommit(ctx, owner, repo, sha, nil)
+			if err != nil {
+				if result := apiErrorResult("failed to get commit", err, http.StatusNotFound, http.StatusUnprocessableEntity); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to get commit: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			author := commit.GetCommit().GetAuthor()
+			committer := commit.GetCommit().GetCommitter()
+			result := commitDetails{
+				SHA:     commit.GetSHA(),
+				Message: commit.GetCommit().GetMessage(),
+				Author: commitIdentity{
+					Login: commit.GetAuthor().GetLogin(),
+					Name:  author.GetName(),
+					Email: author.GetEmail(),
+					Date:  author.Date,
+				},
+				Committer: commitIdentity{
+					Login: commit.GetCommitter().GetLogin(),
+					Name:  committer.GetName(),
+					Email: committer.GetEmail(),
+					Date:  committer.Date,
+				},
+				Verification: commitVerification{
+					Verified: commit.GetCommit().GetVerification().GetVerified(),
+					Reason:   commit.GetCommit().GetVerification().GetReason(),
+				},
+				Parents: make([]string, 0, len(commit.Parents)),
+				Stats:   commit.GetStats(),
+				Files:   make([]pullRequestFile, 0, len(commit.Files)),
+				// The API lists the first 300 files, and links to the next page for the others
+				FilesTruncated: resp.NextPage != 0,
+				HTMLURL:        commit.GetHTMLURL(),
+			}
+			for _, parent := range commit.Parents {
+				result.Parents = append(result.Parents, parent.GetSHA())
+			}
+			for _, file := range commit.Files {
+				trimmedFile := pullRequestFile{
+					Filename:         file.GetFilename(),
+					Status:           file.GetStatus(),
+					Additions:        file.GetAdditions(),
+					Deletions:        file.GetDeletions(),
+					PreviousFilename: file.GetPreviousFilename(),
+					SHA:              file.GetSHA(),
+				}
+				if includePatch {
+					trimmedFile.Patch, trimmedFile.PatchTruncated = truncatePatch(file.GetPatch(), patchLimit)
+				}
+				result.Files = append(result.Files, trimmedFile)
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// branchMatchLimit is the maximum number of branches searched for the ones matching a substring.
+const branchMatchLimit = 1000
+
+// branchSummary is a trimmed down branch.
+type branchSummary struct {
+	Name      string `json:"name"`
+	SHA       string `json:"sha"`
+	Protected bool   `json:"protected"`
+}
+
+// branchList is a page of branches. Truncated is set when more branches than are searched
+// for a match exist.
+type branchList struct {
+	Branches  []branchSummary `json:"branches"`
+	Truncated bool            `json:"truncated,omitempty"`
+}
+
+// ListBranches creates a tool to list the branches of a repository.
+func ListBranches(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_branches",
+			mcp.WithDescription(t("TOOL_LIST_BRANCHES_DESCRIPTION", "List the branches of a GitHub repository, with their head commit and whether they are protected")),
+			mcp.WithString("owner",
 				mcp.Required(),
-				mcp.Description("Content of the file"),
+				mcp.Description("Repository owner"),
 			),
-			mcp.WithString("message",
+			mcp.WithString("repo",
 				mcp.Required(),
-				mcp.Description("Commit message"),
+				mcp.Description("Repository name"),
 			),
-			mcp.WithString("branch",
+			mcp.WithBoolean("protected",
+				mcp.Description("Only list protected branches when true, or unprotected branches when false"),
+			),
+			mcp.WithString("match",
+				mcp.Description("Only list branches whose name contains this text, e.g. 'release/'. Only the first 1000 branches are searched, the result is marked truncated when there are more"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			protected, hasProtected, err := OptionalParamOK[bool](request, "protected")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			match, err := OptionalParam[string](request, "match")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts := &github.BranchListOptions{
+				ListOptions: github.ListOptions{
+					Page:    pagination.page,
+					PerPage: pagination.perPage,
+				},
+			}
+			if hasProtected {
+				opts.Protected = github.Ptr(protected)
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			var branches []*github.Branch
+			var truncated bool
+			if match == "" {
+				var resp *github.Response
+				branches, resp, err = client.Repositories.ListBranches(ctx, owner, repo, opts)
+				if err != nil {
+					if result := apiErrorResult("failed to list branches", err, http.StatusNotFound); result != nil {
+						return result, nil
+					}
+					return nil, fmt.Errorf("failed to list branches: %w", err)
+				}
+				_ = resp.Body.Close()
+			} else {
+				// The API cannot filter branches by name, so the matching branches are paginated here.
+				opts.ListOptions = github.ListOptions{PerPage: 100}
+				var all []*github.Branch
+				all, truncated, err = fetchAllPages(ctx, &opts.ListOptions, branchMatchLimit, func() ([]*github.Branch, *github.Response, error) {
+					return client.Repositories.ListBranches(ctx, owner, repo, opts)
+				})
+				if err != nil {
+					if result := apiErrorResult("failed to list branches", err, http.StatusNotFound); result != nil {
+						return result, nil
+					}
+					return nil, fmt.Errorf("failed to list branches: %w", err)
+				}
+				for _, branch := range all {
+					if strings.Contains(branch.GetName(), match) {
+						branches = append(branches, branch)
+					}
+				}
+				if branches, err = paginateSlice(branches, pagination); err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+			}
+
+			result := branchList{
+				Branches:  make([]branchSummary, 0, len(branches)),
+				Truncated: truncated,
+			}
+			for _, branch := range branches {
+				result.Branches = append(result.Branches, branchSummary{
+					Name:      branch.GetName(),
+					SHA:       branch.GetCommit().GetSHA(),
+					Protected: branch.GetProtected(),
+				})
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// tagMatchLimit is the maximum number of tags searched for the ones with a prefix.
+const tagMatchLimit = 1000
+
+// tagSummary is a trimmed down tag.
+type tagSummary struct {
+	Name       string `json:"name"`
+	SHA        string `json:"sha"`
+	TarballURL string `json:"tarball_url"`
+}
+
+// tagList is a page of tags. Truncated is set when more tags than are searched for a prefix
+// exist.
+type tagList struct {
+	Tags      []tagSummary `json:"tags"`
+	Truncated bool         `json:"truncated,omitempty"`
+}
+
+// ListTags creates a tool to list the tags of a repository.
+func ListTags(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_tags",
+			mcp.WithDescription(t("TOOL_LIST_TAGS_DESCRIPTION", "List the tags of a GitHub repository, with the commit they point to")),
+			mcp.WithString("owner",
 				mcp.Required(),
-				mcp.Description("Branch to create/update the file in"),
+				mcp.Description("Repository owner"),
 			),
-			mcp.WithString("sha",
-				mcp.Description("SHA of file being replaced (for updates)"),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("prefix",
+				mcp.Description("Only list tags whose name starts with this text, e.g. 'v2.'. Only the first 1000 tags are searched, the result is marked truncated when there are more"),
 			),
+			WithPagination(),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := requiredParam[string](request, "owner")
@@ -124,62 +507,2361 @@ func CreateOrUpdateFile(getClient GetClientFn, t translations.TranslationHelperF
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			path, err := requiredParam[string](request, "path")
+			prefix, err := OptionalParam[string](request, "prefix")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			content, err := requiredParam[string](request, "content")
+			pagination, err := OptionalPaginationParams(request)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			message, err := requiredParam[string](request, "message")
+
+			opts := &github.ListOptions{
+				Page:    pagination.page,
+				PerPage: pagination.perPage,
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			var tags []*github.RepositoryTag
+			var truncated bool
+			if prefix == "" {
+				var resp *github.Response
+				tags, resp, err = client.Repositories.ListTags(ctx, owner, repo, opts)
+				if err != nil {
+					if result := apiErrorResult("failed to list tags", err, http.StatusNotFound); result != nil {
+						return result, nil
+					}
+					return nil, fmt.Errorf("failed to list tags: %w", err)
+				}
+				_ = resp.Body.Close()
+			} else {
+				// The API cannot filter tags by name, so the matching tags are paginated here.
+				*opts = github.ListOptions{PerPage: 100}
+				var all []*github.RepositoryTag
+				all, truncated, err = fetchAllPages(ctx, opts, tagMatchLimit, func() ([]*github.RepositoryTag, *github.Response, error) {
+					return client.Repositories.ListTags(ctx, owner, repo, opts)
+				})
+				if err != nil {
+					if result := apiErrorResult("failed to list tags", err, http.StatusNotFound); result != nil {
+						return result, nil
+					}
+					return nil, fmt.Errorf("failed to list tags: %w", err)
+				}
+				for _, tag := range all {
+					if strings.HasPrefix(tag.GetName(), prefix) {
+						tags = append(tags, tag)
+					}
+				}
+				if tags, err = paginateSlice(tags, pagination); err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+			}
+
+			result := tagList{
+				Tags:      make([]tagSummary, 0, len(tags)),
+				Truncated: truncated,
+			}
+			for _, tag := range tags {
+				result.Tags = append(result.Tags, tagSummary{
+					Name:       tag.GetName(),
+					SHA:        tag.GetCommit().GetSHA(),
+					TarballURL: tag.GetTarballURL(),
+				})
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// tagDetails is a tag with the commit it points to. Annotated tags also have the tag object
+// with its tagger, message and signature verification.
+type tagDetails struct {
+	Name         string              `json:"name"`
+	Type         string              `json:"type"`
+	SHA          string              `json:"sha"`
+	TagSHA       string              `json:"tag_sha,omitempty"`
+	Tagger       *commitIdentity     `json:"tagger,omitempty"`
+	Message      string              `json:"message,omitempty"`
+	Verification *commitVerification `json:"verification,omitempty"`
+}
+
+// GetTag creates a tool to get a tag of a repository.
+func GetTag(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_tag",
+			mcp.WithDescription(t("TOOL_GET_TAG_DESCRIPTION", "Get a tag of a GitHub repository, whether it is lightweight or annotated, and the tagger, message and verification of annotated tags")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("tag",
+				mcp.Required(),
+				mcp.Description("Tag name, e.g. 'v1.2.0'"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			branch, err := requiredParam[string](request, "branch")
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			tag, err := requiredParam[string](request, "tag")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			// Convert content to base64
-			contentBytes := []byte(content)
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			ref, resp, err := client.Git.GetRef(ctx, owner, repo, "refs/tags/"+tag)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("failed to get tag: tag %q not found in %s/%s", tag, owner, repo)), nil
+				}
+				return nil, fmt.Errorf("failed to get tag reference: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
 
-			// Create the file options
-			opts := &github.RepositoryContentFileOptions{
-				Message: github.Ptr(message),
-				Content: contentBytes,
+			result := tagDetails{
+				Name: tag,
+				Type: "lightweight",
+				SHA:  ref.GetObject().GetSHA(),
+			}
+			// The reference of an annotated tag points to a tag object instead of a commit
+			if ref.GetObject().GetType() == "tag" {
+				tagObject, resp, err := client.Git.GetTag(ctx, owner, repo, ref.GetObject().GetSHA())
+				if err != nil {
+					return nil, fmt.Errorf("failed to get tag object: %w", err)
+				}
+				defer func() { _ = resp.Body.Close() }()
+
+				result.Type = "annotated"
+				result.SHA = tagObject.GetObject().GetSHA()
+				result.TagSHA = tagObject.GetSHA()
+				result.Tagger = &commitIdentity{
+					Name:  tagObject.GetTagger().GetName(),
+					Email: tagObject.GetTagger().GetEmail(),
+					Date:  tagObject.GetTagger().Date,
+				}
+				result.Message = tagObject.GetMessage()
+				result.Verification = &commitVerification{
+					Verified: tagObject.GetVerification().GetVerified(),
+					Reason:   tagObject.GetVerification().GetReason(),
+				}
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// tagTargetSHA returns the SHA of the commit a tag points to, peeling annotated tags.
+func tagTargetSHA(ctx context.Context, client *github.Client, owner, repo string, ref *github.Reference) (string, error) {
+	if ref.GetObject().GetType() != "tag" {
+		return ref.GetObject().GetSHA(), nil
+	}
+	tagObject, resp, err := client.Git.GetTag(ctx, owner, repo, ref.GetObject().GetSHA())
+	if err != nil {
+		return "", err
+	}
+	_ = resp.Body.Close()
+	return tagObject.GetObject().GetSHA(), nil
+}
+
+// createdTag is the tag created by create_tag.
+type createdTag struct {
+	Ref    string `json:"ref"`
+	Type   string `json:"type"`
+	SHA    string `json:"sha"`
+	TagSHA string `json:"tag_sha,omitempty"`
+}
+
+// CreateTag creates a tool to create a lightweight or annotated tag in a repository.
+func CreateTag(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_tag",
+			mcp.WithDescription(t("TOOL_CREATE_TAG_DESCRIPTION", "Create a tag in a GitHub repository at a commit or the head of a branch. A message makes it an annotated tag")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("tag",
+				mcp.Required(),
+				mcp.Description("Name for the new tag, without 'refs/tags/'"),
+			),
+			mcp.WithString("sha",
+				mcp.Description("Commit SHA to tag"),
+			),
+			mcp.WithString("branch",
+				mcp.Description("Branch whose head commit to tag, as an alternative to sha"),
+			),
+			mcp.WithString("message",
+				mcp.Description("Message of an annotated tag. Without it a lightweight tag is created"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			tag, err := requiredParam[string](request, "tag")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if err := validateTagName(tag); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			sha, err := OptionalParam[string](request, "sha")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			branch, err := OptionalParam[string](request, "branch")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if (sha == "") == (branch == "") {
+				return mcp.NewToolResultError("exactly one of sha and branch must be provided"), nil
+			}
+			message, err := OptionalParam[string](request, "message")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			// Check the tag is new before creating a tag object, which cannot be deleted
+			existing, resp, err := client.Git.GetRef(ctx, owner, repo, "refs/tags/"+tag)
+			if err == nil {
+				_ = resp.Body.Close()
+				target, err := tagTargetSHA(ctx, client, owner, repo, existing)
+				if err != nil {
+					return nil, fmt.Errorf("failed to get existing tag: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to create tag: tag %q already exists at %s", tag, target)), nil
+			}
+			if resp == nil || resp.StatusCode != http.StatusNotFound {
+				return nil, fmt.Errorf("failed to get tag reference: %w", err)
+			}
+
+			if branch != "" {
+				ref, resp, err := client.Git.GetRef(ctx, owner, repo, "refs/heads/"+branch)
+				if err != nil {
+					if resp != nil && resp.StatusCode == http.StatusNotFound {
+						return mcp.NewToolResultError(fmt.Sprintf("failed to create tag: branch %q not found in %s/%s", branch, owner, repo)), nil
+					}
+					return nil, fmt.Errorf("failed to get reference: %w", err)
+				}
+				defer func() { _ = resp.Body.Close() }()
+
+				sha = ref.GetObject().GetSHA()
+			}
+
+			result := createdTag{
+				Ref:  "refs/tags/" + tag,
+				Type: "lightweight",
+				SHA:  sha,
+			}
+			refSHA := sha
+			if message != "" {
+				tagObject, resp, err := client.Git.CreateTag(ctx, owner, repo, &github.Tag{
+					Tag:     github.Ptr(tag),
+					Message: github.Ptr(message),
+					Object:  &github.GitObject{Type: github.Ptr("commit"), SHA: github.Ptr(sha)},
+				})
+				if err != nil {
+					// An unknown sha is a validation error.
+					if result := apiErrorResult("failed to create tag object", err, http.StatusUnprocessableEntity); result != nil {
+						return result, nil
+					}
+					return nil, fmt.Errorf("failed to create tag object: %w", err)
+				}
+				defer func() { _ = resp.Body.Close() }()
+
+				result.Type = "annotated"
+				result.TagSHA = tagObject.GetSHA()
+				refSHA = tagObject.GetSHA()
+			}
+
+			_, resp, err = client.Git.CreateRef(ctx, owner, repo, &github.Reference{
+				Ref:    github.Ptr(result.Ref),
+				Object: &github.GitObject{SHA: github.Ptr(refSHA)},
+			})
+			if err != nil {
+				if result := apiErrorResult("failed to create tag", err, http.StatusUnprocessableEntity); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to create tag: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// comparedCommit is a trimmed down commit of a comparison between two refs.
+type comparedCommit struct {
+	SHA     string            `json:"sha"`
+	Message string            `json:"message"`
+	Author  string            `json:"author"`
+	Date    *github.Timestamp `json:"date,omitempty"`
+}
+
+// newComparedCommit trims commit down to the first line of its message, and the login of its
+// author, or the name when the author is not a GitHub user.
+func newComparedCommit(commit *github.RepositoryCommit) comparedCommit {
+	message, _, _ := strings.Cut(commit.GetCommit().GetMessage(), "\n")
+	author := commit.GetAuthor().GetLogin()
+	if author == "" {
+		author = commit.GetCommit().GetAuthor().GetName()
+	}
+	return comparedCommit{
+		SHA:     commit.GetSHA(),
+		Message: message,
+		Author:  author,
+		Date:    commit.GetCommit().GetAuthor().Date,
+	}
+}
+
+// comparedFile is a file changed between two refs.
+type comparedFile struct {
+	Filename  string `json:"filename"`
+	Status    string `json:"status"`
+	Additions int    `json:"additions"`
+	Deletions int    `json:"deletions"`
+}
+
+// refComparison is a trimmed down comparison between two refs.
+type refComparison struct {
+	Status         string           `json:"status"`
+	AheadBy        int              `json:"ahead_by"`
+	BehindBy       int              `json:"behind_by"`
+	TotalCommits   int              `json:"total_commits"`
+	Commits        []comparedCommit `json:"commits"`
+	TotalFiles     int              `json:"total_files"`
+	Files          []comparedFile   `json:"files"`
+	FilesTruncated bool             `json:"files_truncated"`
+	HTMLURL        string           `json:"html_url"`
+}
+
+func newRefComparison(comparison *github.CommitsComparison, maxFiles int) refComparison {
+	result := refComparison{
+		Status:       comparison.GetStatus(),
+		AheadBy:      comparison.GetAheadBy(),
+		BehindBy:     comparison.GetBehindBy(),
+		TotalCommits: comparison.GetTotalCommits(),
+		Commits:      make([]comparedCommit, 0, len(comparison.Commits)),
+		TotalFiles:   len(comparison.Files),
+		Files:        []comparedFile{},
+		HTMLURL:      comparison.GetHTMLURL(),
+	}
+	for _, commit := range comparison.Commits {
+		result.Commits = append(result.Commits, newComparedCommit(commit))
+	}
+	for i, file := range comparison.Files {
+		if i == maxFiles {
+			result.FilesTruncated = true
+			break
+		}
+		result.Files = append(result.Files, comparedFile{
+			Filename:  file.GetFilename(),
+			Status:    file.GetStatus(),
+			Additions: file.GetAdditions(),
+			Deletions: file.GetDeletions(),
+		})
+	}
+	return result
+}
+
+// CompareRefs creates a tool to compare two branches, tags, or commits of a repository.
+func CompareRefs(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("compare_refs",
+			mcp.WithDescription(t("TOOL_COMPARE_REFS_DESCRIPTION", "Compare two branches, tags, or commits of a GitHub repository, listing the commits and files that head adds to base")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("base",
+				mcp.Required(),
+				mcp.Description("Branch, tag, or commit SHA to compare against, or 'owner:branch' for a branch of a fork"),
+			),
+			mcp.WithString("head",
+				mcp.Required(),
+				mcp.Description("Branch, tag, or commit SHA to compare, or 'owner:branch' for a branch of a fork"),
+			),
+			mcp.WithNumber("max_files",
+				mcp.Description("Maximum number of changed files to return, defaults to 100"),
+				mcp.Min(1),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			base, err := requiredParam[string](request, "base")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			head, err := requiredParam[string](request, "head")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			maxFiles, err := OptionalIntParamWithDefault(request, "max_files", 100)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if maxFiles < 1 {
+				return mcp.NewToolResultError("max_files must be at least 1"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			comparison, resp, err := client.Repositories.CompareCommits(ctx, owner, repo, base, head, nil)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					// The API does not say which ref is unknown, so look them up one by one.
+					for _, ref := range []string{base, head} {
+						found, err := refExists(ctx, client, owner, repo, ref)
+						if err != nil {
+							return nil, fmt.Errorf("failed to get ref %s: %w", ref, err)
+						}
+						if !found {
+							return mcp.NewToolResultError(fmt.Sprintf("failed to compare refs: ref %q not found in %s/%s", ref, owner, repo)), nil
+						}
+					}
+				}
+				if result := apiErrorResult("failed to compare refs", err, http.StatusNotFound); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to compare refs: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			r, err := json.Marshal(newRefComparison(comparison, maxFiles))
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// refExists reports whether a branch, tag, or commit SHA resolves to a commit of a repository.
+// A ref in the 'owner:branch' form is looked up in the fork of that owner, which is assumed to
+// have the same name as the repository.
+func refExists(ctx context.Context, client *github.Client, owner, repo, ref string) (bool, error) {
+	if forkOwner, branch, ok := strings.Cut(ref, ":"); ok {
+		owner, ref = forkOwner, branch
+	}
+	_, resp, err := client.Repositories.GetCommitSHA1(ctx, owner, repo, ref, "")
+	if err != nil {
+		if resp != nil && (resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusUnprocessableEntity) {
+			return false, nil
+		}
+		return false, err
+	}
+	_ = resp.Body.Close()
+	return true, nil
+}
+
+// commitComparison is a trimmed down comparison between two commits, with their merge base.
+type commitComparison struct {
+	refComparison
+	MergeBaseSHA string `json:"merge_base_sha"`
+}
+
+// CompareCommits creates a tool to compare two commits of a repository, such as the tags of
+// two releases.
+func CompareCommits(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("compare_commits",
+			mcp.WithDescription(t("TOOL_COMPARE_COMMITS_DESCRIPTION", "Compare two commits or tags of a GitHub repository, listing the commits and files of head since its merge base with base (three-dot comparison), e.g. to write a changelog")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("base",
+				mcp.Required(),
+				mcp.Description("Commit SHA, tag, or branch to compare against"),
+			),
+			mcp.WithString("head",
+				mcp.Required(),
+				mcp.Description("Commit SHA, tag, or branch to compare"),
+			),
+			mcp.WithNumber("max_files",
+				mcp.Description("Maximum number of changed files to return, defaults to 100"),
+				mcp.Min(1),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			base, err := requiredParam[string](request, "base")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			head, err := requiredParam[string](request, "head")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			maxFiles, err := OptionalIntParamWithDefault(request, "max_files", 100)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if maxFiles < 1 {
+				return mcp.NewToolResultError("max_files must be at least 1"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			comparison, resp, err := client.Repositories.CompareCommits(ctx, owner, repo, base, head, nil)
+			if err != nil {
+				if result := apiErrorResult("failed to compare commits", err, http.StatusNotFound); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to compare commits: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			// Identical commits are their own merge base
+			if comparison.GetStatus() == "identical" {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to compare commits: base %s and head %s are the same commit %s",
+					base, head, comparison.GetMergeBaseCommit().GetSHA())), nil
+			}
+
+			r, err := json.Marshal(commitComparison{
+				refComparison: newRefComparison(comparison, maxFiles),
+				MergeBaseSHA:  comparison.GetMergeBaseCommit().GetSHA(),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// repositorySummary is a trimmed down repository with the fields that matter when working with it.
+type repositorySummary struct {
+	FullName        string          `json:"full_name"`
+	Description     string          `json:"description"`
+	HTMLURL         string          `json:"html_url"`
+	DefaultBranch   string          `json:"default_branch"`
+	Visibility      string          `json:"visibility"`
+	Topics          []string        `json:"topics"`
+	Fork            bool            `json:"fork"`
+	Archived        bool            `json:"archived"`
+	Disabled        bool            `json:"disabled"`
+	License         string          `json:"license,omitempty"`
+	OpenIssuesCount int             `json:"open_issues_count"`
+	ForksCount      int             `json:"forks_count"`
+	StargazersCount int             `json:"stargazers_count"`
+	Permissions     map[string]bool `json:"permissions,omitempty"`
+	HasIssues       bool            `json:"has_issues"`
+	HasDiscussions  bool            `json:"has_discussions"`
+	HasWiki         bool            `json:"has_wiki"`
+	Note            string          `json:"note,omitempty"`
+}
+
+func newRepositorySummary(repository *github.Repository) repositorySummary {
+	summary := repositorySummary{
+		FullName:        repository.GetFullName(),
+		Description:     repository.GetDescription(),
+		HTMLURL:         repository.GetHTMLURL(),
+		DefaultBranch:   repository.GetDefaultBranch(),
+		Visibility:      repository.GetVisibility(),
+		Topics:          repository.Topics,
+		Fork:            repository.GetFork(),
+		Archived:        repository.GetArchived(),
+		Disabled:        repository.GetDisabled(),
+		License:         repository.GetLicense().GetSPDXID(),
+		OpenIssuesCount: repository.GetOpenIssuesCount(),
+		ForksCount:      repository.GetForksCount(),
+		StargazersCount: repository.GetStargazersCount(),
+		Permissions:     repository.Permissions,
+		HasIssues:       repository.GetHasIssues(),
+		HasDiscussions:  repository.GetHasDiscussions(),
+		HasWiki:         repository.GetHasWiki(),
+	}
+	if summary.Topics == nil {
+		summary.Topics = []string{}
+	}
+	return summary
+}
+
+// GetRepository creates a tool to get the details of a repository.
+func GetRepository(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_repository",
+			mcp.WithDescription(t("TOOL_GET_REPOSITORY_DESCRIPTION", "Get the details of a repository, such as its default branch, visibility, topics, license, counts, enabled features, and the permissions of the current user")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithBoolean("full",
+				mcp.Description("Return the full repository object as returned by the API instead of the key fields (default false)"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			full, err := OptionalParam[bool](request, "full")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			// Renamed and transferred repositories are redirected to with a 301, which is
+			// followed, so the repository returned is the one under its current name.
+			repository, resp, err := client.Repositories.Get(ctx, owner, repo)
+			if err != nil {
+				if result := apiErrorResult("failed to get repository", err, http.StatusNotFound, http.StatusForbidden); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to get repository: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			var result any = repository
+			if !full {
+				summary := newRepositorySummary(repository)
+				if requested := owner + "/" + repo; !strings.EqualFold(summary.FullName, requested) {
+					summary.Note = fmt.Sprintf("%s was renamed or transferred to %s, use the new name", requested, summary.FullName)
+				}
+				result = summary
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+var (
+	// contributorsRetries is how many times list_contributors retries while GitHub computes the
+	// contributors of a repository.
+	contributorsRetries = 3
+	// contributorsRetryInterval is the time before the first retry, it doubles after every retry.
+	contributorsRetryInterval = time.Second
+)
+
+// contributorSummary is a trimmed down contributor. Anonymous contributors have an email
+// instead of a login.
+type contributorSummary struct {
+	Login         string `json:"login,omitempty"`
+	Email         string `json:"email,omitempty"`
+	Contributions int    `json:"contributions"`
+	HTMLURL       string `json:"html_url,omitempty"`
+}
+
+// contributorList is the result of list_contributors, which is pending while GitHub computes it.
+type contributorList struct {
+	Contributors []contributorSummary `json:"contributors"`
+	StatsPending bool                 `json:"stats_pending"`
+	Note         string               `json:"note,omitempty"`
+}
+
+// ListContributors creates a tool to list the contributors of a repository.
+func ListContributors(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_contributors",
+			mcp.WithDescription(t("TOOL_LIST_CONTRIBUTORS_DESCRIPTION", "List the contributors of a GitHub repository by number of commits")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithBoolean("include_anonymous",
+				mcp.Description("Include contributors without a GitHub account, identified by their email"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			includeAnonymous, err := OptionalParam[bool](request, "include_anonymous")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts := &github.ListContributorsOptions{
+				ListOptions: github.ListOptions{
+					Page:    pagination.page,
+					PerPage: pagination.perPage,
+				},
+			}
+			if includeAnonymous {
+				opts.Anon = "true"
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			result := contributorList{Contributors: []contributorSummary{}}
+			var contributors []*github.Contributor
+			interval := contributorsRetryInterval
+			for attempt := 0; ; attempt++ {
+				var resp *github.Response
+				contributors, resp, err = client.Repositories.ListContributors(ctx, owner, repo, opts)
+				if err == nil {
+					_ = resp.Body.Close()
+					break
+				}
+				// GitHub answers 202 Accepted while it computes the contributors
+				var acceptedErr *github.AcceptedError
+				if !errors.As(err, &acceptedErr) {
+					if result := apiErrorResult("failed to list contributors", err, http.StatusNotFound); result != nil {
+						return result, nil
+					}
+					return nil, fmt.Errorf("failed to list contributors: %w", err)
+				}
+				if attempt == contributorsRetries {
+					result.StatsPending = true
+					result.Note = fmt.Sprintf("GitHub is still computing the contributors of %s/%s, retry in a few moments", owner, repo)
+					break
+				}
+
+				timer := time.NewTimer(interval)
+				select {
+				case <-ctx.Done():
+					timer.Stop()
+					return nil, fmt.Errorf("failed to list contributors: %w", ctx.Err())
+				case <-timer.C:
+				}
+				interval *= 2
+			}
+
+			for _, contributor := range contributors {
+				result.Contributors = append(result.Contributors, contributorSummary{
+					Login:         contributor.GetLogin(),
+					Email:         contributor.GetEmail(),
+					Contributions: contributor.GetContributions(),
+					HTMLURL:       contributor.GetHTMLURL(),
+				})
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+const (
+	// maxTopicLength is the maximum length of a repository topic.
+	maxTopicLength = 50
+	// maxTopics is the maximum number of topics of a repository.
+	maxTopics = 20
+)
+
+// topicErrors checks topics against the rules GitHub applies to them, and returns the reason
+// each invalid topic is rejected for.
+func topicErrors(topics []string) []string {
+	var errs []string
+	if len(topics) > maxTopics {
+		errs = append(errs, fmt.Sprintf("a repository can have at most %d topics, got %d", maxTopics, len(topics)))
+	}
+	seen := make(map[string]bool, len(topics))
+	for _, topic := range topics {
+		switch {
+		case topic == "":
+			errs = append(errs, "topics must not be empty")
+		case len(topic) > maxTopicLength:
+			errs = append(errs, fmt.Sprintf("%q: it is longer than %d characters", topic, maxTopicLength))
+		case strings.ToLower(topic) != topic:
+			errs = append(errs, fmt.Sprintf("%q: it must be lowercase", topic))
+		case strings.HasPrefix(topic, "-"):
+			errs = append(errs, fmt.Sprintf("%q: it must start with a letter or number", topic))
+		case strings.IndexFunc(topic, func(r rune) bool {
+			return (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-'
+		}) >= 0:
+			errs = append(errs, fmt.Sprintf("%q: it can only contain letters, numbers and hyphens", topic))
+		case seen[topic]:
+			errs = append(errs, fmt.Sprintf("%q: it is listed more than once", topic))
+		}
+		seen[topic] = true
+	}
+	return errs
+}
+
+// GetRepositoryTopics creates a tool to get the topics of a repository.
+func GetRepositoryTopics(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_repository_topics",
+			mcp.WithDescription(t("TOOL_GET_REPOSITORY_TOPICS_DESCRIPTION", "Get the topics of a GitHub repository")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			topics, resp, err := client.Repositories.ListAllTopics(ctx, owner, repo)
+			if err != nil {
+				if result := apiErrorResult("failed to get repository topics", err, http.StatusNotFound); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to get repository topics: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if topics == nil {
+				topics = []string{}
+			}
+			r, err := json.Marshal(topics)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// ReplaceRepositoryTopics creates a tool to replace all the topics of a repository.
+func ReplaceRepositoryTopics(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("replace_repository_topics",
+			mcp.WithDescription(t("TOOL_REPLACE_REPOSITORY_TOPICS_DESCRIPTION", "Replace all the topics of a GitHub repository")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithArray("topics",
+				mcp.Required(),
+				mcp.Description(fmt.Sprintf("The new topics, at most %d lowercase names of letters, numbers and hyphens up to %d characters long. An empty array removes all topics", maxTopics, maxTopicLength)),
+				mcp.Items(
+					map[string]interface{}{
+						"type": "string",
+					},
+				),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if _, ok := request.Params.Arguments["topics"]; !ok {
+				return mcp.NewToolResultError("missing required parameter: topics"), nil
+			}
+			topics, err := OptionalStringArrayParam(request, "topics")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if errs := topicErrors(topics); len(errs) > 0 {
+				return mcp.NewToolResultError("invalid topics: " + strings.Join(errs, "; ")), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			replaced, resp, err := client.Repositories.ReplaceAllTopics(ctx, owner, repo, topics)
+			if err != nil {
+				if result := apiErrorResult("failed to replace repository topics", err, http.StatusNotFound, http.StatusForbidden, http.StatusUnprocessableEntity); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to replace repository topics: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if replaced == nil {
+				replaced = []string{}
+			}
+			r, err := json.Marshal(replaced)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// archivedRepository is a repository that was archived, with the open work it froze.
+type archivedRepository struct {
+	FullName         string `json:"full_name"`
+	Archived         bool   `json:"archived"`
+	OpenIssues       int    `json:"open_issues"`
+	OpenPullRequests int    `json:"open_pull_requests"`
+}
+
+// ArchiveRepository creates a tool to archive a repository, making it read-only.
+func ArchiveRepository(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("archive_repository",
+			mcp.WithDescription(t("TOOL_ARCHIVE_REPOSITORY_DESCRIPTION", "Archive a repository, making it read-only: its open issues and pull requests can no longer be changed. Returns the number of open issues and pull requests that were frozen")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithBoolean("confirm",
+				mcp.Required(),
+				mcp.Description("Must be true to confirm the repository should be archived"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			confirm, err := OptionalParam[bool](request, "confirm")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if !confirm {
+				return mcp.NewToolResultError("confirm must be set to true to archive a repository"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			// The open issues count of the REST API includes pull requests, so both counts
+			// are taken from GraphQL.
+			var query struct {
+				Repository struct {
+					IsArchived bool `json:"isArchived"`
+					Issues     struct {
+						TotalCount int `json:"totalCount"`
+					} `json:"issues"`
+					PullRequests struct {
+						TotalCount int `json:"totalCount"`
+					} `json:"pullRequests"`
+				} `json:"repository"`
+			}
+			err = executeGraphQL(ctx, client, openWorkQuery, map[string]interface{}{
+				"owner": owner,
+				"repo":  repo,
+			}, &query)
+			if err != nil {
+				if result := graphQLErrorResult("failed to get repository", err); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to get repository: %w", err)
+			}
+			if query.Repository.IsArchived {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to archive repository: %s/%s is already archived", owner, repo)), nil
+			}
+
+			archived, resp, err := client.Repositories.Edit(ctx, owner, repo, &github.Repository{
+				Archived: github.Ptr(true),
+			})
+			if err != nil {
+				if result := apiErrorResult("failed to archive repository", err, http.StatusNotFound, http.StatusForbidden, http.StatusUnprocessableEntity); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to archive repository: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			r, err := json.Marshal(archivedRepository{
+				FullName:         archived.GetFullName(),
+				Archived:         archived.GetArchived(),
+				OpenIssues:       query.Repository.Issues.TotalCount,
+				OpenPullRequests: query.Repository.PullRequests.TotalCount,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+const openWorkQuery = `query OpenWork($owner: String!, $repo: String!) {
+  repository(owner: $owner, name: $repo) {
+    isArchived
+    issues(states: OPEN) { totalCount }
+    pullRequests(states: OPEN) { totalCount }
+  }
+}`
+
+// UnarchiveRepository creates a tool to unarchive a repository, making it writable again.
+func UnarchiveRepository(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("unarchive_repository",
+			mcp.WithDescription(t("TOOL_UNARCHIVE_REPOSITORY_DESCRIPTION", "Unarchive a repository, making it writable again. Some repositories can only be unarchived from the settings page on GitHub")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			unarchived, resp, err := client.Repositories.Edit(ctx, owner, repo, &github.Repository{
+				Archived: github.Ptr(false),
+			})
+			if err != nil {
+				// GitHub refuses to unarchive some repositories through the API, depending on
+				// their plan, with a message that tells the user what to do instead.
+				if result := apiErrorResult("failed to unarchive repository", err, http.StatusNotFound, http.StatusForbidden, http.StatusUnprocessableEntity); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to unarchive repository: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			r, err := json.Marshal(map[string]interface{}{
+				"full_name": unarchived.GetFullName(),
+				"archived":  unarchived.GetArchived(),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// transferredRepository is a repository transfer to another account. Transfers to users stay
+// pending until the new owner accepts them.
+type transferredRepository struct {
+	From   string `json:"from"`
+	To     string `json:"to"`
+	Status string `json:"status"`
+	Note   string `json:"note,omitempty"`
+}
+
+// TransferRepository creates a tool to transfer a repository to another user or organization.
+func TransferRepository(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("transfer_repository",
+			mcp.WithDescription(t("TOOL_TRANSFER_REPOSITORY_DESCRIPTION", "Transfer a repository to another user or organization. Transfers to organizations complete right away, transfers to users stay pending until the user accepts them")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("new_owner",
+				mcp.Required(),
+				mcp.Description("Username or organization to transfer the repository to"),
+			),
+			mcp.WithString("new_name",
+				mcp.Description("New name of the repository, defaults to its current name"),
+			),
+			mcp.WithArray("team_ids",
+				mcp.Description("IDs of the teams of the new organization to give access to the repository"),
+				mcp.Items(
+					map[string]interface{}{
+						"type": "number",
+					},
+				),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			newOwner, err := requiredParam[string](request, "new_owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if strings.EqualFold(newOwner, owner) {
+				return mcp.NewToolResultError(fmt.Sprintf("new_owner must be different from owner, %s/%s already belongs to %s", owner, repo, owner)), nil
+			}
+			newName, err := OptionalParam[string](request, "new_name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			teamIDs, err := OptionalIntArrayParam(request, "team_ids")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			// GitHub answers 202 for both kinds of transfers, so whether the transfer is
+			// pending is told by the kind of account of the new owner.
+			target, resp, err := client.Users.Get(ctx, newOwner)
+			if err != nil {
+				if result := apiErrorResult("failed to get new owner", err, http.StatusNotFound); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to get new owner: %w", err)
+			}
+			_ = resp.Body.Close()
+			toOrganization := target.GetType() == "Organization"
+			if len(teamIDs) > 0 && !toOrganization {
+				return mcp.NewToolResultError(fmt.Sprintf("team_ids can only be given when transferring to an organization, %s is a user", newOwner)), nil
+			}
+
+			transfer := github.TransferRequest{NewOwner: newOwner}
+			if newName != "" {
+				transfer.NewName = github.Ptr(newName)
+			}
+			for _, id := range teamIDs {
+				transfer.TeamID = append(transfer.TeamID, int64(id))
+			}
+			_, resp, err = client.Repositories.Transfer(ctx, owner, repo, transfer)
+			if err != nil && (resp == nil || resp.StatusCode != http.StatusAccepted || !isAcceptedError(err)) {
+				if result := apiErrorResult("failed to transfer repository", err, http.StatusNotFound, http.StatusForbidden, http.StatusUnprocessableEntity); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to transfer repository: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if newName == "" {
+				newName = repo
+			}
+			result := transferredRepository{
+				From:   owner + "/" + repo,
+				To:     newOwner + "/" + newName,
+				Status: "completed",
+			}
+			if !toOrganization {
+				result.Status = "pending"
+				result.Note = fmt.Sprintf("%s has to accept the transfer, until then the repository stays at %s", newOwner, result.From)
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// dispatchPayloadLimit is the maximum serialized size of the client payload of a
+// repository_dispatch event.
+const dispatchPayloadLimit = 64 * 1024
+
+// RepositoryDispatch creates a tool to trigger a repository_dispatch event, starting the
+// workflows and webhooks listening for it.
+func RepositoryDispatch(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_repository_dispatch_event",
+			mcp.WithDescription(t("TOOL_CREATE_REPOSITORY_DISPATCH_EVENT_DESCRIPTION", "Trigger a repository_dispatch event to start the GitHub Actions workflows and webhooks listening for it")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("event_type",
+				mcp.Required(),
+				mcp.Description("Type of the event, matched against the types a workflow listens for"),
+			),
+			mcp.WithObject("client_payload",
+				mcp.Description(fmt.Sprintf("JSON object passed to the workflows as github.event.client_payload, at most %d bytes when serialized", dispatchPayloadLimit)),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			eventType, err := requiredParam[string](request, "event_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			clientPayload, ok, err := OptionalParamOK[map[string]any](request, "client_payload")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			dispatch := github.DispatchRequestOptions{EventType: eventType}
+			if ok {
+				payload, err := json.Marshal(clientPayload)
+				if err != nil {
+					return nil, fmt.Errorf("failed to marshal client payload: %w", err)
+				}
+				if len(payload) > dispatchPayloadLimit {
+					return mcp.NewToolResultError(fmt.Sprintf("client_payload is %d bytes when serialized, at most %d bytes are allowed", len(payload), dispatchPayloadLimit)), nil
+				}
+				dispatch.ClientPayload = (*json.RawMessage)(&payload)
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			_, resp, err := client.Repositories.Dispatch(ctx, owner, repo, dispatch)
+			if err != nil {
+				if result := apiErrorResult("failed to create repository dispatch event", err, http.StatusNotFound, http.StatusForbidden, http.StatusUnprocessableEntity); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to create repository dispatch event: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			r, err := json.Marshal(map[string]interface{}{
+				"dispatched": true,
+				"event_type": eventType,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// languageShare is the size of the code of a repository in a language.
+type languageShare struct {
+	Language   string  `json:"language"`
+	Bytes      int     `json:"bytes"`
+	Percentage float64 `json:"percentage"`
+}
+
+// repositoryLanguages is the breakdown of the code of a repository by language, largest first.
+type repositoryLanguages struct {
+	TotalBytes int             `json:"total_bytes"`
+	Languages  []languageShare `json:"languages"`
+}
+
+// newRepositoryLanguages sorts languages by size, and computes the share of each rounded to one
+// decimal.
+func newRepositoryLanguages(languages map[string]int) repositoryLanguages {
+	result := repositoryLanguages{Languages: make([]languageShare, 0, len(languages))}
+	for _, bytes := range languages {
+		result.TotalBytes += bytes
+	}
+	for language, bytes := range languages {
+		share := languageShare{Language: language, Bytes: bytes}
+		if result.TotalBytes > 0 {
+			share.Percentage = math.Round(float64(bytes)*1000/float64(result.TotalBytes)) / 10
+		}
+		result.Languages = append(result.Languages, share)
+	}
+	sort.Slice(result.Languages, func(i, j int) bool {
+		a, b := result.Languages[i], result.Languages[j]
+		if a.Bytes != b.Bytes {
+			return a.Bytes > b.Bytes
+		}
+		return a.Language < b.Language
+	})
+	return result
+}
+
+// GetRepositoryLanguages creates a tool to get the languages of a repository.
+func GetRepositoryLanguages(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_repository_languages",
+			mcp.WithDescription(t("TOOL_GET_REPOSITORY_LANGUAGES_DESCRIPTION", "Get the languages of a GitHub repository, with the bytes of code and percentage of each, largest first")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			languages, resp, err := client.Repositories.ListLanguages(ctx, owner, repo)
+			if err != nil {
+				if result := apiErrorResult("failed to get repository languages", err, http.StatusNotFound); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to get repository languages: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			r, err := json.Marshal(newRepositoryLanguages(languages))
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// committedFile is the outcome of a file creation or update.
+type committedFile struct {
+	Path       string `json:"path"`
+	ContentSHA string `json:"content_sha"`
+	CommitSHA  string `json:"commit_sha"`
+	HTMLURL    string `json:"html_url,omitempty"`
+	CommitURL  string `json:"commit_url,omitempty"`
+}
+
+// fileSHAMissing reports whether err is GitHub refusing to overwrite an existing file because
+// the sha of the blob being replaced was not supplied.
+func fileSHAMissing(err error) bool {
+	var errorResponse *github.ErrorResponse
+	if !errors.As(err, &errorResponse) || errorResponse.Response == nil {
+		return false
+	}
+	return errorResponse.Response.StatusCode == http.StatusUnprocessableEntity &&
+		strings.Contains(errorResponse.Message, `"sha" wasn't supplied`)
+}
+
+// fileConflictResult converts a 409 Conflict, returned when the file was modified since the
+// sha being replaced was read, into a tool result error. It returns nil for any other error.
+func fileConflictResult(path, branch string, err error) *mcp.CallToolResult {
+	var errorResponse *github.ErrorResponse
+	if !errors.As(err, &errorResponse) || errorResponse.Response == nil ||
+		errorResponse.Response.StatusCode != http.StatusConflict {
+		return nil
+	}
+	return mcp.NewToolResultError(fmt.Sprintf("failed to create/update file: %s was modified concurrently on branch %s (%s), get its latest sha and retry",
+		path, branch, apiErrorDetails(errorResponse)))
+}
+
+// CreateOrUpdateFile creates a tool to create or update a file in a GitHub repository.
+func CreateOrUpdateFile(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_or_update_file",
+			mcp.WithDescription(t("TOOL_CREATE_OR_UPDATE_FILE_DESCRIPTION", "Create or update a single file in a GitHub repository")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner (username or organization)"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("path",
+				mcp.Required(),
+				mcp.Description("Path where to create/update the file"),
+			),
+			mcp.WithString("content",
+				mcp.Required(),
+				mcp.Description("Content of the file as plain text, it is base64 encoded before being sent"),
+			),
+			mcp.WithString("message",
+				mcp.Required(),
+				mcp.Description("Commit message"),
+			),
+			mcp.WithString("branch",
+				mcp.Required(),
+				mcp.Description("Branch to create/update the file in"),
+			),
+			mcp.WithString("sha",
+				mcp.Description("SHA of file being replaced (for updates). When omitted and the file exists, its current SHA is looked up"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			path, err := requiredParam[string](request, "path")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			content, err := requiredParam[string](request, "content")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			message, err := requiredParam[string](request, "message")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			branch, err := requiredParam[string](request, "branch")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			// The client base64 encodes the content bytes
+			contentBytes := []byte(content)
+
+			// Create the file options
+			opts := &github.RepositoryContentFileOptions{
+				Message: github.Ptr(message),
+				Content: contentBytes,
 				Branch:  github.Ptr(branch),
 			}
-
-			// If SHA is provided, set it (for updates)
-			sha, err := OptionalParam[string](request, "sha")
+
+			// If SHA is provided, set it (for updates)
+			sha, err := OptionalParam[string](request, "sha")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if sha != "" {
+				opts.SHA = github.Ptr(sha)
+			}
+
+			// Create or update the file
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			fileContent, resp, err := client.Repositories.CreateFile(ctx, owner, repo, path, opts)
+			if err != nil && sha == "" && fileSHAMissing(err) {
+				// The file already exists, look up the sha of its current blob and retry once
+				current, _, getResp, getErr := client.Repositories.GetContents(ctx, owner, repo, path,
+					&github.RepositoryContentGetOptions{Ref: branch})
+				if getErr != nil {
+					return nil, fmt.Errorf("failed to get current sha of file: %w", getErr)
+				}
+				_ = getResp.Body.Close()
+				if current == nil {
+					return mcp.NewToolResultError(fmt.Sprintf("failed to create/update file: %s is a directory", path)), nil
+				}
+				opts.SHA = current.SHA
+				fileContent, resp, err = client.Repositories.CreateFile(ctx, owner, repo, path, opts)
+			}
+			if err != nil {
+				if result := fileConflictResult(path, branch, err); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to create/update file: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != 200 && resp.StatusCode != 201 {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to create/update file: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(committedFile{
+				Path:       fileContent.GetContent().GetPath(),
+				ContentSHA: fileContent.GetContent().GetSHA(),
+				CommitSHA:  fileContent.Commit.GetSHA(),
+				HTMLURL:    fileContent.GetContent().GetHTMLURL(),
+				CommitURL:  fileContent.Commit.GetHTMLURL(),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// CreateRepository creates a tool to create a new GitHub repository.
+func CreateRepository(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_repository",
+			mcp.WithDescription(t("TOOL_CREATE_REPOSITORY_DESCRIPTION", "Create a new GitHub repository in your account or in an organization")),
+			mcp.WithString("name",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("description",
+				mcp.Description("Repository description"),
+			),
+			mcp.WithBoolean("private",
+				mcp.Description("Whether repo should be private"),
+			),
+			mcp.WithString("org",
+				mcp.Description("Organization to create the repository in, instead of your account"),
+			),
+			mcp.WithBoolean("autoInit",
+				mcp.Description("Initialize with README"),
+			),
+			mcp.WithString("gitignore_template",
+				mcp.Description("Name of the .gitignore template to initialize the repository with, e.g. 'Go'"),
+			),
+			mcp.WithString("license_template",
+				mcp.Description("Keyword of the license to initialize the repository with, e.g. 'mit' or 'apache-2.0'"),
+			),
+			mcp.WithBoolean("has_issues",
+				mcp.Description("Whether issues are enabled (default true)"),
+			),
+			mcp.WithBoolean("has_projects",
+				mcp.Description("Whether projects are enabled (default true)"),
+			),
+			mcp.WithBoolean("has_wiki",
+				mcp.Description("Whether the wiki is enabled (default true)"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			name, err := requiredParam[string](request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			description, err := OptionalParam[string](request, "description")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			private, err := OptionalParam[bool](request, "private")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			org, err := OptionalParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			autoInit, err := OptionalParam[bool](request, "autoInit")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			repo := &github.Repository{
+				Name:        github.Ptr(name),
+				Description: github.Ptr(description),
+				Private:     github.Ptr(private),
+				AutoInit:    github.Ptr(autoInit),
+			}
+
+			// Templates and settings are only sent when provided, so the defaults of GitHub apply.
+			if gitignoreTemplate, err := OptionalParam[string](request, "gitignore_template"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if gitignoreTemplate != "" {
+				repo.GitignoreTemplate = github.Ptr(gitignoreTemplate)
+			}
+			if licenseTemplate, err := OptionalParam[string](request, "license_template"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if licenseTemplate != "" {
+				repo.LicenseTemplate = github.Ptr(licenseTemplate)
+			}
+			if hasIssues, ok, err := OptionalParamOK[bool](request, "has_issues"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				repo.HasIssues = github.Ptr(hasIssues)
+			}
+			if hasProjects, ok, err := OptionalParamOK[bool](request, "has_projects"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				repo.HasProjects = github.Ptr(hasProjects)
+			}
+			if hasWiki, ok, err := OptionalParamOK[bool](request, "has_wiki"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			} else if ok {
+				repo.HasWiki = github.Ptr(hasWiki)
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			createdRepo, resp, err := client.Repositories.Create(ctx, org, repo)
+			if err != nil {
+				if repositoryNameTaken(err) {
+					return existingRepositoryResult(ctx, client, org, name, err)
+				}
+				return nil, fmt.Errorf("failed to create repository: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusCreated {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to create repository: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(createdRepo)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// repositoryNameTaken reports whether err is the validation error returned when a repository
+// with the same name already exists for the owner.
+func repositoryNameTaken(err error) bool {
+	var errorResponse *github.ErrorResponse
+	if !errors.As(err, &errorResponse) || errorResponse.Response == nil || errorResponse.Response.StatusCode != http.StatusUnprocessableEntity {
+		return false
+	}
+	for _, e := range errorResponse.Errors {
+		if e.Field == "name" && strings.Contains(e.Message, "already exists") {
+			return true
+		}
+	}
+	return false
+}
+
+// existingRepositoryResult returns a tool result error pointing to the repository that keeps a
+// new repository from being created in org, or in the account of the user when org is empty.
+// When the repository cannot be looked up, the API error is returned as is.
+func existingRepositoryResult(ctx context.Context, client *github.Client, org, name string, createErr error) (*mcp.CallToolResult, error) {
+	owner := org
+	if owner == "" {
+		user, resp, err := client.Users.Get(ctx, "")
+		if err != nil {
+			return apiErrorResult("failed to create repository", createErr), nil
+		}
+		_ = resp.Body.Close()
+		owner = user.GetLogin()
+	}
+
+	existing, resp, err := client.Repositories.Get(ctx, owner, name)
+	if err != nil {
+		return apiErrorResult("failed to create repository", createErr), nil
+	}
+	_ = resp.Body.Close()
+
+	return mcp.NewToolResultError(fmt.Sprintf("failed to create repository: %s already exists at %s",
+		existing.GetFullName(), existing.GetHTMLURL())), nil
+}
+
+// fileContents is a file of a repository, with its content decoded. The content of binary
+// files is left out.
+type fileContents struct {
+	Type        string `json:"type"`
+	Name        string `json:"name"`
+	Path        string `json:"path"`
+	SHA         string `json:"sha"`
+	Size        int    `json:"size"`
+	Encoding    string `json:"encoding"`
+	Content     string `json:"content,omitempty"`
+	HTMLURL     string `json:"html_url,omitempty"`
+	DownloadURL string `json:"download_url,omitempty"`
+	Note        string `json:"note,omitempty"`
+}
+
+// directoryEntry is a file or directory in a directory of a repository.
+type directoryEntry struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+	Size int    `json:"size"`
+	Path string `json:"path"`
+}
+
+// GetFileContents creates a tool to get the contents of a file or directory from a GitHub repository.
+func GetFileContents(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_file_contents",
+			mcp.WithDescription(t("TOOL_GET_FILE_CONTENTS_DESCRIPTION", "Get the contents of a file, or the entries of a directory, from a GitHub repository. Binary files are described but their content is not returned")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner (username or organization)"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("path",
+				mcp.Required(),
+				mcp.Description("Path to file/directory"),
+			),
+			mcp.WithString("ref",
+				mcp.Description("Branch, tag, or commit SHA to get contents from, defaults to the default branch"),
+			),
+			mcp.WithString("branch",
+				mcp.Description("Deprecated alias of ref"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			path, err := requiredParam[string](request, "path")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ref, err := OptionalParam[string](request, "ref")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			// branch is what ref was called before it took tags and commits too.
+			branch, err := OptionalParam[string](request, "branch")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			switch {
+			case ref == "":
+				ref = branch
+			case branch != "" && branch != ref:
+				return mcp.NewToolResultError("branch is a deprecated alias of ref, and cannot be given with a different ref"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			opts := &github.RepositoryContentGetOptions{Ref: ref}
+			fileContent, dirContent, resp, err := client.Repositories.GetContents(ctx, owner, repo, path, opts)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get file contents: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != 200 {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get file contents: %s", string(body))), nil
+			}
+
+			var result interface{}
+			if fileContent != nil {
+				contents, err := decodeFileContents(ctx, client, owner, repo, fileContent)
+				if err != nil {
+					return nil, err
+				}
+				result = contents
+			} else {
+				entries := make([]directoryEntry, 0, len(dirContent))
+				for _, entry := range dirContent {
+					entries = append(entries, directoryEntry{
+						Name: entry.GetName(),
+						Type: entry.GetType(),
+						Size: entry.GetSize(),
+						Path: entry.GetPath(),
+					})
+				}
+				result = entries
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// decodeFileContents decodes the content of a file. The contents API leaves out the content of
+// files over 1MB, which is then fetched from the blobs API.
+func decodeFileContents(ctx context.Context, client *github.Client, owner, repo string, file *github.RepositoryContent) (fileContents, error) {
+	contents := fileContents{
+		Type:        file.GetType(),
+		Name:        file.GetName(),
+		Path:        file.GetPath(),
+		SHA:         file.GetSHA(),
+		Size:        file.GetSize(),
+		HTMLURL:     file.GetHTMLURL(),
+		DownloadURL: file.GetDownloadURL(),
+	}
+
+	var data []byte
+	switch {
+	case file.GetType() == "file" && (file.GetEncoding() == "none" || (file.Content == nil && file.GetSize() > 0)):
+		blob, resp, err := client.Git.GetBlob(ctx, owner, repo, file.GetSHA())
+		if err != nil {
+			return contents, fmt.Errorf("failed to get blob of %s: %w", file.GetPath(), err)
+		}
+		_ = resp.Body.Close()
+		if blob.GetEncoding() == "base64" {
+			data, err = base64.StdEncoding.DecodeString(blob.GetContent())
+			if err != nil {
+				return contents, fmt.Errorf("failed to decode blob of %s: %w", file.GetPath(), err)
+			}
+		} else {
+			data = []byte(blob.GetContent())
+		}
+	default:
+		content, err := file.GetContent()
+		if err != nil {
+			return contents, fmt.Errorf("failed to decode %s: %w", file.GetPath(), err)
+		}
+		data = []byte(content)
+	}
+
+	if bytes.IndexByte(data, 0) >= 0 || !utf8.Valid(data) {
+		contents.Encoding = "binary"
+		contents.Note = "the file is binary, download it from download_url to get its content"
+		return contents, nil
+	}
+	contents.Encoding = "utf-8"
+	contents.Content = string(data)
+	return contents, nil
+}
+
+// readmeLimit is the maximum size in bytes of a returned README.
+const readmeLimit = 64 * 1024
+
+// readme is the markdown or rendered HTML of the README of a repository or directory.
+type readme struct {
+	Name      string `json:"name,omitempty"`
+	Path      string `json:"path,omitempty"`
+	Format    string `json:"format"`
+	Content   string `json:"content"`
+	Truncated bool   `json:"truncated,omitempty"`
+	Note      string `json:"note,omitempty"`
+	HTMLURL   string `json:"html_url,omitempty"`
+}
+
+// GetReadme creates a tool to get the README of a repository, or of a directory of it.
+func GetReadme(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_readme",
+			mcp.WithDescription(t("TOOL_GET_README_DESCRIPTION", "Get the README of a GitHub repository, or of one of its directories, as markdown or rendered HTML")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("ref",
+				mcp.Description("Branch, tag, or commit SHA to get the README from, defaults to the default branch"),
+			),
+			mcp.WithString("dir",
+				mcp.Description("Directory to get the README of, defaults to the root of the repository"),
+			),
+			mcp.WithString("format",
+				mcp.Description("Return the README as 'markdown' (default), or as 'html' rendered by GitHub"),
+				mcp.Enum("markdown", "html"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ref, err := OptionalParam[string](request, "ref")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			dir, err := OptionalParam[string](request, "dir")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			format, err := OptionalParam[string](request, "format")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if format == "" {
+				format = "markdown"
+			}
+			if format != "markdown" && format != "html" {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid format %q: must be one of markdown, html", format)), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			u := fmt.Sprintf("repos/%v/%v/readme", owner, repo)
+			if dir = strings.Trim(dir, "/"); dir != "" {
+				u += "/" + dir
+			}
+			if ref != "" {
+				u += "?ref=" + url.QueryEscape(ref)
+			}
+			req, err := client.NewRequest(http.MethodGet, u, nil)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create request: %w", err)
+			}
+
+			result := readme{Format: format}
+			var resp *github.Response
+			if format == "html" {
+				var rendered bytes.Buffer
+				req.Header.Set("Accept", "application/vnd.github.html")
+				resp, err = client.Do(ctx, req, &rendered)
+				result.Content = rendered.String()
+			} else {
+				var file github.RepositoryContent
+				resp, err = client.Do(ctx, req, &file)
+				if err == nil && file.GetEncoding() == "none" {
+					// The contents API leaves out the content of files over 1 MB.
+					_ = resp.Body.Close()
+					return mcp.NewToolResultError(fmt.Sprintf("%s is too large to be returned, download it from %s instead", file.GetPath(), file.GetDownloadURL())), nil
+				}
+				if err == nil {
+					result.Name = file.GetName()
+					result.Path = file.GetPath()
+					result.HTMLURL = file.GetHTMLURL()
+					result.Content, err = file.GetContent()
+					if err != nil {
+						return nil, fmt.Errorf("failed to decode README: %w", err)
+					}
+				}
+			}
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					location := fmt.Sprintf("%s/%s", owner, repo)
+					if dir != "" {
+						location += "/" + dir
+					}
+					at := "the default branch"
+					if ref != "" {
+						at = ref
+					}
+					return mcp.NewToolResultError(fmt.Sprintf("no README exists in %s at %s", location, at)), nil
+				}
+				return nil, fmt.Errorf("failed to get README: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			size := len(result.Content)
+			if result.Content, result.Truncated = truncatePatch(result.Content, readmeLimit); result.Truncated {
+				result.Note = fmt.Sprintf("the README is %d bytes long, only the first %d are returned", size, readmeLimit)
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// blameRange is a range of lines of a file last changed by the same commit.
+type blameRange struct {
+	StartingLine  int              `json:"starting_line"`
+	EndingLine    int              `json:"ending_line"`
+	CommitSHA     string           `json:"commit_sha"`
+	Author        string           `json:"author,omitempty"`
+	CommittedDate github.Timestamp `json:"committed_date"`
+	Message       string           `json:"message"`
+}
+
+// blameRangeNode is a blame range as returned by the GraphQL API.
+type blameRangeNode struct {
+	StartingLine int `json:"startingLine"`
+	EndingLine   int `json:"endingLine"`
+	Commit       struct {
+		OID             string           `json:"oid"`
+		CommittedDate   github.Timestamp `json:"committedDate"`
+		MessageHeadline string           `json:"messageHeadline"`
+		Author          struct {
+			User *struct {
+				Login string `json:"login"`
+			} `json:"user"`
+		} `json:"author"`
+	} `json:"commit"`
+}
+
+// fileBlame is the blame of a file, restricted to the requested lines.
+type fileBlame struct {
+	Path   string       `json:"path"`
+	Ref    string       `json:"ref"`
+	Ranges []blameRange `json:"ranges"`
+}
+
+// GetBlame creates a tool to get the blame of a file, telling which commit last changed each line.
+func GetBlame(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_file_blame",
+			mcp.WithDescription(t("TOOL_GET_FILE_BLAME_DESCRIPTION", "Get the blame of a file in a GitHub repository: the commit that last changed each range of lines")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner (username or organization)"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("path",
+				mcp.Required(),
+				mcp.Description("Path to the file"),
+			),
+			mcp.WithString("ref",
+				mcp.Description("Branch, tag, or commit SHA to blame at, defaults to the default branch"),
+			),
+			mcp.WithNumber("start_line",
+				mcp.Description("First line of interest, only ranges overlapping the lines are returned"),
+				mcp.Min(1),
+			),
+			mcp.WithNumber("end_line",
+				mcp.Description("Last line of interest, only ranges overlapping the lines are returned"),
+				mcp.Min(1),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			path, err := requiredParam[string](request, "path")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ref, err := OptionalParam[string](request, "ref")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			startLine, err := OptionalIntParamWithDefault(request, "start_line", 1)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			endLine, err := OptionalIntParam(request, "end_line")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if startLine < 1 || endLine < 0 {
+				return mcp.NewToolResultError("start_line and end_line must be positive"), nil
+			}
+			if endLine != 0 && endLine < startLine {
+				return mcp.NewToolResultError("end_line must not be before start_line"), nil
+			}
+			path = strings.TrimPrefix(path, "/")
+			expression := ref
+			if expression == "" {
+				expression = "HEAD"
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			type blameObject struct {
+				Typename string `json:"__typename"`
+				Blame    struct {
+					Ranges []blameRangeNode `json:"ranges"`
+				} `json:"blame"`
+			}
+			var query struct {
+				Repository struct {
+					Object *struct {
+						blameObject
+						// Target is the object an annotated tag points to.
+						Target *blameObject `json:"target"`
+					} `json:"object"`
+				} `json:"repository"`
+			}
+			err = executeGraphQL(ctx, client, fileBlameQuery, map[string]interface{}{
+				"owner":      owner,
+				"repo":       repo,
+				"expression": expression,
+				"path":       path,
+			}, &query)
+			if err != nil {
+				if result := graphQLErrorResult("failed to get blame", err); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to get blame: %w", err)
+			}
+
+			if query.Repository.Object == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get blame: ref %q not found in %s/%s", ref, owner, repo)), nil
+			}
+			object := &query.Repository.Object.blameObject
+			if object.Typename == "Tag" && query.Repository.Object.Target != nil {
+				object = query.Repository.Object.Target
+			}
+			switch {
+			case object.Typename != "Commit":
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get blame: ref %q does not point to a commit", ref)), nil
+			case len(object.Blame.Ranges) == 0:
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get blame: %s does not exist at %s or is a binary file", path, expression)), nil
+			}
+
+			nodes := object.Blame.Ranges
+			if last := nodes[len(nodes)-1].EndingLine; startLine > last {
+				return mcp.NewToolResultError(fmt.Sprintf("start_line %d is past the end of %s, which has %d lines", startLine, path, last)), nil
+			}
+
+			result := fileBlame{
+				Path:   path,
+				Ref:    expression,
+				Ranges: []blameRange{},
+			}
+			for _, node := range nodes {
+				if node.EndingLine < startLine || (endLine != 0 && node.StartingLine > endLine) {
+					continue
+				}
+				blame := blameRange{
+					StartingLine:  node.StartingLine,
+					EndingLine:    node.EndingLine,
+					CommitSHA:     node.Commit.OID,
+					CommittedDate: node.Commit.CommittedDate,
+					Message:       node.Commit.MessageHeadline,
+				}
+				if user := node.Commit.Author.User; user != nil {
+					blame.Author = user.Login
+				}
+				result.Ranges = append(result.Ranges, blame)
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+const fileBlameQuery = `query FileBlame($owner: String!, $repo: String!, $expression: String!, $path: String!) {
+  repository(owner: $owner, name: $repo) {
+    object(expression: $expression) {
+      __typename
+      ...Blame
+      ... on Tag {
+        target { __typename ...Blame }
+      }
+    }
+  }
+}
+
+fragment Blame on Commit {
+  blame(path: $path) {
+    ranges {
+      startingLine endingLine
+      commit { oid committedDate messageHeadline author { user { login } } }
+    }
+  }
+}`
+
+var (
+	// forkReadyTimeout is how long fork_repository waits for a new fork to be ready.
+	forkReadyTimeout = 30 * time.Second
+	// forkPollInterval is the time between the first checks of a new fork. It doubles after
+	// every check, up to maxForkPollInterval.
+	forkPollInterval    = time.Second
+	maxForkPollInterval = 8 * time.Second
+)
+
+// forkedRepository is a trimmed down fork, with whether it is ready to be used.
+type forkedRepository struct {
+	FullName      string `json:"full_name"`
+	HTMLURL       string `json:"html_url"`
+	CloneURL      string `json:"clone_url"`
+	SSHURL        string `json:"ssh_url"`
+	DefaultBranch string `json:"default_branch"`
+	Ready         bool   `json:"ready"`
+	Note          string `json:"note,omitempty"`
+}
+
+// ForkRepository creates a tool to fork a repository.
+func ForkRepository(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("fork_repository",
+			mcp.WithDescription(t("TOOL_FORK_REPOSITORY_DESCRIPTION", "Fork a GitHub repository to your account or specified organization")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("organization",
+				mcp.Description("Organization to fork to"),
+			),
+			mcp.WithString("name",
+				mcp.Description("Name of the fork, defaults to the name of the repository"),
+			),
+			mcp.WithBoolean("default_branch_only",
+				mcp.Description("Only fork the default branch (default false)"),
+			),
+			mcp.WithBoolean("wait",
+				mcp.Description("Wait up to 30 seconds for the fork to be ready, as forks are created in the background and cannot be pushed to right away (default false)"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			org, err := OptionalParam[string](request, "organization")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			name, err := OptionalParam[string](request, "name")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			if sha != "" {
-				opts.SHA = github.Ptr(sha)
+			defaultBranchOnly, err := OptionalParam[bool](request, "default_branch_only")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			wait, err := OptionalParam[bool](request, "wait")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts := &github.RepositoryCreateForkOptions{
+				Organization:      org,
+				Name:              name,
+				DefaultBranchOnly: defaultBranchOnly,
 			}
 
-			// Create or update the file
 			client, err := getClient(ctx)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
-			fileContent, resp, err := client.Repositories.CreateFile(ctx, owner, repo, path, opts)
+			forkedRepo, resp, err := client.Repositories.CreateFork(ctx, owner, repo, opts)
 			if err != nil {
-				return nil, fmt.Errorf("failed to create/update file: %w", err)
+				// Check if it's an acceptedError. An acceptedError indicates that the fork is in progress,
+				// and it's not a real error.
+				if resp == nil || resp.StatusCode != http.StatusAccepted || !isAcceptedError(err) {
+					return nil, fmt.Errorf("failed to fork repository: %w", err)
+				}
 			}
 			defer func() { _ = resp.Body.Close() }()
 
-			if resp.StatusCode != 200 && resp.StatusCode != 201 {
-				body, err := io.ReadAll(resp.Body)
-				if err != nil {
-					return nil, fmt.Errorf("failed to read response body: %w", err)
-				}
-				return mcp.NewToolResultError(fmt.Sprintf("failed to create/update file: %s", string(body))), nil
+			result := forkedRepository{
+				FullName:      forkedRepo.GetFullName(),
+				HTMLURL:       forkedRepo.GetHTMLURL(),
+				CloneURL:      forkedRepo.GetCloneURL(),
+				SSHURL:        forkedRepo.GetSSHURL(),
+				DefaultBranch: forkedRepo.GetDefaultBranch(),
+			}
+			switch {
+			case !wait:
+				result.Note = "Fork is in progress, it can take a few moments before it can be used"
+			case waitForFork(ctx, client, forkedRepo):
+				result.Ready = true
+			default:
+				result.Note = fmt.Sprintf("Fork is still in progress after %s", forkReadyTimeout)
 			}
 
-			r, err := json.Marshal(fileContent)
+			r, err := json.Marshal(result)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
@@ -188,68 +2870,133 @@ func CreateOrUpdateFile(getClient GetClientFn, t translations.TranslationHelperF
 		}
 }
 
-// CreateRepository creates a tool to create a new GitHub repository.
-func CreateRepository(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
-	return mcp.NewTool("create_repository",
-			mcp.WithDescription(t("TOOL_CREATE_REPOSITORY_DESCRIPTION", "Create a new GitHub repository in your account")),
-			mcp.WithString("name",
+// forkFilterLimit is the maximum number of forks searched for the ones active since a date.
+const forkFilterLimit = 1000
+
+// forkSummary is a trimmed down fork of a repository.
+type forkSummary struct {
+	FullName        string            `json:"full_name"`
+	Owner           string            `json:"owner"`
+	StargazersCount int               `json:"stargazers_count"`
+	PushedAt        *github.Timestamp `json:"pushed_at,omitempty"`
+	DefaultBranch   string            `json:"default_branch"`
+	HTMLURL         string            `json:"html_url"`
+}
+
+// forkList is a page of forks. Truncated is set when more forks than are searched for the
+// active ones exist.
+type forkList struct {
+	Forks     []forkSummary `json:"forks"`
+	Truncated bool          `json:"truncated,omitempty"`
+}
+
+// ListForks creates a tool to list the forks of a repository.
+func ListForks(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_forks",
+			mcp.WithDescription(t("TOOL_LIST_FORKS_DESCRIPTION", "List the forks of a GitHub repository, optionally only the ones pushed to recently")),
+			mcp.WithString("owner",
 				mcp.Required(),
-				mcp.Description("Repository name"),
+				mcp.Description("Repository owner"),
 			),
-			mcp.WithString("description",
-				mcp.Description("Repository description"),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
 			),
-			mcp.WithBoolean("private",
-				mcp.Description("Whether repo should be private"),
+			mcp.WithString("sort",
+				mcp.Description("Sort order of the forks (default newest)"),
+				mcp.Enum("newest", "oldest", "stargazers", "watchers"),
 			),
-			mcp.WithBoolean("autoInit",
-				mcp.Description("Initialize with README"),
+			mcp.WithString("active_since",
+				mcp.Description("Only list forks pushed to since this date (ISO 8601 timestamp). Only the first 1000 forks are searched, the result is marked truncated when there are more"),
 			),
+			WithPagination(),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-			name, err := requiredParam[string](request, "name")
+			owner, err := requiredParam[string](request, "owner")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			description, err := OptionalParam[string](request, "description")
+			repo, err := requiredParam[string](request, "repo")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			private, err := OptionalParam[bool](request, "private")
+			sortBy, err := OptionalParam[string](request, "sort")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			autoInit, err := OptionalParam[bool](request, "autoInit")
+			activeSince, err := OptionalTimestampParam(request, "active_since")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			repo := &github.Repository{
-				Name:        github.Ptr(name),
-				Description: github.Ptr(description),
-				Private:     github.Ptr(private),
-				AutoInit:    github.Ptr(autoInit),
+			opts := &github.RepositoryListForksOptions{
+				Sort: sortBy,
+				ListOptions: github.ListOptions{
+					Page:    pagination.page,
+					PerPage: pagination.perPage,
+				},
 			}
 
 			client, err := getClient(ctx)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
-			createdRepo, resp, err := client.Repositories.Create(ctx, "", repo)
-			if err != nil {
-				return nil, fmt.Errorf("failed to create repository: %w", err)
-			}
-			defer func() { _ = resp.Body.Close() }()
 
-			if resp.StatusCode != http.StatusCreated {
-				body, err := io.ReadAll(resp.Body)
+			var forks []*github.Repository
+			var truncated bool
+			if activeSince.IsZero() {
+				var resp *github.Response
+				forks, resp, err = client.Repositories.ListForks(ctx, owner, repo, opts)
 				if err != nil {
-					return nil, fmt.Errorf("failed to read response body: %w", err)
+					if result := apiErrorResult("failed to list forks", err, http.StatusNotFound); result != nil {
+						return result, nil
+					}
+					return nil, fmt.Errorf("failed to list forks: %w", err)
+				}
+				_ = resp.Body.Close()
+			} else {
+				// The API cannot filter forks by activity, so the active forks are paginated here.
+				opts.ListOptions = github.ListOptions{PerPage: 100}
+				var all []*github.Repository
+				all, truncated, err = fetchAllPages(ctx, &opts.ListOptions, forkFilterLimit, func() ([]*github.Repository, *github.Response, error) {
+					return client.Repositories.ListForks(ctx, owner, repo, opts)
+				})
+				if err != nil {
+					if result := apiErrorResult("failed to list forks", err, http.StatusNotFound); result != nil {
+						return result, nil
+					}
+					return nil, fmt.Errorf("failed to list forks: %w", err)
+				}
+				for _, fork := range all {
+					if !fork.GetPushedAt().Before(activeSince) {
+						forks = append(forks, fork)
+					}
+				}
+				if forks, err = paginateSlice(forks, pagination); err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
 				}
-				return mcp.NewToolResultError(fmt.Sprintf("failed to create repository: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(createdRepo)
+			result := forkList{
+				Forks:     make([]forkSummary, 0, len(forks)),
+				Truncated: truncated,
+			}
+			for _, fork := range forks {
+				result.Forks = append(result.Forks, forkSummary{
+					FullName:        fork.GetFullName(),
+					Owner:           fork.GetOwner().GetLogin(),
+					StargazersCount: fork.GetStargazersCount(),
+					PushedAt:        fork.PushedAt,
+					DefaultBranch:   fork.GetDefaultBranch(),
+					HTMLURL:         fork.GetHTMLURL(),
+				})
+			}
+
+			r, err := json.Marshal(result)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
@@ -258,24 +3005,52 @@ func CreateRepository(getClient GetClientFn, t translations.TranslationHelperFun
 		}
 }
 
-// GetFileContents creates a tool to get the contents of a file or directory from a GitHub repository.
-func GetFileContents(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
-	return mcp.NewTool("get_file_contents",
-			mcp.WithDescription(t("TOOL_GET_FILE_CONTENTS_DESCRIPTION", "Get the contents of a file or directory from a GitHub repository")),
+// waitForFork polls a new fork until its default branch exists, which is once its Git data has
+// been copied, and reports whether it did within forkReadyTimeout.
+func waitForFork(ctx context.Context, client *github.Client, fork *github.Repository) bool {
+	ctx, cancel := context.WithTimeout(ctx, forkReadyTimeout)
+	defer cancel()
+
+	interval := forkPollInterval
+	for {
+		_, resp, err := client.Repositories.GetBranch(ctx, fork.GetOwner().GetLogin(), fork.GetName(), fork.GetDefaultBranch(), 1)
+		if err == nil {
+			_ = resp.Body.Close()
+			return true
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return false
+		case <-timer.C:
+		}
+		interval = min(interval*2, maxForkPollInterval)
+	}
+}
+
+// CreateBranch creates a tool to create a new branch.
+func CreateBranch(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_branch",
+			mcp.WithDescription(t("TOOL_CREATE_BRANCH_DESCRIPTION", "Create a new branch in a GitHub repository")),
 			mcp.WithString("owner",
 				mcp.Required(),
-				mcp.Description("Repository owner (username or organization)"),
+				mcp.Description("Repository owner"),
 			),
 			mcp.WithString("repo",
 				mcp.Required(),
 				mcp.Description("Repository name"),
 			),
-			mcp.WithString("path",
+			mcp.WithString("branch",
 				mcp.Required(),
-				mcp.Description("Path to file/directory"),
+				mcp.Description("Name for new branch, without 'refs/heads/'"),
 			),
-			mcp.WithString("branch",
-				mcp.Description("Branch to get contents from"),
+			mcp.WithString("from_branch",
+				mcp.Description("Source branch (defaults to repo default)"),
+			),
+			mcp.WithString("from_sha",
+				mcp.Description("Commit SHA to create the branch at, as an alternative to from_branch"),
 			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -287,42 +3062,77 @@ func GetFileContents(getClient GetClientFn, t translations.TranslationHelperFunc
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			path, err := requiredParam[string](request, "path")
+			branch, err := requiredParam[string](request, "branch")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			branch, err := OptionalParam[string](request, "branch")
+			if err := validateBranchName(branch); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			fromBranch, err := OptionalParam[string](request, "from_branch")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			fromSHA, err := OptionalParam[string](request, "from_sha")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			if fromBranch != "" && fromSHA != "" {
+				return mcp.NewToolResultError("only one of from_branch and from_sha can be provided"), nil
+			}
 
 			client, err := getClient(ctx)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
-			opts := &github.RepositoryContentGetOptions{Ref: branch}
-			fileContent, dirContent, resp, err := client.Repositories.GetContents(ctx, owner, repo, path, opts)
-			if err != nil {
-				return nil, fmt.Errorf("failed to get file contents: %w", err)
-			}
-			defer func() { _ = resp.Body.Close() }()
 
-			if resp.StatusCode != 200 {
-				body, err := io.ReadAll(resp.Body)
+			// Get the source branch SHA
+			if fromSHA == "" {
+				if fromBranch == "" {
+					// Get default branch if from_branch not specified
+					repository, resp, err := client.Repositories.Get(ctx, owner, repo)
+					if err != nil {
+						return nil, fmt.Errorf("failed to get repository: %w", err)
+					}
+					defer func() { _ = resp.Body.Close() }()
+
+					fromBranch = *repository.DefaultBranch
+				}
+
+				ref, resp, err := client.Git.GetRef(ctx, owner, repo, "refs/heads/"+fromBranch)
 				if err != nil {
-					return nil, fmt.Errorf("failed to read response body: %w", err)
+					return nil, fmt.Errorf("failed to get reference: %w", err)
 				}
-				return mcp.NewToolResultError(fmt.Sprintf("failed to get file contents: %s", string(body))), nil
+				defer func() { _ = resp.Body.Close() }()
+
+				fromSHA = ref.GetObject().GetSHA()
 			}
 
-			var result interface{}
-			if fileContent != nil {
-				result = fileContent
-			} else {
-				result = dirContent
+			// Create new branch
+			newRef := &github.Reference{
+				Ref:    github.Ptr("refs/heads/" + branch),
+				Object: &github.GitObject{SHA: github.Ptr(fromSHA)},
 			}
 
-			r, err := json.Marshal(result)
+			createdRef, resp, err := client.Git.CreateRef(ctx, owner, repo, newRef)
+			if err != nil {
+				var errorResponse *github.ErrorResponse
+				if errors.As(err, &errorResponse) && errorResponse.Message == "Reference already exists" {
+					if existing, resp, err := client.Git.GetRef(ctx, owner, repo, "refs/heads/"+branch); err == nil {
+						_ = resp.Body.Close()
+						return mcp.NewToolResultError(fmt.Sprintf("failed to create branch: branch %q already exists at %s",
+							branch, existing.GetObject().GetSHA())), nil
+					}
+				}
+				// An unknown from_sha is a validation error.
+				if result := apiErrorResult("failed to create branch", err, http.StatusUnprocessableEntity); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to create branch: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			r, err := json.Marshal(createdRef)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
@@ -331,10 +3141,67 @@ func GetFileContents(getClient GetClientFn, t translations.TranslationHelperFunc
 		}
 }
 
-// ForkRepository creates a tool to fork a repository.
-func ForkRepository(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
-	return mcp.NewTool("fork_repository",
-			mcp.WithDescription(t("TOOL_FORK_REPOSITORY_DESCRIPTION", "Fork a GitHub repository to your account or specified organization")),
+// validateBranchName checks that a branch name is a valid Git ref name, following the rules of
+// git check-ref-format, so that invalid names are rejected with a clear reason.
+func validateBranchName(branch string) error {
+	return validateRefName("branch", "refs/heads/", branch)
+}
+
+// validateTagName checks that a tag name is a valid Git ref name, like validateBranchName.
+func validateTagName(tag string) error {
+	return validateRefName("tag", "refs/tags/", tag)
+}
+
+// validateRefName checks that the name of a ref of the given kind, without its namespace
+// prefix, follows the rules of git check-ref-format.
+func validateRefName(kind, namespace, name string) error {
+	switch {
+	case strings.HasPrefix(name, "refs/"):
+		return fmt.Errorf("invalid %s name %q: give the name without the '%s' prefix", kind, name, namespace)
+	case name == "@", strings.HasPrefix(name, "-"):
+		return fmt.Errorf("invalid %s name %q", kind, name)
+	case strings.HasPrefix(name, "/"), strings.HasSuffix(name, "/"), strings.Contains(name, "//"):
+		return fmt.Errorf("invalid %s name %q: it must not start or end with '/' or contain '//'", kind, name)
+	case strings.HasSuffix(name, "."), strings.HasSuffix(name, ".lock"):
+		return fmt.Errorf("invalid %s name %q: it must not end with '.' or '.lock'", kind, name)
+	case strings.Contains(name, ".."), strings.Contains(name, "@{"):
+		return fmt.Errorf("invalid %s name %q: it must not contain '..' or '@{'", kind, name)
+	}
+	for _, component := range strings.Split(name, "/") {
+		if strings.HasPrefix(component, ".") {
+			return fmt.Errorf("invalid %s name %q: no part of it may start with '.'", kind, name)
+		}
+	}
+	for _, r := range name {
+		if r < 0x20 || r == 0x7f || strings.ContainsRune(" ~^:?*[\\", r) {
+			return fmt.Errorf("invalid %s name %q: it must not contain spaces, control characters or any of ~^:?*[\\", kind, name)
+		}
+	}
+	return nil
+}
+
+// blockingPullRequest is an open pull request that keeps a branch from being deleted.
+type blockingPullRequest struct {
+	Number  int    `json:"number"`
+	Title   string `json:"title"`
+	HTMLURL string `json:"html_url"`
+	// Side is whether the branch is the head or the base of the pull request.
+	Side string `json:"side"`
+}
+
+// deletedBranch is the result of deleting a branch.
+type deletedBranch struct {
+	Branch  string `json:"branch"`
+	Deleted bool   `json:"deleted"`
+	// ClosedPullRequests are the open pull requests from or into the branch, which GitHub closes
+	// when the branch is deleted with force.
+	ClosedPullRequests []int `json:"closed_pull_requests,omitempty"`
+}
+
+// DeleteBranch creates a tool to delete a branch.
+func DeleteBranch(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("delete_branch",
+			mcp.WithDescription(t("TOOL_DELETE_BRANCH_DESCRIPTION", "Delete a branch of a GitHub repository. The default branch cannot be deleted, and branches with open pull requests are only deleted with force")),
 			mcp.WithString("owner",
 				mcp.Required(),
 				mcp.Description("Repository owner"),
@@ -343,8 +3210,12 @@ func ForkRepository(getClient GetClientFn, t translations.TranslationHelperFunc)
 				mcp.Required(),
 				mcp.Description("Repository name"),
 			),
-			mcp.WithString("organization",
-				mcp.Description("Organization to fork to"),
+			mcp.WithString("branch",
+				mcp.Required(),
+				mcp.Description("Name of the branch to delete"),
+			),
+			mcp.WithBoolean("force",
+				mcp.Description("Delete the branch even if open pull requests are opened from or into it (default false)"),
 			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -356,40 +3227,60 @@ func ForkRepository(getClient GetClientFn, t translations.TranslationHelperFunc)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			org, err := OptionalParam[string](request, "organization")
+			branch, err := requiredParam[string](request, "branch")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-
-			opts := &github.RepositoryCreateForkOptions{}
-			if org != "" {
-				opts.Organization = org
+			force, err := OptionalParam[bool](request, "force")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
 			}
 
 			client, err := getClient(ctx)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
-			forkedRepo, resp, err := client.Repositories.CreateFork(ctx, owner, repo, opts)
+			repository, resp, err := client.Repositories.Get(ctx, owner, repo)
 			if err != nil {
-				// Check if it's an acceptedError. An acceptedError indicates that the update is in progress,
-				// and it's not a real error.
-				if resp != nil && resp.StatusCode == http.StatusAccepted && isAcceptedError(err) {
-					return mcp.NewToolResultText("Fork is in progress"), nil
+				if result := apiErrorResult("failed to get repository", err, http.StatusNotFound); result != nil {
+					return result, nil
 				}
-				return nil, fmt.Errorf("failed to fork repository: %w", err)
+				return nil, fmt.Errorf("failed to get repository: %w", err)
+			}
+			_ = resp.Body.Close()
+			if branch == repository.GetDefaultBranch() {
+				return mcp.NewToolResultError(fmt.Sprintf("refusing to delete branch %q: it is the default branch of the repository", branch)), nil
 			}
-			defer func() { _ = resp.Body.Close() }()
 
-			if resp.StatusCode != http.StatusAccepted {
-				body, err := io.ReadAll(resp.Body)
-				if err != nil {
-					return nil, fmt.Errorf("failed to read response body: %w", err)
+			blocking, err := openPullRequestsOfBranch(ctx, client, owner, repo, branch)
+			if err != nil {
+				return nil, err
+			}
+			if len(blocking) > 0 && !force {
+				details := make([]string, 0, len(blocking))
+				for _, pr := range blocking {
+					details = append(details, fmt.Sprintf("#%d %q (%s)", pr.Number, pr.Title, pr.Side))
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("refusing to delete branch %q: it has open pull requests %s, set force to delete it anyway",
+					branch, strings.Join(details, ", "))), nil
+			}
+
+			resp, err = client.Git.DeleteRef(ctx, owner, repo, "refs/heads/"+branch)
+			if err != nil {
+				// Protected branches cannot be deleted.
+				if result := apiErrorResult("failed to delete branch", err, http.StatusNotFound, http.StatusForbidden, http.StatusUnprocessableEntity); result != nil {
+					return result, nil
 				}
-				return mcp.NewToolResultError(fmt.Sprintf("failed to fork repository: %s", string(body))), nil
+				return nil, fmt.Errorf("failed to delete branch: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			result := deletedBranch{Branch: branch, Deleted: true}
+			for _, pr := range blocking {
+				result.ClosedPullRequests = append(result.ClosedPullRequests, pr.Number)
 			}
 
-			r, err := json.Marshal(forkedRepo)
+			r, err := json.Marshal(result)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
@@ -398,10 +3289,47 @@ func ForkRepository(getClient GetClientFn, t translations.TranslationHelperFunc)
 		}
 }
 
-// CreateBranch creates a tool to create a new branch.
-func CreateBranch(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
-	return mcp.NewTool("create_branch",
-			mcp.WithDescription(t("TOOL_CREATE_BRANCH_DESCRIPTION", "Create a new branch in a GitHub repository")),
+// openPullRequestsOfBranch lists the open pull requests whose head or base is a branch of the
+// repository, as deleting the branch closes them.
+func openPullRequestsOfBranch(ctx context.Context, client *github.Client, owner, repo, branch string) ([]blockingPullRequest, error) {
+	var blocking []blockingPullRequest
+	filters := []struct {
+		side string
+		opts *github.PullRequestListOptions
+	}{
+		{side: "head", opts: &github.PullRequestListOptions{State: "open", Head: owner + ":" + branch}},
+		{side: "base", opts: &github.PullRequestListOptions{State: "open", Base: branch}},
+	}
+	for _, filter := range filters {
+		filter.opts.ListOptions = github.ListOptions{PerPage: 100}
+		prs, resp, err := client.PullRequests.List(ctx, owner, repo, filter.opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list pull requests with %s %s: %w", filter.side, branch, err)
+		}
+		_ = resp.Body.Close()
+		for _, pr := range prs {
+			blocking = append(blocking, blockingPullRequest{
+				Number:  pr.GetNumber(),
+				Title:   pr.GetTitle(),
+				HTMLURL: pr.GetHTMLURL(),
+				Side:    filter.side,
+			})
+		}
+	}
+	return blocking, nil
+}
+
+// renamedBranch is the result of renaming a branch.
+type renamedBranch struct {
+	OldName string `json:"old_name"`
+	NewName string `json:"new_name"`
+	SHA     string `json:"sha"`
+}
+
+// RenameBranch creates a tool to rename a branch.
+func RenameBranch(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("rename_branch",
+			mcp.WithDescription(t("TOOL_RENAME_BRANCH_DESCRIPTION", "Rename a branch of a GitHub repository. Open pull requests and branch protection rules are updated to the new name")),
 			mcp.WithString("owner",
 				mcp.Required(),
 				mcp.Description("Repository owner"),
@@ -412,10 +3340,11 @@ func CreateBranch(getClient GetClientFn, t translations.TranslationHelperFunc) (
 			),
 			mcp.WithString("branch",
 				mcp.Required(),
-				mcp.Description("Name for new branch"),
+				mcp.Description("Name of the branch to rename"),
 			),
-			mcp.WithString("from_branch",
-				mcp.Description("Source branch (defaults to repo default)"),
+			mcp.WithString("new_name",
+				mcp.Required(),
+				mcp.Description("New name of the branch, without 'refs/heads/'"),
 			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -431,50 +3360,33 @@ func CreateBranch(getClient GetClientFn, t translations.TranslationHelperFunc) (
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			fromBranch, err := OptionalParam[string](request, "from_branch")
+			newName, err := requiredParam[string](request, "new_name")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			if err := validateBranchName(newName); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
 
 			client, err := getClient(ctx)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
 			}
-
-			// Get the source branch SHA
-			var ref *github.Reference
-
-			if fromBranch == "" {
-				// Get default branch if from_branch not specified
-				repository, resp, err := client.Repositories.Get(ctx, owner, repo)
-				if err != nil {
-					return nil, fmt.Errorf("failed to get repository: %w", err)
-				}
-				defer func() { _ = resp.Body.Close() }()
-
-				fromBranch = *repository.DefaultBranch
-			}
-
-			// Get SHA of source branch
-			ref, resp, err := client.Git.GetRef(ctx, owner, repo, "refs/heads/"+fromBranch)
-			if err != nil {
-				return nil, fmt.Errorf("failed to get reference: %w", err)
-			}
-			defer func() { _ = resp.Body.Close() }()
-
-			// Create new branch
-			newRef := &github.Reference{
-				Ref:    github.Ptr("refs/heads/" + branch),
-				Object: &github.GitObject{SHA: ref.Object.SHA},
-			}
-
-			createdRef, resp, err := client.Git.CreateRef(ctx, owner, repo, newRef)
+			renamed, resp, err := client.Repositories.RenameBranch(ctx, owner, repo, branch, newName)
 			if err != nil {
-				return nil, fmt.Errorf("failed to create branch: %w", err)
+				// Renaming needs admin access for the default branch and protected branches.
+				if result := apiErrorResult("failed to rename branch", err, http.StatusNotFound, http.StatusForbidden, http.StatusUnprocessableEntity); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to rename branch: %w", err)
 			}
 			defer func() { _ = resp.Body.Close() }()
 
-			r, err := json.Marshal(createdRef)
+			r, err := json.Marshal(renamedBranch{
+				OldName: branch,
+				NewName: renamed.GetName(),
+				SHA:     renamed.GetCommit().GetSHA(),
+			})
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
@@ -483,6 +3395,61 @@ func CreateBranch(getClient GetClientFn, t translations.TranslationHelperFunc) (
 		}
 }
 
+// pushedFile is a single file change of push_files.
+type pushedFile struct {
+	path    string
+	content string
+	delete  bool
+}
+
+// parsePushedFiles validates the files argument of push_files, every path must be written or
+// deleted exactly once.
+func parsePushedFiles(filesObj []interface{}) ([]pushedFile, error) {
+	files := make([]pushedFile, 0, len(filesObj))
+	seen := make(map[string]bool, len(filesObj))
+	for _, file := range filesObj {
+		fileMap, ok := file.(map[string]interface{})
+		if !ok {
+			return nil, errors.New("each file must be an object with path and content")
+		}
+
+		path, ok := fileMap["path"].(string)
+		if !ok || path == "" {
+			return nil, errors.New("each file must have a path")
+		}
+		if seen[path] {
+			return nil, fmt.Errorf("file %s is listed more than once", path)
+		}
+		seen[path] = true
+
+		deleteFile, _ := fileMap["delete"].(bool)
+		content, hasContent := fileMap["content"].(string)
+		switch {
+		case deleteFile && hasContent:
+			return nil, fmt.Errorf("file %s cannot have both content and delete", path)
+		case !deleteFile && !hasContent:
+			return nil, errors.New("each file must have content")
+		}
+
+		files = append(files, pushedFile{path: path, content: content, delete: deleteFile})
+	}
+	if len(files) == 0 {
+		return nil, errors.New("files must contain at least one file")
+	}
+	return files, nil
+}
+
+// notFastForward reports whether err is GitHub refusing to move a reference to a commit that
+// does not descend from its current target.
+func notFastForward(err error) bool {
+	var errorResponse *github.ErrorResponse
+	if !errors.As(err, &errorResponse) || errorResponse.Response == nil {
+		return false
+	}
+	return errorResponse.Response.StatusCode == http.StatusUnprocessableEntity &&
+		strings.Contains(strings.ToLower(errorResponse.Message), "not a fast forward")
+}
+
 // PushFiles creates a tool to push multiple files in a single commit to a GitHub repository.
 func PushFiles(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("push_files",
@@ -505,7 +3472,7 @@ func PushFiles(getClient GetClientFn, t translations.TranslationHelperFunc) (too
 					map[string]interface{}{
 						"type":                 "object",
 						"additionalProperties": false,
-						"required":             []string{"path", "content"},
+						"required":             []string{"path"},
 						"properties": map[string]interface{}{
 							"path": map[string]interface{}{
 								"type":        "string",
@@ -515,9 +3482,13 @@ func PushFiles(getClient GetClientFn, t translations.TranslationHelperFunc) (too
 								"type":        "string",
 								"description": "file content",
 							},
+							"delete": map[string]interface{}{
+								"type":        "boolean",
+								"description": "delete the file instead of writing content to it",
+							},
 						},
 					}),
-				mcp.Description("Array of file objects to push, each object with path (string) and content (string)"),
+				mcp.Description("Array of file objects to push, each object with path (string) and either content (string) or delete (true)"),
 			),
 			mcp.WithString("message",
 				mcp.Required(),
@@ -547,6 +3518,10 @@ func PushFiles(getClient GetClientFn, t translations.TranslationHelperFunc) (too
 			if !ok {
 				return mcp.NewToolResultError("files parameter must be an array of objects with path and content"), nil
 			}
+			files, err := parsePushedFiles(filesObj)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
 
 			client, err := getClient(ctx)
 			if err != nil {
@@ -567,37 +3542,34 @@ func PushFiles(getClient GetClientFn, t translations.TranslationHelperFunc) (too
 			}
 			defer func() { _ = resp.Body.Close() }()
 
-			// Create tree entries for all files
+			// Create a blob for every written file, deleted files are tree entries without a sha
 			var entries []*github.TreeEntry
-
-			for _, file := range filesObj {
-				fileMap, ok := file.(map[string]interface{})
-				if !ok {
-					return mcp.NewToolResultError("each file must be an object with path and content"), nil
-				}
-
-				path, ok := fileMap["path"].(string)
-				if !ok || path == "" {
-					return mcp.NewToolResultError("each file must have a path"), nil
+			for _, file := range files {
+				entry := &github.TreeEntry{
+					Path: github.Ptr(file.path),
+					Mode: github.Ptr("100644"), // Regular file mode
+					Type: github.Ptr("blob"),
 				}
-
-				content, ok := fileMap["content"].(string)
-				if !ok {
-					return mcp.NewToolResultError("each file must have content"), nil
+				if !file.delete {
+					blob, resp, err := client.Git.CreateBlob(ctx, owner, repo, &github.Blob{
+						Content:  github.Ptr(file.content),
+						Encoding: github.Ptr("utf-8"),
+					})
+					if err != nil {
+						return nil, fmt.Errorf("failed to create blob for %s: %w", file.path, err)
+					}
+					_ = resp.Body.Close()
+					entry.SHA = blob.SHA
 				}
-
-				// Create a tree entry for the file
-				entries = append(entries, &github.TreeEntry{
-					Path:    github.Ptr(path),
-					Mode:    github.Ptr("100644"), // Regular file mode
-					Type:    github.Ptr("blob"),
-					Content: github.Ptr(content),
-				})
+				entries = append(entries, entry)
 			}
 
 			// Create a new tree with the file entries
 			newTree, resp, err := client.Git.CreateTree(ctx, owner, repo, *baseCommit.Tree.SHA, entries)
 			if err != nil {
+				if result := apiErrorResult("failed to create tree", err, http.StatusUnprocessableEntity); result != nil {
+					return result, nil
+				}
 				return nil, fmt.Errorf("failed to create tree: %w", err)
 			}
 			defer func() { _ = resp.Body.Close() }()
@@ -618,6 +3590,16 @@ func PushFiles(getClient GetClientFn, t translations.TranslationHelperFunc) (too
 			ref.Object.SHA = newCommit.SHA
 			updatedRef, resp, err := client.Git.UpdateRef(ctx, owner, repo, ref, false)
 			if err != nil {
+				if notFastForward(err) {
+					// Someone else pushed to the branch since its head was read
+					head, headResp, headErr := client.Git.GetRef(ctx, owner, repo, "refs/heads/"+branch)
+					if headErr != nil {
+						return nil, fmt.Errorf("failed to get branch reference: %w", headErr)
+					}
+					_ = headResp.Body.Close()
+					return mcp.NewToolResultError(fmt.Sprintf("failed to update reference: branch %s moved from %s to %s while the files were pushed, push them again on top of the new head",
+						branch, baseCommit.GetSHA(), head.GetObject().GetSHA())), nil
+				}
 				return nil, fmt.Errorf("failed to update reference: %w", err)
 			}
 			defer func() { _ = resp.Body.Close() }()
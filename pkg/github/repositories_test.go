@@ -2,8 +2,11 @@ package github
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -25,6 +28,7 @@ func Test_GetFileContents(t *testing.T) {
 	assert.Contains(t, tool.InputSchema.Properties, "owner")
 	assert.Contains(t, tool.InputSchema.Properties, "repo")
 	assert.Contains(t, tool.InputSchema.Properties, "path")
+	assert.Contains(t, tool.InputSchema.Properties, "ref")
 	assert.Contains(t, tool.InputSchema.Properties, "branch")
 	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "path"})
 
@@ -34,6 +38,7 @@ func Test_GetFileContents(t *testing.T) {
 		Name:        github.Ptr("README.md"),
 		Path:        github.Ptr("README.md"),
 		Content:     github.Ptr("IyBUZXN0IFJlcG9zaXRvcnkKClRoaXMgaXMgYSB0ZXN0IHJlcG9zaXRvcnku"), // Base64 encoded "# Test Repository\n\nThis is a test repository."
+		Encoding:    github.Ptr("base64"),
 		SHA:         github.Ptr("abc123"),
 		Size:        github.Ptr(42),
 		HTMLURL:     github.Ptr("https://github.com/owner/repo/blob/main/README.md"),
@@ -59,6 +64,30 @@ func Test_GetFileContents(t *testing.T) {
 		},
 	}
 
+	// Setup mock binary file, a PNG header
+	mockBinaryContent := &github.RepositoryContent{
+		Type:        github.Ptr("file"),
+		Name:        github.Ptr("logo.png"),
+		Path:        github.Ptr("docs/logo.png"),
+		Content:     github.Ptr(base64.StdEncoding.EncodeToString([]byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR"))),
+		Encoding:    github.Ptr("base64"),
+		SHA:         github.Ptr("bin789"),
+		Size:        github.Ptr(16),
+		DownloadURL: github.Ptr("https://raw.githubusercontent.com/owner/repo/main/docs/logo.png"),
+	}
+
+	// Setup mock large file, whose content the contents API leaves out
+	largeContent := strings.Repeat("line of a large file\n", 60000)
+	mockLargeContent := &github.RepositoryContent{
+		Type:     github.Ptr("file"),
+		Name:     github.Ptr("data.txt"),
+		Path:     github.Ptr("data.txt"),
+		Content:  github.Ptr(""),
+		Encoding: github.Ptr("none"),
+		SHA:      github.Ptr("big123"),
+		Size:     github.Ptr(len(largeContent)),
+	}
+
 	tests := []struct {
 		name           string
 		mockedClient   *http.Client
@@ -79,14 +108,67 @@ func Test_GetFileContents(t *testing.T) {
 					),
 				),
 			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"path":  "README.md",
+				"ref":   "main",
+			},
+			expectError: false,
+			expectedResult: fileContents{
+				Type:        "file",
+				Name:        "README.md",
+				Path:        "README.md",
+				SHA:         "abc123",
+				Size:        42,
+				Encoding:    "utf-8",
+				Content:     "# Test Repository\n\nThis is a test repository.",
+				HTMLURL:     "https://github.com/owner/repo/blob/main/README.md",
+				DownloadURL: "https://raw.githubusercontent.com/owner/repo/main/README.md",
+			},
+		},
+		{
+			name: "deprecated branch alias of ref",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					expectQueryParams(t, map[string]string{
+						"ref": "main",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockFileContent),
+					),
+				),
+			),
 			requestArgs: map[string]interface{}{
 				"owner":  "owner",
 				"repo":   "repo",
 				"path":   "README.md",
 				"branch": "main",
 			},
-			expectError:    false,
-			expectedResult: mockFileContent,
+			expectError: false,
+			expectedResult: fileContents{
+				Type:        "file",
+				Name:        "README.md",
+				Path:        "README.md",
+				SHA:         "abc123",
+				Size:        42,
+				Encoding:    "utf-8",
+				Content:     "# Test Repository\n\nThis is a test repository.",
+				HTMLURL:     "https://github.com/owner/repo/blob/main/README.md",
+				DownloadURL: "https://raw.githubusercontent.com/owner/repo/main/README.md",
+			},
+		},
+		{
+			name:         "branch different from ref",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"path":   "README.md",
+				"ref":    "v1.0.0",
+				"branch": "main",
+			},
+			expectedErrMsg: "branch is a deprecated alias of ref, and cannot be given with a different ref",
 		},
 		{
 			name: "successful directory content fetch",
@@ -103,8 +185,72 @@ func Test_GetFileContents(t *testing.T) {
 				"repo":  "repo",
 				"path":  "src",
 			},
-			expectError:    false,
-			expectedResult: mockDirContent,
+			expectError: false,
+			expectedResult: []directoryEntry{
+				{Name: "README.md", Type: "file", Size: 42, Path: "README.md"},
+				{Name: "src", Type: "dir", Size: 0, Path: "src"},
+			},
+		},
+		{
+			name: "binary file content fetch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					mockBinaryContent,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"path":  "docs/logo.png",
+			},
+			expectError: false,
+			expectedResult: fileContents{
+				Type:        "file",
+				Name:        "logo.png",
+				Path:        "docs/logo.png",
+				SHA:         "bin789",
+				Size:        16,
+				Encoding:    "binary",
+				DownloadURL: "https://raw.githubusercontent.com/owner/repo/main/docs/logo.png",
+				Note:        "the file is binary, download it from download_url to get its content",
+			},
+		},
+		{
+			name: "large file content fetch falls back to blob",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					mockLargeContent,
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposGitBlobsByOwnerByRepoByFileSha,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						require.Equal(t, "/repos/owner/repo/git/blobs/big123", r.URL.Path)
+						mockResponse(t, http.StatusOK, &github.Blob{
+							SHA:      github.Ptr("big123"),
+							Content:  github.Ptr(base64.StdEncoding.EncodeToString([]byte(largeContent))),
+							Encoding: github.Ptr("base64"),
+							Size:     github.Ptr(len(largeContent)),
+						})(w, r)
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"path":  "data.txt",
+			},
+			expectError: false,
+			expectedResult: fileContents{
+				Type:     "file",
+				Name:     "data.txt",
+				Path:     "data.txt",
+				SHA:      "big123",
+				Size:     len(largeContent),
+				Encoding: "utf-8",
+				Content:  largeContent,
+			},
 		},
 		{
 			name: "content fetch fails",
@@ -118,10 +264,10 @@ func Test_GetFileContents(t *testing.T) {
 				),
 			),
 			requestArgs: map[string]interface{}{
-				"owner":  "owner",
-				"repo":   "repo",
-				"path":   "nonexistent.md",
-				"branch": "main",
+				"owner": "owner",
+				"repo":  "repo",
+				"path":  "nonexistent.md",
+				"ref":   "main",
 			},
 			expectError:    true,
 			expectedErrMsg: "failed to get file contents",
@@ -162,96 +308,201 @@ func Test_GetFileContents(t *testing.T) {
 			// Parse the result and get the text content if no error
 			textContent := getTextResult(t, result)
 
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
 			// Verify based on expected type
 			switch expected := tc.expectedResult.(type) {
-			case *github.RepositoryContent:
-				var returnedContent github.RepositoryContent
-				err = json.Unmarshal([]byte(textContent.Text), &returnedContent)
+			case fileContents:
+				var returned fileContents
+				err = json.Unmarshal([]byte(textContent.Text), &returned)
 				require.NoError(t, err)
-				assert.Equal(t, *expected.Name, *returnedContent.Name)
-				assert.Equal(t, *expected.Path, *returnedContent.Path)
-				assert.Equal(t, *expected.Type, *returnedContent.Type)
-			case []*github.RepositoryContent:
-				var returnedContents []*github.RepositoryContent
-				err = json.Unmarshal([]byte(textContent.Text), &returnedContents)
+				assert.Equal(t, expected, returned)
+			case []directoryEntry:
+				var returned []directoryEntry
+				err = json.Unmarshal([]byte(textContent.Text), &returned)
 				require.NoError(t, err)
-				assert.Len(t, returnedContents, len(expected))
-				for i, content := range returnedContents {
-					assert.Equal(t, *expected[i].Name, *content.Name)
-					assert.Equal(t, *expected[i].Path, *content.Path)
-					assert.Equal(t, *expected[i].Type, *content.Type)
-				}
+				assert.Equal(t, expected, returned)
 			}
 		})
 	}
 }
 
-func Test_ForkRepository(t *testing.T) {
+func Test_GetReadme(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
-	tool, _ := ForkRepository(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	tool, _ := GetReadme(stubGetClientFn(mockClient), translations.NullTranslationHelper)
 
-	assert.Equal(t, "fork_repository", tool.Name)
+	assert.Equal(t, "get_readme", tool.Name)
 	assert.NotEmpty(t, tool.Description)
 	assert.Contains(t, tool.InputSchema.Properties, "owner")
 	assert.Contains(t, tool.InputSchema.Properties, "repo")
-	assert.Contains(t, tool.InputSchema.Properties, "organization")
+	assert.Contains(t, tool.InputSchema.Properties, "ref")
+	assert.Contains(t, tool.InputSchema.Properties, "dir")
+	assert.Contains(t, tool.InputSchema.Properties, "format")
 	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
 
-	// Setup mock forked repo for success case
-	mockForkedRepo := &github.Repository{
-		ID:       github.Ptr(int64(123456)),
-		Name:     github.Ptr("repo"),
-		FullName: github.Ptr("new-owner/repo"),
-		Owner: &github.User{
-			Login: github.Ptr("new-owner"),
-		},
-		HTMLURL:       github.Ptr("https://github.com/new-owner/repo"),
-		DefaultBranch: github.Ptr("main"),
-		Fork:          github.Ptr(true),
-		ForksCount:    github.Ptr(0),
+	markdown := "# Project\n\nA project."
+	readmeFile := func(path, content string) *github.RepositoryContent {
+		return &github.RepositoryContent{
+			Type:     github.Ptr("file"),
+			Name:     github.Ptr("README.md"),
+			Path:     github.Ptr(path),
+			Encoding: github.Ptr("base64"),
+			Content:  github.Ptr(base64.StdEncoding.EncodeToString([]byte(content))),
+			HTMLURL:  github.Ptr("https://github.com/owner/repo/blob/main/" + path),
+		}
 	}
+	longMarkdown := strings.Repeat("a", readmeLimit+10)
 
 	tests := []struct {
 		name           string
 		mockedClient   *http.Client
 		requestArgs    map[string]interface{}
-		expectError    bool
-		expectedRepo   *github.Repository
+		expectedReadme readme
 		expectedErrMsg string
 	}{
 		{
-			name: "successful repository fork",
+			name: "markdown of the repository README",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposReadmeByOwnerByRepo,
+					readmeFile("README.md", markdown),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectedReadme: readme{
+				Name:    "README.md",
+				Path:    "README.md",
+				Format:  "markdown",
+				Content: markdown,
+				HTMLURL: "https://github.com/owner/repo/blob/main/README.md",
+			},
+		},
+		{
+			name: "markdown of a directory README at a ref",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
-					mock.PostReposForksByOwnerByRepo,
-					mockResponse(t, http.StatusAccepted, mockForkedRepo),
+					mock.GetReposReadmeByOwnerByRepoByDir,
+					expectQueryParams(t, map[string]string{
+						"ref": "v1.0.0",
+					}).andThen(
+						func(w http.ResponseWriter, r *http.Request) {
+							require.Equal(t, "/repos/owner/repo/readme/docs", r.URL.Path)
+							mockResponse(t, http.StatusOK, readmeFile("docs/README.md", markdown))(w, r)
+						},
+					),
 				),
 			),
 			requestArgs: map[string]interface{}{
 				"owner": "owner",
 				"repo":  "repo",
+				"dir":   "docs/",
+				"ref":   "v1.0.0",
+			},
+			expectedReadme: readme{
+				Name:    "README.md",
+				Path:    "docs/README.md",
+				Format:  "markdown",
+				Content: markdown,
+				HTMLURL: "https://github.com/owner/repo/blob/main/docs/README.md",
 			},
-			expectError:  false,
-			expectedRepo: mockForkedRepo,
 		},
 		{
-			name: "repository fork fails",
+			name: "rendered HTML",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
-					mock.PostReposForksByOwnerByRepo,
-					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
-						w.WriteHeader(http.StatusForbidden)
-						_, _ = w.Write([]byte(`{"message": "Forbidden"}`))
+					mock.GetReposReadmeByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						require.Equal(t, "application/vnd.github.html", r.Header.Get("Accept"))
+						_, _ = w.Write([]byte("<h1>Project</h1>\n<p>A project.</p>"))
 					}),
 				),
 			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"format": "html",
+			},
+			expectedReadme: readme{
+				Format:  "html",
+				Content: "<h1>Project</h1>\n<p>A project.</p>",
+			},
+		},
+		{
+			name: "long README is truncated",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposReadmeByOwnerByRepo,
+					readmeFile("README.md", longMarkdown),
+				),
+			),
 			requestArgs: map[string]interface{}{
 				"owner": "owner",
 				"repo":  "repo",
 			},
-			expectError:    true,
-			expectedErrMsg: "failed to fork repository",
+			expectedReadme: readme{
+				Name:      "README.md",
+				Path:      "README.md",
+				Format:    "markdown",
+				Content:   longMarkdown[:readmeLimit],
+				Truncated: true,
+				Note:      "the README is 65546 bytes long, only the first 65536 are returned",
+				HTMLURL:   "https://github.com/owner/repo/blob/main/README.md",
+			},
+		},
+		{
+			name: "no README",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposReadmeByOwnerByRepoByDir,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"dir":   "src",
+				"ref":   "main",
+			},
+			expectedErrMsg: "no README exists in owner/repo/src at main",
+		},
+		{
+			name: "README over the size of the contents API",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposReadmeByOwnerByRepo,
+					&github.RepositoryContent{
+						Type:        github.Ptr("file"),
+						Name:        github.Ptr("README.md"),
+						Path:        github.Ptr("README.md"),
+						Encoding:    github.Ptr("none"),
+						Content:     github.Ptr(""),
+						DownloadURL: github.Ptr("https://raw.githubusercontent.com/owner/repo/main/README.md"),
+					},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectedErrMsg: "README.md is too large to be returned, download it from https://raw.githubusercontent.com/owner/repo/main/README.md instead",
+		},
+		{
+			name:         "invalid format",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"format": "pdf",
+			},
+			expectedErrMsg: `invalid format "pdf": must be one of markdown, html`,
 		},
 	}
 
@@ -259,185 +510,320 @@ func Test_ForkRepository(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			// Setup client with mock
 			client := github.NewClient(tc.mockedClient)
-			_, handler := ForkRepository(stubGetClientFn(client), translations.NullTranslationHelper)
+			_, handler := GetReadme(stubGetClientFn(client), translations.NullTranslationHelper)
 
 			// Create call request
 			request := createMCPRequest(tc.requestArgs)
 
 			// Call handler
 			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
 
-			// Verify results
-			if tc.expectError {
-				require.Error(t, err)
-				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
 				return
 			}
+			require.False(t, result.IsError, textContent.Text)
 
+			// Unmarshal and verify the result
+			var returned readme
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
 			require.NoError(t, err)
+			assert.Equal(t, tc.expectedReadme, returned)
+		})
+	}
+}
 
-			// Parse the result and get the text content if no error
-			textContent := getTextResult(t, result)
+// blameData is the data of the blame of a file at a commit.
+func blameData(ranges ...map[string]any) map[string]any {
+	nodes := make([]any, len(ranges))
+	for i, r := range ranges {
+		nodes[i] = r
+	}
+	return map[string]any{
+		"repository": map[string]any{
+			"object": map[string]any{
+				"__typename": "Commit",
+				"blame":      map[string]any{"ranges": nodes},
+			},
+		},
+	}
+}
 
-			assert.Contains(t, textContent.Text, "Fork is in progress")
-		})
+// blameRangeData is a blame range node, with a nil login standing for an author that
+// is not a GitHub user.
+func blameRangeData(start, end int, sha string, login any, message string) map[string]any {
+	var user any
+	if login != nil {
+		user = map[string]any{"login": login}
+	}
+	return map[string]any{
+		"startingLine": start,
+		"endingLine":   end,
+		"commit": map[string]any{
+			"oid":             sha,
+			"committedDate":   "2024-03-01T10:00:00Z",
+			"messageHeadline": message,
+			"author":          map[string]any{"user": user},
+		},
 	}
 }
 
-func Test_CreateBranch(t *testing.T) {
+func Test_GetBlame(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
-	tool, _ := CreateBranch(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	tool, _ := GetBlame(stubGetClientFn(mockClient), translations.NullTranslationHelper)
 
-	assert.Equal(t, "create_branch", tool.Name)
+	assert.Equal(t, "get_file_blame", tool.Name)
 	assert.NotEmpty(t, tool.Description)
 	assert.Contains(t, tool.InputSchema.Properties, "owner")
 	assert.Contains(t, tool.InputSchema.Properties, "repo")
-	assert.Contains(t, tool.InputSchema.Properties, "branch")
-	assert.Contains(t, tool.InputSchema.Properties, "from_branch")
-	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "branch"})
-
-	// Setup mock repository for default branch test
-	mockRepo := &github.Repository{
-		DefaultBranch: github.Ptr("main"),
-	}
-
-	// Setup mock reference for from_branch tests
-	mockSourceRef := &github.Reference{
-		Ref: github.Ptr("refs/heads/main"),
-		Object: &github.GitObject{
-			SHA: github.Ptr("abc123def456"),
-		},
-	}
+	assert.Contains(t, tool.InputSchema.Properties, "path")
+	assert.Contains(t, tool.InputSchema.Properties, "ref")
+	assert.Contains(t, tool.InputSchema.Properties, "start_line")
+	assert.Contains(t, tool.InputSchema.Properties, "end_line")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "path"})
 
-	// Setup mock created reference
-	mockCreatedRef := &github.Reference{
-		Ref: github.Ptr("refs/heads/new-feature"),
-		Object: &github.GitObject{
-			SHA: github.Ptr("abc123def456"),
-		},
-	}
+	committed := github.Timestamp{Time: time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)}
+	mainBlame := blameData(
+		blameRangeData(1, 10, "aaa111", "alice", "Initial commit"),
+		blameRangeData(11, 14, "bbb222", nil, "Handle errors"),
+		blameRangeData(15, 40, "ccc333", "bob", "Add flags"),
+	)
 
 	tests := []struct {
 		name           string
 		mockedClient   *http.Client
 		requestArgs    map[string]interface{}
-		expectError    bool
-		expectedRef    *github.Reference
+		expectedBlame  fileBlame
 		expectedErrMsg string
 	}{
 		{
-			name: "successful branch creation with from_branch",
+			name: "whole file at the default branch",
 			mockedClient: mock.NewMockedHTTPClient(
-				mock.WithRequestMatch(
-					mock.GetReposGitRefByOwnerByRepoByRef,
-					mockSourceRef,
-				),
-				mock.WithRequestMatch(
-					mock.PostReposGitRefsByOwnerByRepo,
-					mockCreatedRef,
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockGraphQL(t, graphQLExchange{
+						query: "FileBlame",
+						expectedVariables: map[string]any{
+							"owner":      "owner",
+							"repo":       "repo",
+							"expression": "HEAD",
+							"path":       "cmd/main.go",
+						},
+						data: mainBlame,
+					}),
 				),
 			),
 			requestArgs: map[string]interface{}{
-				"owner":       "owner",
-				"repo":        "repo",
-				"branch":      "new-feature",
-				"from_branch": "main",
+				"owner": "owner",
+				"repo":  "repo",
+				"path":  "/cmd/main.go",
+			},
+			expectedBlame: fileBlame{
+				Path: "cmd/main.go",
+				Ref:  "HEAD",
+				Ranges: []blameRange{
+					{StartingLine: 1, EndingLine: 10, CommitSHA: "aaa111", Author: "alice", CommittedDate: committed, Message: "Initial commit"},
+					{StartingLine: 11, EndingLine: 14, CommitSHA: "bbb222", CommittedDate: committed, Message: "Handle errors"},
+					{StartingLine: 15, EndingLine: 40, CommitSHA: "ccc333", Author: "bob", CommittedDate: committed, Message: "Add flags"},
+				},
 			},
-			expectError: false,
-			expectedRef: mockCreatedRef,
 		},
 		{
-			name: "successful branch creation with default branch",
+			name: "ranges overlapping the lines at a ref",
 			mockedClient: mock.NewMockedHTTPClient(
-				mock.WithRequestMatch(
-					mock.GetReposByOwnerByRepo,
-					mockRepo,
-				),
-				mock.WithRequestMatch(
-					mock.GetReposGitRefByOwnerByRepoByRef,
-					mockSourceRef,
-				),
 				mock.WithRequestMatchHandler(
-					mock.PostReposGitRefsByOwnerByRepo,
-					expectRequestBody(t, map[string]interface{}{
-						"ref": "refs/heads/new-feature",
-						"sha": "abc123def456",
-					}).andThen(
-						mockResponse(t, http.StatusCreated, mockCreatedRef),
-					),
+					postGraphQL,
+					mockGraphQL(t, graphQLExchange{
+						query: "FileBlame",
+						expectedVariables: map[string]any{
+							"expression": "v1.2.0",
+						},
+						data: mainBlame,
+					}),
 				),
 			),
 			requestArgs: map[string]interface{}{
-				"owner":  "owner",
-				"repo":   "repo",
-				"branch": "new-feature",
+				"owner":      "owner",
+				"repo":       "repo",
+				"path":       "cmd/main.go",
+				"ref":        "v1.2.0",
+				"start_line": float64(12),
+				"end_line":   float64(15),
+			},
+			expectedBlame: fileBlame{
+				Path: "cmd/main.go",
+				Ref:  "v1.2.0",
+				Ranges: []blameRange{
+					{StartingLine: 11, EndingLine: 14, CommitSHA: "bbb222", CommittedDate: committed, Message: "Handle errors"},
+					{StartingLine: 15, EndingLine: 40, CommitSHA: "ccc333", Author: "bob", CommittedDate: committed, Message: "Add flags"},
+				},
 			},
-			expectError: false,
-			expectedRef: mockCreatedRef,
 		},
 		{
-			name: "fail to get repository",
+			name: "annotated tag",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
-					mock.GetReposByOwnerByRepo,
-					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
-						w.WriteHeader(http.StatusNotFound)
-						_, _ = w.Write([]byte(`{"message": "Repository not found"}`))
+					postGraphQL,
+					mockGraphQL(t, graphQLExchange{
+						query: "FileBlame",
+						expectedVariables: map[string]any{
+							"expression": "v1.2.0",
+						},
+						data: map[string]any{
+							"repository": map[string]any{
+								"object": map[string]any{
+									"__typename": "Tag",
+									"target":     mainBlame["repository"].(map[string]any)["object"],
+								},
+							},
+						},
 					}),
 				),
 			),
 			requestArgs: map[string]interface{}{
-				"owner":  "owner",
-				"repo":   "nonexistent-repo",
-				"branch": "new-feature",
+				"owner":    "owner",
+				"repo":     "repo",
+				"path":     "cmd/main.go",
+				"ref":      "v1.2.0",
+				"end_line": float64(10),
+			},
+			expectedBlame: fileBlame{
+				Path: "cmd/main.go",
+				Ref:  "v1.2.0",
+				Ranges: []blameRange{
+					{StartingLine: 1, EndingLine: 10, CommitSHA: "aaa111", Author: "alice", CommittedDate: committed, Message: "Initial commit"},
+				},
 			},
-			expectError:    true,
-			expectedErrMsg: "failed to get repository",
 		},
 		{
-			name: "fail to get reference",
+			name: "start line only",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
-					mock.GetReposGitRefByOwnerByRepoByRef,
-					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
-						w.WriteHeader(http.StatusNotFound)
-						_, _ = w.Write([]byte(`{"message": "Reference not found"}`))
+					postGraphQL,
+					mockGraphQL(t, graphQLExchange{query: "FileBlame", data: mainBlame}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"path":       "cmd/main.go",
+				"start_line": float64(20),
+			},
+			expectedBlame: fileBlame{
+				Path: "cmd/main.go",
+				Ref:  "HEAD",
+				Ranges: []blameRange{
+					{StartingLine: 15, EndingLine: 40, CommitSHA: "ccc333", Author: "bob", CommittedDate: committed, Message: "Add flags"},
+				},
+			},
+		},
+		{
+			name: "start line past the end of the file",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockGraphQL(t, graphQLExchange{query: "FileBlame", data: mainBlame}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"path":       "cmd/main.go",
+				"start_line": float64(41),
+			},
+			expectedErrMsg: "start_line 41 is past the end of cmd/main.go, which has 40 lines",
+		},
+		{
+			name:         "end line before start line",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"path":       "cmd/main.go",
+				"start_line": float64(10),
+				"end_line":   float64(5),
+			},
+			expectedErrMsg: "end_line must not be before start_line",
+		},
+		{
+			name: "missing or binary file",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockGraphQL(t, graphQLExchange{query: "FileBlame", data: blameData()}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"path":  "logo.png",
+			},
+			expectedErrMsg: "failed to get blame: logo.png does not exist at HEAD or is a binary file",
+		},
+		{
+			name: "ref not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockGraphQL(t, graphQLExchange{
+						query: "FileBlame",
+						data: map[string]any{
+							"repository": map[string]any{"object": nil},
+						},
 					}),
 				),
 			),
 			requestArgs: map[string]interface{}{
-				"owner":       "owner",
-				"repo":        "repo",
-				"branch":      "new-feature",
-				"from_branch": "nonexistent-branch",
+				"owner": "owner",
+				"repo":  "repo",
+				"path":  "cmd/main.go",
+				"ref":   "nope",
 			},
-			expectError:    true,
-			expectedErrMsg: "failed to get reference",
+			expectedErrMsg: `failed to get blame: ref "nope" not found in owner/repo`,
 		},
 		{
-			name: "fail to create branch",
+			name: "ref is not a commit",
 			mockedClient: mock.NewMockedHTTPClient(
-				mock.WithRequestMatch(
-					mock.GetReposGitRefByOwnerByRepoByRef,
-					mockSourceRef,
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockGraphQL(t, graphQLExchange{
+						query: "FileBlame",
+						data: map[string]any{
+							"repository": map[string]any{"object": map[string]any{"__typename": "Tree"}},
+						},
+					}),
 				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"path":  "cmd/main.go",
+				"ref":   "HEAD:cmd",
+			},
+			expectedErrMsg: `failed to get blame: ref "HEAD:cmd" does not point to a commit`,
+		},
+		{
+			name: "repository not found",
+			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
-					mock.PostReposGitRefsByOwnerByRepo,
-					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
-						w.WriteHeader(http.StatusUnprocessableEntity)
-						_, _ = w.Write([]byte(`{"message": "Reference already exists"}`))
+					postGraphQL,
+					mockGraphQL(t, graphQLExchange{
+						query:  "FileBlame",
+						data:   map[string]any{"repository": nil},
+						errors: []map[string]any{{"type": "NOT_FOUND", "message": "Could not resolve to a Repository with the name 'owner/missing'."}},
 					}),
 				),
 			),
 			requestArgs: map[string]interface{}{
-				"owner":       "owner",
-				"repo":        "repo",
-				"branch":      "existing-branch",
-				"from_branch": "main",
+				"owner": "owner",
+				"repo":  "missing",
+				"path":  "cmd/main.go",
 			},
-			expectError:    true,
-			expectedErrMsg: "failed to create branch",
+			expectedErrMsg: "failed to get blame: Could not resolve to a Repository with the name 'owner/missing'.",
 		},
 	}
 
@@ -445,208 +831,3817 @@ func Test_CreateBranch(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			// Setup client with mock
 			client := github.NewClient(tc.mockedClient)
-			_, handler := CreateBranch(stubGetClientFn(client), translations.NullTranslationHelper)
-
-			// Create call request
-			request := createMCPRequest(tc.requestArgs)
+			_, handler := GetBlame(stubGetClientFn(client), translations.NullTranslationHelper)
 
 			// Call handler
-			result, err := handler(context.Background(), request)
-
-			// Verify results
-			if tc.expectError {
-				require.Error(t, err)
-				assert.Contains(t, err.Error(), tc.expectedErrMsg)
-				return
-			}
-
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
 			require.NoError(t, err)
 
-			// Parse the result and get the text content if no error
 			textContent := getTextResult(t, result)
 
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
 			// Unmarshal and verify the result
-			var returnedRef github.Reference
-			err = json.Unmarshal([]byte(textContent.Text), &returnedRef)
+			var returnedBlame fileBlame
+			err = json.Unmarshal([]byte(textContent.Text), &returnedBlame)
 			require.NoError(t, err)
-			assert.Equal(t, *tc.expectedRef.Ref, *returnedRef.Ref)
-			assert.Equal(t, *tc.expectedRef.Object.SHA, *returnedRef.Object.SHA)
+			assert.Equal(t, tc.expectedBlame, returnedBlame)
 		})
 	}
 }
 
-func Test_ListCommits(t *testing.T) {
+func Test_ForkRepository(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
-	tool, _ := ListCommits(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	tool, _ := ForkRepository(stubGetClientFn(mockClient), translations.NullTranslationHelper)
 
-	assert.Equal(t, "list_commits", tool.Name)
+	assert.Equal(t, "fork_repository", tool.Name)
 	assert.NotEmpty(t, tool.Description)
 	assert.Contains(t, tool.InputSchema.Properties, "owner")
 	assert.Contains(t, tool.InputSchema.Properties, "repo")
-	assert.Contains(t, tool.InputSchema.Properties, "sha")
-	assert.Contains(t, tool.InputSchema.Properties, "page")
-	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.Contains(t, tool.InputSchema.Properties, "organization")
+	assert.Contains(t, tool.InputSchema.Properties, "name")
+	assert.Contains(t, tool.InputSchema.Properties, "default_branch_only")
+	assert.Contains(t, tool.InputSchema.Properties, "wait")
 	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
 
-	// Setup mock commits for success case
-	mockCommits := []*github.RepositoryCommit{
-		{
-			SHA: github.Ptr("abc123def456"),
-			Commit: &github.Commit{
-				Message: github.Ptr("First commit"),
-				Author: &github.CommitAuthor{
-					Name:  github.Ptr("Test User"),
-					Email: github.Ptr("test@example.com"),
-					Date:  &github.Timestamp{Time: time.Now().Add(-48 * time.Hour)},
-				},
-			},
-			Author: &github.User{
-				Login: github.Ptr("testuser"),
-			},
-			HTMLURL: github.Ptr("https://github.com/owner/repo/commit/abc123def456"),
-		},
-		{
-			SHA: github.Ptr("def456abc789"),
-			Commit: &github.Commit{
-				Message: github.Ptr("Second commit"),
-				Author: &github.CommitAuthor{
-					Name:  github.Ptr("Another User"),
-					Email: github.Ptr("another@example.com"),
-					Date:  &github.Timestamp{Time: time.Now().Add(-24 * time.Hour)},
-				},
-			},
-			Author: &github.User{
-				Login: github.Ptr("anotheruser"),
-			},
-			HTMLURL: github.Ptr("https://github.com/owner/repo/commit/def456abc789"),
+	// Setup mock forked repo for success case
+	mockForkedRepo := &github.Repository{
+		ID:       github.Ptr(int64(123456)),
+		Name:     github.Ptr("repo"),
+		FullName: github.Ptr("new-owner/repo"),
+		Owner: &github.User{
+			Login: github.Ptr("new-owner"),
 		},
+		HTMLURL:       github.Ptr("https://github.com/new-owner/repo"),
+		CloneURL:      github.Ptr("https://github.com/new-owner/repo.git"),
+		SSHURL:        github.Ptr("git@github.com:new-owner/repo.git"),
+		DefaultBranch: github.Ptr("main"),
+		Fork:          github.Ptr(true),
+		ForksCount:    github.Ptr(0),
+	}
+	expectedFork := forkedRepository{
+		FullName:      "new-owner/repo",
+		HTMLURL:       "https://github.com/new-owner/repo",
+		CloneURL:      "https://github.com/new-owner/repo.git",
+		SSHURL:        "git@github.com:new-owner/repo.git",
+		DefaultBranch: "main",
+	}
+	withFork := func(update func(fork *forkedRepository)) forkedRepository {
+		fork := expectedFork
+		update(&fork)
+		return fork
+	}
+
+	// Poll quickly, and give up quickly on forks that never become ready.
+	defer func(timeout, interval time.Duration) {
+		forkReadyTimeout, forkPollInterval = timeout, interval
+	}(forkReadyTimeout, forkPollInterval)
+	forkReadyTimeout, forkPollInterval = 100*time.Millisecond, time.Millisecond
+
+	// branchHandler answers that the default branch of the fork does not exist until it was
+	// requested the given number of times.
+	branchHandler := func(notFound int) http.HandlerFunc {
+		requests := 0
+		return func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, "/repos/new-owner/repo/branches/main", r.URL.Path)
+			requests++
+			if notFound < 0 || requests <= notFound {
+				mockResponse(t, http.StatusNotFound, map[string]string{"message": "Branch not found"})(w, r)
+				return
+			}
+			mockResponse(t, http.StatusOK, &github.Branch{Name: github.Ptr("main")})(w, r)
+		}
 	}
 
 	tests := []struct {
-		name            string
-		mockedClient    *http.Client
-		requestArgs     map[string]interface{}
-		expectError     bool
-		expectedCommits []*github.RepositoryCommit
-		expectedErrMsg  string
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedFork   forkedRepository
+		expectedErrMsg string
 	}{
 		{
-			name: "successful commits fetch with default params",
+			name: "successful repository fork",
 			mockedClient: mock.NewMockedHTTPClient(
-				mock.WithRequestMatch(
-					mock.GetReposCommitsByOwnerByRepo,
-					mockCommits,
+				mock.WithRequestMatchHandler(
+					mock.PostReposForksByOwnerByRepo,
+					mockResponse(t, http.StatusAccepted, mockForkedRepo),
 				),
 			),
 			requestArgs: map[string]interface{}{
 				"owner": "owner",
 				"repo":  "repo",
 			},
-			expectError:     false,
-			expectedCommits: mockCommits,
+			expectError: false,
+			expectedFork: withFork(func(fork *forkedRepository) {
+				fork.Note = "Fork is in progress, it can take a few moments before it can be used"
+			}),
 		},
 		{
-			name: "successful commits fetch with branch",
+			name: "fork with new name and wait until ready",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
-					mock.GetReposCommitsByOwnerByRepo,
-					expectQueryParams(t, map[string]string{
-						"sha":      "main",
-						"page":     "1",
-						"per_page": "30",
+					mock.PostReposForksByOwnerByRepo,
+					expectRequestBody(t, map[string]interface{}{
+						"organization":        "new-owner",
+						"name":                "repo",
+						"default_branch_only": true,
 					}).andThen(
-						mockResponse(t, http.StatusOK, mockCommits),
+						mockResponse(t, http.StatusAccepted, mockForkedRepo),
 					),
 				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposBranchesByOwnerByRepoByBranch,
+					branchHandler(2),
+				),
 			),
 			requestArgs: map[string]interface{}{
-				"owner": "owner",
-				"repo":  "repo",
-				"sha":   "main",
+				"owner":               "owner",
+				"repo":                "upstream-repo",
+				"organization":        "new-owner",
+				"name":                "repo",
+				"default_branch_only": true,
+				"wait":                true,
 			},
-			expectError:     false,
-			expectedCommits: mockCommits,
+			expectError: false,
+			expectedFork: withFork(func(fork *forkedRepository) {
+				fork.Ready = true
+			}),
 		},
 		{
-			name: "successful commits fetch with pagination",
+			name: "fork not ready in time",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
-					mock.GetReposCommitsByOwnerByRepo,
-					expectQueryParams(t, map[string]string{
-						"page":     "2",
-						"per_page": "10",
-					}).andThen(
-						mockResponse(t, http.StatusOK, mockCommits),
-					),
+					mock.PostReposForksByOwnerByRepo,
+					mockResponse(t, http.StatusAccepted, mockForkedRepo),
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposBranchesByOwnerByRepoByBranch,
+					branchHandler(-1),
 				),
 			),
 			requestArgs: map[string]interface{}{
-				"owner":   "owner",
-				"repo":    "repo",
-				"page":    float64(2),
-				"perPage": float64(10),
+				"owner": "owner",
+				"repo":  "repo",
+				"wait":  true,
 			},
-			expectError:     false,
-			expectedCommits: mockCommits,
+			expectError: false,
+			expectedFork: withFork(func(fork *forkedRepository) {
+				fork.Note = "Fork is still in progress after 100ms"
+			}),
 		},
 		{
-			name: "commits fetch fails",
+			name: "repository fork fails",
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
-					mock.GetReposCommitsByOwnerByRepo,
+					mock.PostReposForksByOwnerByRepo,
 					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
-						w.WriteHeader(http.StatusNotFound)
-						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+						w.WriteHeader(http.StatusForbidden)
+						_, _ = w.Write([]byte(`{"message": "Forbidden"}`))
 					}),
 				),
 			),
 			requestArgs: map[string]interface{}{
 				"owner": "owner",
-				"repo":  "nonexistent-repo",
+				"repo":  "repo",
 			},
 			expectError:    true,
-			expectedErrMsg: "failed to list commits",
+			expectedErrMsg: "failed to fork repository",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ForkRepository(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+
+			// Parse the result and get the text content if no error
+			textContent := getTextResult(t, result)
+
+			// Unmarshal and verify the result
+			var returned forkedRepository
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedFork, returned)
+		})
+	}
+}
+
+func Test_ListForks(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListForks(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_forks", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "sort")
+	assert.Contains(t, tool.InputSchema.Properties, "active_since")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	pushedAt := func(day int) *github.Timestamp {
+		return &github.Timestamp{Time: time.Date(2025, 3, day, 12, 0, 0, 0, time.UTC)}
+	}
+	fork := func(owner string, stars, day int) *github.Repository {
+		return &github.Repository{
+			FullName:        github.Ptr(owner + "/repo"),
+			Owner:           &github.User{Login: github.Ptr(owner)},
+			StargazersCount: github.Ptr(stars),
+			PushedAt:        pushedAt(day),
+			DefaultBranch:   github.Ptr("main"),
+			HTMLURL:         github.Ptr("https://github.com/" + owner + "/repo"),
+		}
+	}
+	summary := func(owner string, stars, day int) forkSummary {
+		return forkSummary{
+			FullName:        owner + "/repo",
+			Owner:           owner,
+			StargazersCount: stars,
+			PushedAt:        pushedAt(day),
+			DefaultBranch:   "main",
+			HTMLURL:         "https://github.com/" + owner + "/repo",
+		}
+	}
+	// An active fork on the first page, followed by more forks than are searched.
+	truncatedPages := make([]any, 0, forkFilterLimit/100+1)
+	for i := 0; i <= forkFilterLimit/100; i++ {
+		page := make([]*github.Repository, 100)
+		for j := range page {
+			page[j] = fork(fmt.Sprintf("user%d-%d", i, j), 0, 1)
+		}
+		if i == 0 {
+			page[0] = fork("bob", 2, 20)
+		}
+		truncatedPages = append(truncatedPages, page)
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectedForks  []forkSummary
+		truncated      bool
+		expectedErrMsg string
+	}{
+		{
+			name: "list forks by stargazers",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposForksByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"sort":     "stargazers",
+						"page":     "1",
+						"per_page": "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, []*github.Repository{fork("alice", 10, 1), fork("bob", 2, 20)}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"sort":  "stargazers",
+			},
+			expectedForks: []forkSummary{summary("alice", 10, 1), summary("bob", 2, 20)},
+		},
+		{
+			name: "list forks active since a date across pages",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchPages(
+					mock.GetReposForksByOwnerByRepo,
+					[]*github.Repository{fork("alice", 10, 1), fork("bob", 2, 20)},
+					[]*github.Repository{fork("carol", 0, 15), fork("dave", 5, 14)},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"active_since": "2025-03-15",
+			},
+			expectedForks: []forkSummary{summary("bob", 2, 20), summary("carol", 0, 15)},
+		},
+		{
+			name: "no forks active since a date",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposForksByOwnerByRepo,
+					[]*github.Repository{fork("alice", 10, 1)},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"active_since": "2025-03-15T00:00:00Z",
+			},
+			expectedForks: []forkSummary{},
+		},
+		{
+			name: "active since a date stops at the limit",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchPages(
+					mock.GetReposForksByOwnerByRepo,
+					truncatedPages...,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"active_since": "2025-03-15",
+			},
+			expectedForks: []forkSummary{summary("bob", 2, 20)},
+			truncated:     true,
+		},
+		{
+			name: "active since a date with negative page",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposForksByOwnerByRepo,
+					[]*github.Repository{fork("alice", 10, 1)},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"active_since": "2025-03-15",
+				"page":         float64(-1),
+			},
+			expectedErrMsg: "page must be at least 1",
+		},
+		{
+			name:         "invalid active_since",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":        "owner",
+				"repo":         "repo",
+				"active_since": "yesterday",
+			},
+			expectedErrMsg: "invalid active_since: invalid ISO 8601 timestamp: yesterday",
+		},
+		{
+			name: "repository not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposForksByOwnerByRepo,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "missing",
+			},
+			expectedErrMsg: "failed to list forks: Not Found",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListForks(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+			require.False(t, result.IsError, textContent.Text)
+
+			// Unmarshal and verify the result
+			var returned forkList
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedForks, returned.Forks)
+			assert.Equal(t, tc.truncated, returned.Truncated)
+		})
+	}
+}
+
+func Test_CreateBranch(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CreateBranch(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "create_branch", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "branch")
+	assert.Contains(t, tool.InputSchema.Properties, "from_branch")
+	assert.Contains(t, tool.InputSchema.Properties, "from_sha")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "branch"})
+
+	// Setup mock repository for default branch test
+	mockRepo := &github.Repository{
+		DefaultBranch: github.Ptr("main"),
+	}
+
+	// Setup mock reference for from_branch tests
+	mockSourceRef := &github.Reference{
+		Ref: github.Ptr("refs/heads/main"),
+		Object: &github.GitObject{
+			SHA: github.Ptr("abc123def456"),
+		},
+	}
+
+	// Setup mock created reference
+	mockCreatedRef := &github.Reference{
+		Ref: github.Ptr("refs/heads/new-feature"),
+		Object: &github.GitObject{
+			SHA: github.Ptr("abc123def456"),
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedRef    *github.Reference
+		expectedErrMsg string
+		// expectedResultErrMsg is the message of an error returned as a tool result.
+		expectedResultErrMsg string
+	}{
+		{
+			name: "successful branch creation with from_branch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposGitRefByOwnerByRepoByRef,
+					mockSourceRef,
+				),
+				mock.WithRequestMatch(
+					mock.PostReposGitRefsByOwnerByRepo,
+					mockCreatedRef,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"branch":      "new-feature",
+				"from_branch": "main",
+			},
+			expectError: false,
+			expectedRef: mockCreatedRef,
+		},
+		{
+			name: "successful branch creation with default branch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposByOwnerByRepo,
+					mockRepo,
+				),
+				mock.WithRequestMatch(
+					mock.GetReposGitRefByOwnerByRepoByRef,
+					mockSourceRef,
+				),
+				mock.WithRequestMatchHandler(
+					mock.PostReposGitRefsByOwnerByRepo,
+					expectRequestBody(t, map[string]interface{}{
+						"ref": "refs/heads/new-feature",
+						"sha": "abc123def456",
+					}).andThen(
+						mockResponse(t, http.StatusCreated, mockCreatedRef),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "new-feature",
+			},
+			expectError: false,
+			expectedRef: mockCreatedRef,
+		},
+		{
+			name: "fail to get repository",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Repository not found"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "nonexistent-repo",
+				"branch": "new-feature",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get repository",
+		},
+		{
+			name: "fail to get reference",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposGitRefByOwnerByRepoByRef,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Reference not found"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"branch":      "new-feature",
+				"from_branch": "nonexistent-branch",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get reference",
+		},
+		{
+			name: "successful branch creation with from_sha",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposGitRefsByOwnerByRepo,
+					expectRequestBody(t, map[string]interface{}{
+						"ref": "refs/heads/new-feature",
+						"sha": "abc123def456",
+					}).andThen(
+						mockResponse(t, http.StatusCreated, mockCreatedRef),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"branch":   "new-feature",
+				"from_sha": "abc123def456",
+			},
+			expectError: false,
+			expectedRef: mockCreatedRef,
+		},
+		{
+			name: "branch already exists",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposGitRefByOwnerByRepoByRef,
+					mockSourceRef,
+					&github.Reference{
+						Ref:    github.Ptr("refs/heads/existing-branch"),
+						Object: &github.GitObject{SHA: github.Ptr("fed987cba654")},
+					},
+				),
+				mock.WithRequestMatchHandler(
+					mock.PostReposGitRefsByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusUnprocessableEntity)
+						_, _ = w.Write([]byte(`{"message": "Reference already exists"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"branch":      "existing-branch",
+				"from_branch": "main",
+			},
+			expectedResultErrMsg: `failed to create branch: branch "existing-branch" already exists at fed987cba654`,
+		},
+		{
+			name: "unknown from_sha",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposGitRefsByOwnerByRepo,
+					mockResponse(t, http.StatusUnprocessableEntity, map[string]string{"message": "Object does not exist"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"branch":   "new-feature",
+				"from_sha": "0000000",
+			},
+			expectedResultErrMsg: "failed to create branch: Object does not exist",
+		},
+		{
+			name:         "both from_branch and from_sha",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"branch":      "new-feature",
+				"from_branch": "main",
+				"from_sha":    "abc123def456",
+			},
+			expectedResultErrMsg: "only one of from_branch and from_sha can be provided",
+		},
+		{
+			name:         "branch name with refs prefix",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "refs/heads/new-feature",
+			},
+			expectedResultErrMsg: "give the name without the 'refs/heads/' prefix",
+		},
+		{
+			name:         "branch name with invalid characters",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "new feature",
+			},
+			expectedResultErrMsg: `invalid branch name "new feature"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := CreateBranch(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+
+			// Parse the result and get the text content if no error
+			textContent := getTextResult(t, result)
+
+			if tc.expectedResultErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedResultErrMsg)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedRef github.Reference
+			err = json.Unmarshal([]byte(textContent.Text), &returnedRef)
+			require.NoError(t, err)
+			assert.Equal(t, *tc.expectedRef.Ref, *returnedRef.Ref)
+			assert.Equal(t, *tc.expectedRef.Object.SHA, *returnedRef.Object.SHA)
+		})
+	}
+}
+
+func Test_DeleteBranch(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := DeleteBranch(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "delete_branch", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "branch")
+	assert.Contains(t, tool.InputSchema.Properties, "force")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "branch"})
+
+	mockRepo := &github.Repository{DefaultBranch: github.Ptr("main")}
+
+	// pullsHandler lists the given open pull requests from the branch, and into it.
+	pullsHandler := func(head, base []*github.PullRequest) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			query := r.URL.Query()
+			require.Equal(t, "open", query.Get("state"))
+			switch {
+			case query.Get("head") == "owner:feature":
+				mockResponse(t, http.StatusOK, head)(w, r)
+			case query.Get("base") == "feature":
+				mockResponse(t, http.StatusOK, base)(w, r)
+			default:
+				t.Errorf("unexpected query %s", r.URL.RawQuery)
+			}
+		}
+	}
+	headPR := &github.PullRequest{
+		Number:  github.Ptr(12),
+		Title:   github.Ptr("Add feature"),
+		HTMLURL: github.Ptr("https://github.com/owner/repo/pull/12"),
+	}
+	basePR := &github.PullRequest{
+		Number:  github.Ptr(15),
+		Title:   github.Ptr("Improve feature"),
+		HTMLURL: github.Ptr("https://github.com/owner/repo/pull/15"),
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectedResult deletedBranch
+		expectedErrMsg string
+	}{
+		{
+			name: "delete branch without pull requests",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposByOwnerByRepo,
+					mockRepo,
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposPullsByOwnerByRepo,
+					pullsHandler(nil, nil),
+				),
+				mock.WithRequestMatchHandler(
+					mock.DeleteReposGitRefsByOwnerByRepoByRef,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						require.Equal(t, "/repos/owner/repo/git/refs/heads/feature", r.URL.Path)
+						w.WriteHeader(http.StatusNoContent)
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "feature",
+			},
+			expectedResult: deletedBranch{Branch: "feature", Deleted: true},
+		},
+		{
+			name: "branch with open pull requests",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposByOwnerByRepo,
+					mockRepo,
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposPullsByOwnerByRepo,
+					pullsHandler([]*github.PullRequest{headPR}, []*github.PullRequest{basePR}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "feature",
+			},
+			expectedErrMsg: `refusing to delete branch "feature": it has open pull requests #12 "Add feature" (head), #15 "Improve feature" (base), set force to delete it anyway`,
+		},
+		{
+			name: "force delete branch with open pull requests",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposByOwnerByRepo,
+					mockRepo,
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposPullsByOwnerByRepo,
+					pullsHandler([]*github.PullRequest{headPR}, []*github.PullRequest{basePR}),
+				),
+				mock.WithRequestMatchHandler(
+					mock.DeleteReposGitRefsByOwnerByRepoByRef,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNoContent)
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "feature",
+				"force":  true,
+			},
+			expectedResult: deletedBranch{Branch: "feature", Deleted: true, ClosedPullRequests: []int{12, 15}},
+		},
+		{
+			name: "default branch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposByOwnerByRepo,
+					mockRepo,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "main",
+				"force":  true,
+			},
+			expectedErrMsg: `refusing to delete branch "main": it is the default branch of the repository`,
+		},
+		{
+			name: "protected branch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposByOwnerByRepo,
+					mockRepo,
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposPullsByOwnerByRepo,
+					pullsHandler(nil, nil),
+				),
+				mock.WithRequestMatchHandler(
+					mock.DeleteReposGitRefsByOwnerByRepoByRef,
+					mockResponse(t, http.StatusUnprocessableEntity, map[string]string{"message": "Cannot delete this protected branch"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "feature",
+			},
+			expectedErrMsg: "failed to delete branch: Cannot delete this protected branch",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := DeleteBranch(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+			require.False(t, result.IsError, textContent.Text)
+
+			// Unmarshal and verify the result
+			var returned deletedBranch
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
+
+func Test_RenameBranch(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := RenameBranch(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "rename_branch", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "branch")
+	assert.Contains(t, tool.InputSchema.Properties, "new_name")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "branch", "new_name"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectedResult renamedBranch
+		expectedErrMsg string
+	}{
+		{
+			name: "rename branch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposBranchesRenameByOwnerByRepoByBranch,
+					expectRequestBody(t, map[string]interface{}{
+						"new_name": "feature/login",
+					}).andThen(
+						mockResponse(t, http.StatusCreated, &github.Branch{
+							Name:   github.Ptr("feature/login"),
+							Commit: &github.RepositoryCommit{SHA: github.Ptr("abc123")},
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"branch":   "login",
+				"new_name": "feature/login",
+			},
+			expectedResult: renamedBranch{OldName: "login", NewName: "feature/login", SHA: "abc123"},
+		},
+		{
+			name:         "invalid new name",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"branch":   "login",
+				"new_name": "refs/heads/feature/login",
+			},
+			expectedErrMsg: "give the name without the 'refs/heads/' prefix",
+		},
+		{
+			name: "branch not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposBranchesRenameByOwnerByRepoByBranch,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Branch not found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":    "owner",
+				"repo":     "repo",
+				"branch":   "missing",
+				"new_name": "feature/login",
+			},
+			expectedErrMsg: "failed to rename branch: Branch not found",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := RenameBranch(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+			require.False(t, result.IsError, textContent.Text)
+
+			// Unmarshal and verify the result
+			var returned renamedBranch
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
+
+func Test_ValidateBranchName(t *testing.T) {
+	for _, branch := range []string{"main", "feature/login", "release/1.0", "fix-#123", "user@host"} {
+		assert.NoError(t, validateBranchName(branch), branch)
+	}
+	for _, branch := range []string{
+		"refs/heads/main", "-main", "@", "/main", "main/", "feature//login", "main.", "main.lock",
+		"a..b", "main@{1}", "feature/.hidden", "new feature", "a~1", "a^", "a:b", "a?", "a*", "a[b", `a\b`, "a\tb",
+	} {
+		assert.Error(t, validateBranchName(branch), branch)
+	}
+}
+
+func Test_ListCommits(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListCommits(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_commits", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "sha")
+	assert.Contains(t, tool.InputSchema.Properties, "branch")
+	assert.Contains(t, tool.InputSchema.Properties, "path")
+	assert.Contains(t, tool.InputSchema.Properties, "author")
+	assert.Contains(t, tool.InputSchema.Properties, "since")
+	assert.Contains(t, tool.InputSchema.Properties, "until")
+	assert.Contains(t, tool.InputSchema.Properties, "include_stats")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	// Setup mock commits for success case
+	mockCommits := []*github.RepositoryCommit{
+		{
+			SHA: github.Ptr("abc123def456"),
+			Commit: &github.Commit{
+				Message: github.Ptr("First commit\n\nWith a longer description."),
+				Author: &github.CommitAuthor{
+					Name:  github.Ptr("Test User"),
+					Email: github.Ptr("test@example.com"),
+					Date:  &github.Timestamp{Time: time.Now().Add(-48 * time.Hour)},
+				},
+			},
+			Author: &github.User{
+				Login: github.Ptr("testuser"),
+			},
+			HTMLURL: github.Ptr("https://github.com/owner/repo/commit/abc123def456"),
+		},
+		{
+			SHA: github.Ptr("def456abc789"),
+			Commit: &github.Commit{
+				Message: github.Ptr("Second commit"),
+				Author: &github.CommitAuthor{
+					Name:  github.Ptr("Another User"),
+					Email: github.Ptr("another@example.com"),
+					Date:  &github.Timestamp{Time: time.Now().Add(-24 * time.Hour)},
+				},
+			},
+			Author: &github.User{
+				Login: github.Ptr("anotheruser"),
+			},
+			HTMLURL: github.Ptr("https://github.com/owner/repo/commit/def456abc789"),
+		},
+	}
+
+	tests := []struct {
+		name            string
+		mockedClient    *http.Client
+		requestArgs     map[string]interface{}
+		expectError     bool
+		expectedCommits []*github.RepositoryCommit
+		expectedStats   []*github.CommitStats
+		expectedErrMsg  string
+	}{
+		{
+			name: "successful commits fetch with default params",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposCommitsByOwnerByRepo,
+					mockCommits,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:     false,
+			expectedCommits: mockCommits,
+		},
+		{
+			name: "successful commits fetch with branch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"sha":      "main",
+						"page":     "1",
+						"per_page": "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockCommits),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"sha":   "main",
+			},
+			expectError:     false,
+			expectedCommits: mockCommits,
+		},
+		{
+			name: "successful commits fetch with pagination",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"page":     "2",
+						"per_page": "10",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockCommits),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"page":    float64(2),
+				"perPage": float64(10),
+			},
+			expectError:     false,
+			expectedCommits: mockCommits,
+		},
+		{
+			name: "successful commits fetch with filters",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"sha":      "release",
+						"path":     "docs/README.md",
+						"author":   "testuser",
+						"since":    "2024-01-01T00:00:00Z",
+						"until":    "2024-02-01T12:00:00Z",
+						"page":     "1",
+						"per_page": "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockCommits),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "release",
+				"path":   "docs/README.md",
+				"author": "testuser",
+				"since":  "2024-01-01",
+				"until":  "2024-02-01T12:00:00Z",
+			},
+			expectError:     false,
+			expectedCommits: mockCommits,
+		},
+		{
+			name: "successful commits fetch with stats",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposCommitsByOwnerByRepo,
+					mockCommits,
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsByOwnerByRepoByRef,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						stats := map[string]*github.CommitStats{
+							"/repos/owner/repo/commits/abc123def456": {Additions: github.Ptr(10), Deletions: github.Ptr(2), Total: github.Ptr(12)},
+							"/repos/owner/repo/commits/def456abc789": {Additions: github.Ptr(1), Deletions: github.Ptr(0), Total: github.Ptr(1)},
+						}
+						require.Contains(t, stats, r.URL.Path)
+						mockResponse(t, http.StatusOK, &github.RepositoryCommit{Stats: stats[r.URL.Path]})(w, r)
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":         "owner",
+				"repo":          "repo",
+				"include_stats": true,
+			},
+			expectError:     false,
+			expectedCommits: mockCommits,
+			expectedStats: []*github.CommitStats{
+				{Additions: github.Ptr(10), Deletions: github.Ptr(2), Total: github.Ptr(12)},
+				{Additions: github.Ptr(1), Deletions: github.Ptr(0), Total: github.Ptr(1)},
+			},
+		},
+		{
+			name:         "invalid since",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"since": "last week",
+			},
+			expectError:    false,
+			expectedErrMsg: "invalid since: invalid ISO 8601 timestamp: last week",
+		},
+		{
+			name:         "until before since",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"since": "2024-02-01",
+				"until": "2024-01-01",
+			},
+			expectError:    false,
+			expectedErrMsg: "until must not be before since",
+		},
+		{
+			name:         "both sha and branch",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"sha":    "abc123def456",
+				"branch": "main",
+			},
+			expectError:    false,
+			expectedErrMsg: "only one of sha and branch can be provided",
+		},
+		{
+			name: "empty repository",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsByOwnerByRepo,
+					mockResponse(t, http.StatusConflict, map[string]string{"message": "Git Repository is empty."}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    false,
+			expectedErrMsg: "failed to list commits: owner/repo is empty, nothing has been pushed to it yet",
+		},
+		{
+			name: "commits fetch fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "nonexistent-repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list commits",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListCommits(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+
+			// Verify results
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+
+			if tc.expectedErrMsg != "" {
+				require.True(t, result.IsError)
+				assert.Contains(t, getTextResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
+			// Parse the result and get the text content if no error
+			textContent := getTextResult(t, result)
+
+			// Unmarshal and verify the result
+			var returnedCommits []commitSummary
+			err = json.Unmarshal([]byte(textContent.Text), &returnedCommits)
+			require.NoError(t, err)
+			assert.Len(t, returnedCommits, len(tc.expectedCommits))
+			for i, commit := range returnedCommits {
+				message, _, _ := strings.Cut(*tc.expectedCommits[i].Commit.Message, "\n")
+				assert.Equal(t, *tc.expectedCommits[i].SHA, commit.SHA)
+				assert.Equal(t, message, commit.Message)
+				assert.Equal(t, *tc.expectedCommits[i].Author.Login, commit.Author)
+				assert.Equal(t, *tc.expectedCommits[i].HTMLURL, commit.HTMLURL)
+				if tc.expectedStats != nil {
+					assert.Equal(t, tc.expectedStats[i], commit.Stats)
+				} else {
+					assert.Nil(t, commit.Stats)
+				}
+			}
+		})
+	}
+}
+
+func Test_GetCommit(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetCommit(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_commit", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "sha")
+	assert.Contains(t, tool.InputSchema.Properties, "include_patch")
+	assert.Contains(t, tool.InputSchema.Properties, "patch_limit")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "sha"})
+
+	date := &github.Timestamp{Time: time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)}
+	mockCommit := &github.RepositoryCommit{
+		SHA: github.Ptr("abc123def456"),
+		Commit: &github.Commit{
+			Message:   github.Ptr("Fix parser\n\nHandle empty input."),
+			Author:    &github.CommitAuthor{Name: github.Ptr("Test User"), Email: github.Ptr("test@example.com"), Date: date},
+			Committer: &github.CommitAuthor{Name: github.Ptr("GitHub"), Email: github.Ptr("noreply@github.com"), Date: date},
+			Verification: &github.SignatureVerification{
+				Verified: github.Ptr(true),
+				Reason:   github.Ptr("valid"),
+			},
+		},
+		Author:    &github.User{Login: github.Ptr("testuser")},
+		Committer: &github.User{Login: github.Ptr("web-flow")},
+		Parents:   []*github.Commit{{SHA: github.Ptr("parent1")}},
+		Stats:     &github.CommitStats{Additions: github.Ptr(3), Deletions: github.Ptr(1), Total: github.Ptr(4)},
+		Files: []*github.CommitFile{
+			{
+				Filename:  github.Ptr("parser.go"),
+				Status:    github.Ptr("modified"),
+				Additions: github.Ptr(3),
+				Deletions: github.Ptr(1),
+				SHA:       github.Ptr("file1"),
+				Patch:     github.Ptr("@@ -1,2 +1,4 @@\n-old\n+new"),
+			},
+		},
+		HTMLURL: github.Ptr("https://github.com/owner/repo/commit/abc123def456"),
+	}
+
+	tests := []struct {
+		name             string
+		mockedClient     *http.Client
+		requestArgs      map[string]interface{}
+		expectedPatch    string
+		expectTruncated  bool
+		expectFilesLimit bool
+		expectedErrMsg   string
+	}{
+		{
+			name: "get commit without patches",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposCommitsByOwnerByRepoByRef,
+					mockCommit,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"sha":   "abc123def456",
+			},
+		},
+		{
+			name: "get commit with patches",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposCommitsByOwnerByRepoByRef,
+					mockCommit,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":         "owner",
+				"repo":          "repo",
+				"sha":           "abc123def456",
+				"include_patch": true,
+			},
+			expectedPatch: "@@ -1,2 +1,4 @@\n-old\n+new",
+		},
+		{
+			name: "get commit with truncated patches",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposCommitsByOwnerByRepoByRef,
+					mockCommit,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":         "owner",
+				"repo":          "repo",
+				"sha":           "abc123def456",
+				"include_patch": true,
+				"patch_limit":   float64(15),
+			},
+			expectedPatch:   "@@ -1,2 +1,4 @@",
+			expectTruncated: true,
+		},
+		{
+			name: "get commit with more files than the API lists",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsByOwnerByRepoByRef,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						w.Header().Set("Link", `<https://api.github.com/repos/owner/repo/commits/abc123def456?page=2>; rel="next"`)
+						mockResponse(t, http.StatusOK, mockCommit)(w, r)
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"sha":   "main",
+			},
+			expectFilesLimit: true,
+		},
+		{
+			name: "unknown commit",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsByOwnerByRepoByRef,
+					mockResponse(t, http.StatusUnprocessableEntity, map[string]string{"message": "No commit found for SHA: nope"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"sha":   "nope",
+			},
+			expectedErrMsg: "failed to get commit: No commit found for SHA: nope",
+		},
+		{
+			name:         "negative patch limit",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":         "owner",
+				"repo":          "repo",
+				"sha":           "abc123",
+				"include_patch": true,
+				"patch_limit":   float64(-1),
+			},
+			expectedErrMsg: "patch_limit must be at least 1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetCommit(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectedErrMsg != "" {
+				require.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			var returnedCommit commitDetails
+			err = json.Unmarshal([]byte(textContent.Text), &returnedCommit)
+			require.NoError(t, err)
+
+			assert.Equal(t, "abc123def456", returnedCommit.SHA)
+			assert.Equal(t, "Fix parser\n\nHandle empty input.", returnedCommit.Message)
+			assert.Equal(t, "testuser", returnedCommit.Author.Login)
+			assert.Equal(t, "test@example.com", returnedCommit.Author.Email)
+			assert.Equal(t, "web-flow", returnedCommit.Committer.Login)
+			assert.Equal(t, commitVerification{Verified: true, Reason: "valid"}, returnedCommit.Verification)
+			assert.Equal(t, []string{"parent1"}, returnedCommit.Parents)
+			assert.Equal(t, 4, returnedCommit.Stats.GetTotal())
+			assert.Equal(t, tc.expectFilesLimit, returnedCommit.FilesTruncated)
+
+			require.Len(t, returnedCommit.Files, 1)
+			assert.Equal(t, "parser.go", returnedCommit.Files[0].Filename)
+			assert.Equal(t, 3, returnedCommit.Files[0].Additions)
+			assert.Equal(t, 1, returnedCommit.Files[0].Deletions)
+			assert.Equal(t, tc.expectedPatch, returnedCommit.Files[0].Patch)
+			assert.Equal(t, tc.expectTruncated, returnedCommit.Files[0].PatchTruncated)
+		})
+	}
+}
+
+func Test_ListBranches(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListBranches(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_branches", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "protected")
+	assert.Contains(t, tool.InputSchema.Properties, "match")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	branch := func(name, sha string, protected bool) *github.Branch {
+		return &github.Branch{
+			Name:      github.Ptr(name),
+			Commit:    &github.RepositoryCommit{SHA: github.Ptr(sha)},
+			Protected: github.Ptr(protected),
+		}
+	}
+	mockBranches := []*github.Branch{
+		branch("main", "abc123", true),
+		branch("release/1.0", "def456", true),
+		branch("feature/login", "789abc", false),
+	}
+	// A matching branch on the first page, followed by more branches than are searched.
+	truncatedPages := make([]any, 0, branchMatchLimit/100+1)
+	for i := 0; i <= branchMatchLimit/100; i++ {
+		page := make([]*github.Branch, 100)
+		for j := range page {
+			page[j] = branch(fmt.Sprintf("feature/%d-%d", i, j), "abc123", false)
+		}
+		if i == 0 {
+			page[0] = mockBranches[1]
+		}
+		truncatedPages = append(truncatedPages, page)
+	}
+
+	tests := []struct {
+		name             string
+		mockedClient     *http.Client
+		requestArgs      map[string]interface{}
+		expectedBranches []branchSummary
+		truncated        bool
+		expectedErrMsg   string
+	}{
+		{
+			name: "list branches with minimal parameters",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposBranchesByOwnerByRepo,
+					mockBranches,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectedBranches: []branchSummary{
+				{Name: "main", SHA: "abc123", Protected: true},
+				{Name: "release/1.0", SHA: "def456", Protected: true},
+				{Name: "feature/login", SHA: "789abc", Protected: false},
+			},
+		},
+		{
+			name: "list protected branches",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposBranchesByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"protected": "true",
+						"page":      "2",
+						"per_page":  "2",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockBranches[:2]),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":     "owner",
+				"repo":      "repo",
+				"protected": true,
+				"page":      float64(2),
+				"perPage":   float64(2),
+			},
+			expectedBranches: []branchSummary{
+				{Name: "main", SHA: "abc123", Protected: true},
+				{Name: "release/1.0", SHA: "def456", Protected: true},
+			},
+		},
+		{
+			name: "list branches matching a substring across pages",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchPages(
+					mock.GetReposBranchesByOwnerByRepo,
+					mockBranches,
+					[]*github.Branch{
+						branch("release/1.1", "111111", false),
+						branch("docs", "222222", false),
+						branch("release/2.0", "333333", false),
+					},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"match":   "release/",
+				"page":    float64(2),
+				"perPage": float64(2),
+			},
+			expectedBranches: []branchSummary{
+				{Name: "release/2.0", SHA: "333333", Protected: false},
+			},
+		},
+		{
+			name: "no branches matching",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposBranchesByOwnerByRepo,
+					mockBranches,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"match": "hotfix",
+			},
+			expectedBranches: []branchSummary{},
+		},
+		{
+			name: "matching stops at the limit",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchPages(
+					mock.GetReposBranchesByOwnerByRepo,
+					truncatedPages...,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"match": "release/",
+			},
+			expectedBranches: []branchSummary{
+				{Name: "release/1.0", SHA: "def456", Protected: true},
+			},
+			truncated: true,
+		},
+		{
+			name: "matching with negative page",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposBranchesByOwnerByRepo,
+					mockBranches,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"match": "release/",
+				"page":  float64(-1),
+			},
+			expectedErrMsg: "page must be at least 1",
+		},
+		{
+			name: "repository not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposBranchesByOwnerByRepo,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "missing",
+			},
+			expectedErrMsg: "failed to list branches: Not Found",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListBranches(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+			require.False(t, result.IsError, textContent.Text)
+
+			// Unmarshal and verify the result
+			var returned branchList
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedBranches, returned.Branches)
+			assert.Equal(t, tc.truncated, returned.Truncated)
+		})
+	}
+}
+
+func Test_ListTags(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListTags(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_tags", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "prefix")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	tag := func(name, sha string) *github.RepositoryTag {
+		return &github.RepositoryTag{
+			Name:       github.Ptr(name),
+			Commit:     &github.Commit{SHA: github.Ptr(sha)},
+			TarballURL: github.Ptr("https://api.github.com/repos/owner/repo/tarball/refs/tags/" + name),
+		}
+	}
+	summary := func(name, sha string) tagSummary {
+		return tagSummary{
+			Name:       name,
+			SHA:        sha,
+			TarballURL: "https://api.github.com/repos/owner/repo/tarball/refs/tags/" + name,
+		}
+	}
+	mockTags := []*github.RepositoryTag{
+		tag("v2.1.0", "abc123"),
+		tag("v2.0.0", "def456"),
+		tag("v1.9.0", "789abc"),
+	}
+	// A tag with the prefix on the first page, followed by more tags than are searched.
+	truncatedPages := make([]any, 0, tagMatchLimit/100+1)
+	for i := 0; i <= tagMatchLimit/100; i++ {
+		page := make([]*github.RepositoryTag, 100)
+		for j := range page {
+			page[j] = tag(fmt.Sprintf("v1.%d.%d", i, j), "abc123")
+		}
+		if i == 0 {
+			page[0] = mockTags[0]
+		}
+		truncatedPages = append(truncatedPages, page)
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectedTags   []tagSummary
+		truncated      bool
+		expectedErrMsg string
+	}{
+		{
+			name: "list tags with pagination",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposTagsByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"page":     "2",
+						"per_page": "3",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockTags),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"page":    float64(2),
+				"perPage": float64(3),
+			},
+			expectedTags: []tagSummary{
+				summary("v2.1.0", "abc123"),
+				summary("v2.0.0", "def456"),
+				summary("v1.9.0", "789abc"),
+			},
+		},
+		{
+			name: "list tags with a prefix across pages",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchPages(
+					mock.GetReposTagsByOwnerByRepo,
+					mockTags,
+					[]*github.RepositoryTag{
+						tag("v1.0.0", "111111"),
+						tag("nightly", "222222"),
+						tag("v2.0.0-rc.1", "333333"),
+					},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"prefix": "v2.",
+			},
+			expectedTags: []tagSummary{
+				summary("v2.1.0", "abc123"),
+				summary("v2.0.0", "def456"),
+				summary("v2.0.0-rc.1", "333333"),
+			},
+		},
+		{
+			name: "no tags with the prefix",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposTagsByOwnerByRepo,
+					mockTags,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"prefix": "v3.",
+			},
+			expectedTags: []tagSummary{},
+		},
+		{
+			name: "prefix stops at the limit",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchPages(
+					mock.GetReposTagsByOwnerByRepo,
+					truncatedPages...,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"prefix": "v2.",
+			},
+			expectedTags: []tagSummary{
+				summary("v2.1.0", "abc123"),
+			},
+			truncated: true,
+		},
+		{
+			name: "prefix with page size over the maximum",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposTagsByOwnerByRepo,
+					mockTags,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"prefix":  "v2.",
+				"perPage": float64(101),
+			},
+			expectedErrMsg: "perPage must be between 1 and 100",
+		},
+		{
+			name: "repository not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposTagsByOwnerByRepo,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "missing",
+			},
+			expectedErrMsg: "failed to list tags: Not Found",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListTags(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+			require.False(t, result.IsError, textContent.Text)
+
+			// Unmarshal and verify the result
+			var returned tagList
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedTags, returned.Tags)
+			assert.Equal(t, tc.truncated, returned.Truncated)
+		})
+	}
+}
+
+func Test_GetTag(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetTag(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_tag", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "tag")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "tag"})
+
+	taggedAt := &github.Timestamp{Time: time.Date(2025, 4, 1, 9, 30, 0, 0, time.UTC)}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectedTag    tagDetails
+		expectedErrMsg string
+	}{
+		{
+			name: "lightweight tag",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposGitRefByOwnerByRepoByRef,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						require.Equal(t, "/repos/owner/repo/git/ref/tags/nightly", r.URL.Path)
+						mockResponse(t, http.StatusOK, &github.Reference{
+							Ref:    github.Ptr("refs/tags/nightly"),
+							Object: &github.GitObject{Type: github.Ptr("commit"), SHA: github.Ptr("abc123")},
+						})(w, r)
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"tag":   "nightly",
+			},
+			expectedTag: tagDetails{
+				Name: "nightly",
+				Type: "lightweight",
+				SHA:  "abc123",
+			},
+		},
+		{
+			name: "annotated tag",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposGitRefByOwnerByRepoByRef,
+					&github.Reference{
+						Ref:    github.Ptr("refs/tags/v1.2.0"),
+						Object: &github.GitObject{Type: github.Ptr("tag"), SHA: github.Ptr("tag456")},
+					},
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposGitTagsByOwnerByRepoByTagSha,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						require.Equal(t, "/repos/owner/repo/git/tags/tag456", r.URL.Path)
+						mockResponse(t, http.StatusOK, &github.Tag{
+							Tag:     github.Ptr("v1.2.0"),
+							SHA:     github.Ptr("tag456"),
+							Message: github.Ptr("Release 1.2.0\n"),
+							Tagger: &github.CommitAuthor{
+								Name:  github.Ptr("Release Bot"),
+								Email: github.Ptr("release@example.com"),
+								Date:  taggedAt,
+							},
+							Object: &github.GitObject{Type: github.Ptr("commit"), SHA: github.Ptr("abc123")},
+							Verification: &github.SignatureVerification{
+								Verified: github.Ptr(false),
+								Reason:   github.Ptr("unsigned"),
+							},
+						})(w, r)
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"tag":   "v1.2.0",
+			},
+			expectedTag: tagDetails{
+				Name:   "v1.2.0",
+				Type:   "annotated",
+				SHA:    "abc123",
+				TagSHA: "tag456",
+				Tagger: &commitIdentity{
+					Name:  "Release Bot",
+					Email: "release@example.com",
+					Date:  taggedAt,
+				},
+				Message:      "Release 1.2.0\n",
+				Verification: &commitVerification{Verified: false, Reason: "unsigned"},
+			},
+		},
+		{
+			name: "tag not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposGitRefByOwnerByRepoByRef,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"tag":   "v9.9.9",
+			},
+			expectedErrMsg: `failed to get tag: tag "v9.9.9" not found in owner/repo`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetTag(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+			require.False(t, result.IsError, textContent.Text)
+
+			// Unmarshal and verify the result
+			var returned tagDetails
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedTag, returned)
+		})
+	}
+}
+
+func Test_CreateTag(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CreateTag(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "create_tag", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "tag")
+	assert.Contains(t, tool.InputSchema.Properties, "sha")
+	assert.Contains(t, tool.InputSchema.Properties, "branch")
+	assert.Contains(t, tool.InputSchema.Properties, "message")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "tag"})
+
+	// refsHandler serves the given references by path, and answers 404 for any other.
+	refsHandler := func(refs map[string]*github.Reference) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			ref, ok := refs[r.URL.Path]
+			if !ok {
+				mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"})(w, r)
+				return
+			}
+			mockResponse(t, http.StatusOK, ref)(w, r)
+		}
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectedTag    createdTag
+		expectedErrMsg string
+	}{
+		{
+			name: "lightweight tag at a commit",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposGitRefByOwnerByRepoByRef,
+					refsHandler(nil),
+				),
+				mock.WithRequestMatchHandler(
+					mock.PostReposGitRefsByOwnerByRepo,
+					expectRequestBody(t, map[string]interface{}{
+						"ref": "refs/tags/v1.0.0",
+						"sha": "abc123",
+					}).andThen(
+						mockResponse(t, http.StatusCreated, &github.Reference{Ref: github.Ptr("refs/tags/v1.0.0")}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"tag":   "v1.0.0",
+				"sha":   "abc123",
+			},
+			expectedTag: createdTag{
+				Ref:  "refs/tags/v1.0.0",
+				Type: "lightweight",
+				SHA:  "abc123",
+			},
+		},
+		{
+			name: "annotated tag at the head of a branch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposGitRefByOwnerByRepoByRef,
+					refsHandler(map[string]*github.Reference{
+						"/repos/owner/repo/git/ref/heads/main": {
+							Ref:    github.Ptr("refs/heads/main"),
+							Object: &github.GitObject{Type: github.Ptr("commit"), SHA: github.Ptr("def456")},
+						},
+					}),
+				),
+				mock.WithRequestMatchHandler(
+					mock.PostReposGitTagsByOwnerByRepo,
+					expectRequestBody(t, map[string]interface{}{
+						"tag":     "v1.1.0",
+						"message": "Release 1.1.0",
+						"object":  "def456",
+						"type":    "commit",
+					}).andThen(
+						mockResponse(t, http.StatusCreated, &github.Tag{SHA: github.Ptr("tag789")}),
+					),
+				),
+				mock.WithRequestMatchHandler(
+					mock.PostReposGitRefsByOwnerByRepo,
+					expectRequestBody(t, map[string]interface{}{
+						"ref": "refs/tags/v1.1.0",
+						"sha": "tag789",
+					}).andThen(
+						mockResponse(t, http.StatusCreated, &github.Reference{Ref: github.Ptr("refs/tags/v1.1.0")}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"tag":     "v1.1.0",
+				"branch":  "main",
+				"message": "Release 1.1.0",
+			},
+			expectedTag: createdTag{
+				Ref:    "refs/tags/v1.1.0",
+				Type:   "annotated",
+				SHA:    "def456",
+				TagSHA: "tag789",
+			},
+		},
+		{
+			name: "tag already exists",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposGitRefByOwnerByRepoByRef,
+					refsHandler(map[string]*github.Reference{
+						"/repos/owner/repo/git/ref/tags/v1.0.0": {
+							Ref:    github.Ptr("refs/tags/v1.0.0"),
+							Object: &github.GitObject{Type: github.Ptr("tag"), SHA: github.Ptr("tag123")},
+						},
+					}),
+				),
+				mock.WithRequestMatch(
+					mock.GetReposGitTagsByOwnerByRepoByTagSha,
+					&github.Tag{
+						SHA:    github.Ptr("tag123"),
+						Object: &github.GitObject{Type: github.Ptr("commit"), SHA: github.Ptr("abc123")},
+					},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"tag":   "v1.0.0",
+				"sha":   "def456",
+			},
+			expectedErrMsg: `failed to create tag: tag "v1.0.0" already exists at abc123`,
+		},
+		{
+			name: "unknown branch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposGitRefByOwnerByRepoByRef,
+					refsHandler(nil),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"tag":    "v1.0.0",
+				"branch": "missing",
+			},
+			expectedErrMsg: `failed to create tag: branch "missing" not found in owner/repo`,
+		},
+		{
+			name:         "neither sha nor branch",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"tag":   "v1.0.0",
+			},
+			expectedErrMsg: "exactly one of sha and branch must be provided",
+		},
+		{
+			name:         "invalid tag name",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"tag":   "refs/tags/v1.0.0",
+				"sha":   "abc123",
+			},
+			expectedErrMsg: `invalid tag name "refs/tags/v1.0.0": give the name without the 'refs/tags/' prefix`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := CreateTag(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+			require.False(t, result.IsError, textContent.Text)
+
+			// Unmarshal and verify the result
+			var returned createdTag
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedTag, returned)
+		})
+	}
+}
+
+func Test_CompareRefs(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CompareRefs(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "compare_refs", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "base")
+	assert.Contains(t, tool.InputSchema.Properties, "head")
+	assert.Contains(t, tool.InputSchema.Properties, "max_files")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "base", "head"})
+
+	commitDate := &github.Timestamp{Time: time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)}
+	mockComparison := &github.CommitsComparison{
+		Status:       github.Ptr("diverged"),
+		AheadBy:      github.Ptr(2),
+		BehindBy:     github.Ptr(1),
+		TotalCommits: github.Ptr(2),
+		HTMLURL:      github.Ptr("https://github.com/owner/repo/compare/main...feature"),
+		Commits: []*github.RepositoryCommit{
+			{
+				SHA: github.Ptr("abc123"),
+				Commit: &github.Commit{
+					Message: github.Ptr("Add feature\n\nWith a longer description"),
+					Author:  &github.CommitAuthor{Name: github.Ptr("Test User"), Date: commitDate},
+				},
+				Author: &github.User{Login: github.Ptr("testuser")},
+			},
+			{
+				SHA: github.Ptr("def456"),
+				Commit: &github.Commit{
+					Message: github.Ptr("Fix tests"),
+					Author:  &github.CommitAuthor{Name: github.Ptr("Another User"), Date: commitDate},
+				},
+			},
+		},
+		Files: []*github.CommitFile{
+			{Filename: github.Ptr("feature.go"), Status: github.Ptr("added"), Additions: github.Ptr(40)},
+			{Filename: github.Ptr("feature_test.go"), Status: github.Ptr("modified"), Additions: github.Ptr(10), Deletions: github.Ptr(2)},
+		},
+	}
+
+	// refsHandler serves the commit lookups of refs, which only exist for the given paths.
+	refsHandler := func(paths ...string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			for _, path := range paths {
+				if r.URL.Path == path {
+					_, _ = w.Write([]byte("abc123"))
+					return
+				}
+			}
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+		}
+	}
+
+	tests := []struct {
+		name               string
+		mockedClient       *http.Client
+		requestArgs        map[string]interface{}
+		expectedComparison refComparison
+		expectedErrMsg     string
+	}{
+		{
+			name: "compare branches with a file cap",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposCompareByOwnerByRepoByBasehead,
+					mockComparison,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":     "owner",
+				"repo":      "repo",
+				"base":      "main",
+				"head":      "feature",
+				"max_files": float64(1),
+			},
+			expectedComparison: refComparison{
+				Status:       "diverged",
+				AheadBy:      2,
+				BehindBy:     1,
+				TotalCommits: 2,
+				Commits: []comparedCommit{
+					{SHA: "abc123", Message: "Add feature", Author: "testuser", Date: commitDate},
+					{SHA: "def456", Message: "Fix tests", Author: "Another User", Date: commitDate},
+				},
+				TotalFiles: 2,
+				Files: []comparedFile{
+					{Filename: "feature.go", Status: "added", Additions: 40},
+				},
+				FilesTruncated: true,
+				HTMLURL:        "https://github.com/owner/repo/compare/main...feature",
+			},
+		},
+		{
+			name: "unknown head",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCompareByOwnerByRepoByBasehead,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsByOwnerByRepoByRef,
+					refsHandler("/repos/owner/repo/commits/main"),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"base":  "main",
+				"head":  "missing",
+			},
+			expectedErrMsg: `failed to compare refs: ref "missing" not found in owner/repo`,
+		},
+		{
+			name: "unknown branch of a fork",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCompareByOwnerByRepoByBasehead,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsByOwnerByRepoByRef,
+					refsHandler("/repos/owner/repo/commits/main"),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"base":  "main",
+				"head":  "contributor:feature",
+			},
+			expectedErrMsg: `failed to compare refs: ref "contributor:feature" not found in owner/repo`,
+		},
+		{
+			name: "refs exist but the comparison is not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCompareByOwnerByRepoByBasehead,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommitsByOwnerByRepoByRef,
+					refsHandler("/repos/owner/repo/commits/main", "/repos/contributor/repo/commits/feature"),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"base":  "main",
+				"head":  "contributor:feature",
+			},
+			expectedErrMsg: "failed to compare refs: Not Found",
+		},
+		{
+			name:         "negative file cap",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":     "owner",
+				"repo":      "repo",
+				"base":      "main",
+				"head":      "feature",
+				"max_files": float64(-1),
+			},
+			expectedErrMsg: "max_files must be at least 1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := CompareRefs(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+			require.False(t, result.IsError, textContent.Text)
+
+			// Unmarshal and verify the result
+			var returned refComparison
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedComparison, returned)
+		})
+	}
+}
+
+func Test_CompareCommits(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := CompareCommits(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "compare_commits", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "base")
+	assert.Contains(t, tool.InputSchema.Properties, "head")
+	assert.Contains(t, tool.InputSchema.Properties, "max_files")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "base", "head"})
+
+	commitDate := &github.Timestamp{Time: time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)}
+	mockComparison := &github.CommitsComparison{
+		Status:          github.Ptr("ahead"),
+		AheadBy:         github.Ptr(1),
+		BehindBy:        github.Ptr(0),
+		TotalCommits:    github.Ptr(1),
+		HTMLURL:         github.Ptr("https://github.com/owner/repo/compare/v1.0.0...v1.1.0"),
+		MergeBaseCommit: &github.RepositoryCommit{SHA: github.Ptr("base123")},
+		Commits: []*github.RepositoryCommit{
+			{
+				SHA: github.Ptr("abc123"),
+				Commit: &github.Commit{
+					Message: github.Ptr("Add feature\n\nWith a longer description"),
+					Author:  &github.CommitAuthor{Name: github.Ptr("Test User"), Date: commitDate},
+				},
+				Author: &github.User{Login: github.Ptr("testuser")},
+			},
+		},
+		Files: []*github.CommitFile{
+			{Filename: github.Ptr("feature.go"), Status: github.Ptr("added"), Additions: github.Ptr(40)},
+			{Filename: github.Ptr("CHANGELOG.md"), Status: github.Ptr("modified"), Additions: github.Ptr(3)},
+		},
+	}
+
+	tests := []struct {
+		name               string
+		mockedClient       *http.Client
+		requestArgs        map[string]interface{}
+		expectedComparison commitComparison
+		expectedErrMsg     string
+	}{
+		{
+			name: "compare tags with a file cap",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCompareByOwnerByRepoByBasehead,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						require.Equal(t, "/repos/owner/repo/compare/v1.0.0...v1.1.0", r.URL.Path)
+						mockResponse(t, http.StatusOK, mockComparison)(w, r)
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":     "owner",
+				"repo":      "repo",
+				"base":      "v1.0.0",
+				"head":      "v1.1.0",
+				"max_files": float64(1),
+			},
+			expectedComparison: commitComparison{
+				refComparison: refComparison{
+					Status:       "ahead",
+					AheadBy:      1,
+					TotalCommits: 1,
+					Commits: []comparedCommit{
+						{SHA: "abc123", Message: "Add feature", Author: "testuser", Date: commitDate},
+					},
+					TotalFiles: 2,
+					Files: []comparedFile{
+						{Filename: "feature.go", Status: "added", Additions: 40},
+					},
+					FilesTruncated: true,
+					HTMLURL:        "https://github.com/owner/repo/compare/v1.0.0...v1.1.0",
+				},
+				MergeBaseSHA: "base123",
+			},
+		},
+		{
+			name: "base and head are the same commit",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposCompareByOwnerByRepoByBasehead,
+					&github.CommitsComparison{
+						Status:          github.Ptr("identical"),
+						MergeBaseCommit: &github.RepositoryCommit{SHA: github.Ptr("same123")},
+					},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"base":  "v1.0.0",
+				"head":  "main",
+			},
+			expectedErrMsg: "failed to compare commits: base v1.0.0 and head main are the same commit same123",
+		},
+		{
+			name: "unknown commit",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCompareByOwnerByRepoByBasehead,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"base":  "v0.0.0",
+				"head":  "v1.1.0",
+			},
+			expectedErrMsg: "failed to compare commits: Not Found",
+		},
+		{
+			name:         "negative file cap",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":     "owner",
+				"repo":      "repo",
+				"base":      "v1.0.0",
+				"head":      "v1.1.0",
+				"max_files": float64(-1),
+			},
+			expectedErrMsg: "max_files must be at least 1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := CompareCommits(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectedErrMsg != "" {
+				require.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			var returnedComparison commitComparison
+			err = json.Unmarshal([]byte(textContent.Text), &returnedComparison)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedComparison, returnedComparison)
+		})
+	}
+}
+
+func Test_GetRepository(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetRepository(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_repository", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "full")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	mockRepo := &github.Repository{
+		ID:              github.Ptr(int64(12345)),
+		Name:            github.Ptr("new-repo"),
+		FullName:        github.Ptr("new-owner/new-repo"),
+		Description:     github.Ptr("A test repository"),
+		HTMLURL:         github.Ptr("https://github.com/new-owner/new-repo"),
+		DefaultBranch:   github.Ptr("main"),
+		Visibility:      github.Ptr("public"),
+		Topics:          []string{"go", "mcp"},
+		Archived:        github.Ptr(false),
+		License:         &github.License{Key: github.Ptr("mit"), SPDXID: github.Ptr("MIT")},
+		OpenIssuesCount: github.Ptr(7),
+		ForksCount:      github.Ptr(3),
+		StargazersCount: github.Ptr(42),
+		Permissions:     map[string]bool{"admin": false, "push": true, "pull": true},
+		HasIssues:       github.Ptr(true),
+		HasDiscussions:  github.Ptr(true),
+		HasWiki:         github.Ptr(false),
+	}
+	expectedSummary := repositorySummary{
+		FullName:        "new-owner/new-repo",
+		Description:     "A test repository",
+		HTMLURL:         "https://github.com/new-owner/new-repo",
+		DefaultBranch:   "main",
+		Visibility:      "public",
+		Topics:          []string{"go", "mcp"},
+		License:         "MIT",
+		OpenIssuesCount: 7,
+		ForksCount:      3,
+		StargazersCount: 42,
+		Permissions:     map[string]bool{"admin": false, "push": true, "pull": true},
+		HasIssues:       true,
+		HasDiscussions:  true,
+	}
+
+	// repositoryHandler redirects the old name of the repository to its new name, like the API
+	// does for renamed repositories.
+	repositoryHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/repos/old-owner/old-repo" {
+			w.Header().Set("Location", "/repos/new-owner/new-repo")
+			w.WriteHeader(http.StatusMovedPermanently)
+			return
+		}
+		mockResponse(t, http.StatusOK, mockRepo)(w, r)
+	})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectedResult repositorySummary
+		expectFull     bool
+		expectedErrMsg string
+	}{
+		{
+			name: "key fields",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposByOwnerByRepo,
+					repositoryHandler,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "new-owner",
+				"repo":  "new-repo",
+			},
+			expectedResult: expectedSummary,
+		},
+		{
+			name: "renamed repository",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposByOwnerByRepo,
+					repositoryHandler,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "old-owner",
+				"repo":  "old-repo",
+			},
+			expectedResult: func() repositorySummary {
+				summary := expectedSummary
+				summary.Note = "old-owner/old-repo was renamed or transferred to new-owner/new-repo, use the new name"
+				return summary
+			}(),
+		},
+		{
+			name: "full repository",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposByOwnerByRepo,
+					repositoryHandler,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "new-owner",
+				"repo":  "new-repo",
+				"full":  true,
+			},
+			expectFull: true,
+		},
+		{
+			name: "repository not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposByOwnerByRepo,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "missing",
+			},
+			expectedErrMsg: "failed to get repository: Not Found",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetRepository(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+			require.False(t, result.IsError, textContent.Text)
+
+			// Unmarshal and verify the result
+			if tc.expectFull {
+				var returned github.Repository
+				err = json.Unmarshal([]byte(textContent.Text), &returned)
+				require.NoError(t, err)
+				assert.Equal(t, *mockRepo.ID, *returned.ID)
+				assert.Equal(t, *mockRepo.FullName, *returned.FullName)
+				assert.Equal(t, *mockRepo.License.Key, *returned.License.Key)
+				return
+			}
+			var returned repositorySummary
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
+
+func Test_ListContributors(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListContributors(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_contributors", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "include_anonymous")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	// Retry quickly while the contributors are computed.
+	defer func(interval time.Duration) {
+		contributorsRetryInterval = interval
+	}(contributorsRetryInterval)
+	contributorsRetryInterval = time.Millisecond
+
+	mockContributors := []*github.Contributor{
+		{
+			Login:         github.Ptr("octocat"),
+			Contributions: github.Ptr(42),
+			HTMLURL:       github.Ptr("https://github.com/octocat"),
+			Type:          github.Ptr("User"),
+		},
+		{
+			Email:         github.Ptr("someone@example.com"),
+			Name:          github.Ptr("Someone"),
+			Contributions: github.Ptr(3),
+			Type:          github.Ptr("Anonymous"),
+		},
+	}
+	computing := mockResponse(t, http.StatusAccepted, map[string]string{})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectedResult contributorList
+		expectedErrMsg string
+	}{
+		{
+			name: "list contributors with anonymous ones",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposContributorsByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"anon":     "true",
+						"page":     "1",
+						"per_page": "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockContributors),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":             "owner",
+				"repo":              "repo",
+				"include_anonymous": true,
+			},
+			expectedResult: contributorList{
+				Contributors: []contributorSummary{
+					{Login: "octocat", Contributions: 42, HTMLURL: "https://github.com/octocat"},
+					{Email: "someone@example.com", Contributions: 3},
+				},
+			},
+		},
+		{
+			name: "contributors listed once computed",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposContributorsByOwnerByRepo,
+					mockSequence(t,
+						computing,
+						computing,
+						mockResponse(t, http.StatusOK, mockContributors[:1]),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectedResult: contributorList{
+				Contributors: []contributorSummary{
+					{Login: "octocat", Contributions: 42, HTMLURL: "https://github.com/octocat"},
+				},
+			},
+		},
+		{
+			name: "contributors still being computed",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposContributorsByOwnerByRepo,
+					mockSequence(t, computing, computing, computing, computing),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectedResult: contributorList{
+				Contributors: []contributorSummary{},
+				StatsPending: true,
+				Note:         "GitHub is still computing the contributors of owner/repo, retry in a few moments",
+			},
+		},
+		{
+			name: "repository not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposContributorsByOwnerByRepo,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "missing",
+			},
+			expectedErrMsg: "failed to list contributors: Not Found",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListContributors(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+			require.False(t, result.IsError, textContent.Text)
+
+			// Unmarshal and verify the result
+			var returned contributorList
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
+
+func Test_GetRepositoryTopics(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetRepositoryTopics(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_repository_topics", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectedTopics []string
+		expectedErrMsg string
+	}{
+		{
+			name: "get topics",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposTopicsByOwnerByRepo,
+					map[string][]string{"names": {"go", "mcp"}},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectedTopics: []string{"go", "mcp"},
+		},
+		{
+			name: "no topics",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposTopicsByOwnerByRepo,
+					map[string][]string{"names": {}},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectedTopics: []string{},
+		},
+		{
+			name: "repository not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposTopicsByOwnerByRepo,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "missing",
+			},
+			expectedErrMsg: "failed to get repository topics: Not Found",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetRepositoryTopics(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+			require.False(t, result.IsError, textContent.Text)
+
+			// Unmarshal and verify the result
+			var returned []string
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedTopics, returned)
+		})
+	}
+}
+
+func Test_ReplaceRepositoryTopics(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ReplaceRepositoryTopics(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "replace_repository_topics", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "topics")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "topics"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectedTopics []string
+		expectedErrMsg string
+	}{
+		{
+			name: "replace topics",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposTopicsByOwnerByRepo,
+					expectRequestBody(t, map[string]interface{}{
+						"names": []interface{}{"go", "model-context-protocol"},
+					}).andThen(
+						mockResponse(t, http.StatusOK, map[string][]string{"names": {"go", "model-context-protocol"}}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"topics": []interface{}{"go", "model-context-protocol"},
+			},
+			expectedTopics: []string{"go", "model-context-protocol"},
+		},
+		{
+			name: "remove all topics",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposTopicsByOwnerByRepo,
+					expectRequestBody(t, map[string]interface{}{
+						"names": []interface{}{},
+					}).andThen(
+						mockResponse(t, http.StatusOK, map[string][]string{"names": {}}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"topics": []interface{}{},
+			},
+			expectedTopics: []string{},
+		},
+		{
+			name:         "invalid topics are rejected before the call",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"topics": []interface{}{"go", "Go", "-cli", "c++", strings.Repeat("a", 51), "go"},
+			},
+			expectedErrMsg: `invalid topics: "Go": it must be lowercase; "-cli": it must start with a letter or number; ` +
+				`"c++": it can only contain letters, numbers and hyphens; "` + strings.Repeat("a", 51) + `": it is longer than 50 characters; ` +
+				`"go": it is listed more than once`,
+		},
+		{
+			name:         "too many topics",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"topics": func() []interface{} {
+					topics := make([]interface{}, 21)
+					for i := range topics {
+						topics[i] = fmt.Sprintf("topic-%d", i)
+					}
+					return topics
+				}(),
+			},
+			expectedErrMsg: "invalid topics: a repository can have at most 20 topics, got 21",
+		},
+		{
+			name:         "missing topics",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectedErrMsg: "missing required parameter: topics",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ReplaceRepositoryTopics(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+			require.False(t, result.IsError, textContent.Text)
+
+			// Unmarshal and verify the result
+			var returned []string
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedTopics, returned)
+		})
+	}
+}
+
+func Test_ArchiveRepository(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ArchiveRepository(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "archive_repository", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "confirm")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "confirm"})
+
+	openWork := func(archived bool, issues, pullRequests int) any {
+		return map[string]any{
+			"repository": map[string]any{
+				"isArchived":   archived,
+				"issues":       map[string]any{"totalCount": issues},
+				"pullRequests": map[string]any{"totalCount": pullRequests},
+			},
+		}
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectedResult archivedRepository
+		expectedErrMsg string
+	}{
+		{
+			name: "archive with open work",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockGraphQL(t, graphQLExchange{
+						query: "OpenWork",
+						expectedVariables: map[string]any{
+							"owner": "owner",
+							"repo":  "repo",
+						},
+						data: openWork(false, 12, 3),
+					}),
+				),
+				mock.WithRequestMatchHandler(
+					mock.PatchReposByOwnerByRepo,
+					expectRequestBody(t, map[string]any{
+						"archived": true,
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.Repository{
+							FullName: github.Ptr("owner/repo"),
+							Archived: github.Ptr(true),
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"confirm": true,
+			},
+			expectedResult: archivedRepository{
+				FullName:         "owner/repo",
+				Archived:         true,
+				OpenIssues:       12,
+				OpenPullRequests: 3,
+			},
+		},
+		{
+			name:         "not confirmed",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"confirm": false,
+			},
+			expectedErrMsg: "confirm must be set to true to archive a repository",
+		},
+		{
+			name: "already archived",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockGraphQL(t, graphQLExchange{
+						query: "OpenWork",
+						data:  openWork(true, 12, 3),
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"confirm": true,
+			},
+			expectedErrMsg: "failed to archive repository: owner/repo is already archived",
+		},
+		{
+			name: "repository not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockGraphQL(t, graphQLExchange{
+						query: "OpenWork",
+						data:  map[string]any{"repository": nil},
+						errors: []map[string]any{
+							{"type": "NOT_FOUND", "message": "Could not resolve to a Repository with the name 'owner/missing'."},
+						},
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "missing",
+				"confirm": true,
+			},
+			expectedErrMsg: "failed to get repository: Could not resolve to a Repository with the name 'owner/missing'.",
+		},
+		{
+			name: "not an admin",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					postGraphQL,
+					mockGraphQL(t, graphQLExchange{
+						query: "OpenWork",
+						data:  openWork(false, 0, 0),
+					}),
+				),
+				mock.WithRequestMatchHandler(
+					mock.PatchReposByOwnerByRepo,
+					mockResponse(t, http.StatusForbidden, map[string]string{"message": "Must have admin rights to Repository."}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"confirm": true,
+			},
+			expectedErrMsg: "failed to archive repository: Must have admin rights to Repository.",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ArchiveRepository(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+			require.False(t, result.IsError, textContent.Text)
+
+			var returned archivedRepository
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
+
+func Test_UnarchiveRepository(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := UnarchiveRepository(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "unarchive_repository", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectedText   string
+		expectedErrMsg string
+	}{
+		{
+			name: "unarchive",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposByOwnerByRepo,
+					expectRequestBody(t, map[string]any{
+						"archived": false,
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.Repository{
+							FullName: github.Ptr("owner/repo"),
+							Archived: github.Ptr(false),
+						}),
+					),
+				),
+			),
+			expectedText: `{"archived":false,"full_name":"owner/repo"}`,
+		},
+		{
+			name: "unarchiving restricted",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PatchReposByOwnerByRepo,
+					mockResponse(t, http.StatusForbidden, map[string]string{"message": "Repository cannot be unarchived via the API, unarchive it from the repository settings."}),
+				),
+			),
+			expectedErrMsg: "failed to unarchive repository: Repository cannot be unarchived via the API, unarchive it from the repository settings.",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := UnarchiveRepository(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			}))
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			assert.False(t, result.IsError)
+			assert.Equal(t, tc.expectedText, textContent.Text)
+		})
+	}
+}
+
+func Test_TransferRepository(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := TransferRepository(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "transfer_repository", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "new_owner")
+	assert.Contains(t, tool.InputSchema.Properties, "new_name")
+	assert.Contains(t, tool.InputSchema.Properties, "team_ids")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "new_owner"})
+
+	accepted := func(owner string) http.HandlerFunc {
+		return mockResponse(t, http.StatusAccepted, &github.Repository{
+			FullName: github.Ptr(owner + "/repo"),
+			Owner:    &github.User{Login: github.Ptr(owner)},
+		})
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectedResult transferredRepository
+		expectedErrMsg string
+	}{
+		{
+			name: "transfer to an organization",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetUsersByUsername,
+					&github.User{Login: github.Ptr("acme"), Type: github.Ptr("Organization")},
+				),
+				mock.WithRequestMatchHandler(
+					mock.PostReposTransferByOwnerByRepo,
+					expectRequestBody(t, map[string]any{
+						"new_owner": "acme",
+						"new_name":  "tool",
+						"team_ids":  []any{float64(7), float64(9)},
+					}).andThen(accepted("acme")),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":     "owner",
+				"repo":      "repo",
+				"new_owner": "acme",
+				"new_name":  "tool",
+				"team_ids":  []any{float64(7), float64(9)},
+			},
+			expectedResult: transferredRepository{
+				From:   "owner/repo",
+				To:     "acme/tool",
+				Status: "completed",
+			},
+		},
+		{
+			name: "transfer to a user",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetUsersByUsername,
+					&github.User{Login: github.Ptr("hubot"), Type: github.Ptr("User")},
+				),
+				mock.WithRequestMatchHandler(
+					mock.PostReposTransferByOwnerByRepo,
+					expectRequestBody(t, map[string]any{
+						"new_owner": "hubot",
+					}).andThen(accepted("owner")),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":     "owner",
+				"repo":      "repo",
+				"new_owner": "hubot",
+			},
+			expectedResult: transferredRepository{
+				From:   "owner/repo",
+				To:     "hubot/repo",
+				Status: "pending",
+				Note:   "hubot has to accept the transfer, until then the repository stays at owner/repo",
+			},
+		},
+		{
+			name:         "same owner",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":     "owner",
+				"repo":      "repo",
+				"new_owner": "Owner",
+			},
+			expectedErrMsg: "new_owner must be different from owner, owner/repo already belongs to owner",
+		},
+		{
+			name: "teams of a user",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetUsersByUsername,
+					&github.User{Login: github.Ptr("hubot"), Type: github.Ptr("User")},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":     "owner",
+				"repo":      "repo",
+				"new_owner": "hubot",
+				"team_ids":  []any{float64(7)},
+			},
+			expectedErrMsg: "team_ids can only be given when transferring to an organization, hubot is a user",
+		},
+		{
+			name: "new owner not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetUsersByUsername,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":     "owner",
+				"repo":      "repo",
+				"new_owner": "nobody",
+			},
+			expectedErrMsg: "failed to get new owner: Not Found",
+		},
+		{
+			name: "name taken at the new owner",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetUsersByUsername,
+					&github.User{Login: github.Ptr("acme"), Type: github.Ptr("Organization")},
+				),
+				mock.WithRequestMatchHandler(
+					mock.PostReposTransferByOwnerByRepo,
+					mockResponse(t, http.StatusUnprocessableEntity, map[string]string{"message": "Repository has already been taken"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":     "owner",
+				"repo":      "repo",
+				"new_owner": "acme",
+			},
+			expectedErrMsg: "failed to transfer repository: Repository has already been taken",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := TransferRepository(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+			require.False(t, result.IsError, textContent.Text)
+
+			var returned transferredRepository
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, returned)
+		})
+	}
+}
+
+func Test_RepositoryDispatch(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := RepositoryDispatch(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "create_repository_dispatch_event", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "event_type")
+	assert.Contains(t, tool.InputSchema.Properties, "client_payload")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "event_type"})
+
+	clientPayload := map[string]any{
+		"environment": "staging",
+		"unit":        false,
+		"retries":     float64(3),
+		"services":    []any{"api", "worker"},
+		"release": map[string]any{
+			"version": "1.2.0",
+			"notes":   nil,
+			"flags":   map[string]any{"canary": true},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectedText   string
+		expectedErrMsg string
+	}{
+		{
+			name: "dispatch with a payload",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposDispatchesByOwnerByRepo,
+					expectRequestBody(t, map[string]any{
+						"event_type":     "deploy",
+						"client_payload": clientPayload,
+					}).andThen(
+						http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+							w.WriteHeader(http.StatusNoContent)
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":          "owner",
+				"repo":           "repo",
+				"event_type":     "deploy",
+				"client_payload": clientPayload,
+			},
+			expectedText: `{"dispatched":true,"event_type":"deploy"}`,
+		},
+		{
+			name: "dispatch without a payload",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposDispatchesByOwnerByRepo,
+					expectRequestBody(t, map[string]any{
+						"event_type": "nightly",
+					}).andThen(
+						http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+							w.WriteHeader(http.StatusNoContent)
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"event_type": "nightly",
+			},
+			expectedText: `{"dispatched":true,"event_type":"nightly"}`,
+		},
+		{
+			name:         "payload too large",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":          "owner",
+				"repo":           "repo",
+				"event_type":     "deploy",
+				"client_payload": map[string]any{"data": strings.Repeat("a", dispatchPayloadLimit)},
+			},
+			expectedErrMsg: "client_payload is 65547 bytes when serialized, at most 65536 bytes are allowed",
+		},
+		{
+			name:         "payload not an object",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":          "owner",
+				"repo":           "repo",
+				"event_type":     "deploy",
+				"client_payload": []any{"api"},
+			},
+			expectedErrMsg: "parameter client_payload is not of type map[string]interface {}, is []interface {}",
+		},
+		{
+			name: "rejected by GitHub",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposDispatchesByOwnerByRepo,
+					mockResponse(t, http.StatusUnprocessableEntity, map[string]string{"message": "No more than 10 properties are allowed; 11 were supplied."}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"event_type": "deploy",
+			},
+			expectedErrMsg: "failed to create repository dispatch event: No more than 10 properties are allowed; 11 were supplied.",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := RepositoryDispatch(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			assert.False(t, result.IsError)
+			assert.Equal(t, tc.expectedText, textContent.Text)
+		})
+	}
+}
+
+func Test_GetRepositoryLanguages(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetRepositoryLanguages(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_repository_languages", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	tests := []struct {
+		name              string
+		languages         map[string]int
+		expectedLanguages repositoryLanguages
+	}{
+		{
+			name:      "languages sorted by size",
+			languages: map[string]int{"Shell": 1000, "Go": 60000, "Makefile": 1000, "Dockerfile": 500},
+			expectedLanguages: repositoryLanguages{
+				TotalBytes: 62500,
+				Languages: []languageShare{
+					{Language: "Go", Bytes: 60000, Percentage: 96},
+					{Language: "Makefile", Bytes: 1000, Percentage: 1.6},
+					{Language: "Shell", Bytes: 1000, Percentage: 1.6},
+					{Language: "Dockerfile", Bytes: 500, Percentage: 0.8},
+				},
+			},
+		},
+		{
+			name:      "percentages rounded to one decimal",
+			languages: map[string]int{"Go": 2, "Python": 1},
+			expectedLanguages: repositoryLanguages{
+				TotalBytes: 3,
+				Languages: []languageShare{
+					{Language: "Go", Bytes: 2, Percentage: 66.7},
+					{Language: "Python", Bytes: 1, Percentage: 33.3},
+				},
+			},
+		},
+		{
+			name:      "single language",
+			languages: map[string]int{"Go": 1234},
+			expectedLanguages: repositoryLanguages{
+				TotalBytes: 1234,
+				Languages:  []languageShare{{Language: "Go", Bytes: 1234, Percentage: 100}},
+			},
+		},
+		{
+			name:      "zero bytes",
+			languages: map[string]int{"Go": 0},
+			expectedLanguages: repositoryLanguages{
+				Languages: []languageShare{{Language: "Go"}},
+			},
+		},
+		{
+			name:      "empty repository",
+			languages: map[string]int{},
+			expectedLanguages: repositoryLanguages{
+				Languages: []languageShare{},
+			},
 		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			// Setup client with mock
-			client := github.NewClient(tc.mockedClient)
-			_, handler := ListCommits(stubGetClientFn(client), translations.NullTranslationHelper)
+			client := github.NewClient(mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposLanguagesByOwnerByRepo,
+					tc.languages,
+				),
+			))
+			_, handler := GetRepositoryLanguages(stubGetClientFn(client), translations.NullTranslationHelper)
 
 			// Create call request
-			request := createMCPRequest(tc.requestArgs)
+			request := createMCPRequest(map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			})
 
 			// Call handler
 			result, err := handler(context.Background(), request)
-
-			// Verify results
-			if tc.expectError {
-				require.Error(t, err)
-				assert.Contains(t, err.Error(), tc.expectedErrMsg)
-				return
-			}
-
 			require.NoError(t, err)
-
-			// Parse the result and get the text content if no error
 			textContent := getTextResult(t, result)
+			require.False(t, result.IsError, textContent.Text)
 
 			// Unmarshal and verify the result
-			var returnedCommits []*github.RepositoryCommit
-			err = json.Unmarshal([]byte(textContent.Text), &returnedCommits)
+			var returned repositoryLanguages
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
 			require.NoError(t, err)
-			assert.Len(t, returnedCommits, len(tc.expectedCommits))
-			for i, commit := range returnedCommits {
-				assert.Equal(t, *tc.expectedCommits[i].SHA, *commit.SHA)
-				assert.Equal(t, *tc.expectedCommits[i].Commit.Message, *commit.Commit.Message)
-				assert.Equal(t, *tc.expectedCommits[i].Author.Login, *commit.Author.Login)
-				assert.Equal(t, *tc.expectedCommits[i].HTMLURL, *commit.HTMLURL)
-			}
+			assert.Equal(t, tc.expectedLanguages, returned)
 		})
 	}
+
+	t.Run("repository not found", func(t *testing.T) {
+		client := github.NewClient(mock.NewMockedHTTPClient(
+			mock.WithRequestMatchHandler(
+				mock.GetReposLanguagesByOwnerByRepo,
+				mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+			),
+		))
+		_, handler := GetRepositoryLanguages(stubGetClientFn(client), translations.NullTranslationHelper)
+
+		result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+			"owner": "owner",
+			"repo":  "missing",
+		}))
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Equal(t, "failed to get repository languages: Not Found", getTextResult(t, result).Text)
+	})
 }
 
 func Test_CreateOrUpdateFile(t *testing.T) {
@@ -769,6 +4764,111 @@ func Test_CreateOrUpdateFile(t *testing.T) {
 			expectError:    true,
 			expectedErrMsg: "failed to create/update file",
 		},
+		{
+			name: "existing file updated after looking up its sha",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposContentsByOwnerByRepoByPath,
+					mockSequence(t,
+						expectRequestBody(t, map[string]interface{}{
+							"message": "Update example file",
+							"content": "IyBVcGRhdGVkIEV4YW1wbGU=",
+							"branch":  "main",
+						}).andThen(
+							mockResponse(t, http.StatusUnprocessableEntity, map[string]string{
+								"message": "Invalid request.\n\n\"sha\" wasn't supplied.",
+							}),
+						),
+						expectRequestBody(t, map[string]interface{}{
+							"message": "Update example file",
+							"content": "IyBVcGRhdGVkIEV4YW1wbGU=",
+							"branch":  "main",
+							"sha":     "0123456789abcdef",
+						}).andThen(
+							mockResponse(t, http.StatusOK, mockFileResponse),
+						),
+					),
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					expectQueryParams(t, map[string]string{
+						"ref": "main",
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.RepositoryContent{
+							Type: github.Ptr("file"),
+							Path: github.Ptr("docs/example.md"),
+							SHA:  github.Ptr("0123456789abcdef"),
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"path":    "docs/example.md",
+				"content": "# Updated Example",
+				"message": "Update example file",
+				"branch":  "main",
+			},
+			expectError:     false,
+			expectedContent: mockFileResponse,
+		},
+		{
+			name: "file modified concurrently after looking up its sha",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposContentsByOwnerByRepoByPath,
+					mockSequence(t,
+						mockResponse(t, http.StatusUnprocessableEntity, map[string]string{
+							"message": "Invalid request.\n\n\"sha\" wasn't supplied.",
+						}),
+						mockResponse(t, http.StatusConflict, map[string]string{
+							"message": "docs/example.md does not match 0123456789abcdef",
+						}),
+					),
+				),
+				mock.WithRequestMatch(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					&github.RepositoryContent{
+						Type: github.Ptr("file"),
+						Path: github.Ptr("docs/example.md"),
+						SHA:  github.Ptr("0123456789abcdef"),
+					},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"path":    "docs/example.md",
+				"content": "# Updated Example",
+				"message": "Update example file",
+				"branch":  "main",
+			},
+			expectError:    false,
+			expectedErrMsg: "docs/example.md was modified concurrently on branch main (docs/example.md does not match 0123456789abcdef), get its latest sha and retry",
+		},
+		{
+			name: "stale sha is reported as a conflict",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposContentsByOwnerByRepoByPath,
+					mockResponse(t, http.StatusConflict, map[string]string{
+						"message": "docs/example.md does not match abc123def456",
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"path":    "docs/example.md",
+				"content": "# Updated Example",
+				"message": "Update example file",
+				"branch":  "main",
+				"sha":     "abc123def456",
+			},
+			expectError:    false,
+			expectedErrMsg: "was modified concurrently on branch main",
+		},
 	}
 
 	for _, tc := range tests {
@@ -792,22 +4892,28 @@ func Test_CreateOrUpdateFile(t *testing.T) {
 
 			require.NoError(t, err)
 
+			if tc.expectedErrMsg != "" {
+				require.True(t, result.IsError)
+				assert.Contains(t, getTextResult(t, result).Text, tc.expectedErrMsg)
+				return
+			}
+
 			// Parse the result and get the text content if no error
 			textContent := getTextResult(t, result)
 
 			// Unmarshal and verify the result
-			var returnedContent github.RepositoryContentResponse
-			err = json.Unmarshal([]byte(textContent.Text), &returnedContent)
+			var returnedFile committedFile
+			err = json.Unmarshal([]byte(textContent.Text), &returnedFile)
 			require.NoError(t, err)
 
 			// Verify content
-			assert.Equal(t, *tc.expectedContent.Content.Name, *returnedContent.Content.Name)
-			assert.Equal(t, *tc.expectedContent.Content.Path, *returnedContent.Content.Path)
-			assert.Equal(t, *tc.expectedContent.Content.SHA, *returnedContent.Content.SHA)
+			assert.Equal(t, *tc.expectedContent.Content.Path, returnedFile.Path)
+			assert.Equal(t, *tc.expectedContent.Content.SHA, returnedFile.ContentSHA)
+			assert.Equal(t, *tc.expectedContent.Content.HTMLURL, returnedFile.HTMLURL)
 
 			// Verify commit
-			assert.Equal(t, *tc.expectedContent.Commit.SHA, *returnedContent.Commit.SHA)
-			assert.Equal(t, *tc.expectedContent.Commit.Message, *returnedContent.Commit.Message)
+			assert.Equal(t, *tc.expectedContent.Commit.SHA, returnedFile.CommitSHA)
+			assert.Equal(t, *tc.expectedContent.Commit.HTMLURL, returnedFile.CommitURL)
 		})
 	}
 }
@@ -822,7 +4928,13 @@ func Test_CreateRepository(t *testing.T) {
 	assert.Contains(t, tool.InputSchema.Properties, "name")
 	assert.Contains(t, tool.InputSchema.Properties, "description")
 	assert.Contains(t, tool.InputSchema.Properties, "private")
+	assert.Contains(t, tool.InputSchema.Properties, "org")
 	assert.Contains(t, tool.InputSchema.Properties, "autoInit")
+	assert.Contains(t, tool.InputSchema.Properties, "gitignore_template")
+	assert.Contains(t, tool.InputSchema.Properties, "license_template")
+	assert.Contains(t, tool.InputSchema.Properties, "has_issues")
+	assert.Contains(t, tool.InputSchema.Properties, "has_projects")
+	assert.Contains(t, tool.InputSchema.Properties, "has_wiki")
 	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"name"})
 
 	// Setup mock repository response
@@ -845,6 +4957,8 @@ func Test_CreateRepository(t *testing.T) {
 		expectError    bool
 		expectedRepo   *github.Repository
 		expectedErrMsg string
+		// expectedResultErrMsg is the message of an error returned as a tool result.
+		expectedResultErrMsg string
 	}{
 		{
 			name: "successful repository creation with all parameters",
@@ -917,6 +5031,72 @@ func Test_CreateRepository(t *testing.T) {
 			expectError:    true,
 			expectedErrMsg: "failed to create repository",
 		},
+		{
+			name: "repository creation in organization with templates and settings",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostOrgsReposByOrg,
+					expectRequestBody(t, map[string]interface{}{
+						"name":               "test-repo",
+						"description":        "",
+						"private":            false,
+						"auto_init":          true,
+						"gitignore_template": "Go",
+						"license_template":   "mit",
+						"has_issues":         true,
+						"has_wiki":           false,
+					}).andThen(
+						mockResponse(t, http.StatusCreated, mockRepo),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"name":               "test-repo",
+				"org":                "testorg",
+				"autoInit":           true,
+				"gitignore_template": "Go",
+				"license_template":   "mit",
+				"has_issues":         true,
+				"has_wiki":           false,
+			},
+			expectError:  false,
+			expectedRepo: mockRepo,
+		},
+		{
+			name: "repository name already exists",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.EndpointPattern{
+						Pattern: "/user/repos",
+						Method:  "POST",
+					},
+					mockResponse(t, http.StatusUnprocessableEntity, map[string]interface{}{
+						"message": "Repository creation failed.",
+						"errors": []map[string]string{
+							{"resource": "Repository", "code": "custom", "field": "name", "message": "name already exists on this account"},
+						},
+					}),
+				),
+				mock.WithRequestMatch(
+					mock.GetUser,
+					&github.User{Login: github.Ptr("testuser")},
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						require.Equal(t, "/repos/testuser/test-repo", r.URL.Path)
+						mockResponse(t, http.StatusOK, &github.Repository{
+							FullName: github.Ptr("testuser/test-repo"),
+							HTMLURL:  github.Ptr("https://github.com/testuser/test-repo"),
+						})(w, r)
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"name": "test-repo",
+			},
+			expectedResultErrMsg: "failed to create repository: testuser/test-repo already exists at https://github.com/testuser/test-repo",
+		},
 	}
 
 	for _, tc := range tests {
@@ -943,6 +5123,12 @@ func Test_CreateRepository(t *testing.T) {
 			// Parse the result and get the text content if no error
 			textContent := getTextResult(t, result)
 
+			if tc.expectedResultErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedResultErrMsg)
+				return
+			}
+
 			// Unmarshal and verify the result
 			var returnedRepo github.Repository
 			err = json.Unmarshal([]byte(textContent.Text), &returnedRepo)
@@ -1028,6 +5214,24 @@ func Test_PushFiles(t *testing.T) {
 					mock.GetReposGitCommitsByOwnerByRepoByCommitSha,
 					mockCommit,
 				),
+				// Create blobs
+				mock.WithRequestMatchHandler(
+					mock.PostReposGitBlobsByOwnerByRepo,
+					mockSequence(t,
+						expectRequestBody(t, map[string]interface{}{
+							"content":  "# Updated README\n\nThis is an updated README file.",
+							"encoding": "utf-8",
+						}).andThen(
+							mockResponse(t, http.StatusCreated, &github.Blob{SHA: github.Ptr("blob1")}),
+						),
+						expectRequestBody(t, map[string]interface{}{
+							"content":  "# Example\n\nThis is an example file.",
+							"encoding": "utf-8",
+						}).andThen(
+							mockResponse(t, http.StatusCreated, &github.Blob{SHA: github.Ptr("blob2")}),
+						),
+					),
+				),
 				// Create tree
 				mock.WithRequestMatchHandler(
 					mock.PostReposGitTreesByOwnerByRepo,
@@ -1035,16 +5239,16 @@ func Test_PushFiles(t *testing.T) {
 						"base_tree": "def456",
 						"tree": []interface{}{
 							map[string]interface{}{
-								"path":    "README.md",
-								"mode":    "100644",
-								"type":    "blob",
-								"content": "# Updated README\n\nThis is an updated README file.",
+								"path": "README.md",
+								"mode": "100644",
+								"type": "blob",
+								"sha":  "blob1",
 							},
 							map[string]interface{}{
-								"path":    "docs/example.md",
-								"mode":    "100644",
-								"type":    "blob",
-								"content": "# Example\n\nThis is an example file.",
+								"path": "docs/example.md",
+								"mode": "100644",
+								"type": "blob",
+								"sha":  "blob2",
 							},
 						},
 					}).andThen(
@@ -1092,6 +5296,174 @@ func Test_PushFiles(t *testing.T) {
 			expectError: false,
 			expectedRef: mockUpdatedRef,
 		},
+		{
+			name: "successful push writing and deleting files",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposGitRefByOwnerByRepoByRef,
+					mockRef,
+				),
+				mock.WithRequestMatch(
+					mock.GetReposGitCommitsByOwnerByRepoByCommitSha,
+					mockCommit,
+				),
+				mock.WithRequestMatchHandler(
+					mock.PostReposGitBlobsByOwnerByRepo,
+					mockSequence(t,
+						expectRequestBody(t, map[string]interface{}{
+							"content":  "# Moved",
+							"encoding": "utf-8",
+						}).andThen(
+							mockResponse(t, http.StatusCreated, &github.Blob{SHA: github.Ptr("blob1")}),
+						),
+					),
+				),
+				mock.WithRequestMatchHandler(
+					mock.PostReposGitTreesByOwnerByRepo,
+					expectRequestBody(t, map[string]interface{}{
+						"base_tree": "def456",
+						"tree": []interface{}{
+							map[string]interface{}{
+								"path": "docs/old.md",
+								"mode": "100644",
+								"type": "blob",
+								"sha":  nil,
+							},
+							map[string]interface{}{
+								"path": "docs/new.md",
+								"mode": "100644",
+								"type": "blob",
+								"sha":  "blob1",
+							},
+						},
+					}).andThen(
+						mockResponse(t, http.StatusCreated, mockTree),
+					),
+				),
+				mock.WithRequestMatchHandler(
+					mock.PostReposGitCommitsByOwnerByRepo,
+					expectRequestBody(t, map[string]interface{}{
+						"message": "Move docs",
+						"tree":    "ghi789",
+						"parents": []interface{}{"abc123"},
+					}).andThen(
+						mockResponse(t, http.StatusCreated, mockNewCommit),
+					),
+				),
+				mock.WithRequestMatch(
+					mock.PatchReposGitRefsByOwnerByRepoByRef,
+					mockUpdatedRef,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "main",
+				"files": []interface{}{
+					map[string]interface{}{
+						"path":   "docs/old.md",
+						"delete": true,
+					},
+					map[string]interface{}{
+						"path":    "docs/new.md",
+						"content": "# Moved",
+					},
+				},
+				"message": "Move docs",
+			},
+			expectError: false,
+			expectedRef: mockUpdatedRef,
+		},
+		{
+			name: "branch moved while pushing",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposGitRefByOwnerByRepoByRef,
+					mockRef,
+					&github.Reference{
+						Ref:    github.Ptr("refs/heads/main"),
+						Object: &github.GitObject{SHA: github.Ptr("mno345")},
+					},
+				),
+				mock.WithRequestMatch(
+					mock.GetReposGitCommitsByOwnerByRepoByCommitSha,
+					mockCommit,
+				),
+				mock.WithRequestMatch(
+					mock.PostReposGitBlobsByOwnerByRepo,
+					&github.Blob{SHA: github.Ptr("blob1")},
+				),
+				mock.WithRequestMatch(
+					mock.PostReposGitTreesByOwnerByRepo,
+					mockTree,
+				),
+				mock.WithRequestMatch(
+					mock.PostReposGitCommitsByOwnerByRepo,
+					mockNewCommit,
+				),
+				mock.WithRequestMatchHandler(
+					mock.PatchReposGitRefsByOwnerByRepoByRef,
+					mockResponse(t, http.StatusUnprocessableEntity, map[string]string{
+						"message": "Update is not a fast forward",
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "main",
+				"files": []interface{}{
+					map[string]interface{}{
+						"path":    "README.md",
+						"content": "# README",
+					},
+				},
+				"message": "Update file",
+			},
+			expectError:    false,
+			expectedErrMsg: "failed to update reference: branch main moved from abc123 to mno345 while the files were pushed",
+		},
+		{
+			name:         "fails when a file has both content and delete",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "main",
+				"files": []interface{}{
+					map[string]interface{}{
+						"path":    "README.md",
+						"content": "# README",
+						"delete":  true,
+					},
+				},
+				"message": "Update file",
+			},
+			expectError:    false,
+			expectedErrMsg: "file README.md cannot have both content and delete",
+		},
+		{
+			name:         "fails when a file is listed twice",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "main",
+				"files": []interface{}{
+					map[string]interface{}{
+						"path":    "README.md",
+						"content": "# README",
+					},
+					map[string]interface{}{
+						"path":   "README.md",
+						"delete": true,
+					},
+				},
+				"message": "Update file",
+			},
+			expectError:    false,
+			expectedErrMsg: "file README.md is listed more than once",
+		},
 		{
 			name:         "fails when files parameter is invalid",
 			mockedClient: mock.NewMockedHTTPClient(
@@ -1229,6 +5601,11 @@ func Test_PushFiles(t *testing.T) {
 					mock.GetReposGitCommitsByOwnerByRepoByCommitSha,
 					mockCommit,
 				),
+				// Create blob
+				mock.WithRequestMatch(
+					mock.PostReposGitBlobsByOwnerByRepo,
+					&github.Blob{SHA: github.Ptr("blob1")},
+				),
 				// Fail to create tree
 				mock.WithRequestMatchHandler(
 					mock.PostReposGitTreesByOwnerByRepo,
@@ -0,0 +1,204 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	// recentCommitterFiles is the maximum number of changed files whose recent committers are
+	// looked up, as each file takes a request.
+	recentCommitterFiles = 20
+	// recentCommitsPerFile is the number of recent commits of a file whose authors are counted
+	// as its recent committers.
+	recentCommitsPerFile = 10
+)
+
+// reviewerSuggestion is a user or team suggested to review a pull request.
+type reviewerSuggestion struct {
+	Reviewer string `json:"reviewer"`
+	Type     string `json:"type"`
+	Reason   string `json:"reason"`
+
+	ownedFiles     int
+	committedFiles int
+}
+
+// reviewerSuggestions are the reviewers suggested for a pull request, best first.
+type reviewerSuggestions struct {
+	Suggestions []reviewerSuggestion `json:"suggestions"`
+	Codeowners  string               `json:"codeowners,omitempty"`
+	Note        string               `json:"note,omitempty"`
+}
+
+// SuggestReviewers creates a tool to suggest reviewers for a pull request from the code owners
+// and the recent committers of the files it changes.
+func SuggestReviewers(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("suggest_reviewers",
+			mcp.WithDescription(t("TOOL_SUGGEST_REVIEWERS_DESCRIPTION", "Suggest reviewers for a pull request, ranked by the files they own according to CODEOWNERS and then by the files they recently committed to")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			pr, resp, err := client.PullRequests.Get(ctx, owner, repo, pullNumber)
+			if err != nil {
+				if result := apiErrorResult("failed to get pull request", err, http.StatusNotFound); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to get pull request: %w", err)
+			}
+			_ = resp.Body.Close()
+
+			opts := &github.ListOptions{PerPage: 100}
+			files, _, err := fetchAllPages(ctx, opts, pullRequestFileListLimit, func() ([]*github.CommitFile, *github.Response, error) {
+				return client.PullRequests.ListFiles(ctx, owner, repo, pullNumber, opts)
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to get pull request files: %w", err)
+			}
+			paths := make([]string, 0, len(files))
+			for _, file := range files {
+				paths = append(paths, file.GetFilename())
+			}
+
+			// The code owners of the base branch are the ones asked to review.
+			base := pr.GetBase().GetRef()
+			codeownersPath, rules, err := fetchCodeowners(ctx, client, owner, repo, base)
+			if err != nil {
+				return nil, err
+			}
+
+			author := pr.GetUser().GetLogin()
+			suggestions := make(map[string]*reviewerSuggestion)
+			suggestion := func(reviewer, reviewerType string) *reviewerSuggestion {
+				key := reviewerType + ":" + strings.ToLower(reviewer)
+				if suggestions[key] == nil {
+					suggestions[key] = &reviewerSuggestion{Reviewer: reviewer, Type: reviewerType}
+				}
+				return suggestions[key]
+			}
+
+			for _, path := range paths {
+				for _, codeowner := range rules.owners(path) {
+					// Owners given by email cannot be requested to review.
+					name, ok := strings.CutPrefix(codeowner, "@")
+					if !ok || strings.EqualFold(name, author) {
+						continue
+					}
+					if _, team, ok := strings.Cut(name, "/"); ok {
+						suggestion(team, "team").ownedFiles++
+					} else {
+						suggestion(name, "user").ownedFiles++
+					}
+				}
+			}
+
+			for _, path := range paths[:min(len(paths), recentCommitterFiles)] {
+				commits, resp, err := client.Repositories.ListCommits(ctx, owner, repo, &github.CommitsListOptions{
+					SHA:         base,
+					Path:        path,
+					ListOptions: github.ListOptions{PerPage: recentCommitsPerFile},
+				})
+				if err != nil {
+					return nil, fmt.Errorf("failed to list commits of %s: %w", path, err)
+				}
+				_ = resp.Body.Close()
+
+				committers := make(map[string]bool)
+				for _, commit := range commits {
+					login := commit.GetAuthor().GetLogin()
+					if login == "" || strings.HasSuffix(login, "[bot]") || strings.EqualFold(login, author) || committers[strings.ToLower(login)] {
+						continue
+					}
+					committers[strings.ToLower(login)] = true
+					suggestion(login, "user").committedFiles++
+				}
+			}
+
+			result := reviewerSuggestions{
+				Suggestions: make([]reviewerSuggestion, 0, len(suggestions)),
+				Codeowners:  codeownersPath,
+			}
+			for _, s := range suggestions {
+				var reasons []string
+				if s.ownedFiles > 0 {
+					reasons = append(reasons, fmt.Sprintf("code owner of %s", changedFileCount(s.ownedFiles)))
+				}
+				if s.committedFiles > 0 {
+					reasons = append(reasons, fmt.Sprintf("recent committer to %s", changedFileCount(s.committedFiles)))
+				}
+				s.Reason = strings.Join(reasons, ", ")
+				result.Suggestions = append(result.Suggestions, *s)
+			}
+			// Rank code owners first, as their review may be required.
+			sort.Slice(result.Suggestions, func(i, j int) bool {
+				a, b := result.Suggestions[i], result.Suggestions[j]
+				if a.ownedFiles != b.ownedFiles {
+					return a.ownedFiles > b.ownedFiles
+				}
+				if a.committedFiles != b.committedFiles {
+					return a.committedFiles > b.committedFiles
+				}
+				return a.Reviewer < b.Reviewer
+			})
+
+			var notes []string
+			if codeownersPath == "" {
+				notes = append(notes, "the repository has no CODEOWNERS file")
+			}
+			if len(paths) > recentCommitterFiles {
+				notes = append(notes, fmt.Sprintf("recent committers were only looked up for the first %d of %d changed files", recentCommitterFiles, len(paths)))
+			}
+			result.Note = strings.Join(notes, "; ")
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+func changedFileCount(n int) string {
+	if n == 1 {
+		return "1 changed file"
+	}
+	return fmt.Sprintf("%d changed files", n)
+}
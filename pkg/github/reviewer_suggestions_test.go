@@ -0,0 +1,180 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_SuggestReviewers(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := SuggestReviewers(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "suggest_reviewers", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "pullNumber")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "pullNumber"})
+
+	mockPR := &github.PullRequest{
+		Number: github.Ptr(42),
+		User:   &github.User{Login: github.Ptr("author")},
+		Base:   &github.PullRequestBranch{Ref: github.Ptr("main")},
+	}
+	mockFiles := []*github.CommitFile{
+		{Filename: github.Ptr("pkg/server.go")},
+		{Filename: github.Ptr("docs/index.md")},
+	}
+	commitBy := func(login string) *github.RepositoryCommit {
+		return &github.RepositoryCommit{Author: &github.User{Login: github.Ptr(login)}}
+	}
+
+	// contentsHandler serves a CODEOWNERS file at the given path, and no other files.
+	contentsHandler := func(path, content string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/repos/owner/repo/contents/"+path {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+				return
+			}
+			assert.Equal(t, "main", r.URL.Query().Get("ref"))
+			b, err := json.Marshal(&github.RepositoryContent{
+				Type:    github.Ptr("file"),
+				Path:    github.Ptr(path),
+				Content: github.Ptr(content),
+			})
+			require.NoError(t, err)
+			_, _ = w.Write(b)
+		}
+	}
+
+	tests := []struct {
+		name                string
+		mockedClient        *http.Client
+		requestArgs         map[string]interface{}
+		expectedSuggestions reviewerSuggestions
+		expectedErrMsg      string
+	}{
+		{
+			name: "code owners and recent committers",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					mockPR,
+				),
+				mock.WithRequestMatch(
+					mock.GetReposPullsFilesByOwnerByRepoByPullNumber,
+					mockFiles,
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					contentsHandler("CODEOWNERS", "*.go @gopher @org/backend\n/docs/ @writer @author docs@example.com\n"),
+				),
+				mock.WithRequestMatch(
+					mock.GetReposCommitsByOwnerByRepo,
+					[]*github.RepositoryCommit{commitBy("alice"), commitBy("gopher"), commitBy("dependabot[bot]"), commitBy("alice")},
+					[]*github.RepositoryCommit{commitBy("Alice"), commitBy("author")},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectedSuggestions: reviewerSuggestions{
+				Suggestions: []reviewerSuggestion{
+					{Reviewer: "gopher", Type: "user", Reason: "code owner of 1 changed file, recent committer to 1 changed file"},
+					{Reviewer: "backend", Type: "team", Reason: "code owner of 1 changed file"},
+					{Reviewer: "writer", Type: "user", Reason: "code owner of 1 changed file"},
+					{Reviewer: "alice", Type: "user", Reason: "recent committer to 2 changed files"},
+				},
+				Codeowners: "CODEOWNERS",
+			},
+		},
+		{
+			name: "no CODEOWNERS file",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					mockPR,
+				),
+				mock.WithRequestMatch(
+					mock.GetReposPullsFilesByOwnerByRepoByPullNumber,
+					mockFiles[:1],
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposContentsByOwnerByRepoByPath,
+					contentsHandler("OWNERS", ""),
+				),
+				mock.WithRequestMatch(
+					mock.GetReposCommitsByOwnerByRepo,
+					[]*github.RepositoryCommit{commitBy("alice")},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(42),
+			},
+			expectedSuggestions: reviewerSuggestions{
+				Suggestions: []reviewerSuggestion{
+					{Reviewer: "alice", Type: "user", Reason: "recent committer to 1 changed file"},
+				},
+				Note: "the repository has no CODEOWNERS file",
+			},
+		},
+		{
+			name: "pull request not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposPullsByOwnerByRepoByPullNumber,
+					mockResponse(t, http.StatusNotFound, `{"message": "Not Found"}`),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"pullNumber": float64(999),
+			},
+			expectedErrMsg: "failed to get pull request",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := SuggestReviewers(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Create call request
+			request := createMCPRequest(tc.requestArgs)
+
+			// Call handler
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.expectedErrMsg)
+				return
+			}
+			require.False(t, result.IsError, textContent.Text)
+
+			// Unmarshal and verify the result
+			var returned reviewerSuggestions
+			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedSuggestions, returned)
+		})
+	}
+}
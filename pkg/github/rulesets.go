@@ -0,0 +1,290 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// branchRulesLimit is the maximum number of rules fetched for a branch.
+const branchRulesLimit = 1000
+
+// rulesetSummary is a trimmed down ruleset of a ruleset listing.
+type rulesetSummary struct {
+	ID          int64  `json:"id"`
+	Name        string `json:"name"`
+	Target      string `json:"target,omitempty"`
+	Enforcement string `json:"enforcement"`
+	SourceType  string `json:"source_type,omitempty"`
+	Source      string `json:"source"`
+}
+
+// rulesetDetails is a ruleset with its conditions and rules.
+type rulesetDetails struct {
+	rulesetSummary
+	Conditions           *github.RepositoryRulesetConditions `json:"conditions,omitempty"`
+	Rules                []rulesetRule                       `json:"rules"`
+	CurrentUserCanBypass string                              `json:"current_user_can_bypass,omitempty"`
+	HTMLURL              string                              `json:"html_url,omitempty"`
+}
+
+// rulesetRule is a rule of a ruleset, with its parameters as the API describes them.
+type rulesetRule struct {
+	Type       string          `json:"type"`
+	Parameters json.RawMessage `json:"parameters,omitempty"`
+}
+
+// branchRule is a rule applying to a branch, with the ruleset it comes from.
+type branchRule struct {
+	Type              string          `json:"type"`
+	RulesetID         int64           `json:"ruleset_id"`
+	RulesetSourceType string          `json:"ruleset_source_type"`
+	RulesetSource     string          `json:"ruleset_source"`
+	Parameters        json.RawMessage `json:"parameters,omitempty"`
+}
+
+// branchRules are the rules applying to a branch.
+type branchRules struct {
+	Branch    string       `json:"branch"`
+	Rules     []branchRule `json:"rules"`
+	Truncated bool         `json:"truncated,omitempty"`
+}
+
+// newRulesetSummary trims a ruleset down to a rulesetSummary.
+func newRulesetSummary(ruleset *github.RepositoryRuleset) rulesetSummary {
+	summary := rulesetSummary{
+		ID:          ruleset.GetID(),
+		Name:        ruleset.Name,
+		Enforcement: string(ruleset.Enforcement),
+		Source:      ruleset.Source,
+	}
+	if ruleset.Target != nil {
+		summary.Target = string(*ruleset.Target)
+	}
+	if ruleset.SourceType != nil {
+		summary.SourceType = string(*ruleset.SourceType)
+	}
+	return summary
+}
+
+// newRulesetDetails converts a ruleset into rulesetDetails, listing its rules in the
+// type and parameters form of the API.
+func newRulesetDetails(ruleset *github.RepositoryRuleset) (rulesetDetails, error) {
+	details := rulesetDetails{
+		rulesetSummary: newRulesetSummary(ruleset),
+		Conditions:     ruleset.Conditions,
+		Rules:          []rulesetRule{},
+	}
+	if ruleset.CurrentUserCanBypass != nil {
+		details.CurrentUserCanBypass = string(*ruleset.CurrentUserCanBypass)
+	}
+	if links := ruleset.Links; links != nil && links.HTML != nil {
+		details.HTMLURL = links.HTML.GetHRef()
+	}
+
+	if ruleset.Rules != nil {
+		rules, err := json.Marshal(ruleset.Rules)
+		if err != nil {
+			return details, fmt.Errorf("failed to marshal rules: %w", err)
+		}
+		if err := json.Unmarshal(rules, &details.Rules); err != nil {
+			return details, fmt.Errorf("failed to unmarshal rules: %w", err)
+		}
+	}
+	return details, nil
+}
+
+// ListRepositoryRulesets creates a tool to list the rulesets of a repository.
+func ListRepositoryRulesets(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_repository_rulesets",
+			mcp.WithDescription(t("TOOL_LIST_REPOSITORY_RULESETS_DESCRIPTION", "List the rulesets of a repository, with their target and enforcement")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithBoolean("includes_parents",
+				mcp.Description("Also list the rulesets of the organization that apply to the repository, defaults to true"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			includesParents, ok, err := OptionalParamOK[bool](request, "includes_parents")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if !ok {
+				includesParents = true
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			rulesets, resp, err := client.Repositories.GetAllRulesets(ctx, owner, repo, includesParents)
+			if err != nil {
+				if result := apiErrorResult("failed to list rulesets", err, http.StatusNotFound); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to list rulesets: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			summaries := make([]rulesetSummary, 0, len(rulesets))
+			for _, ruleset := range rulesets {
+				summaries = append(summaries, newRulesetSummary(ruleset))
+			}
+
+			r, err := json.Marshal(summaries)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// GetRepositoryRuleset creates a tool to get a ruleset of a repository with its conditions and rules.
+func GetRepositoryRuleset(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_repository_ruleset",
+			mcp.WithDescription(t("TOOL_GET_REPOSITORY_RULESET_DESCRIPTION", "Get a ruleset of a repository, or of its organization, with the refs it applies to and its rules")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("ruleset_id",
+				mcp.Required(),
+				mcp.Description("Ruleset ID, as returned by list_repository_rulesets"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			rulesetID, err := RequiredInt(request, "ruleset_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			// Rulesets of the organization are only found when parents are included.
+			ruleset, resp, err := client.Repositories.GetRuleset(ctx, owner, repo, int64(rulesetID), true)
+			if err != nil {
+				if result := apiErrorResult("failed to get ruleset", err, http.StatusNotFound); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to get ruleset: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			details, err := newRulesetDetails(ruleset)
+			if err != nil {
+				return nil, err
+			}
+
+			r, err := json.Marshal(details)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// GetRulesForBranch creates a tool to get the rules of all rulesets that apply to a branch.
+func GetRulesForBranch(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_rules_for_branch",
+			mcp.WithDescription(t("TOOL_GET_RULES_FOR_BRANCH_DESCRIPTION", "Get the rules that apply to a branch, from every active ruleset of the repository and its organization")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("branch",
+				mcp.Required(),
+				mcp.Description("Branch name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			branch, err := requiredParam[string](request, "branch")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			// Repositories.GetRulesForBranch groups the rules by type, losing the order and
+			// the rules it does not know, so the endpoint is requested directly.
+			listOptions := github.ListOptions{PerPage: 100}
+			rules, truncated, err := fetchAllPages(ctx, &listOptions, branchRulesLimit, func() ([]branchRule, *github.Response, error) {
+				u := fmt.Sprintf("repos/%s/%s/rules/branches/%s?per_page=%d&page=%d",
+					owner, repo, url.PathEscape(branch), listOptions.PerPage, max(listOptions.Page, 1))
+				req, err := client.NewRequest(http.MethodGet, u, nil)
+				if err != nil {
+					return nil, nil, err
+				}
+				var page []branchRule
+				resp, err := client.Do(ctx, req, &page)
+				return page, resp, err
+			})
+			if err != nil {
+				if result := apiErrorResult("failed to get rules for branch", err, http.StatusNotFound); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to get rules for branch: %w", err)
+			}
+
+			r, err := json.Marshal(branchRules{
+				Branch:    branch,
+				Rules:     rules,
+				Truncated: truncated,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
@@ -0,0 +1,379 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListRepositoryRulesets(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListRepositoryRulesets(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "list_repository_rulesets", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "includes_parents")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	mockRulesets := []map[string]any{
+		{"id": 42, "name": "protect main", "target": "branch", "source_type": "Repository", "source": "owner/repo", "enforcement": "active"},
+		{"id": 7, "name": "no large files", "target": "push", "source_type": "Organization", "source": "owner", "enforcement": "evaluate"},
+	}
+
+	tests := []struct {
+		name             string
+		mockedClient     *http.Client
+		requestArgs      map[string]interface{}
+		expectedRulesets []rulesetSummary
+		expectedErrMsg   string
+	}{
+		{
+			name: "rulesets including the organization ones by default",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposRulesetsByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"includes_parents": "true",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockRulesets),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectedRulesets: []rulesetSummary{
+				{ID: 42, Name: "protect main", Target: "branch", Enforcement: "active", SourceType: "Repository", Source: "owner/repo"},
+				{ID: 7, Name: "no large files", Target: "push", Enforcement: "evaluate", SourceType: "Organization", Source: "owner"},
+			},
+		},
+		{
+			name: "repository rulesets only",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposRulesetsByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"includes_parents": "false",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockRulesets[:1]),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":            "owner",
+				"repo":             "repo",
+				"includes_parents": false,
+			},
+			expectedRulesets: []rulesetSummary{
+				{ID: 42, Name: "protect main", Target: "branch", Enforcement: "active", SourceType: "Repository", Source: "owner/repo"},
+			},
+		},
+		{
+			name: "repository not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposRulesetsByOwnerByRepo,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "missing",
+			},
+			expectedErrMsg: "failed to list rulesets: Not Found",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListRepositoryRulesets(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedRulesets []rulesetSummary
+			err = json.Unmarshal([]byte(textContent.Text), &returnedRulesets)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedRulesets, returnedRulesets)
+		})
+	}
+}
+
+func Test_GetRepositoryRuleset(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetRepositoryRuleset(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_repository_ruleset", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "ruleset_id")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "ruleset_id"})
+
+	branchRuleset := map[string]any{
+		"id":          42,
+		"name":        "protect main",
+		"target":      "branch",
+		"source_type": "Repository",
+		"source":      "owner/repo",
+		"enforcement": "active",
+		"conditions": map[string]any{
+			"ref_name": map[string]any{"include": []string{"~DEFAULT_BRANCH", "refs/heads/release/*"}, "exclude": []string{}},
+		},
+		"rules": []any{
+			map[string]any{"type": "non_fast_forward"},
+			map[string]any{
+				"type": "required_status_checks",
+				"parameters": map[string]any{
+					"required_status_checks":               []any{map[string]any{"context": "ci/build", "integration_id": 15368}},
+					"strict_required_status_checks_policy": true,
+				},
+			},
+		},
+		"current_user_can_bypass": "never",
+		"_links": map[string]any{
+			"html": map[string]any{"href": "https://github.com/owner/repo/rules/42"},
+		},
+	}
+	pushRuleset := map[string]any{
+		"id":          7,
+		"name":        "no large files",
+		"target":      "push",
+		"source_type": "Organization",
+		"source":      "owner",
+		"enforcement": "active",
+		"rules": []any{
+			map[string]any{"type": "max_file_size", "parameters": map[string]any{"max_file_size": 10}},
+			map[string]any{"type": "file_extension_restriction", "parameters": map[string]any{"restricted_file_extensions": []string{".exe"}}},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		rulesetID      float64
+		expectedJSON   string
+		expectedErrMsg string
+	}{
+		{
+			name: "branch ruleset with a required check",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposRulesetsByOwnerByRepoByRulesetId,
+					expectQueryParams(t, map[string]string{
+						"includes_parents": "true",
+					}).andThen(
+						mockResponse(t, http.StatusOK, branchRuleset),
+					),
+				),
+			),
+			rulesetID: 42,
+			expectedJSON: `{
+				"id": 42, "name": "protect main", "target": "branch", "enforcement": "active",
+				"source_type": "Repository", "source": "owner/repo",
+				"conditions": {"ref_name": {"include": ["~DEFAULT_BRANCH", "refs/heads/release/*"], "exclude": []}},
+				"rules": [
+					{"type": "required_status_checks", "parameters": {
+						"required_status_checks": [{"context": "ci/build", "integration_id": 15368}],
+						"strict_required_status_checks_policy": true
+					}},
+					{"type": "non_fast_forward"}
+				],
+				"current_user_can_bypass": "never",
+				"html_url": "https://github.com/owner/repo/rules/42"
+			}`,
+		},
+		{
+			name: "push ruleset of the organization",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposRulesetsByOwnerByRepoByRulesetId,
+					pushRuleset,
+				),
+			),
+			rulesetID: 7,
+			expectedJSON: `{
+				"id": 7, "name": "no large files", "target": "push", "enforcement": "active",
+				"source_type": "Organization", "source": "owner",
+				"rules": [
+					{"type": "file_extension_restriction", "parameters": {"restricted_file_extensions": [".exe"]}},
+					{"type": "max_file_size", "parameters": {"max_file_size": 10}}
+				]
+			}`,
+		},
+		{
+			name: "ruleset not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposRulesetsByOwnerByRepoByRulesetId,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			rulesetID:      99,
+			expectedErrMsg: "failed to get ruleset: Not Found",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetRepositoryRuleset(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+				"owner":      "owner",
+				"repo":       "repo",
+				"ruleset_id": tc.rulesetID,
+			}))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			assert.False(t, result.IsError)
+			assert.JSONEq(t, tc.expectedJSON, textContent.Text)
+		})
+	}
+}
+
+func Test_GetRulesForBranch(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetRulesForBranch(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_rules_for_branch", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "branch")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "branch"})
+
+	statusChecks := json.RawMessage(`{"required_status_checks":[{"context":"ci/build"}],"strict_required_status_checks_policy":false}`)
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectedRules  branchRules
+		expectedErrMsg string
+	}{
+		{
+			name: "rules across pages",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposRulesBranchesByOwnerByRepoByBranch,
+					mockSequence(t,
+						expectQueryParams(t, map[string]string{
+							"per_page": "100",
+							"page":     "1",
+						}).andThen(
+							func(w http.ResponseWriter, r *http.Request) {
+								w.Header().Set("Link", `<https://api.github.com/repos/owner/repo/rules/branches/main?per_page=100&page=2>; rel="next"`)
+								mockResponse(t, http.StatusOK, []any{
+									map[string]any{"type": "non_fast_forward", "ruleset_source_type": "Repository", "ruleset_source": "owner/repo", "ruleset_id": 42},
+								}).ServeHTTP(w, r)
+							},
+						),
+						expectQueryParams(t, map[string]string{
+							"per_page": "100",
+							"page":     "2",
+						}).andThen(
+							mockResponse(t, http.StatusOK, []any{
+								map[string]any{"type": "required_status_checks", "ruleset_source_type": "Organization", "ruleset_source": "owner", "ruleset_id": 7, "parameters": statusChecks},
+							}),
+						),
+					),
+				),
+			),
+			expectedRules: branchRules{
+				Branch: "main",
+				Rules: []branchRule{
+					{Type: "non_fast_forward", RulesetID: 42, RulesetSourceType: "Repository", RulesetSource: "owner/repo"},
+					{Type: "required_status_checks", RulesetID: 7, RulesetSourceType: "Organization", RulesetSource: "owner", Parameters: statusChecks},
+				},
+			},
+		},
+		{
+			name: "no rules",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposRulesBranchesByOwnerByRepoByBranch,
+					[]any{},
+				),
+			),
+			expectedRules: branchRules{
+				Branch: "main",
+				Rules:  []branchRule{},
+			},
+		},
+		{
+			name: "repository not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposRulesBranchesByOwnerByRepoByBranch,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			expectedErrMsg: "failed to get rules for branch: Not Found",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetRulesForBranch(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "main",
+			}))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			// Unmarshal and verify the result
+			var returnedRules branchRules
+			err = json.Unmarshal([]byte(textContent.Text), &returnedRules)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedRules, returnedRules)
+		})
+	}
+}
@@ -3,8 +3,12 @@ package github
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"regexp"
+	"strings"
+	"time"
 
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/google/go-github/v69/github"
@@ -12,13 +16,64 @@ import (
 	"github.com/mark3labs/mcp-go/server"
 )
 
+// searchResultLimit is the number of results GitHub search makes reachable,
+// no matter how many matched.
+const searchResultLimit = 1000
+
+// starsRange matches the values the stars qualifier accepts.
+var starsRange = regexp.MustCompile(`^((>|>=|<|<=)?\d+|\d+\.\.(\d+|\*)|\*\.\.\d+)$`)
+
+// repositorySearchResult is a trimmed repository search result.
+type repositorySearchResult struct {
+	TotalCount        int                    `json:"total_count"`
+	IncompleteResults bool                   `json:"incomplete_results"`
+	ResultsCapped     bool                   `json:"results_capped"`
+	Items             []repositorySearchItem `json:"items"`
+}
+
+// repositorySearchItem is a repository matched by a search.
+type repositorySearchItem struct {
+	FullName    string            `json:"full_name"`
+	Description string            `json:"description,omitempty"`
+	Stars       int               `json:"stars"`
+	Language    string            `json:"language,omitempty"`
+	UpdatedAt   *github.Timestamp `json:"updated_at,omitempty"`
+	Topics      []string          `json:"topics"`
+}
+
 // SearchRepositories creates a tool to search for GitHub repositories.
 func SearchRepositories(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("search_repositories",
 			mcp.WithDescription(t("TOOL_SEARCH_REPOSITORIES_DESCRIPTION", "Search for GitHub repositories")),
 			mcp.WithString("query",
 				mcp.Required(),
-				mcp.Description("Search query"),
+				mcp.Description("Search query using GitHub repository search syntax, combined with the qualifiers below"),
+			),
+			mcp.WithString("language",
+				mcp.Description("Only match repositories written mainly in this language"),
+			),
+			mcp.WithString("org",
+				mcp.Description("Only match repositories of this organization"),
+			),
+			mcp.WithString("user",
+				mcp.Description("Only match repositories of this user"),
+			),
+			mcp.WithString("stars",
+				mcp.Description("Star count or range, e.g. '>100', '<=50' or '10..50'"),
+			),
+			mcp.WithString("topic",
+				mcp.Description("Only match repositories with this topic"),
+			),
+			mcp.WithBoolean("archived",
+				mcp.Description("Only match archived repositories when true, or only active ones when false"),
+			),
+			mcp.WithString("sort",
+				mcp.Description("Sort field, best match when omitted"),
+				mcp.Enum("stars", "forks", "help-wanted-issues", "updated"),
+			),
+			mcp.WithString("order",
+				mcp.Description("Sort order ('asc' or 'desc')"),
+				mcp.Enum("asc", "desc"),
 			),
 			WithPagination(),
 		),
@@ -27,12 +82,27 @@ func SearchRepositories(getClient GetClientFn, t translations.TranslationHelperF
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			qualifiers, err := repositorySearchQualifiers(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			query = strings.Join(append([]string{query}, qualifiers...), " ")
+			sortBy, err := OptionalParam[string](request, "sort")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			order, err := OptionalParam[string](request, "order")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
 			pagination, err := OptionalPaginationParams(request)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
 			opts := &github.SearchOptions{
+				Sort:  sortBy,
+				Order: order,
 				ListOptions: github.ListOptions{
 					Page:    pagination.page,
 					PerPage: pagination.perPage,
@@ -57,7 +127,28 @@ func SearchRepositories(getClient GetClientFn, t translations.TranslationHelperF
 				return mcp.NewToolResultError(fmt.Sprintf("failed to search repositories: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(result)
+			trimmed := repositorySearchResult{
+				TotalCount:        result.GetTotal(),
+				IncompleteResults: result.GetIncompleteResults(),
+				ResultsCapped:     result.GetTotal() > searchResultLimit,
+				Items:             make([]repositorySearchItem, 0, len(result.Repositories)),
+			}
+			for _, repo := range result.Repositories {
+				topics := repo.Topics
+				if topics == nil {
+					topics = []string{}
+				}
+				trimmed.Items = append(trimmed.Items, repositorySearchItem{
+					FullName:    repo.GetFullName(),
+					Description: repo.GetDescription(),
+					Stars:       repo.GetStargazersCount(),
+					Language:    repo.GetLanguage(),
+					UpdatedAt:   repo.UpdatedAt,
+					Topics:      topics,
+				})
+			}
+
+			r, err := json.Marshal(trimmed)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
@@ -66,13 +157,89 @@ func SearchRepositories(getClient GetClientFn, t translations.TranslationHelperF
 		}
 }
 
+// repositorySearchQualifiers builds search qualifiers from the structured filters of
+// the search_repositories tool, in a fixed order.
+func repositorySearchQualifiers(request mcp.CallToolRequest) ([]string, error) {
+	var qualifiers []string
+
+	for _, name := range []string{"language", "org", "user", "topic"} {
+		value, err := OptionalParam[string](request, name)
+		if err != nil {
+			return nil, err
+		}
+		if value != "" {
+			qualifiers = append(qualifiers, searchQualifier(name, value))
+		}
+	}
+
+	stars, err := OptionalParam[string](request, "stars")
+	if err != nil {
+		return nil, err
+	}
+	if stars != "" {
+		stars = strings.ReplaceAll(stars, " ", "")
+		if !starsRange.MatchString(stars) {
+			return nil, fmt.Errorf("invalid stars: %q is not a count or range like '>100' or '10..50'", stars)
+		}
+		qualifiers = append(qualifiers, "stars:"+stars)
+	}
+
+	archived, ok, err := OptionalParamOK[bool](request, "archived")
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		qualifiers = append(qualifiers, fmt.Sprintf("archived:%t", archived))
+	}
+
+	return qualifiers, nil
+}
+
+// codeSearchResult is a trimmed code search result.
+type codeSearchResult struct {
+	TotalCount        int              `json:"total_count"`
+	IncompleteResults bool             `json:"incomplete_results"`
+	ResultsCapped     bool             `json:"results_capped"`
+	Items             []codeSearchItem `json:"items"`
+}
+
+// codeSearchItem is a file matched by a code search. Fragments are only set when
+// text matches were requested.
+type codeSearchItem struct {
+	Repository string   `json:"repository"`
+	Path       string   `json:"path"`
+	HTMLURL    string   `json:"html_url"`
+	Fragments  []string `json:"fragments,omitempty"`
+}
+
 // SearchCode creates a tool to search for code across GitHub repositories.
 func SearchCode(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("search_code",
 			mcp.WithDescription(t("TOOL_SEARCH_CODE_DESCRIPTION", "Search for code across GitHub repositories")),
 			mcp.WithString("q",
 				mcp.Required(),
-				mcp.Description("Search query using GitHub code search syntax"),
+				mcp.Description("Search query using GitHub code search syntax, combined with the qualifiers below"),
+			),
+			mcp.WithString("repo",
+				mcp.Description("Only search this repository, in owner/name form"),
+			),
+			mcp.WithString("org",
+				mcp.Description("Only search repositories of this organization"),
+			),
+			mcp.WithString("path",
+				mcp.Description("Only match files under this path"),
+			),
+			mcp.WithString("extension",
+				mcp.Description("Only match files with this extension, without the dot"),
+			),
+			mcp.WithString("filename",
+				mcp.Description("Only match files with this name"),
+			),
+			mcp.WithString("language",
+				mcp.Description("Only match files in this language"),
+			),
+			mcp.WithBoolean("text_matches",
+				mcp.Description("Return the matched fragments of each file"),
 			),
 			mcp.WithString("sort",
 				mcp.Description("Sort field ('indexed' only)"),
@@ -88,6 +255,15 @@ func SearchCode(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			qualifiers, err := codeSearchQualifiers(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			query = strings.Join(append([]string{query}, qualifiers...), " ")
+			textMatches, err := OptionalParam[bool](request, "text_matches")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
 			sort, err := OptionalParam[string](request, "sort")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
@@ -102,8 +278,9 @@ func SearchCode(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 			}
 
 			opts := &github.SearchOptions{
-				Sort:  sort,
-				Order: order,
+				Sort:      sort,
+				Order:     order,
+				TextMatch: textMatches,
 				ListOptions: github.ListOptions{
 					PerPage: pagination.perPage,
 					Page:    pagination.page,
@@ -117,6 +294,9 @@ func SearchCode(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 
 			result, resp, err := client.Search.Code(ctx, query, opts)
 			if err != nil {
+				if result, marshalErr := rateLimitErrorResult("failed to search code", err); result != nil || marshalErr != nil {
+					return result, marshalErr
+				}
 				return nil, fmt.Errorf("failed to search code: %w", err)
 			}
 			defer func() { _ = resp.Body.Close() }()
@@ -129,7 +309,27 @@ func SearchCode(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 				return mcp.NewToolResultError(fmt.Sprintf("failed to search code: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(result)
+			trimmed := codeSearchResult{
+				TotalCount:        result.GetTotal(),
+				IncompleteResults: result.GetIncompleteResults(),
+				ResultsCapped:     result.GetTotal() > searchResultLimit,
+				Items:             make([]codeSearchItem, 0, len(result.CodeResults)),
+			}
+			for _, code := range result.CodeResults {
+				item := codeSearchItem{
+					Repository: code.GetRepository().GetFullName(),
+					Path:       code.GetPath(),
+					HTMLURL:    code.GetHTMLURL(),
+				}
+				for _, match := range code.TextMatches {
+					if match.GetFragment() != "" {
+						item.Fragments = append(item.Fragments, match.GetFragment())
+					}
+				}
+				trimmed.Items = append(trimmed.Items, item)
+			}
+
+			r, err := json.Marshal(trimmed)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
@@ -138,6 +338,32 @@ func SearchCode(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 		}
 }
 
+// codeSearchQualifiers builds search qualifiers from the structured filters of the
+// search_code tool, in a fixed order.
+func codeSearchQualifiers(request mcp.CallToolRequest) ([]string, error) {
+	repo, err := OptionalParam[string](request, "repo")
+	if err != nil {
+		return nil, err
+	}
+	if repo != "" {
+		if owner, name, ok := strings.Cut(repo, "/"); !ok || owner == "" || name == "" || strings.Contains(name, "/") {
+			return nil, fmt.Errorf("invalid repo: %q is not in owner/name form", repo)
+		}
+	}
+
+	var qualifiers []string
+	for _, name := range []string{"repo", "org", "path", "extension", "filename", "language"} {
+		value, err := OptionalParam[string](request, name)
+		if err != nil {
+			return nil, err
+		}
+		if value != "" {
+			qualifiers = append(qualifiers, searchQualifier(name, value))
+		}
+	}
+	return qualifiers, nil
+}
+
 // SearchUsers creates a tool to search for GitHub users.
 func SearchUsers(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("search_users",
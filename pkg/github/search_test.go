@@ -21,6 +21,14 @@ func Test_SearchRepositories(t *testing.T) {
 	assert.Equal(t, "search_repositories", tool.Name)
 	assert.NotEmpty(t, tool.Description)
 	assert.Contains(t, tool.InputSchema.Properties, "query")
+	assert.Contains(t, tool.InputSchema.Properties, "language")
+	assert.Contains(t, tool.InputSchema.Properties, "org")
+	assert.Contains(t, tool.InputSchema.Properties, "user")
+	assert.Contains(t, tool.InputSchema.Properties, "stars")
+	assert.Contains(t, tool.InputSchema.Properties, "topic")
+	assert.Contains(t, tool.InputSchema.Properties, "archived")
+	assert.Contains(t, tool.InputSchema.Properties, "sort")
+	assert.Contains(t, tool.InputSchema.Properties, "order")
 	assert.Contains(t, tool.InputSchema.Properties, "page")
 	assert.Contains(t, tool.InputSchema.Properties, "perPage")
 	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"query"})
@@ -37,6 +45,8 @@ func Test_SearchRepositories(t *testing.T) {
 				HTMLURL:         github.Ptr("https://github.com/owner/repo-1"),
 				Description:     github.Ptr("Test repository 1"),
 				StargazersCount: github.Ptr(100),
+				Language:        github.Ptr("Go"),
+				Topics:          []string{"mcp", "cli"},
 			},
 			{
 				ID:              github.Ptr(int64(67890)),
@@ -48,13 +58,17 @@ func Test_SearchRepositories(t *testing.T) {
 			},
 		},
 	}
+	expectedItems := []repositorySearchItem{
+		{FullName: "owner/repo-1", Description: "Test repository 1", Stars: 100, Language: "Go", Topics: []string{"mcp", "cli"}},
+		{FullName: "owner/repo-2", Description: "Test repository 2", Stars: 50, Topics: []string{}},
+	}
 
 	tests := []struct {
 		name           string
 		mockedClient   *http.Client
 		requestArgs    map[string]interface{}
 		expectError    bool
-		expectedResult *github.RepositoriesSearchResult
+		expectedResult *repositorySearchResult
 		expectedErrMsg string
 	}{
 		{
@@ -76,8 +90,11 @@ func Test_SearchRepositories(t *testing.T) {
 				"page":    float64(2),
 				"perPage": float64(10),
 			},
-			expectError:    false,
-			expectedResult: mockSearchResult,
+			expectError: false,
+			expectedResult: &repositorySearchResult{
+				TotalCount: 2,
+				Items:      expectedItems,
+			},
 		},
 		{
 			name: "repository search with default pagination",
@@ -96,8 +113,102 @@ func Test_SearchRepositories(t *testing.T) {
 			requestArgs: map[string]interface{}{
 				"query": "golang test",
 			},
+			expectError: false,
+			expectedResult: &repositorySearchResult{
+				TotalCount: 2,
+				Items:      expectedItems,
+			},
+		},
+		{
+			name: "qualifiers composed into the query",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetSearchRepositories,
+					expectQueryParams(t, map[string]string{
+						"q":        "mcp server language:Go org:github topic:\"model context\" stars:>100 archived:false",
+						"sort":     "stars",
+						"order":    "desc",
+						"page":     "1",
+						"per_page": "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockSearchResult),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"query":    "mcp server",
+				"language": "Go",
+				"org":      "github",
+				"topic":    "model context",
+				"stars":    ">100",
+				"archived": false,
+				"sort":     "stars",
+				"order":    "desc",
+			},
+			expectError: false,
+			expectedResult: &repositorySearchResult{
+				TotalCount: 2,
+				Items:      expectedItems,
+			},
+		},
+		{
+			name: "stars range and archived repositories",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetSearchRepositories,
+					expectQueryParams(t, map[string]string{
+						"q":        "tools user:octocat stars:10..50 archived:true",
+						"page":     "1",
+						"per_page": "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockSearchResult),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"query":    "tools",
+				"user":     "octocat",
+				"stars":    "10..50",
+				"archived": true,
+			},
+			expectError: false,
+			expectedResult: &repositorySearchResult{
+				TotalCount: 2,
+				Items:      expectedItems,
+			},
+		},
+		{
+			name: "results beyond the search cap",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetSearchRepositories,
+					&github.RepositoriesSearchResult{
+						Total:             github.Ptr(4200),
+						IncompleteResults: github.Ptr(true),
+						Repositories:      mockSearchResult.Repositories[:1],
+					},
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"query": "language:go",
+			},
+			expectError: false,
+			expectedResult: &repositorySearchResult{
+				TotalCount:        4200,
+				IncompleteResults: true,
+				ResultsCapped:     true,
+				Items:             expectedItems[:1],
+			},
+		},
+		{
+			name:         "invalid stars range",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"query": "tools",
+				"stars": "lots",
+			},
 			expectError:    false,
-			expectedResult: mockSearchResult,
+			expectedErrMsg: `invalid stars: "lots" is not a count or range like '>100' or '10..50'`,
 		},
 		{
 			name: "search fails",
@@ -142,20 +253,17 @@ func Test_SearchRepositories(t *testing.T) {
 			// Parse the result and get the text content if no error
 			textContent := getTextResult(t, result)
 
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
 			// Unmarshal and verify the result
-			var returnedResult github.RepositoriesSearchResult
+			var returnedResult repositorySearchResult
 			err = json.Unmarshal([]byte(textContent.Text), &returnedResult)
 			require.NoError(t, err)
-			assert.Equal(t, *tc.expectedResult.Total, *returnedResult.Total)
-			assert.Equal(t, *tc.expectedResult.IncompleteResults, *returnedResult.IncompleteResults)
-			assert.Len(t, returnedResult.Repositories, len(tc.expectedResult.Repositories))
-			for i, repo := range returnedResult.Repositories {
-				assert.Equal(t, *tc.expectedResult.Repositories[i].ID, *repo.ID)
-				assert.Equal(t, *tc.expectedResult.Repositories[i].Name, *repo.Name)
-				assert.Equal(t, *tc.expectedResult.Repositories[i].FullName, *repo.FullName)
-				assert.Equal(t, *tc.expectedResult.Repositories[i].HTMLURL, *repo.HTMLURL)
-			}
-
+			assert.Equal(t, *tc.expectedResult, returnedResult)
 		})
 	}
 }
@@ -168,6 +276,13 @@ func Test_SearchCode(t *testing.T) {
 	assert.Equal(t, "search_code", tool.Name)
 	assert.NotEmpty(t, tool.Description)
 	assert.Contains(t, tool.InputSchema.Properties, "q")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "org")
+	assert.Contains(t, tool.InputSchema.Properties, "path")
+	assert.Contains(t, tool.InputSchema.Properties, "extension")
+	assert.Contains(t, tool.InputSchema.Properties, "filename")
+	assert.Contains(t, tool.InputSchema.Properties, "language")
+	assert.Contains(t, tool.InputSchema.Properties, "text_matches")
 	assert.Contains(t, tool.InputSchema.Properties, "sort")
 	assert.Contains(t, tool.InputSchema.Properties, "order")
 	assert.Contains(t, tool.InputSchema.Properties, "perPage")
@@ -195,13 +310,42 @@ func Test_SearchCode(t *testing.T) {
 			},
 		},
 	}
+	expectedItems := []codeSearchItem{
+		{Repository: "owner/repo", Path: "path/to/file1.go", HTMLURL: "https://github.com/owner/repo/blob/main/path/to/file1.go"},
+		{Repository: "owner/repo", Path: "path/to/file2.go", HTMLURL: "https://github.com/owner/repo/blob/main/path/to/file2.go"},
+	}
+
+	mockTextMatchResult := &github.CodeSearchResult{
+		Total:             github.Ptr(1),
+		IncompleteResults: github.Ptr(false),
+		CodeResults: []*github.CodeResult{
+			{
+				Path:       github.Ptr("main.go"),
+				HTMLURL:    github.Ptr("https://github.com/owner/repo/blob/main/main.go"),
+				Repository: &github.Repository{FullName: github.Ptr("owner/repo")},
+				TextMatches: []*github.TextMatch{
+					{
+						ObjectType: github.Ptr("FileContent"),
+						Property:   github.Ptr("content"),
+						Fragment:   github.Ptr("func main() {\n\tfmt.Println(\"hello\")\n}"),
+						Matches:    []*github.Match{{Text: github.Ptr("fmt.Println"), Indices: []int{14, 25}}},
+					},
+					{
+						ObjectType: github.Ptr("FileContent"),
+						Property:   github.Ptr("content"),
+						Fragment:   github.Ptr("\tfmt.Println(\"bye\")"),
+					},
+				},
+			},
+		},
+	}
 
 	tests := []struct {
 		name           string
 		mockedClient   *http.Client
 		requestArgs    map[string]interface{}
 		expectError    bool
-		expectedResult *github.CodeSearchResult
+		expectedResult *codeSearchResult
 		expectedErrMsg string
 	}{
 		{
@@ -227,8 +371,11 @@ func Test_SearchCode(t *testing.T) {
 				"page":    float64(1),
 				"perPage": float64(30),
 			},
-			expectError:    false,
-			expectedResult: mockSearchResult,
+			expectError: false,
+			expectedResult: &codeSearchResult{
+				TotalCount: 2,
+				Items:      expectedItems,
+			},
 		},
 		{
 			name: "code search with minimal parameters",
@@ -247,8 +394,99 @@ func Test_SearchCode(t *testing.T) {
 			requestArgs: map[string]interface{}{
 				"q": "fmt.Println language:go",
 			},
+			expectError: false,
+			expectedResult: &codeSearchResult{
+				TotalCount: 2,
+				Items:      expectedItems,
+			},
+		},
+		{
+			name: "qualifiers composed into the query",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetSearchCode,
+					expectQueryParams(t, map[string]string{
+						"q":        "NewClient repo:owner/repo path:pkg/github extension:go filename:client.go language:go",
+						"page":     "1",
+						"per_page": "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockSearchResult),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"q":         "NewClient",
+				"repo":      "owner/repo",
+				"path":      "pkg/github",
+				"extension": "go",
+				"filename":  "client.go",
+				"language":  "go",
+			},
+			expectError: false,
+			expectedResult: &codeSearchResult{
+				TotalCount: 2,
+				Items:      expectedItems,
+			},
+		},
+		{
+			name: "text matches return fragments",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetSearchCode,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						assert.Contains(t, r.Header.Get("Accept"), "text-match")
+						assert.Equal(t, "fmt.Println org:github", r.URL.Query().Get("q"))
+						mockResponse(t, http.StatusOK, mockTextMatchResult).ServeHTTP(w, r)
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"q":            "fmt.Println",
+				"org":          "github",
+				"text_matches": true,
+			},
+			expectError: false,
+			expectedResult: &codeSearchResult{
+				TotalCount: 1,
+				Items: []codeSearchItem{
+					{
+						Repository: "owner/repo",
+						Path:       "main.go",
+						HTMLURL:    "https://github.com/owner/repo/blob/main/main.go",
+						Fragments:  []string{"func main() {\n\tfmt.Println(\"hello\")\n}", "\tfmt.Println(\"bye\")"},
+					},
+				},
+			},
+		},
+		{
+			name:         "invalid repo",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"q":    "NewClient",
+				"repo": "repo",
+			},
 			expectError:    false,
-			expectedResult: mockSearchResult,
+			expectedErrMsg: `invalid repo: "repo" is not in owner/name form`,
+		},
+		{
+			name: "secondary rate limit",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetSearchCode,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						w.Header().Set("Retry-After", "42")
+						mockResponse(t, http.StatusForbidden, map[string]string{
+							"message":           "You have exceeded a secondary rate limit.",
+							"documentation_url": "https://docs.github.com/rest/overview/rate-limits-for-the-rest-api#about-secondary-rate-limits",
+						}).ServeHTTP(w, r)
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"q": "fmt.Println",
+			},
+			expectError:    false,
+			expectedErrMsg: `{"error":"rate_limited","message":"failed to search code: secondary rate limit exceeded","retry_after_seconds":42}`,
 		},
 		{
 			name: "search code fails",
@@ -293,20 +531,17 @@ func Test_SearchCode(t *testing.T) {
 			// Parse the result and get the text content if no error
 			textContent := getTextResult(t, result)
 
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
 			// Unmarshal and verify the result
-			var returnedResult github.CodeSearchResult
+			var returnedResult codeSearchResult
 			err = json.Unmarshal([]byte(textContent.Text), &returnedResult)
 			require.NoError(t, err)
-			assert.Equal(t, *tc.expectedResult.Total, *returnedResult.Total)
-			assert.Equal(t, *tc.expectedResult.IncompleteResults, *returnedResult.IncompleteResults)
-			assert.Len(t, returnedResult.CodeResults, len(tc.expectedResult.CodeResults))
-			for i, code := range returnedResult.CodeResults {
-				assert.Equal(t, *tc.expectedResult.CodeResults[i].Name, *code.Name)
-				assert.Equal(t, *tc.expectedResult.CodeResults[i].Path, *code.Path)
-				assert.Equal(t, *tc.expectedResult.CodeResults[i].SHA, *code.SHA)
-				assert.Equal(t, *tc.expectedResult.CodeResults[i].HTMLURL, *code.HTMLURL)
-				assert.Equal(t, *tc.expectedResult.CodeResults[i].Repository.FullName, *code.Repository.FullName)
-			}
+			assert.Equal(t, *tc.expectedResult, returnedResult)
 		})
 	}
 }
@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"slices"
+	"time"
 
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/google/go-github/v69/github"
@@ -36,38 +38,155 @@ func NewServer(getClient GetClientFn, version string, readOnly bool, t translati
 	s.AddTool(GetIssue(getClient, t))
 	s.AddTool(SearchIssues(getClient, t))
 	s.AddTool(ListIssues(getClient, t))
+	s.AddTool(ListMyIssues(getClient, t))
+	s.AddTool(ListIssuesMentioningUser(getClient, t))
 	s.AddTool(GetIssueComments(getClient, t))
+	s.AddTool(GetIssueTimeline(getClient, t))
+	s.AddTool(GetIssueEvents(getClient, t))
+	s.AddTool(ListSubIssues(getClient, t))
+	s.AddTool(ListIssueTemplates(getClient, t))
+	s.AddTool(FindDuplicateIssues(getClient, t))
 	if !readOnly {
 		s.AddTool(CreateIssue(getClient, t))
 		s.AddTool(AddIssueComment(getClient, t))
+		s.AddTool(UpdateIssueComment(getClient, t))
+		s.AddTool(DeleteIssueComment(getClient, t))
 		s.AddTool(UpdateIssue(getClient, t))
+		s.AddTool(BulkUpdateIssues(getClient, t))
+		s.AddTool(CloseIssue(getClient, t))
+		s.AddTool(TransferIssue(getClient, t))
+		s.AddTool(PinIssue(getClient, t))
+		s.AddTool(UnpinIssue(getClient, t))
+		s.AddTool(AssignIssue(getClient, t))
+		s.AddTool(UnassignIssue(getClient, t))
+		s.AddTool(AddLabelsToIssue(getClient, t))
+		s.AddTool(SetIssueLabels(getClient, t))
+		s.AddTool(RemoveLabelFromIssue(getClient, t))
+		s.AddTool(AddSubIssue(getClient, t))
+		s.AddTool(RemoveSubIssue(getClient, t))
+		s.AddTool(ReprioritizeSubIssue(getClient, t))
+	}
+
+	// Add GitHub tools - Labels
+	s.AddTool(ListLabels(getClient, t))
+	if !readOnly {
+		s.AddTool(CreateLabel(getClient, t))
+		s.AddTool(UpdateLabel(getClient, t))
+		s.AddTool(DeleteLabel(getClient, t))
+	}
+
+	// Add GitHub tools - Milestones
+	s.AddTool(ListMilestones(getClient, t))
+	if !readOnly {
+		s.AddTool(CreateMilestone(getClient, t))
+		s.AddTool(UpdateMilestone(getClient, t))
+		s.AddTool(CloseMilestone(getClient, t))
 	}
 
 	// Add GitHub tools - Pull Requests
 	s.AddTool(GetPullRequest(getClient, t))
 	s.AddTool(ListPullRequests(getClient, t))
+	s.AddTool(ListPullRequestsForCommit(getClient, t))
+	s.AddTool(SearchPullRequests(getClient, t))
 	s.AddTool(GetPullRequestFiles(getClient, t))
+	s.AddTool(GetPullRequestDiff(getClient, t))
 	s.AddTool(GetPullRequestStatus(getClient, t))
+	s.AddTool(GetFailedCheckLogs(getClient, t))
+	s.AddTool(GetPullRequestMergeConflicts(getClient, t))
 	s.AddTool(GetPullRequestComments(getClient, t))
+	s.AddTool(ListPullRequestReviewComments(getClient, t))
 	s.AddTool(GetPullRequestReviews(getClient, t))
+	s.AddTool(ListReviewThreads(getClient, t))
+	s.AddTool(SuggestReviewers(getClient, t))
 	if !readOnly {
 		s.AddTool(MergePullRequest(getClient, t))
 		s.AddTool(UpdatePullRequestBranch(getClient, t))
 		s.AddTool(CreatePullRequestReview(getClient, t))
+		s.AddTool(CreateAndSubmitPullRequestReview(getClient, t))
+		s.AddTool(CreatePendingPullRequestReview(getClient, t))
+		s.AddTool(AddCommentToPendingReview(getClient, t))
+		s.AddTool(SubmitPendingPullRequestReview(getClient, t))
+		s.AddTool(DeletePendingPullRequestReview(getClient, t))
+		s.AddTool(DismissPullRequestReview(getClient, t))
+		s.AddTool(ReplyToReviewComment(getClient, t))
+		s.AddTool(AddSuggestedChange(getClient, t))
+		s.AddTool(ResolveReviewThread(getClient, t))
+		s.AddTool(UnresolveReviewThread(getClient, t))
+		s.AddTool(RequestReviewers(getClient, t))
+		s.AddTool(RemoveRequestedReviewers(getClient, t))
+		s.AddTool(ReRequestReview(getClient, t))
 		s.AddTool(CreatePullRequest(getClient, t))
 		s.AddTool(UpdatePullRequest(getClient, t))
+		s.AddTool(ClosePullRequest(getClient, t))
+		s.AddTool(ReopenPullRequest(getClient, t))
+		s.AddTool(UpdatePullRequestMetadata(getClient, t))
+		s.AddTool(ConvertPullRequestToDraft(getClient, t))
+		s.AddTool(MarkPullRequestReadyForReview(getClient, t))
+		s.AddTool(EnablePullRequestAutoMerge(getClient, t))
+		s.AddTool(DisablePullRequestAutoMerge(getClient, t))
 	}
 
 	// Add GitHub tools - Repositories
 	s.AddTool(SearchRepositories(getClient, t))
+	s.AddTool(GetRepository(getClient, t))
+	s.AddTool(ListContributors(getClient, t))
+	s.AddTool(GetRepositoryTopics(getClient, t))
+	s.AddTool(GetRepositoryLanguages(getClient, t))
+	s.AddTool(ListForks(getClient, t))
+	s.AddTool(GetRepositoryTraffic(getClient, t))
 	s.AddTool(GetFileContents(getClient, t))
+	s.AddTool(GetReadme(getClient, t))
+	s.AddTool(GetBlame(getClient, t))
 	s.AddTool(ListCommits(getClient, t))
+	s.AddTool(GetCommit(getClient, t))
+	s.AddTool(ListBranches(getClient, t))
+	s.AddTool(GetBranchProtection(getClient, t))
+	s.AddTool(ListRepositoryRulesets(getClient, t))
+	s.AddTool(GetRepositoryRuleset(getClient, t))
+	s.AddTool(GetRulesForBranch(getClient, t))
+	s.AddTool(ListTags(getClient, t))
+	s.AddTool(GetTag(getClient, t))
+	s.AddTool(CompareRefs(getClient, t))
+	s.AddTool(CompareCommits(getClient, t))
 	if !readOnly {
 		s.AddTool(CreateOrUpdateFile(getClient, t))
 		s.AddTool(CreateRepository(getClient, t))
 		s.AddTool(ForkRepository(getClient, t))
+		s.AddTool(ReplaceRepositoryTopics(getClient, t))
+		s.AddTool(ArchiveRepository(getClient, t))
+		s.AddTool(UnarchiveRepository(getClient, t))
+		s.AddTool(TransferRepository(getClient, t))
+		s.AddTool(RepositoryDispatch(getClient, t))
 		s.AddTool(CreateBranch(getClient, t))
+		s.AddTool(DeleteBranch(getClient, t))
+		s.AddTool(RenameBranch(getClient, t))
+		s.AddTool(UpdateBranchProtection(getClient, t))
 		s.AddTool(PushFiles(getClient, t))
+		s.AddTool(CreateTag(getClient, t))
+	}
+
+	// Add GitHub tools - Collaborators
+	s.AddTool(ListCollaborators(getClient, t))
+	s.AddTool(ListRepositoryInvitations(getClient, t))
+	s.AddTool(CheckUserPermission(getClient, t))
+	if !readOnly {
+		s.AddTool(AddCollaborator(getClient, t))
+		s.AddTool(RemoveCollaborator(getClient, t))
+	}
+
+	// Add GitHub tools - Stars
+	s.AddTool(ListStargazers(getClient, t))
+	s.AddTool(IsRepositoryStarred(getClient, t))
+	if !readOnly {
+		s.AddTool(StarRepository(getClient, t))
+		s.AddTool(UnstarRepository(getClient, t))
+	}
+
+	// Add GitHub tools - Subscriptions
+	s.AddTool(GetRepositorySubscription(getClient, t))
+	if !readOnly {
+		s.AddTool(WatchRepository(getClient, t))
+		s.AddTool(UnwatchRepository(getClient, t))
 	}
 
 	// Add GitHub tools - Search
@@ -83,12 +202,32 @@ func NewServer(getClient GetClientFn, version string, readOnly bool, t translati
 
 	// Add GitHub tools - Discussions
 	s.AddTool(ListDiscussions(getClient, t))
+	s.AddTool(ListOrgDiscussions(getClient, t))
 	s.AddTool(GetDiscussion(getClient, t))
 	s.AddTool(GetDiscussionCategories(getClient, t))
 	s.AddTool(GetDiscussionComments(getClient, t))
+	s.AddTool(SearchDiscussions(getClient, t))
 	if !readOnly {
 		s.AddTool(AddDiscussionComment(getClient, t))
 		s.AddTool(CreateDiscussion(getClient, t))
+		s.AddTool(UpdateDiscussion(getClient, t))
+		s.AddTool(DeleteDiscussion(getClient, t))
+		s.AddTool(UpdateDiscussionComment(getClient, t))
+		s.AddTool(MarkDiscussionAnswer(getClient, t))
+		s.AddTool(PinDiscussion(getClient, t))
+		s.AddTool(UnpinDiscussion(getClient, t))
+		s.AddTool(AddDiscussionLabels(getClient, t))
+		s.AddTool(RemoveDiscussionLabels(getClient, t))
+		s.AddTool(ConvertIssueToDiscussion(getClient, t))
+		s.AddTool(TransferDiscussion(getClient, t))
+		s.AddTool(CreateDiscussionCategory(getClient, t))
+		s.AddTool(UpdateDiscussionCategory(getClient, t))
+	}
+
+	// Add GitHub tools - Reactions
+	s.AddTool(ListReactions(getClient, t))
+	if !readOnly {
+		s.AddTool(AddReaction(getClient, t))
 	}
 
 	return s
@@ -160,6 +299,80 @@ func isAcceptedError(err error) bool {
 	return errors.As(err, &acceptedError)
 }
 
+// apiErrorResult converts a GitHub API error response into a tool result error
+// carrying the API message, so that clients can recover from it. If status codes
+// are given, only responses with one of those codes are converted. It returns nil
+// when err is not a matching API error response.
+func apiErrorResult(message string, err error, statusCodes ...int) *mcp.CallToolResult {
+	var errorResponse *github.ErrorResponse
+	if !errors.As(err, &errorResponse) || errorResponse.Response == nil {
+		return nil
+	}
+	if len(statusCodes) > 0 && !slices.Contains(statusCodes, errorResponse.Response.StatusCode) {
+		return nil
+	}
+
+	return mcp.NewToolResultError(fmt.Sprintf("%s: %s", message, apiErrorDetails(errorResponse)))
+}
+
+// apiErrorDetails joins the message of a GitHub API error response with the messages of
+// its individual errors.
+func apiErrorDetails(errorResponse *github.ErrorResponse) string {
+	details := errorResponse.Message
+	for _, e := range errorResponse.Errors {
+		if e.Message != "" {
+			details += "; " + e.Message
+		} else {
+			details += "; " + e.Error()
+		}
+	}
+	return details
+}
+
+// rateLimited is the error returned when a request hits a rate limit, telling the client
+// how long to wait before trying again.
+type rateLimited struct {
+	Error             string `json:"error"`
+	Message           string `json:"message"`
+	RetryAfterSeconds int    `json:"retry_after_seconds"`
+}
+
+// secondaryRateLimitWait is how long GitHub asks clients to wait after a secondary
+// rate limit when it sends no retry-after header.
+const secondaryRateLimitWait = time.Minute
+
+// rateLimitErrorResult converts a primary or secondary rate limit error into a structured
+// rate_limited tool result error carrying the wait time. It returns nil when err is not a
+// rate limit error.
+func rateLimitErrorResult(message string, err error) (*mcp.CallToolResult, error) {
+	var wait time.Duration
+	var rateLimitErr *github.RateLimitError
+	var abuseErr *github.AbuseRateLimitError
+	switch {
+	case errors.As(err, &rateLimitErr):
+		message += ": API rate limit exceeded"
+		wait = time.Until(rateLimitErr.Rate.Reset.Time)
+	case errors.As(err, &abuseErr):
+		message += ": secondary rate limit exceeded"
+		wait = secondaryRateLimitWait
+		if abuseErr.RetryAfter != nil {
+			wait = *abuseErr.RetryAfter
+		}
+	default:
+		return nil, nil
+	}
+
+	r, err := json.Marshal(rateLimited{
+		Error:             "rate_limited",
+		Message:           message,
+		RetryAfterSeconds: int(max(wait, 0).Round(time.Second) / time.Second),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal response: %w", err)
+	}
+	return mcp.NewToolResultError(string(r)), nil
+}
+
 // requiredParam is a helper function that can be used to fetch a requested parameter from the request.
 // It does the following checks:
 // 1. Checks if the parameter is present in the request.
@@ -244,6 +457,24 @@ func OptionalIntParamWithDefault(r mcp.CallToolRequest, p string, d int) (int, e
 	return v, nil
 }
 
+// OptionalTimestampParam is a helper function that can be used to fetch a requested ISO 8601
+// timestamp parameter from the request. It returns the zero time if the parameter is absent or
+// empty, and an error naming the parameter if the timestamp is malformed.
+func OptionalTimestampParam(r mcp.CallToolRequest, p string) (time.Time, error) {
+	v, err := OptionalParam[string](r, p)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if v == "" {
+		return time.Time{}, nil
+	}
+	t, err := parseISOTimestamp(v)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid %s: %w", p, err)
+	}
+	return t, nil
+}
+
 // OptionalStringArrayParam is a helper function that can be used to fetch a requested parameter from the request.
 // It does the following checks:
 // 1. Checks if the parameter is present in the request, if not, it returns its zero-value
@@ -274,6 +505,80 @@ func OptionalStringArrayParam(r mcp.CallToolRequest, p string) ([]string, error)
 	}
 }
 
+// RequiredIntArrayParam is a helper function that can be used to fetch a requested parameter from the request.
+// It does the following checks:
+// 1. Checks if the parameter is present in the request and not empty
+// 2. Iterates the elements and checks each is a whole number
+func RequiredIntArrayParam(r mcp.CallToolRequest, p string) ([]int, error) {
+	v, ok := r.Params.Arguments[p].([]any)
+	if !ok {
+		if _, present := r.Params.Arguments[p]; present {
+			return nil, fmt.Errorf("parameter %s could not be coerced to []int, is %T", p, r.Params.Arguments[p])
+		}
+		return nil, fmt.Errorf("missing required parameter: %s", p)
+	}
+	if len(v) == 0 {
+		return nil, fmt.Errorf("missing required parameter: %s", p)
+	}
+
+	ints := make([]int, len(v))
+	for i, elem := range v {
+		f, ok := elem.(float64)
+		if !ok || f != float64(int(f)) {
+			return nil, fmt.Errorf("parameter %s must contain only whole numbers, got %v", p, elem)
+		}
+		ints[i] = int(f)
+	}
+	return ints, nil
+}
+
+// OptionalIntArrayParam is a helper function that can be used to fetch a requested parameter from the request.
+// It does the following checks:
+// 1. Checks if the parameter is present in the request and not empty, if not, it returns an empty slice
+// 2. If it is present, iterates the elements and checks each is a whole number
+func OptionalIntArrayParam(r mcp.CallToolRequest, p string) ([]int, error) {
+	switch v := r.Params.Arguments[p].(type) {
+	case nil:
+		return []int{}, nil
+	case []any:
+		if len(v) == 0 {
+			return []int{}, nil
+		}
+	}
+	return RequiredIntArrayParam(r, p)
+}
+
+// fetchAllPages calls list repeatedly, advancing listOptions.Page, until the last page is reached
+// or maxItems items are collected. It reports whether items were left out because of maxItems.
+// It stops as soon as the context is done or a request fails, returning the items fetched so far.
+func fetchAllPages[T any](ctx context.Context, listOptions *github.ListOptions, maxItems int, list func() ([]T, *github.Response, error)) ([]T, bool, error) {
+	items := make([]T, 0)
+	for {
+		if err := ctx.Err(); err != nil {
+			return items, false, err
+		}
+		page, resp, err := list()
+		if err != nil {
+			return items, false, err
+		}
+		_ = resp.Body.Close()
+
+		for _, item := range page {
+			if len(items) == maxItems {
+				return items, true, nil
+			}
+			items = append(items, item)
+		}
+		if resp.NextPage == 0 {
+			return items, false, nil
+		}
+		if len(items) == maxItems {
+			return items, true, nil
+		}
+		listOptions.Page = resp.NextPage
+	}
+}
+
 // WithPagination returns a ToolOption that adds "page" and "perPage" parameters to the tool.
 // The "page" parameter is optional, min 1. The "perPage" parameter is optional, min 1, max 100.
 func WithPagination() mcp.ToolOption {
@@ -315,3 +620,18 @@ func OptionalPaginationParams(r mcp.CallToolRequest) (PaginationParams, error) {
 		perPage: perPage,
 	}, nil
 }
+
+// paginateSlice returns the page of items selected by the pagination parameters, for tools
+// that fetch more items than they return and page through them in memory. Pages past the
+// end are empty, while pages and page sizes the API would reject are errors.
+func paginateSlice[T any](items []T, pagination PaginationParams) ([]T, error) {
+	if pagination.page < 1 {
+		return nil, errors.New("page must be at least 1")
+	}
+	if pagination.perPage < 1 || pagination.perPage > 100 {
+		return nil, errors.New("perPage must be between 1 and 100")
+	}
+	start := min((pagination.page-1)*pagination.perPage, len(items))
+	end := min(start+pagination.perPage, len(items))
+	return items[start:end], nil
+}
@@ -174,6 +174,112 @@ func Test_IsAcceptedError(t *testing.T) {
 	}
 }
 
+func Test_APIErrorResult(t *testing.T) {
+	notFound := &github.ErrorResponse{
+		Response: &http.Response{StatusCode: http.StatusNotFound},
+		Message:  "Not Found",
+	}
+	validationFailed := &github.ErrorResponse{
+		Response: &http.Response{StatusCode: http.StatusUnprocessableEntity},
+		Message:  "Validation Failed",
+		Errors: []github.Error{
+			{Resource: "Label", Field: "name", Code: "already_exists"},
+			{Message: "custom message"},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		err            error
+		statusCodes    []int
+		expectResult   bool
+		expectedErrMsg string
+	}{
+		{
+			name:           "any status code",
+			err:            notFound,
+			expectResult:   true,
+			expectedErrMsg: "failed to do thing: Not Found",
+		},
+		{
+			name:           "matching status code",
+			err:            fmt.Errorf("wrapped: %w", notFound),
+			statusCodes:    []int{http.StatusForbidden, http.StatusNotFound},
+			expectResult:   true,
+			expectedErrMsg: "failed to do thing: Not Found",
+		},
+		{
+			name:         "non-matching status code",
+			err:          notFound,
+			statusCodes:  []int{http.StatusForbidden},
+			expectResult: false,
+		},
+		{
+			name:           "error details are included",
+			err:            validationFailed,
+			expectResult:   true,
+			expectedErrMsg: "failed to do thing: Validation Failed; already_exists error caused by name field on Label resource; custom message",
+		},
+		{
+			name:         "regular error",
+			err:          fmt.Errorf("some other error"),
+			expectResult: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result := apiErrorResult("failed to do thing", tc.err, tc.statusCodes...)
+			if !tc.expectResult {
+				assert.Nil(t, result)
+				return
+			}
+
+			require.NotNil(t, result)
+			assert.True(t, result.IsError)
+			textContent := getTextResult(t, result)
+			assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+		})
+	}
+}
+
+func Test_RateLimitErrorResult(t *testing.T) {
+	rateLimitErr := &github.RateLimitError{
+		Rate:     github.Rate{Reset: github.Timestamp{Time: time.Now().Add(90 * time.Second)}},
+		Response: &http.Response{StatusCode: http.StatusForbidden},
+	}
+	result, err := rateLimitErrorResult("failed to list things", fmt.Errorf("wrapped: %w", rateLimitErr))
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.True(t, result.IsError)
+	assert.JSONEq(t, `{"error":"rate_limited","message":"failed to list things: API rate limit exceeded","retry_after_seconds":90}`, getTextResult(t, result).Text)
+
+	// A reset in the past needs no wait.
+	rateLimitErr.Rate.Reset = github.Timestamp{Time: time.Now().Add(-time.Minute)}
+	result, err = rateLimitErrorResult("failed to list things", rateLimitErr)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"error":"rate_limited","message":"failed to list things: API rate limit exceeded","retry_after_seconds":0}`, getTextResult(t, result).Text)
+
+	abuseErr := &github.AbuseRateLimitError{
+		Response:   &http.Response{StatusCode: http.StatusForbidden},
+		RetryAfter: github.Ptr(30 * time.Second),
+	}
+	result, err = rateLimitErrorResult("failed to list things", abuseErr)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"error":"rate_limited","message":"failed to list things: secondary rate limit exceeded","retry_after_seconds":30}`, getTextResult(t, result).Text)
+
+	// Without a retry-after header, the wait GitHub recommends is used.
+	result, err = rateLimitErrorResult("failed to list things", &github.AbuseRateLimitError{
+		Response: &http.Response{StatusCode: http.StatusForbidden},
+	})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"error":"rate_limited","message":"failed to list things: secondary rate limit exceeded","retry_after_seconds":60}`, getTextResult(t, result).Text)
+
+	result, err = rateLimitErrorResult("failed to list things", fmt.Errorf("connection reset"))
+	require.NoError(t, err)
+	assert.Nil(t, result)
+}
+
 func Test_RequiredStringParam(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -490,6 +596,68 @@ func Test_OptionalBooleanParam(t *testing.T) {
 	}
 }
 
+func Test_OptionalTimestampParam(t *testing.T) {
+	tests := []struct {
+		name        string
+		params      map[string]interface{}
+		paramName   string
+		expected    time.Time
+		expectError string
+	}{
+		{
+			name:      "RFC3339 timestamp",
+			params:    map[string]interface{}{"since": "2024-01-15T14:30:00Z"},
+			paramName: "since",
+			expected:  time.Date(2024, 1, 15, 14, 30, 0, 0, time.UTC),
+		},
+		{
+			name:      "date only",
+			params:    map[string]interface{}{"since": "2024-01-15"},
+			paramName: "since",
+			expected:  time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "missing parameter",
+			params:    map[string]interface{}{},
+			paramName: "since",
+			expected:  time.Time{},
+		},
+		{
+			name:      "empty string",
+			params:    map[string]interface{}{"since": ""},
+			paramName: "since",
+			expected:  time.Time{},
+		},
+		{
+			name:        "malformed timestamp",
+			params:      map[string]interface{}{"since": "last week"},
+			paramName:   "since",
+			expectError: "invalid since: invalid ISO 8601 timestamp: last week",
+		},
+		{
+			name:        "wrong type parameter",
+			params:      map[string]interface{}{"since": float64(1)},
+			paramName:   "since",
+			expectError: "parameter since is not of type string",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			request := createMCPRequest(tc.params)
+			result, err := OptionalTimestampParam(request, tc.paramName)
+
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+			} else {
+				assert.NoError(t, err)
+				assert.True(t, tc.expected.Equal(result))
+			}
+		})
+	}
+}
+
 func TestOptionalStringArrayParam(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -558,6 +726,215 @@ func TestOptionalStringArrayParam(t *testing.T) {
 	}
 }
 
+func TestRequiredIntArrayParam(t *testing.T) {
+	tests := []struct {
+		name        string
+		params      map[string]interface{}
+		paramName   string
+		expected    []int
+		expectError bool
+	}{
+		{
+			name: "valid number array parameter",
+			params: map[string]any{
+				"numbers": []any{float64(1), float64(42)},
+			},
+			paramName:   "numbers",
+			expected:    []int{1, 42},
+			expectError: false,
+		},
+		{
+			name:        "parameter not in request",
+			params:      map[string]any{},
+			paramName:   "numbers",
+			expectError: true,
+		},
+		{
+			name: "empty array",
+			params: map[string]any{
+				"numbers": []any{},
+			},
+			paramName:   "numbers",
+			expectError: true,
+		},
+		{
+			name: "wrong type parameter",
+			params: map[string]any{
+				"numbers": float64(1),
+			},
+			paramName:   "numbers",
+			expectError: true,
+		},
+		{
+			name: "fractional number",
+			params: map[string]any{
+				"numbers": []any{float64(1), 2.5},
+			},
+			paramName:   "numbers",
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			request := createMCPRequest(tc.params)
+			result, err := RequiredIntArrayParam(request, tc.paramName)
+
+			if tc.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestOptionalIntArrayParam(t *testing.T) {
+	tests := []struct {
+		name        string
+		params      map[string]interface{}
+		paramName   string
+		expected    []int
+		expectError bool
+	}{
+		{
+			name: "valid number array parameter",
+			params: map[string]any{
+				"numbers": []any{float64(1), float64(42)},
+			},
+			paramName:   "numbers",
+			expected:    []int{1, 42},
+			expectError: false,
+		},
+		{
+			name:        "parameter not in request",
+			params:      map[string]any{},
+			paramName:   "numbers",
+			expected:    []int{},
+			expectError: false,
+		},
+		{
+			name: "empty array",
+			params: map[string]any{
+				"numbers": []any{},
+			},
+			paramName:   "numbers",
+			expected:    []int{},
+			expectError: false,
+		},
+		{
+			name: "wrong type parameter",
+			params: map[string]any{
+				"numbers": float64(1),
+			},
+			paramName:   "numbers",
+			expectError: true,
+		},
+		{
+			name: "fractional number",
+			params: map[string]any{
+				"numbers": []any{float64(1), 2.5},
+			},
+			paramName:   "numbers",
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			request := createMCPRequest(tc.params)
+			result, err := OptionalIntArrayParam(request, tc.paramName)
+
+			if tc.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expected, result)
+			}
+		})
+	}
+}
+
+func Test_FetchAllPages(t *testing.T) {
+	issue := func(number int) *github.Issue {
+		return &github.Issue{Number: github.Ptr(number)}
+	}
+	pages := mock.WithRequestMatchPages(
+		mock.GetReposIssuesByOwnerByRepo,
+		[]*github.Issue{issue(1), issue(2)},
+		[]*github.Issue{issue(3), issue(4)},
+		[]*github.Issue{issue(5)},
+	)
+
+	tests := []struct {
+		name              string
+		mockedClient      *http.Client
+		maxItems          int
+		cancel            bool
+		expectError       bool
+		expectedNumbers   []int
+		expectedTruncated bool
+	}{
+		{
+			name:            "concatenates all pages in order",
+			mockedClient:    mock.NewMockedHTTPClient(pages),
+			maxItems:        100,
+			expectedNumbers: []int{1, 2, 3, 4, 5},
+		},
+		{
+			name:              "stops at max items",
+			mockedClient:      mock.NewMockedHTTPClient(pages),
+			maxItems:          3,
+			expectedNumbers:   []int{1, 2, 3},
+			expectedTruncated: true,
+		},
+		{
+			name:              "max items at a page boundary",
+			mockedClient:      mock.NewMockedHTTPClient(pages),
+			maxItems:          4,
+			expectedNumbers:   []int{1, 2, 3, 4},
+			expectedTruncated: true,
+		},
+		{
+			name:            "cancelled context",
+			mockedClient:    mock.NewMockedHTTPClient(pages),
+			maxItems:        100,
+			cancel:          true,
+			expectError:     true,
+			expectedNumbers: []int{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if tc.cancel {
+				cancel()
+			}
+
+			opts := &github.IssueListByRepoOptions{}
+			issues, truncated, err := fetchAllPages(ctx, &opts.ListOptions, tc.maxItems, func() ([]*github.Issue, *github.Response, error) {
+				return client.Issues.ListByRepo(ctx, "owner", "repo", opts)
+			})
+
+			if tc.expectError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			numbers := []int{}
+			for _, issue := range issues {
+				numbers = append(numbers, issue.GetNumber())
+			}
+			assert.Equal(t, tc.expectedNumbers, numbers)
+			assert.Equal(t, tc.expectedTruncated, truncated)
+		})
+	}
+}
+
 func TestOptionalPaginationParams(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -640,3 +1017,58 @@ func TestOptionalPaginationParams(t *testing.T) {
 		})
 	}
 }
+
+func Test_PaginateSlice(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5}
+
+	tests := []struct {
+		name        string
+		pagination  PaginationParams
+		expected    []int
+		expectedErr string
+	}{
+		{
+			name:       "first page",
+			pagination: PaginationParams{page: 1, perPage: 2},
+			expected:   []int{1, 2},
+		},
+		{
+			name:       "last partial page",
+			pagination: PaginationParams{page: 3, perPage: 2},
+			expected:   []int{5},
+		},
+		{
+			name:       "page past the end",
+			pagination: PaginationParams{page: 4, perPage: 2},
+			expected:   []int{},
+		},
+		{
+			name:        "negative page",
+			pagination:  PaginationParams{page: -1, perPage: 2},
+			expectedErr: "page must be at least 1",
+		},
+		{
+			name:        "negative page size",
+			pagination:  PaginationParams{page: 1, perPage: -2},
+			expectedErr: "perPage must be between 1 and 100",
+		},
+		{
+			name:        "page size over the maximum",
+			pagination:  PaginationParams{page: 1, perPage: 101},
+			expectedErr: "perPage must be between 1 and 100",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			page, err := paginateSlice(items, tc.pagination)
+
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, page)
+		})
+	}
+}
@@ -0,0 +1,244 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// stargazer is a trimmed down user who starred a repository.
+type stargazer struct {
+	Login     string            `json:"login"`
+	StarredAt *github.Timestamp `json:"starred_at,omitempty"`
+	HTMLURL   string            `json:"html_url"`
+}
+
+// stargazers is a page of the stargazers of a repository, with the total number of stargazers.
+type stargazers struct {
+	TotalCount int         `json:"total_count"`
+	Stargazers []stargazer `json:"stargazers"`
+}
+
+// starredRepository is whether the authenticated user starred a repository.
+type starredRepository struct {
+	Repository string `json:"repository"`
+	Starred    bool   `json:"starred"`
+}
+
+// marshalStarredRepository returns the starred state of owner/repo as a tool result.
+func marshalStarredRepository(owner, repo string, starred bool) (*mcp.CallToolResult, error) {
+	r, err := json.Marshal(starredRepository{
+		Repository: owner + "/" + repo,
+		Starred:    starred,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal response: %w", err)
+	}
+
+	return mcp.NewToolResultText(string(r)), nil
+}
+
+// ListStargazers creates a tool to list the users who starred a repository.
+func ListStargazers(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_stargazers",
+			mcp.WithDescription(t("TOOL_LIST_STARGAZERS_DESCRIPTION", "List the users who starred a repository and when they did, with the total number of stargazers")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			// The total is taken from the repository, so that counting the stargazers does
+			// not take paging through all of them.
+			repository, resp, err := client.Repositories.Get(ctx, owner, repo)
+			if err != nil {
+				if result := apiErrorResult("failed to get repository", err, http.StatusNotFound); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to get repository: %w", err)
+			}
+			_ = resp.Body.Close()
+
+			// ListStargazers requests the starred_at timestamps with the star media type.
+			users, resp, err := client.Activity.ListStargazers(ctx, owner, repo, &github.ListOptions{
+				Page:    pagination.page,
+				PerPage: pagination.perPage,
+			})
+			if err != nil {
+				if result := apiErrorResult("failed to list stargazers", err, http.StatusNotFound, http.StatusUnprocessableEntity); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to list stargazers: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			result := stargazers{
+				TotalCount: repository.GetStargazersCount(),
+				Stargazers: make([]stargazer, 0, len(users)),
+			}
+			for _, user := range users {
+				result.Stargazers = append(result.Stargazers, stargazer{
+					Login:     user.GetUser().GetLogin(),
+					StarredAt: user.StarredAt,
+					HTMLURL:   user.GetUser().GetHTMLURL(),
+				})
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// IsRepositoryStarred creates a tool to check whether the authenticated user starred a repository.
+func IsRepositoryStarred(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("is_repository_starred",
+			mcp.WithDescription(t("TOOL_IS_REPOSITORY_STARRED_DESCRIPTION", "Check whether the authenticated user starred a repository")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			// GitHub answers 204 for starred repositories and 404 otherwise, which
+			// IsStarred turns into false rather than an error.
+			starred, resp, err := client.Activity.IsStarred(ctx, owner, repo)
+			if err != nil {
+				if result := apiErrorResult("failed to check star", err, http.StatusForbidden); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to check star: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return marshalStarredRepository(owner, repo, starred)
+		}
+}
+
+// StarRepository creates a tool to star a repository as the authenticated user.
+func StarRepository(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("star_repository",
+			mcp.WithDescription(t("TOOL_STAR_REPOSITORY_DESCRIPTION", "Star a repository as the authenticated user. Starring a repository twice has no effect")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			resp, err := client.Activity.Star(ctx, owner, repo)
+			if err != nil {
+				if result := apiErrorResult("failed to star repository", err, http.StatusNotFound, http.StatusForbidden); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to star repository: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return marshalStarredRepository(owner, repo, true)
+		}
+}
+
+// UnstarRepository creates a tool to unstar a repository as the authenticated user.
+func UnstarRepository(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("unstar_repository",
+			mcp.WithDescription(t("TOOL_UNSTAR_REPOSITORY_DESCRIPTION", "Unstar a repository as the authenticated user. Unstarring a repository that is not starred has no effect")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			resp, err := client.Activity.Unstar(ctx, owner, repo)
+			if err != nil {
+				if result := apiErrorResult("failed to unstar repository", err, http.StatusNotFound, http.StatusForbidden); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to unstar repository: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return marshalStarredRepository(owner, repo, false)
+		}
+}
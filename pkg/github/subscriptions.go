@@ -0,0 +1,176 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// repositorySubscription is how the authenticated user watches a repository. Ignored
+// repositories are not subscribed to, and send no notifications at all.
+type repositorySubscription struct {
+	Repository string            `json:"repository"`
+	Subscribed bool              `json:"subscribed"`
+	Ignored    bool              `json:"ignored"`
+	Reason     string            `json:"reason,omitempty"`
+	CreatedAt  *github.Timestamp `json:"created_at,omitempty"`
+}
+
+// marshalRepositorySubscription returns the subscription to owner/repo as a tool result.
+// A nil subscription means the repository is not watched.
+func marshalRepositorySubscription(owner, repo string, subscription *github.Subscription) (*mcp.CallToolResult, error) {
+	result := repositorySubscription{Repository: owner + "/" + repo}
+	if subscription != nil {
+		result.Subscribed = subscription.GetSubscribed()
+		result.Ignored = subscription.GetIgnored()
+		result.Reason = subscription.GetReason()
+		result.CreatedAt = subscription.CreatedAt
+	}
+
+	r, err := json.Marshal(result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal response: %w", err)
+	}
+
+	return mcp.NewToolResultText(string(r)), nil
+}
+
+// GetRepositorySubscription creates a tool to get how the authenticated user watches a repository.
+func GetRepositorySubscription(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_repository_subscription",
+			mcp.WithDescription(t("TOOL_GET_REPOSITORY_SUBSCRIPTION_DESCRIPTION", "Get whether the authenticated user watches a repository, or ignores its notifications")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			// GitHub answers 404 for repositories that are not watched, for which
+			// GetRepositorySubscription returns a nil subscription rather than an error.
+			subscription, resp, err := client.Activity.GetRepositorySubscription(ctx, owner, repo)
+			if err != nil {
+				if result := apiErrorResult("failed to get subscription", err, http.StatusForbidden); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to get subscription: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return marshalRepositorySubscription(owner, repo, subscription)
+		}
+}
+
+// WatchRepository creates a tool to watch a repository, or ignore its notifications, as the
+// authenticated user.
+func WatchRepository(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("watch_repository",
+			mcp.WithDescription(t("TOOL_WATCH_REPOSITORY_DESCRIPTION", "Watch a repository as the authenticated user to be notified of all its activity, or ignore it to mute all its notifications")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithBoolean("ignored",
+				mcp.Description("Ignore the repository instead, muting all its notifications"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ignored, err := OptionalParam[bool](request, "ignored")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			subscription, resp, err := client.Activity.SetRepositorySubscription(ctx, owner, repo, &github.Subscription{
+				Subscribed: github.Ptr(!ignored),
+				Ignored:    github.Ptr(ignored),
+			})
+			if err != nil {
+				if result := apiErrorResult("failed to watch repository", err, http.StatusNotFound, http.StatusForbidden); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to watch repository: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			return marshalRepositorySubscription(owner, repo, subscription)
+		}
+}
+
+// UnwatchRepository creates a tool to stop watching, or ignoring, a repository as the authenticated user.
+func UnwatchRepository(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("unwatch_repository",
+			mcp.WithDescription(t("TOOL_UNWATCH_REPOSITORY_DESCRIPTION", "Stop watching, or ignoring, a repository as the authenticated user. Notifications of threads the user takes part in are still sent")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			resp, err := client.Activity.DeleteRepositorySubscription(ctx, owner, repo)
+			if err != nil {
+				if result := apiErrorResult("failed to unwatch repository", err, http.StatusNotFound, http.StatusForbidden); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("failed to unwatch repository: %w", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			// Without a subscription, the repository is neither watched nor ignored.
+			return marshalRepositorySubscription(owner, repo, nil)
+		}
+}
@@ -0,0 +1,274 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetRepositorySubscription(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetRepositorySubscription(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_repository_subscription", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	created := &github.Timestamp{Time: time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)}
+
+	tests := []struct {
+		name                 string
+		mockedClient         *http.Client
+		expectedSubscription repositorySubscription
+		expectedErrMsg       string
+	}{
+		{
+			name: "watched",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposSubscriptionByOwnerByRepo,
+					&github.Subscription{
+						Subscribed: github.Ptr(true),
+						Ignored:    github.Ptr(false),
+						CreatedAt:  created,
+					},
+				),
+			),
+			expectedSubscription: repositorySubscription{
+				Repository: "owner/repo",
+				Subscribed: true,
+				CreatedAt:  created,
+			},
+		},
+		{
+			name: "ignored",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetReposSubscriptionByOwnerByRepo,
+					&github.Subscription{
+						Subscribed: github.Ptr(false),
+						Ignored:    github.Ptr(true),
+						CreatedAt:  created,
+					},
+				),
+			),
+			expectedSubscription: repositorySubscription{
+				Repository: "owner/repo",
+				Ignored:    true,
+				CreatedAt:  created,
+			},
+		},
+		{
+			name: "not watched",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposSubscriptionByOwnerByRepo,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			expectedSubscription: repositorySubscription{
+				Repository: "owner/repo",
+			},
+		},
+		{
+			name: "forbidden",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposSubscriptionByOwnerByRepo,
+					mockResponse(t, http.StatusForbidden, map[string]string{"message": "Resource not accessible by integration"}),
+				),
+			),
+			expectedErrMsg: "failed to get subscription: Resource not accessible by integration",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetRepositorySubscription(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			}))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			var returnedSubscription repositorySubscription
+			err = json.Unmarshal([]byte(textContent.Text), &returnedSubscription)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedSubscription, returnedSubscription)
+		})
+	}
+}
+
+func Test_WatchRepository(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := WatchRepository(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "watch_repository", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "ignored")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	created := &github.Timestamp{Time: time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)}
+
+	tests := []struct {
+		name                 string
+		mockedClient         *http.Client
+		requestArgs          map[string]interface{}
+		expectedSubscription repositorySubscription
+		expectedErrMsg       string
+	}{
+		{
+			name: "watch",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposSubscriptionByOwnerByRepo,
+					expectRequestBody(t, map[string]any{
+						"subscribed": true,
+						"ignored":    false,
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.Subscription{
+							Subscribed: github.Ptr(true),
+							Ignored:    github.Ptr(false),
+							CreatedAt:  created,
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectedSubscription: repositorySubscription{
+				Repository: "owner/repo",
+				Subscribed: true,
+				CreatedAt:  created,
+			},
+		},
+		{
+			name: "ignore",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposSubscriptionByOwnerByRepo,
+					expectRequestBody(t, map[string]any{
+						"subscribed": false,
+						"ignored":    true,
+					}).andThen(
+						mockResponse(t, http.StatusOK, &github.Subscription{
+							Subscribed: github.Ptr(false),
+							Ignored:    github.Ptr(true),
+							CreatedAt:  created,
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"ignored": true,
+			},
+			expectedSubscription: repositorySubscription{
+				Repository: "owner/repo",
+				Subscribed: false,
+				Ignored:    true,
+				CreatedAt:  created,
+			},
+		},
+		{
+			name: "repository not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PutReposSubscriptionByOwnerByRepo,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "missing",
+			},
+			expectedErrMsg: "failed to watch repository: Not Found",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := WatchRepository(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			var returnedSubscription repositorySubscription
+			err = json.Unmarshal([]byte(textContent.Text), &returnedSubscription)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedSubscription, returnedSubscription)
+		})
+	}
+}
+
+func Test_UnwatchRepository(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := UnwatchRepository(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "unwatch_repository", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	mockedClient := mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.DeleteReposSubscriptionByOwnerByRepo,
+			http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusNoContent)
+			}),
+		),
+	)
+
+	client := github.NewClient(mockedClient)
+	_, handler := UnwatchRepository(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner": "owner",
+		"repo":  "repo",
+	}))
+	require.NoError(t, err)
+
+	textContent := getTextResult(t, result)
+	assert.False(t, result.IsError)
+	assert.Equal(t, `{"repository":"owner/repo","subscribed":false,"ignored":false}`, textContent.Text)
+}
@@ -0,0 +1,243 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// trafficMetrics are the traffic metrics of a repository, each fetched from its own endpoint.
+var trafficMetrics = []string{"views", "clones", "referrers", "paths"}
+
+// repositoryTraffic is the traffic of a repository over the last 14 days, holding the
+// requested metrics only.
+type repositoryTraffic struct {
+	Views     *trafficSeries  `json:"views,omitempty"`
+	Clones    *trafficSeries  `json:"clones,omitempty"`
+	Referrers *trafficSources `json:"referrers,omitempty"`
+	Paths     *trafficSources `json:"paths,omitempty"`
+}
+
+// trafficSeries is the number of views or clones per day or week, with the totals over the
+// whole period. Visitors seen on several days count once in the total uniques.
+type trafficSeries struct {
+	Count   int            `json:"count"`
+	Uniques int            `json:"uniques"`
+	Per     string         `json:"per"`
+	Points  []trafficPoint `json:"points"`
+}
+
+// trafficPoint is the number of views or clones of a day or week.
+type trafficPoint struct {
+	Timestamp *github.Timestamp `json:"timestamp"`
+	Count     int               `json:"count"`
+	Uniques   int               `json:"uniques"`
+}
+
+// trafficSources are the top referrers or paths, with totals computed over them. A visitor
+// coming from several referrers, or visiting several paths, is counted once for each.
+type trafficSources struct {
+	Count   int             `json:"count"`
+	Uniques int             `json:"uniques"`
+	Items   []trafficSource `json:"items"`
+}
+
+// trafficSource is a referrer or a path with its number of views.
+type trafficSource struct {
+	Referrer string `json:"referrer,omitempty"`
+	Path     string `json:"path,omitempty"`
+	Title    string `json:"title,omitempty"`
+	Count    int    `json:"count"`
+	Uniques  int    `json:"uniques"`
+}
+
+// newTrafficSeries converts the views or clones data points into a trafficSeries.
+func newTrafficSeries(count, uniques int, per string, data []*github.TrafficData) *trafficSeries {
+	series := &trafficSeries{
+		Count:   count,
+		Uniques: uniques,
+		Per:     per,
+		Points:  make([]trafficPoint, 0, len(data)),
+	}
+	for _, point := range data {
+		series.Points = append(series.Points, trafficPoint{
+			Timestamp: point.Timestamp,
+			Count:     point.GetCount(),
+			Uniques:   point.GetUniques(),
+		})
+	}
+	return series
+}
+
+// newTrafficSources sums up the views of the sources into trafficSources.
+func newTrafficSources(items []trafficSource) *trafficSources {
+	sources := &trafficSources{Items: items}
+	for _, item := range items {
+		sources.Count += item.Count
+		sources.Uniques += item.Uniques
+	}
+	return sources
+}
+
+// fetchTrafficMetric fetches a traffic metric of a repository and stores it in traffic.
+func fetchTrafficMetric(ctx context.Context, client *github.Client, owner, repo, metric, per string, traffic *repositoryTraffic) error {
+	var resp *github.Response
+	var err error
+	switch metric {
+	case "views":
+		var views *github.TrafficViews
+		views, resp, err = client.Repositories.ListTrafficViews(ctx, owner, repo, &github.TrafficBreakdownOptions{Per: per})
+		if err == nil {
+			traffic.Views = newTrafficSeries(views.GetCount(), views.GetUniques(), per, views.Views)
+		}
+	case "clones":
+		var clones *github.TrafficClones
+		clones, resp, err = client.Repositories.ListTrafficClones(ctx, owner, repo, &github.TrafficBreakdownOptions{Per: per})
+		if err == nil {
+			traffic.Clones = newTrafficSeries(clones.GetCount(), clones.GetUniques(), per, clones.Clones)
+		}
+	case "referrers":
+		var referrers []*github.TrafficReferrer
+		referrers, resp, err = client.Repositories.ListTrafficReferrers(ctx, owner, repo)
+		if err == nil {
+			items := make([]trafficSource, 0, len(referrers))
+			for _, referrer := range referrers {
+				items = append(items, trafficSource{
+					Referrer: referrer.GetReferrer(),
+					Count:    referrer.GetCount(),
+					Uniques:  referrer.GetUniques(),
+				})
+			}
+			traffic.Referrers = newTrafficSources(items)
+		}
+	case "paths":
+		var paths []*github.TrafficPath
+		paths, resp, err = client.Repositories.ListTrafficPaths(ctx, owner, repo)
+		if err == nil {
+			items := make([]trafficSource, 0, len(paths))
+			for _, path := range paths {
+				items = append(items, trafficSource{
+					Path:    path.GetPath(),
+					Title:   path.GetTitle(),
+					Count:   path.GetCount(),
+					Uniques: path.GetUniques(),
+				})
+			}
+			traffic.Paths = newTrafficSources(items)
+		}
+	}
+	if resp != nil {
+		_ = resp.Body.Close()
+	}
+	return err
+}
+
+// GetRepositoryTraffic creates a tool to get the traffic of a repository over the last 14 days.
+func GetRepositoryTraffic(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_repository_traffic",
+			mcp.WithDescription(t("TOOL_GET_REPOSITORY_TRAFFIC_DESCRIPTION", "Get the traffic of a repository over the last 14 days: views, clones, top referrers and top paths. Requires push access to the repository")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithArray("metrics",
+				mcp.Description("Metrics to get, defaults to all of them"),
+				mcp.Items(
+					map[string]interface{}{
+						"type": "string",
+						"enum": trafficMetrics,
+					},
+				),
+			),
+			mcp.WithString("per",
+				mcp.Description("Period of the views and clones data points, defaults to day"),
+				mcp.Enum("day", "week"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := requiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := requiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			metrics, err := OptionalStringArrayParam(request, "metrics")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			for _, metric := range metrics {
+				if !slices.Contains(trafficMetrics, metric) {
+					return mcp.NewToolResultError(fmt.Sprintf("invalid metric %q: must be one of views, clones, referrers, paths", metric)), nil
+				}
+			}
+			if len(metrics) == 0 {
+				metrics = trafficMetrics
+			}
+			per, err := OptionalParam[string](request, "per")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if per == "" {
+				per = "day"
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			// Every metric is stored in its own field of traffic, so the requests can run
+			// concurrently without locking.
+			var traffic repositoryTraffic
+			errs := make([]error, len(trafficMetrics))
+			var wg sync.WaitGroup
+			for i, metric := range trafficMetrics {
+				if !slices.Contains(metrics, metric) {
+					continue
+				}
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					errs[i] = fetchTrafficMetric(ctx, client, owner, repo, metric, per, &traffic)
+				}()
+			}
+			wg.Wait()
+
+			for i, err := range errs {
+				if err == nil {
+					continue
+				}
+				message := fmt.Sprintf("failed to get %s traffic", trafficMetrics[i])
+				var errorResponse *github.ErrorResponse
+				if errors.As(err, &errorResponse) && errorResponse.Response != nil && errorResponse.Response.StatusCode == http.StatusForbidden {
+					return mcp.NewToolResultError(fmt.Sprintf("%s: push access to %s/%s is required to view its traffic (%s)", message, owner, repo, errorResponse.Message)), nil
+				}
+				if result := apiErrorResult(message, err, http.StatusNotFound); result != nil {
+					return result, nil
+				}
+				return nil, fmt.Errorf("%s: %w", message, err)
+			}
+
+			r, err := json.Marshal(traffic)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
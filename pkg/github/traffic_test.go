@@ -0,0 +1,209 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v69/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetRepositoryTraffic(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := GetRepositoryTraffic(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+
+	assert.Equal(t, "get_repository_traffic", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "metrics")
+	assert.Contains(t, tool.InputSchema.Properties, "per")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	day1 := &github.Timestamp{Time: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)}
+	day2 := &github.Timestamp{Time: time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC)}
+	week := &github.Timestamp{Time: time.Date(2024, 4, 29, 0, 0, 0, 0, time.UTC)}
+
+	mockViews := mock.WithRequestMatchHandler(
+		mock.GetReposTrafficViewsByOwnerByRepo,
+		expectQueryParams(t, map[string]string{"per": "day"}).andThen(
+			mockResponse(t, http.StatusOK, &github.TrafficViews{
+				Count:   github.Ptr(30),
+				Uniques: github.Ptr(8),
+				Views: []*github.TrafficData{
+					{Timestamp: day1, Count: github.Ptr(20), Uniques: github.Ptr(5)},
+					{Timestamp: day2, Count: github.Ptr(10), Uniques: github.Ptr(4)},
+				},
+			}),
+		),
+	)
+	mockClones := mock.WithRequestMatchHandler(
+		mock.GetReposTrafficClonesByOwnerByRepo,
+		expectQueryParams(t, map[string]string{"per": "day"}).andThen(
+			mockResponse(t, http.StatusOK, &github.TrafficClones{
+				Count:   github.Ptr(3),
+				Uniques: github.Ptr(2),
+				Clones: []*github.TrafficData{
+					{Timestamp: day2, Count: github.Ptr(3), Uniques: github.Ptr(2)},
+				},
+			}),
+		),
+	)
+	mockReferrers := mock.WithRequestMatch(
+		mock.GetReposTrafficPopularReferrersByOwnerByRepo,
+		[]*github.TrafficReferrer{
+			{Referrer: github.Ptr("github.com"), Count: github.Ptr(12), Uniques: github.Ptr(4)},
+			{Referrer: github.Ptr("news.ycombinator.com"), Count: github.Ptr(6), Uniques: github.Ptr(3)},
+		},
+	)
+	mockPaths := mock.WithRequestMatch(
+		mock.GetReposTrafficPopularPathsByOwnerByRepo,
+		[]*github.TrafficPath{
+			{Path: github.Ptr("/owner/repo"), Title: github.Ptr("owner/repo: A repository"), Count: github.Ptr(25), Uniques: github.Ptr(7)},
+			{Path: github.Ptr("/owner/repo/issues"), Title: github.Ptr("Issues"), Count: github.Ptr(5), Uniques: github.Ptr(2)},
+		},
+	)
+
+	tests := []struct {
+		name            string
+		mockedClient    *http.Client
+		requestArgs     map[string]interface{}
+		expectedTraffic repositoryTraffic
+		expectedErrMsg  string
+	}{
+		{
+			name:         "all metrics",
+			mockedClient: mock.NewMockedHTTPClient(mockViews, mockClones, mockReferrers, mockPaths),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectedTraffic: repositoryTraffic{
+				Views: &trafficSeries{
+					Count:   30,
+					Uniques: 8,
+					Per:     "day",
+					Points: []trafficPoint{
+						{Timestamp: day1, Count: 20, Uniques: 5},
+						{Timestamp: day2, Count: 10, Uniques: 4},
+					},
+				},
+				Clones: &trafficSeries{
+					Count:   3,
+					Uniques: 2,
+					Per:     "day",
+					Points: []trafficPoint{
+						{Timestamp: day2, Count: 3, Uniques: 2},
+					},
+				},
+				Referrers: &trafficSources{
+					Count:   18,
+					Uniques: 7,
+					Items: []trafficSource{
+						{Referrer: "github.com", Count: 12, Uniques: 4},
+						{Referrer: "news.ycombinator.com", Count: 6, Uniques: 3},
+					},
+				},
+				Paths: &trafficSources{
+					Count:   30,
+					Uniques: 9,
+					Items: []trafficSource{
+						{Path: "/owner/repo", Title: "owner/repo: A repository", Count: 25, Uniques: 7},
+						{Path: "/owner/repo/issues", Title: "Issues", Count: 5, Uniques: 2},
+					},
+				},
+			},
+		},
+		{
+			name: "weekly views only",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposTrafficViewsByOwnerByRepo,
+					expectQueryParams(t, map[string]string{"per": "week"}).andThen(
+						mockResponse(t, http.StatusOK, &github.TrafficViews{
+							Count:   github.Ptr(30),
+							Uniques: github.Ptr(8),
+							Views: []*github.TrafficData{
+								{Timestamp: week, Count: github.Ptr(30), Uniques: github.Ptr(8)},
+							},
+						}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"metrics": []interface{}{"views"},
+				"per":     "week",
+			},
+			expectedTraffic: repositoryTraffic{
+				Views: &trafficSeries{
+					Count:   30,
+					Uniques: 8,
+					Per:     "week",
+					Points: []trafficPoint{
+						{Timestamp: week, Count: 30, Uniques: 8},
+					},
+				},
+			},
+		},
+		{
+			name: "no push access",
+			mockedClient: mock.NewMockedHTTPClient(
+				mockViews,
+				mockClones,
+				mock.WithRequestMatchHandler(
+					mock.GetReposTrafficPopularReferrersByOwnerByRepo,
+					mockResponse(t, http.StatusForbidden, map[string]string{"message": "Must have push access to repository"}),
+				),
+				mockPaths,
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectedErrMsg: "failed to get referrers traffic: push access to owner/repo is required to view its traffic (Must have push access to repository)",
+		},
+		{
+			name:         "invalid metric",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"metrics": []interface{}{"views", "stars"},
+			},
+			expectedErrMsg: `invalid metric "stars": must be one of views, clones, referrers, paths`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup client with mock
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetRepositoryTraffic(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			// Call handler
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			textContent := getTextResult(t, result)
+			if tc.expectedErrMsg != "" {
+				assert.True(t, result.IsError)
+				assert.Equal(t, tc.expectedErrMsg, textContent.Text)
+				return
+			}
+
+			var returnedTraffic repositoryTraffic
+			err = json.Unmarshal([]byte(textContent.Text), &returnedTraffic)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedTraffic, returnedTraffic)
+		})
+	}
+}